@@ -0,0 +1,10 @@
+-- ClickHouse Analytics Service - UTM campaign columns
+-- Records the UTM values actually sent to the destination for campaign analytics
+-- (single statement so it can be posted to the HTTP interface as-is)
+
+ALTER TABLE analytics.click_analytics
+    ADD COLUMN IF NOT EXISTS utm_source String DEFAULT '',
+    ADD COLUMN IF NOT EXISTS utm_medium String DEFAULT '',
+    ADD COLUMN IF NOT EXISTS utm_campaign String DEFAULT '',
+    ADD COLUMN IF NOT EXISTS utm_term String DEFAULT '',
+    ADD COLUMN IF NOT EXISTS utm_content String DEFAULT '';
@@ -0,0 +1,7 @@
+-- ClickHouse Analytics Service - click source column
+-- Records the ?src= marker of a click (e.g. "qr" for QR code scans) so scans
+-- can be told apart from other clicks
+-- (single statement so it can be posted to the HTTP interface as-is)
+
+ALTER TABLE analytics.click_analytics
+    ADD COLUMN IF NOT EXISTS source LowCardinality(String) DEFAULT '';
@@ -0,0 +1,8 @@
+-- ClickHouse Analytics Service - fraud scoring columns
+-- Records the fraud score of each click and whether it crossed the suspicious
+-- score, so stats can leave out bot and click-farm traffic
+-- (single statement so it can be posted to the HTTP interface as-is)
+
+ALTER TABLE analytics.click_analytics
+    ADD COLUMN IF NOT EXISTS fraud_score UInt8 DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS is_suspicious UInt8 DEFAULT 0;
@@ -0,0 +1,10 @@
+-- Rollback URL Shortener Service - UTM Templates
+
+DROP INDEX IF EXISTS idx_url_mappings_workspace_id;
+
+ALTER TABLE url_mappings DROP COLUMN IF EXISTS utm_template;
+ALTER TABLE url_mappings DROP COLUMN IF EXISTS workspace_id;
+
+DROP TRIGGER IF EXISTS update_workspaces_updated_at ON workspaces;
+DROP INDEX IF EXISTS idx_workspaces_owner_id;
+DROP TABLE IF EXISTS workspaces CASCADE;
@@ -0,0 +1,25 @@
+-- URL Shortener Service - UTM Templates
+-- Adds workspaces with a default UTM template and per-link UTM template overrides
+
+-- Workspaces group links and carry shared settings
+CREATE TABLE workspaces (
+    id VARCHAR(50) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    owner_id VARCHAR(50) NOT NULL,
+    utm_template JSONB DEFAULT '{}'::jsonb,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_workspaces_owner_id ON workspaces(owner_id);
+
+CREATE TRIGGER update_workspaces_updated_at
+    BEFORE UPDATE ON workspaces
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Link-level workspace membership and UTM template
+ALTER TABLE url_mappings ADD COLUMN workspace_id VARCHAR(50) REFERENCES workspaces(id) ON DELETE SET NULL;
+ALTER TABLE url_mappings ADD COLUMN utm_template JSONB DEFAULT '{}'::jsonb;
+
+CREATE INDEX idx_url_mappings_workspace_id ON url_mappings(workspace_id) WHERE workspace_id IS NOT NULL;
@@ -0,0 +1,3 @@
+-- Rollback URL Shortener Service - Password-protected links
+
+ALTER TABLE url_mappings DROP COLUMN IF EXISTS password_hash;
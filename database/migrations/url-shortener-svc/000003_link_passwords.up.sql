@@ -0,0 +1,4 @@
+-- URL Shortener Service - Password-protected links
+-- Stores a bcrypt hash for links that require a password before redirecting
+
+ALTER TABLE url_mappings ADD COLUMN password_hash VARCHAR(255);
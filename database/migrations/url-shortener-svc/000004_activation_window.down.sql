@@ -0,0 +1,4 @@
+-- Rollback URL Shortener Service - Scheduled activation window
+
+ALTER TABLE url_mappings DROP COLUMN IF EXISTS fallback_url;
+ALTER TABLE url_mappings DROP COLUMN IF EXISTS activates_at;
@@ -0,0 +1,5 @@
+-- URL Shortener Service - Scheduled activation window
+-- Links stay dark until activates_at; visitors may be sent to fallback_url meanwhile
+
+ALTER TABLE url_mappings ADD COLUMN activates_at TIMESTAMPTZ;
+ALTER TABLE url_mappings ADD COLUMN fallback_url TEXT;
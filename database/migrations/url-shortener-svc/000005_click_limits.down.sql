@@ -0,0 +1,3 @@
+-- Rollback URL Shortener Service - Click-limited links
+
+ALTER TABLE url_mappings DROP COLUMN IF EXISTS max_clicks;
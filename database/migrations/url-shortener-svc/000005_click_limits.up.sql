@@ -0,0 +1,4 @@
+-- URL Shortener Service - Click-limited links
+-- A link with max_clicks stops redirecting once click_count reaches it (NULL = unlimited)
+
+ALTER TABLE url_mappings ADD COLUMN max_clicks BIGINT CHECK (max_clicks > 0);
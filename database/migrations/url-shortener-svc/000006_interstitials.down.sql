@@ -0,0 +1,5 @@
+-- Rollback URL Shortener Service - Interstitial warning pages
+
+DROP INDEX IF EXISTS idx_url_mappings_user_created;
+DROP TABLE IF EXISTS domain_reviews;
+ALTER TABLE url_mappings DROP COLUMN IF EXISTS interstitial_mode;
@@ -0,0 +1,18 @@
+-- URL Shortener Service - Interstitial warning pages
+-- interstitial_mode: auto (decided by domain review and account trust), always, never
+-- domain_reviews: admin review list; status 'review' forces the interstitial, 'trusted' skips it
+
+ALTER TABLE url_mappings ADD COLUMN interstitial_mode VARCHAR(10) NOT NULL DEFAULT 'auto'
+    CHECK (interstitial_mode IN ('auto', 'always', 'never'));
+
+CREATE TABLE domain_reviews (
+    domain VARCHAR(255) PRIMARY KEY,
+    status VARCHAR(10) NOT NULL CHECK (status IN ('review', 'trusted')),
+    reason TEXT,
+    updated_by VARCHAR(50),
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- Account age lookups (first link per user) for the untrusted-account rule
+CREATE INDEX IF NOT EXISTS idx_url_mappings_user_created ON url_mappings(user_id, created_at DESC);
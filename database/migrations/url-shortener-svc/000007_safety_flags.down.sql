@@ -0,0 +1,5 @@
+-- Rollback URL Shortener Service - Destination safety flags
+
+DROP INDEX IF EXISTS idx_url_mappings_disabled;
+ALTER TABLE url_mappings DROP COLUMN IF EXISTS disabled_at;
+ALTER TABLE url_mappings DROP COLUMN IF EXISTS disabled_reason;
@@ -0,0 +1,7 @@
+-- URL Shortener Service - Destination safety flags
+-- Links flagged by the safety engine are deactivated and keep the reason code for admins
+
+ALTER TABLE url_mappings ADD COLUMN disabled_reason VARCHAR(50);
+ALTER TABLE url_mappings ADD COLUMN disabled_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_url_mappings_disabled ON url_mappings(disabled_at DESC) WHERE disabled_reason IS NOT NULL;
@@ -0,0 +1,4 @@
+-- Rollback URL Shortener Service - Link health monitor
+
+DROP INDEX IF EXISTS idx_link_health_broken;
+DROP TABLE IF EXISTS link_health;
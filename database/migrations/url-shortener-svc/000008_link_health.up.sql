@@ -0,0 +1,16 @@
+-- URL Shortener Service - Link health monitor
+-- Latest result of the background destination check for each link
+
+CREATE TABLE IF NOT EXISTS link_health (
+    short_code VARCHAR(10) PRIMARY KEY REFERENCES url_mappings(short_code) ON DELETE CASCADE,
+    status VARCHAR(10) NOT NULL, -- healthy, failing or broken
+    status_code INTEGER NOT NULL DEFAULT 0,
+    latency_ms BIGINT NOT NULL DEFAULT 0,
+    redirect_chain JSONB NOT NULL DEFAULT '[]'::jsonb,
+    error TEXT,
+    consecutive_failures INTEGER NOT NULL DEFAULT 0,
+    checked_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_healthy_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_link_health_broken ON link_health(checked_at DESC) WHERE status = 'broken';
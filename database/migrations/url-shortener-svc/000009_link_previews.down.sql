@@ -0,0 +1,4 @@
+-- Rollback URL Shortener Service - Link preview metadata
+
+ALTER TABLE url_mappings DROP COLUMN IF EXISTS preview_override;
+ALTER TABLE url_mappings DROP COLUMN IF EXISTS link_preview;
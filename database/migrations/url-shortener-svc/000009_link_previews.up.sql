@@ -0,0 +1,5 @@
+-- URL Shortener Service - Link preview metadata
+-- Open Graph / Twitter card data scraped from the destination, and owner overrides
+
+ALTER TABLE url_mappings ADD COLUMN link_preview JSONB NOT NULL DEFAULT '{}'::jsonb;
+ALTER TABLE url_mappings ADD COLUMN preview_override JSONB NOT NULL DEFAULT '{}'::jsonb;
@@ -0,0 +1,18 @@
+-- Rollback URL Shortener Service - Branded short domains
+-- Fails if the same short code exists on several domains; remove those links first
+
+ALTER TABLE link_health DROP CONSTRAINT IF EXISTS link_health_domain_short_code_fkey;
+ALTER TABLE link_health DROP CONSTRAINT IF EXISTS link_health_pkey;
+
+ALTER TABLE url_mappings DROP CONSTRAINT IF EXISTS url_mappings_domain_short_code_key;
+ALTER TABLE url_mappings ADD CONSTRAINT url_mappings_short_code_key UNIQUE (short_code);
+
+ALTER TABLE link_health DROP COLUMN IF EXISTS domain;
+ALTER TABLE link_health ADD PRIMARY KEY (short_code);
+ALTER TABLE link_health ADD CONSTRAINT link_health_short_code_fkey
+    FOREIGN KEY (short_code) REFERENCES url_mappings(short_code) ON DELETE CASCADE;
+
+ALTER TABLE url_mappings DROP COLUMN IF EXISTS domain;
+
+DROP INDEX IF EXISTS idx_branded_domains_workspace_id;
+DROP TABLE IF EXISTS branded_domains;
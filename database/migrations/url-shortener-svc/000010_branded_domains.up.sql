@@ -0,0 +1,26 @@
+-- URL Shortener Service - Branded short domains
+-- Workspaces can attach their own short domains; a short code is now unique per
+-- domain ('' is the default short domain) so the same code can exist on several domains
+
+CREATE TABLE IF NOT EXISTS branded_domains (
+    domain VARCHAR(253) PRIMARY KEY,
+    workspace_id VARCHAR(50) NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    created_by VARCHAR(50) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_branded_domains_workspace_id ON branded_domains(workspace_id);
+
+ALTER TABLE url_mappings ADD COLUMN domain VARCHAR(253) NOT NULL DEFAULT '';
+ALTER TABLE link_health ADD COLUMN domain VARCHAR(253) NOT NULL DEFAULT '';
+
+-- link_health references the old single-column key, so move it first
+ALTER TABLE link_health DROP CONSTRAINT IF EXISTS link_health_short_code_fkey;
+ALTER TABLE link_health DROP CONSTRAINT IF EXISTS link_health_pkey;
+
+ALTER TABLE url_mappings DROP CONSTRAINT IF EXISTS url_mappings_short_code_key;
+ALTER TABLE url_mappings ADD CONSTRAINT url_mappings_domain_short_code_key UNIQUE (domain, short_code);
+
+ALTER TABLE link_health ADD PRIMARY KEY (domain, short_code);
+ALTER TABLE link_health ADD CONSTRAINT link_health_domain_short_code_fkey
+    FOREIGN KEY (domain, short_code) REFERENCES url_mappings(domain, short_code) ON DELETE CASCADE;
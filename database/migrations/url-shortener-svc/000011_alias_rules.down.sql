@@ -0,0 +1,7 @@
+-- Rollback URL Shortener Service - Alias rules per plan
+-- Fails if aliases longer than 10 characters exist; remove those links first
+
+ALTER TABLE link_health ALTER COLUMN short_code TYPE VARCHAR(10);
+ALTER TABLE url_mappings ALTER COLUMN short_code TYPE VARCHAR(10);
+
+ALTER TABLE workspaces DROP COLUMN IF EXISTS plan;
@@ -0,0 +1,8 @@
+-- URL Shortener Service - Alias rules per plan
+-- Workspaces get a plan that decides the allowed custom alias format; paid plans
+-- allow aliases up to 64 characters with - and _
+
+ALTER TABLE workspaces ADD COLUMN plan VARCHAR(32) NOT NULL DEFAULT 'free';
+
+ALTER TABLE url_mappings ALTER COLUMN short_code TYPE VARCHAR(64);
+ALTER TABLE link_health ALTER COLUMN short_code TYPE VARCHAR(64);
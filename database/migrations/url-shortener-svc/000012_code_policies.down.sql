@@ -0,0 +1,5 @@
+-- Rollback URL Shortener Service - Short code policies
+
+DROP INDEX IF EXISTS idx_url_mappings_domain_lower_short_code;
+
+ALTER TABLE branded_domains DROP COLUMN IF EXISTS code_policy;
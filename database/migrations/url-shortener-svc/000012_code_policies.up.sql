@@ -0,0 +1,8 @@
+-- URL Shortener Service - Short code policies
+-- Branded domains can switch to the readable code policy: case-insensitive
+-- lookups with lower-case storage. Existing mixed-case codes keep their stored
+-- spelling and are found through the lower(short_code) index.
+
+ALTER TABLE branded_domains ADD COLUMN code_policy VARCHAR(16) NOT NULL DEFAULT 'mixed';
+
+CREATE INDEX IF NOT EXISTS idx_url_mappings_domain_lower_short_code ON url_mappings(domain, lower(short_code));
@@ -0,0 +1,17 @@
+-- Rollback URL Shortener Service - Tags and folders
+
+DROP INDEX IF EXISTS idx_url_mappings_user_short_code;
+DROP INDEX IF EXISTS idx_url_mappings_user_last_accessed;
+DROP INDEX IF EXISTS idx_url_mappings_user_click_count;
+DROP INDEX IF EXISTS idx_url_mappings_user_created_at;
+DROP INDEX IF EXISTS idx_url_mappings_folder_id;
+
+ALTER TABLE url_mappings DROP COLUMN IF EXISTS folder_id;
+
+DROP INDEX IF EXISTS idx_url_tags_tag_id;
+DROP TABLE IF EXISTS url_tags;
+DROP TABLE IF EXISTS tags;
+
+DROP INDEX IF EXISTS idx_folders_parent_id;
+DROP INDEX IF EXISTS idx_folders_user_parent_name;
+DROP TABLE IF EXISTS folders;
@@ -0,0 +1,43 @@
+-- URL Shortener Service - Tags and folders
+-- Users organize their links with tags (many-to-many) and nested folders (a
+-- link is in at most one folder). Link listings filter by either and sort by
+-- created_at, click_count, last_accessed or alias, so each gets an index.
+
+CREATE TABLE IF NOT EXISTS folders (
+    id BIGSERIAL PRIMARY KEY,
+    user_id VARCHAR(50) NOT NULL,
+    parent_id BIGINT REFERENCES folders(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- Folder names are unique among siblings (top-level folders have no parent)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_folders_user_parent_name ON folders(user_id, COALESCE(parent_id, 0), lower(name));
+CREATE INDEX IF NOT EXISTS idx_folders_parent_id ON folders(parent_id);
+
+CREATE TABLE IF NOT EXISTS tags (
+    id BIGSERIAL PRIMARY KEY,
+    user_id VARCHAR(50) NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE (user_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS url_tags (
+    url_id BIGINT NOT NULL REFERENCES url_mappings(id) ON DELETE CASCADE,
+    tag_id BIGINT NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (url_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_url_tags_tag_id ON url_tags(tag_id);
+
+-- Deleting a folder deletes its subfolders; their links become unfiled
+ALTER TABLE url_mappings ADD COLUMN folder_id BIGINT REFERENCES folders(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_url_mappings_folder_id ON url_mappings(folder_id) WHERE folder_id IS NOT NULL;
+
+-- Listing sort orders, restricted to the active links listings return
+CREATE INDEX IF NOT EXISTS idx_url_mappings_user_created_at ON url_mappings(user_id, created_at, id) WHERE is_active = true;
+CREATE INDEX IF NOT EXISTS idx_url_mappings_user_click_count ON url_mappings(user_id, click_count, id) WHERE is_active = true;
+CREATE INDEX IF NOT EXISTS idx_url_mappings_user_last_accessed ON url_mappings(user_id, last_accessed, id) WHERE is_active = true;
+CREATE INDEX IF NOT EXISTS idx_url_mappings_user_short_code ON url_mappings(user_id, short_code, id) WHERE is_active = true;
@@ -0,0 +1,10 @@
+-- Rollback URL Shortener Service - Link search
+
+DROP INDEX IF EXISTS idx_tags_name_trgm;
+DROP INDEX IF EXISTS idx_url_mappings_long_url_trgm;
+DROP INDEX IF EXISTS idx_url_mappings_short_code_trgm;
+DROP INDEX IF EXISTS idx_url_mappings_search_vector;
+
+ALTER TABLE url_mappings DROP COLUMN IF EXISTS search_vector;
+
+DROP EXTENSION IF EXISTS pg_trgm;
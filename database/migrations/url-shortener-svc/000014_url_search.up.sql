@@ -0,0 +1,20 @@
+-- URL Shortener Service - Link search
+-- A weighted full-text document per link (short code and title first, then the
+-- destination's host and path, then metadata values) for ranked word searches,
+-- and trigram indexes for substring matches on codes, destinations and tags.
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE url_mappings ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', short_code), 'A') ||
+    setweight(to_tsvector('simple', COALESCE(NULLIF(preview_override->>'title', ''), link_preview->>'title', '')), 'A') ||
+    setweight(to_tsvector('simple', regexp_replace(
+        regexp_replace(long_url, '^[a-zA-Z][a-zA-Z0-9+.-]*://|[?#].*$', '', 'g'),
+        '[^[:alnum:]]+', ' ', 'g')), 'B') ||
+    setweight(jsonb_to_tsvector('simple', COALESCE(metadata, '{}'::jsonb), '["string"]'), 'C')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_url_mappings_search_vector ON url_mappings USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_url_mappings_short_code_trgm ON url_mappings USING GIN (short_code gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_url_mappings_long_url_trgm ON url_mappings USING GIN (long_url gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_tags_name_trgm ON tags USING GIN (name gin_trgm_ops);
@@ -0,0 +1,3 @@
+-- Rollback URL Shortener Service - Keyset pagination
+
+DROP INDEX IF EXISTS idx_url_mappings_disabled_at;
@@ -0,0 +1,5 @@
+-- URL Shortener Service - Keyset pagination
+-- Lists page with cursors on (sort value, id) instead of OFFSET. The user link
+-- listing indexes of 000013 already end in id; the flagged link list needs one.
+
+CREATE INDEX IF NOT EXISTS idx_url_mappings_disabled_at ON url_mappings(disabled_at, id) WHERE disabled_reason IS NOT NULL;
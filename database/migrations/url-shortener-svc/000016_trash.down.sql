@@ -0,0 +1,10 @@
+-- Rollback URL Shortener Service - Trash bin
+
+DROP INDEX IF EXISTS idx_retired_short_codes_reclaimable_at;
+DROP INDEX IF EXISTS idx_retired_short_codes_lower;
+DROP TABLE IF EXISTS retired_short_codes;
+
+DROP INDEX IF EXISTS idx_url_mappings_deleted_at;
+DROP INDEX IF EXISTS idx_url_mappings_user_deleted_at;
+
+ALTER TABLE url_mappings DROP COLUMN IF EXISTS deleted_at;
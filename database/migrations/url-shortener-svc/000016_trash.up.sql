@@ -0,0 +1,23 @@
+-- URL Shortener Service - Trash bin
+-- Deleting a link moves it to the trash: it stops resolving but can be restored
+-- until the retention worker purges it. Purged codes that may not be reused yet
+-- are kept in retired_short_codes.
+
+ALTER TABLE url_mappings ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+-- Links deleted before the trash existed are inactive without a safety flag
+UPDATE url_mappings SET deleted_at = updated_at WHERE is_active = false AND disabled_at IS NULL AND deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_url_mappings_user_deleted_at ON url_mappings(user_id, deleted_at, id) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_url_mappings_deleted_at ON url_mappings(deleted_at) WHERE deleted_at IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS retired_short_codes (
+    domain VARCHAR(253) NOT NULL DEFAULT '',
+    short_code VARCHAR(64) NOT NULL,
+    retired_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    reclaimable_at TIMESTAMPTZ, -- NULL: never reused
+    PRIMARY KEY (domain, short_code)
+);
+
+CREATE INDEX IF NOT EXISTS idx_retired_short_codes_lower ON retired_short_codes(domain, lower(short_code));
+CREATE INDEX IF NOT EXISTS idx_retired_short_codes_reclaimable_at ON retired_short_codes(reclaimable_at) WHERE reclaimable_at IS NOT NULL;
@@ -0,0 +1,6 @@
+-- Rollback URL Shortener Service - Expiry sweeper
+
+DROP INDEX IF EXISTS idx_url_mappings_pending_expiry;
+
+ALTER TABLE url_mappings DROP COLUMN IF EXISTS expiry_notified_at;
+ALTER TABLE url_mappings DROP COLUMN IF EXISTS expired_at;
@@ -0,0 +1,10 @@
+-- URL Shortener Service - Expiry sweeper
+-- The sweeper marks links whose expiration passed (expired_at) and records the
+-- "expiring soon" notice it sent (expiry_notified_at). Changing a link's
+-- expiration clears both.
+
+ALTER TABLE url_mappings ADD COLUMN IF NOT EXISTS expired_at TIMESTAMPTZ;
+ALTER TABLE url_mappings ADD COLUMN IF NOT EXISTS expiry_notified_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_url_mappings_pending_expiry ON url_mappings(expires_at)
+    WHERE expires_at IS NOT NULL AND expired_at IS NULL AND is_active = true;
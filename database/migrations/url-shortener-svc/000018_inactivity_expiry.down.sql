@@ -0,0 +1,13 @@
+-- Rollback URL Shortener Service - Inactivity auto-expiry
+
+-- Archived links become active again rather than looking deleted
+UPDATE url_mappings SET is_active = true WHERE archived_at IS NOT NULL AND deleted_at IS NULL AND disabled_at IS NULL;
+
+DROP INDEX IF EXISTS idx_url_mappings_archived_at;
+
+ALTER TABLE url_mappings DROP COLUMN IF EXISTS revived_at;
+ALTER TABLE url_mappings DROP COLUMN IF EXISTS archived_at;
+ALTER TABLE url_mappings DROP COLUMN IF EXISTS inactivity_exempt;
+
+ALTER TABLE workspaces DROP COLUMN IF EXISTS inactivity_dry_run;
+ALTER TABLE workspaces DROP COLUMN IF EXISTS inactivity_days;
@@ -0,0 +1,14 @@
+-- URL Shortener Service - Inactivity auto-expiry
+-- Workspaces can archive links that were not clicked for inactivity_days (0
+-- turns the policy off). In dry run the sweeper only reports those links.
+-- Archived links stop resolving until their owner revives them; reviving
+-- restarts the inactivity clock. Exempt links are never archived.
+
+ALTER TABLE workspaces ADD COLUMN IF NOT EXISTS inactivity_days INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE workspaces ADD COLUMN IF NOT EXISTS inactivity_dry_run BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE url_mappings ADD COLUMN IF NOT EXISTS inactivity_exempt BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE url_mappings ADD COLUMN IF NOT EXISTS archived_at TIMESTAMPTZ;
+ALTER TABLE url_mappings ADD COLUMN IF NOT EXISTS revived_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_url_mappings_archived_at ON url_mappings(archived_at) WHERE archived_at IS NOT NULL;
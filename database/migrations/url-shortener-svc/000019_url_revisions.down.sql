@@ -0,0 +1,5 @@
+-- Rollback URL Shortener Service - Link revision history
+
+DROP TRIGGER IF EXISTS url_revisions_immutable ON url_revisions;
+DROP FUNCTION IF EXISTS reject_url_revision_update();
+DROP TABLE IF EXISTS url_revisions;
@@ -0,0 +1,27 @@
+-- URL Shortener Service - Link revision history
+-- Every change made through UpdateURL is recorded as an immutable revision:
+-- who made it, when, the fields that changed and the link's state before and
+-- after. Rolling back to the state before a revision records a new revision.
+
+CREATE TABLE IF NOT EXISTS url_revisions (
+    id BIGSERIAL PRIMARY KEY,
+    url_id BIGINT NOT NULL REFERENCES url_mappings(id) ON DELETE CASCADE,
+    revision INTEGER NOT NULL, -- 1, 2, ... per link
+    changed_by VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    changes JSONB NOT NULL DEFAULT '[]'::jsonb, -- [{field, old, new}]
+    state_before JSONB NOT NULL,
+    state_after JSONB NOT NULL,
+    rollback_of BIGINT, -- the revision this one rolled back
+    UNIQUE (url_id, revision)
+);
+
+CREATE OR REPLACE FUNCTION reject_url_revision_update() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'url revisions are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS url_revisions_immutable ON url_revisions;
+CREATE TRIGGER url_revisions_immutable BEFORE UPDATE ON url_revisions
+    FOR EACH ROW EXECUTE FUNCTION reject_url_revision_update();
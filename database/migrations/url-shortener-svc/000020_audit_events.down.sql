@@ -0,0 +1,5 @@
+-- Rollback URL Shortener Service - Audit log of administrative actions
+
+DROP TRIGGER IF EXISTS audit_events_append_only ON audit_events;
+DROP FUNCTION IF EXISTS reject_audit_event_change();
+DROP TABLE IF EXISTS audit_events;
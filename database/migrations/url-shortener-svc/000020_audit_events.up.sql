@@ -0,0 +1,34 @@
+-- URL Shortener Service - Audit log of administrative actions
+-- Append-only: who did what to which link, workspace or review, from where,
+-- with the state before and after. Each event carries the hash of the one
+-- before it, so an edited or removed event breaks the chain.
+
+CREATE TABLE IF NOT EXISTS audit_events (
+    id BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL,
+    actor VARCHAR(255) NOT NULL, -- user ID, "admin" or "system"
+    ip VARCHAR(64) NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    request_id VARCHAR(128) NOT NULL DEFAULT '',
+    action VARCHAR(64) NOT NULL, -- url.create, url.update, url.delete, ...
+    target VARCHAR(512) NOT NULL, -- url:abc123, url:go.acme.com/abc123, workspace:marketing, ...
+    before TEXT NOT NULL DEFAULT '', -- JSON, kept as text so the hashed bytes are preserved
+    after TEXT NOT NULL DEFAULT '',
+    prev_hash CHAR(64) NOT NULL,
+    hash CHAR(64) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor ON audit_events(actor, id);
+CREATE INDEX IF NOT EXISTS idx_audit_events_target ON audit_events(target, id);
+CREATE INDEX IF NOT EXISTS idx_audit_events_action ON audit_events(action, id);
+CREATE INDEX IF NOT EXISTS idx_audit_events_request_id ON audit_events(request_id) WHERE request_id <> '';
+
+CREATE OR REPLACE FUNCTION reject_audit_event_change() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit events are append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS audit_events_append_only ON audit_events;
+CREATE TRIGGER audit_events_append_only BEFORE UPDATE OR DELETE ON audit_events
+    FOR EACH ROW EXECUTE FUNCTION reject_audit_event_change();
@@ -0,0 +1,4 @@
+-- Rollback URL Shortener Service - Outbound webhooks
+
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhooks;
@@ -23,22 +23,30 @@ const (
 
 // Click event for processing
 type ClickEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShortCode     string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
-	LongUrl       string                 `protobuf:"bytes,2,opt,name=long_url,json=longUrl,proto3" json:"long_url,omitempty"`
-	ClientIp      string                 `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
-	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
-	Referrer      string                 `protobuf:"bytes,5,opt,name=referrer,proto3" json:"referrer,omitempty"`
-	Country       string                 `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`
-	City          string                 `protobuf:"bytes,7,opt,name=city,proto3" json:"city,omitempty"`
-	DeviceType    string                 `protobuf:"bytes,8,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
-	Browser       string                 `protobuf:"bytes,9,opt,name=browser,proto3" json:"browser,omitempty"`
-	Os            string                 `protobuf:"bytes,10,opt,name=os,proto3" json:"os,omitempty"`
-	Timestamp     int64                  `protobuf:"varint,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	SessionId     string                 `protobuf:"bytes,12,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	IsUnique      bool                   `protobuf:"varint,13,opt,name=is_unique,json=isUnique,proto3" json:"is_unique,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ShortCode      string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
+	LongUrl        string                 `protobuf:"bytes,2,opt,name=long_url,json=longUrl,proto3" json:"long_url,omitempty"`
+	ClientIp       string                 `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	UserAgent      string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Referrer       string                 `protobuf:"bytes,5,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	Country        string                 `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`
+	City           string                 `protobuf:"bytes,7,opt,name=city,proto3" json:"city,omitempty"`
+	DeviceType     string                 `protobuf:"bytes,8,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
+	Browser        string                 `protobuf:"bytes,9,opt,name=browser,proto3" json:"browser,omitempty"`
+	Os             string                 `protobuf:"bytes,10,opt,name=os,proto3" json:"os,omitempty"`
+	Timestamp      int64                  `protobuf:"varint,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	SessionId      string                 `protobuf:"bytes,12,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	IsUnique       bool                   `protobuf:"varint,13,opt,name=is_unique,json=isUnique,proto3" json:"is_unique,omitempty"`
+	UtmSource      string                 `protobuf:"bytes,14,opt,name=utm_source,json=utmSource,proto3" json:"utm_source,omitempty"`
+	UtmMedium      string                 `protobuf:"bytes,15,opt,name=utm_medium,json=utmMedium,proto3" json:"utm_medium,omitempty"`
+	UtmCampaign    string                 `protobuf:"bytes,16,opt,name=utm_campaign,json=utmCampaign,proto3" json:"utm_campaign,omitempty"`
+	UtmTerm        string                 `protobuf:"bytes,17,opt,name=utm_term,json=utmTerm,proto3" json:"utm_term,omitempty"`
+	UtmContent     string                 `protobuf:"bytes,18,opt,name=utm_content,json=utmContent,proto3" json:"utm_content,omitempty"`
+	Source         string                 `protobuf:"bytes,19,opt,name=source,proto3" json:"source,omitempty"`                                       // click source marker, e.g. "qr" for QR code scans
+	AcceptLanguage string                 `protobuf:"bytes,20,opt,name=accept_language,json=acceptLanguage,proto3" json:"accept_language,omitempty"` // Accept-Language header, for fraud scoring
+	Accept         string                 `protobuf:"bytes,21,opt,name=accept,proto3" json:"accept,omitempty"`                                       // Accept header, for fraud scoring
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ClickEvent) Reset() {
@@ -162,6 +170,62 @@ func (x *ClickEvent) GetIsUnique() bool {
 	return false
 }
 
+func (x *ClickEvent) GetUtmSource() string {
+	if x != nil {
+		return x.UtmSource
+	}
+	return ""
+}
+
+func (x *ClickEvent) GetUtmMedium() string {
+	if x != nil {
+		return x.UtmMedium
+	}
+	return ""
+}
+
+func (x *ClickEvent) GetUtmCampaign() string {
+	if x != nil {
+		return x.UtmCampaign
+	}
+	return ""
+}
+
+func (x *ClickEvent) GetUtmTerm() string {
+	if x != nil {
+		return x.UtmTerm
+	}
+	return ""
+}
+
+func (x *ClickEvent) GetUtmContent() string {
+	if x != nil {
+		return x.UtmContent
+	}
+	return ""
+}
+
+func (x *ClickEvent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ClickEvent) GetAcceptLanguage() string {
+	if x != nil {
+		return x.AcceptLanguage
+	}
+	return ""
+}
+
+func (x *ClickEvent) GetAccept() string {
+	if x != nil {
+		return x.Accept
+	}
+	return ""
+}
+
 // Response for click processing
 type ProcessResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -217,13 +281,14 @@ func (x *ProcessResponse) GetError() string {
 
 // Request for URL statistics
 type StatsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShortCode     string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
-	StartTime     int64                  `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       int64                  `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Granularity   string                 `protobuf:"bytes,4,opt,name=granularity,proto3" json:"granularity,omitempty"` // hour, day, week, month
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ShortCode         string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
+	StartTime         int64                  `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime           int64                  `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Granularity       string                 `protobuf:"bytes,4,opt,name=granularity,proto3" json:"granularity,omitempty"`                                       // hour, day, week, month
+	ExcludeSuspicious bool                   `protobuf:"varint,5,opt,name=exclude_suspicious,json=excludeSuspicious,proto3" json:"exclude_suspicious,omitempty"` // leave out clicks flagged by fraud scoring
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *StatsRequest) Reset() {
@@ -284,6 +349,13 @@ func (x *StatsRequest) GetGranularity() string {
 	return ""
 }
 
+func (x *StatsRequest) GetExcludeSuspicious() bool {
+	if x != nil {
+		return x.ExcludeSuspicious
+	}
+	return false
+}
+
 // URL statistics response
 type StatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -692,13 +764,14 @@ func (x *ReferrerStats) GetPercentage() float32 {
 
 // Request for top URLs
 type TopURLsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	StartTime     int64                  `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       int64                  `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	SortBy        string                 `protobuf:"bytes,4,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"` // clicks, unique_clicks, created_at
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Limit             int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	StartTime         int64                  `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime           int64                  `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	SortBy            string                 `protobuf:"bytes,4,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`                                   // clicks, unique_clicks, created_at
+	ExcludeSuspicious bool                   `protobuf:"varint,5,opt,name=exclude_suspicious,json=excludeSuspicious,proto3" json:"exclude_suspicious,omitempty"` // leave out clicks flagged by fraud scoring
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *TopURLsRequest) Reset() {
@@ -759,6 +832,13 @@ func (x *TopURLsRequest) GetSortBy() string {
 	return ""
 }
 
+func (x *TopURLsRequest) GetExcludeSuspicious() bool {
+	if x != nil {
+		return x.ExcludeSuspicious
+	}
+	return false
+}
+
 // Top URLs response
 type TopURLsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -891,11 +971,12 @@ func (x *URLMetrics) GetLastClicked() int64 {
 
 // Request for dashboard data
 type DashboardRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartTime     int64                  `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       int64                  `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	StartTime         int64                  `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime           int64                  `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	ExcludeSuspicious bool                   `protobuf:"varint,3,opt,name=exclude_suspicious,json=excludeSuspicious,proto3" json:"exclude_suspicious,omitempty"` // leave out clicks flagged by fraud scoring
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *DashboardRequest) Reset() {
@@ -942,6 +1023,13 @@ func (x *DashboardRequest) GetEndTime() int64 {
 	return 0
 }
 
+func (x *DashboardRequest) GetExcludeSuspicious() bool {
+	if x != nil {
+		return x.ExcludeSuspicious
+	}
+	return false
+}
+
 // Dashboard response
 type DashboardResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -1043,6 +1131,205 @@ func (x *DashboardResponse) GetDeviceBreakdown() []*DeviceStats {
 	return nil
 }
 
+// Request for campaign statistics
+type CampaignStatsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ShortCode         string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"` // optional, all links when empty
+	StartTime         int64                  `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime           int64                  `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Limit             int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	ExcludeSuspicious bool                   `protobuf:"varint,5,opt,name=exclude_suspicious,json=excludeSuspicious,proto3" json:"exclude_suspicious,omitempty"` // leave out clicks flagged by fraud scoring
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CampaignStatsRequest) Reset() {
+	*x = CampaignStatsRequest{}
+	mi := &file_proto_analytics_analytics_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CampaignStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampaignStatsRequest) ProtoMessage() {}
+
+func (x *CampaignStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_analytics_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampaignStatsRequest.ProtoReflect.Descriptor instead.
+func (*CampaignStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_analytics_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CampaignStatsRequest) GetShortCode() string {
+	if x != nil {
+		return x.ShortCode
+	}
+	return ""
+}
+
+func (x *CampaignStatsRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *CampaignStatsRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *CampaignStatsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *CampaignStatsRequest) GetExcludeSuspicious() bool {
+	if x != nil {
+		return x.ExcludeSuspicious
+	}
+	return false
+}
+
+// Campaign statistics response
+type CampaignStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaigns     []*CampaignStats       `protobuf:"bytes,1,rep,name=campaigns,proto3" json:"campaigns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CampaignStatsResponse) Reset() {
+	*x = CampaignStatsResponse{}
+	mi := &file_proto_analytics_analytics_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CampaignStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampaignStatsResponse) ProtoMessage() {}
+
+func (x *CampaignStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_analytics_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampaignStatsResponse.ProtoReflect.Descriptor instead.
+func (*CampaignStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_analytics_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CampaignStatsResponse) GetCampaigns() []*CampaignStats {
+	if x != nil {
+		return x.Campaigns
+	}
+	return nil
+}
+
+// Clicks grouped by UTM source, medium and campaign
+type CampaignStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UtmSource     string                 `protobuf:"bytes,1,opt,name=utm_source,json=utmSource,proto3" json:"utm_source,omitempty"`
+	UtmMedium     string                 `protobuf:"bytes,2,opt,name=utm_medium,json=utmMedium,proto3" json:"utm_medium,omitempty"`
+	UtmCampaign   string                 `protobuf:"bytes,3,opt,name=utm_campaign,json=utmCampaign,proto3" json:"utm_campaign,omitempty"`
+	Clicks        int64                  `protobuf:"varint,4,opt,name=clicks,proto3" json:"clicks,omitempty"`
+	UniqueClicks  int64                  `protobuf:"varint,5,opt,name=unique_clicks,json=uniqueClicks,proto3" json:"unique_clicks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CampaignStats) Reset() {
+	*x = CampaignStats{}
+	mi := &file_proto_analytics_analytics_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CampaignStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampaignStats) ProtoMessage() {}
+
+func (x *CampaignStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_analytics_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampaignStats.ProtoReflect.Descriptor instead.
+func (*CampaignStats) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_analytics_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CampaignStats) GetUtmSource() string {
+	if x != nil {
+		return x.UtmSource
+	}
+	return ""
+}
+
+func (x *CampaignStats) GetUtmMedium() string {
+	if x != nil {
+		return x.UtmMedium
+	}
+	return ""
+}
+
+func (x *CampaignStats) GetUtmCampaign() string {
+	if x != nil {
+		return x.UtmCampaign
+	}
+	return ""
+}
+
+func (x *CampaignStats) GetClicks() int64 {
+	if x != nil {
+		return x.Clicks
+	}
+	return 0
+}
+
+func (x *CampaignStats) GetUniqueClicks() int64 {
+	if x != nil {
+		return x.UniqueClicks
+	}
+	return 0
+}
+
 // Health check request
 type HealthRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1052,7 +1339,7 @@ type HealthRequest struct {
 
 func (x *HealthRequest) Reset() {
 	*x = HealthRequest{}
-	mi := &file_proto_analytics_analytics_proto_msgTypes[14]
+	mi := &file_proto_analytics_analytics_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1064,7 +1351,7 @@ func (x *HealthRequest) String() string {
 func (*HealthRequest) ProtoMessage() {}
 
 func (x *HealthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_analytics_analytics_proto_msgTypes[14]
+	mi := &file_proto_analytics_analytics_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1077,7 +1364,7 @@ func (x *HealthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthRequest.ProtoReflect.Descriptor instead.
 func (*HealthRequest) Descriptor() ([]byte, []int) {
-	return file_proto_analytics_analytics_proto_rawDescGZIP(), []int{14}
+	return file_proto_analytics_analytics_proto_rawDescGZIP(), []int{17}
 }
 
 // Health check response
@@ -1093,7 +1380,7 @@ type HealthResponse struct {
 
 func (x *HealthResponse) Reset() {
 	*x = HealthResponse{}
-	mi := &file_proto_analytics_analytics_proto_msgTypes[15]
+	mi := &file_proto_analytics_analytics_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1105,7 +1392,7 @@ func (x *HealthResponse) String() string {
 func (*HealthResponse) ProtoMessage() {}
 
 func (x *HealthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_analytics_analytics_proto_msgTypes[15]
+	mi := &file_proto_analytics_analytics_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1118,7 +1405,7 @@ func (x *HealthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
 func (*HealthResponse) Descriptor() ([]byte, []int) {
-	return file_proto_analytics_analytics_proto_rawDescGZIP(), []int{15}
+	return file_proto_analytics_analytics_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *HealthResponse) GetStatus() string {
@@ -1153,7 +1440,7 @@ var File_proto_analytics_analytics_proto protoreflect.FileDescriptor
 
 const file_proto_analytics_analytics_proto_rawDesc = "" +
 	"\n" +
-	"\x1fproto/analytics/analytics.proto\x12\tanalytics\"\xf1\x02\n" +
+	"\x1fproto/analytics/analytics.proto\x12\tanalytics\"\xe7\x04\n" +
 	"\n" +
 	"ClickEvent\x12\x1d\n" +
 	"\n" +
@@ -1173,17 +1460,29 @@ const file_proto_analytics_analytics_proto_rawDesc = "" +
 	"\ttimestamp\x18\v \x01(\x03R\ttimestamp\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\f \x01(\tR\tsessionId\x12\x1b\n" +
-	"\tis_unique\x18\r \x01(\bR\bisUnique\"A\n" +
+	"\tis_unique\x18\r \x01(\bR\bisUnique\x12\x1d\n" +
+	"\n" +
+	"utm_source\x18\x0e \x01(\tR\tutmSource\x12\x1d\n" +
+	"\n" +
+	"utm_medium\x18\x0f \x01(\tR\tutmMedium\x12!\n" +
+	"\futm_campaign\x18\x10 \x01(\tR\vutmCampaign\x12\x19\n" +
+	"\butm_term\x18\x11 \x01(\tR\autmTerm\x12\x1f\n" +
+	"\vutm_content\x18\x12 \x01(\tR\n" +
+	"utmContent\x12\x16\n" +
+	"\x06source\x18\x13 \x01(\tR\x06source\x12'\n" +
+	"\x0faccept_language\x18\x14 \x01(\tR\x0eacceptLanguage\x12\x16\n" +
+	"\x06accept\x18\x15 \x01(\tR\x06accept\"A\n" +
 	"\x0fProcessResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\"\x89\x01\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xb8\x01\n" +
 	"\fStatsRequest\x12\x1d\n" +
 	"\n" +
 	"short_code\x18\x01 \x01(\tR\tshortCode\x12\x1d\n" +
 	"\n" +
 	"start_time\x18\x02 \x01(\x03R\tstartTime\x12\x19\n" +
 	"\bend_time\x18\x03 \x01(\x03R\aendTime\x12 \n" +
-	"\vgranularity\x18\x04 \x01(\tR\vgranularity\"\xab\x03\n" +
+	"\vgranularity\x18\x04 \x01(\tR\vgranularity\x12-\n" +
+	"\x12exclude_suspicious\x18\x05 \x01(\bR\x11excludeSuspicious\"\xab\x03\n" +
 	"\rStatsResponse\x12\x1d\n" +
 	"\n" +
 	"short_code\x18\x01 \x01(\tR\tshortCode\x12!\n" +
@@ -1223,13 +1522,14 @@ const file_proto_analytics_analytics_proto_rawDesc = "" +
 	"\x06clicks\x18\x02 \x01(\x03R\x06clicks\x12\x1e\n" +
 	"\n" +
 	"percentage\x18\x03 \x01(\x02R\n" +
-	"percentage\"y\n" +
+	"percentage\"\xa8\x01\n" +
 	"\x0eTopURLsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x1d\n" +
 	"\n" +
 	"start_time\x18\x02 \x01(\x03R\tstartTime\x12\x19\n" +
 	"\bend_time\x18\x03 \x01(\x03R\aendTime\x12\x17\n" +
-	"\asort_by\x18\x04 \x01(\tR\x06sortBy\"<\n" +
+	"\asort_by\x18\x04 \x01(\tR\x06sortBy\x12-\n" +
+	"\x12exclude_suspicious\x18\x05 \x01(\bR\x11excludeSuspicious\"<\n" +
 	"\x0fTopURLsResponse\x12)\n" +
 	"\x04urls\x18\x01 \x03(\v2\x15.analytics.URLMetricsR\x04urls\"\xd0\x01\n" +
 	"\n" +
@@ -1241,11 +1541,12 @@ const file_proto_analytics_analytics_proto_rawDesc = "" +
 	"\runique_clicks\x18\x04 \x01(\x03R\funiqueClicks\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12!\n" +
-	"\flast_clicked\x18\x06 \x01(\x03R\vlastClicked\"L\n" +
+	"\flast_clicked\x18\x06 \x01(\x03R\vlastClicked\"{\n" +
 	"\x10DashboardRequest\x12\x1d\n" +
 	"\n" +
 	"start_time\x18\x01 \x01(\x03R\tstartTime\x12\x19\n" +
-	"\bend_time\x18\x02 \x01(\x03R\aendTime\"\x89\x03\n" +
+	"\bend_time\x18\x02 \x01(\x03R\aendTime\x12-\n" +
+	"\x12exclude_suspicious\x18\x03 \x01(\bR\x11excludeSuspicious\"\x89\x03\n" +
 	"\x11DashboardResponse\x12\x1c\n" +
 	"\ttotalUrls\x18\x01 \x01(\x03R\ttotalUrls\x12 \n" +
 	"\vtotalClicks\x18\x02 \x01(\x03R\vtotalClicks\x12\"\n" +
@@ -1256,19 +1557,38 @@ const file_proto_analytics_analytics_proto_rawDesc = "" +
 	"\rclickTimeline\x18\x05 \x03(\v2\x1a.analytics.TimeSeriesPointR\rclickTimeline\x12/\n" +
 	"\atopUrls\x18\x06 \x03(\v2\x15.analytics.URLMetricsR\atopUrls\x12;\n" +
 	"\ftopCountries\x18\a \x03(\v2\x17.analytics.CountryStatsR\ftopCountries\x12@\n" +
-	"\x0fdeviceBreakdown\x18\b \x03(\v2\x16.analytics.DeviceStatsR\x0fdeviceBreakdown\"\x0f\n" +
+	"\x0fdeviceBreakdown\x18\b \x03(\v2\x16.analytics.DeviceStatsR\x0fdeviceBreakdown\"\xb4\x01\n" +
+	"\x14CampaignStatsRequest\x12\x1d\n" +
+	"\n" +
+	"short_code\x18\x01 \x01(\tR\tshortCode\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x03 \x01(\x03R\aendTime\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12-\n" +
+	"\x12exclude_suspicious\x18\x05 \x01(\bR\x11excludeSuspicious\"O\n" +
+	"\x15CampaignStatsResponse\x126\n" +
+	"\tcampaigns\x18\x01 \x03(\v2\x18.analytics.CampaignStatsR\tcampaigns\"\xad\x01\n" +
+	"\rCampaignStats\x12\x1d\n" +
+	"\n" +
+	"utm_source\x18\x01 \x01(\tR\tutmSource\x12\x1d\n" +
+	"\n" +
+	"utm_medium\x18\x02 \x01(\tR\tutmMedium\x12!\n" +
+	"\futm_campaign\x18\x03 \x01(\tR\vutmCampaign\x12\x16\n" +
+	"\x06clicks\x18\x04 \x01(\x03R\x06clicks\x12#\n" +
+	"\runique_clicks\x18\x05 \x01(\x03R\funiqueClicks\"\x0f\n" +
 	"\rHealthRequest\"z\n" +
 	"\x0eHealthResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
 	"\aservice\x18\x02 \x01(\tR\aservice\x12\x18\n" +
 	"\aversion\x18\x03 \x01(\tR\aversion\x12\x1c\n" +
-	"\ttimestamp\x18\x04 \x01(\x03R\ttimestamp2\xe6\x02\n" +
+	"\ttimestamp\x18\x04 \x01(\x03R\ttimestamp2\xbd\x03\n" +
 	"\x10AnalyticsService\x12A\n" +
 	"\fProcessClick\x12\x15.analytics.ClickEvent\x1a\x1a.analytics.ProcessResponse\x12@\n" +
 	"\vGetURLStats\x12\x17.analytics.StatsRequest\x1a\x18.analytics.StatsResponse\x12C\n" +
 	"\n" +
 	"GetTopURLs\x12\x19.analytics.TopURLsRequest\x1a\x1a.analytics.TopURLsResponse\x12I\n" +
-	"\fGetDashboard\x12\x1b.analytics.DashboardRequest\x1a\x1c.analytics.DashboardResponse\x12=\n" +
+	"\fGetDashboard\x12\x1b.analytics.DashboardRequest\x1a\x1c.analytics.DashboardResponse\x12U\n" +
+	"\x10GetCampaignStats\x12\x1f.analytics.CampaignStatsRequest\x1a .analytics.CampaignStatsResponse\x12=\n" +
 	"\x06Health\x12\x18.analytics.HealthRequest\x1a\x19.analytics.HealthResponseB<Z:github.com/go-systems-lab/go-url-shortener/proto/analyticsb\x06proto3"
 
 var (
@@ -1283,24 +1603,27 @@ func file_proto_analytics_analytics_proto_rawDescGZIP() []byte {
 	return file_proto_analytics_analytics_proto_rawDescData
 }
 
-var file_proto_analytics_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_proto_analytics_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_proto_analytics_analytics_proto_goTypes = []any{
-	(*ClickEvent)(nil),        // 0: analytics.ClickEvent
-	(*ProcessResponse)(nil),   // 1: analytics.ProcessResponse
-	(*StatsRequest)(nil),      // 2: analytics.StatsRequest
-	(*StatsResponse)(nil),     // 3: analytics.StatsResponse
-	(*TimeSeriesPoint)(nil),   // 4: analytics.TimeSeriesPoint
-	(*CountryStats)(nil),      // 5: analytics.CountryStats
-	(*DeviceStats)(nil),       // 6: analytics.DeviceStats
-	(*BrowserStats)(nil),      // 7: analytics.BrowserStats
-	(*ReferrerStats)(nil),     // 8: analytics.ReferrerStats
-	(*TopURLsRequest)(nil),    // 9: analytics.TopURLsRequest
-	(*TopURLsResponse)(nil),   // 10: analytics.TopURLsResponse
-	(*URLMetrics)(nil),        // 11: analytics.URLMetrics
-	(*DashboardRequest)(nil),  // 12: analytics.DashboardRequest
-	(*DashboardResponse)(nil), // 13: analytics.DashboardResponse
-	(*HealthRequest)(nil),     // 14: analytics.HealthRequest
-	(*HealthResponse)(nil),    // 15: analytics.HealthResponse
+	(*ClickEvent)(nil),            // 0: analytics.ClickEvent
+	(*ProcessResponse)(nil),       // 1: analytics.ProcessResponse
+	(*StatsRequest)(nil),          // 2: analytics.StatsRequest
+	(*StatsResponse)(nil),         // 3: analytics.StatsResponse
+	(*TimeSeriesPoint)(nil),       // 4: analytics.TimeSeriesPoint
+	(*CountryStats)(nil),          // 5: analytics.CountryStats
+	(*DeviceStats)(nil),           // 6: analytics.DeviceStats
+	(*BrowserStats)(nil),          // 7: analytics.BrowserStats
+	(*ReferrerStats)(nil),         // 8: analytics.ReferrerStats
+	(*TopURLsRequest)(nil),        // 9: analytics.TopURLsRequest
+	(*TopURLsResponse)(nil),       // 10: analytics.TopURLsResponse
+	(*URLMetrics)(nil),            // 11: analytics.URLMetrics
+	(*DashboardRequest)(nil),      // 12: analytics.DashboardRequest
+	(*DashboardResponse)(nil),     // 13: analytics.DashboardResponse
+	(*CampaignStatsRequest)(nil),  // 14: analytics.CampaignStatsRequest
+	(*CampaignStatsResponse)(nil), // 15: analytics.CampaignStatsResponse
+	(*CampaignStats)(nil),         // 16: analytics.CampaignStats
+	(*HealthRequest)(nil),         // 17: analytics.HealthRequest
+	(*HealthResponse)(nil),        // 18: analytics.HealthResponse
 }
 var file_proto_analytics_analytics_proto_depIdxs = []int32{
 	4,  // 0: analytics.StatsResponse.time_series:type_name -> analytics.TimeSeriesPoint
@@ -1313,21 +1636,24 @@ var file_proto_analytics_analytics_proto_depIdxs = []int32{
 	11, // 7: analytics.DashboardResponse.topUrls:type_name -> analytics.URLMetrics
 	5,  // 8: analytics.DashboardResponse.topCountries:type_name -> analytics.CountryStats
 	6,  // 9: analytics.DashboardResponse.deviceBreakdown:type_name -> analytics.DeviceStats
-	0,  // 10: analytics.AnalyticsService.ProcessClick:input_type -> analytics.ClickEvent
-	2,  // 11: analytics.AnalyticsService.GetURLStats:input_type -> analytics.StatsRequest
-	9,  // 12: analytics.AnalyticsService.GetTopURLs:input_type -> analytics.TopURLsRequest
-	12, // 13: analytics.AnalyticsService.GetDashboard:input_type -> analytics.DashboardRequest
-	14, // 14: analytics.AnalyticsService.Health:input_type -> analytics.HealthRequest
-	1,  // 15: analytics.AnalyticsService.ProcessClick:output_type -> analytics.ProcessResponse
-	3,  // 16: analytics.AnalyticsService.GetURLStats:output_type -> analytics.StatsResponse
-	10, // 17: analytics.AnalyticsService.GetTopURLs:output_type -> analytics.TopURLsResponse
-	13, // 18: analytics.AnalyticsService.GetDashboard:output_type -> analytics.DashboardResponse
-	15, // 19: analytics.AnalyticsService.Health:output_type -> analytics.HealthResponse
-	15, // [15:20] is the sub-list for method output_type
-	10, // [10:15] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	16, // 10: analytics.CampaignStatsResponse.campaigns:type_name -> analytics.CampaignStats
+	0,  // 11: analytics.AnalyticsService.ProcessClick:input_type -> analytics.ClickEvent
+	2,  // 12: analytics.AnalyticsService.GetURLStats:input_type -> analytics.StatsRequest
+	9,  // 13: analytics.AnalyticsService.GetTopURLs:input_type -> analytics.TopURLsRequest
+	12, // 14: analytics.AnalyticsService.GetDashboard:input_type -> analytics.DashboardRequest
+	14, // 15: analytics.AnalyticsService.GetCampaignStats:input_type -> analytics.CampaignStatsRequest
+	17, // 16: analytics.AnalyticsService.Health:input_type -> analytics.HealthRequest
+	1,  // 17: analytics.AnalyticsService.ProcessClick:output_type -> analytics.ProcessResponse
+	3,  // 18: analytics.AnalyticsService.GetURLStats:output_type -> analytics.StatsResponse
+	10, // 19: analytics.AnalyticsService.GetTopURLs:output_type -> analytics.TopURLsResponse
+	13, // 20: analytics.AnalyticsService.GetDashboard:output_type -> analytics.DashboardResponse
+	15, // 21: analytics.AnalyticsService.GetCampaignStats:output_type -> analytics.CampaignStatsResponse
+	18, // 22: analytics.AnalyticsService.Health:output_type -> analytics.HealthResponse
+	17, // [17:23] is the sub-list for method output_type
+	11, // [11:17] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_proto_analytics_analytics_proto_init() }
@@ -1341,7 +1667,7 @@ func file_proto_analytics_analytics_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_analytics_analytics_proto_rawDesc), len(file_proto_analytics_analytics_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -36,6 +36,8 @@ type AnalyticsService interface {
 	GetTopURLs(ctx context.Context, in *TopURLsRequest, opts ...client.CallOption) (*TopURLsResponse, error)
 	// Get analytics dashboard data
 	GetDashboard(ctx context.Context, in *DashboardRequest, opts ...client.CallOption) (*DashboardResponse, error)
+	// Get campaign statistics grouped by the UTM values sent
+	GetCampaignStats(ctx context.Context, in *CampaignStatsRequest, opts ...client.CallOption) (*CampaignStatsResponse, error)
 	// Health check
 	Health(ctx context.Context, in *HealthRequest, opts ...client.CallOption) (*HealthResponse, error)
 }
@@ -92,6 +94,16 @@ func (c *analyticsService) GetDashboard(ctx context.Context, in *DashboardReques
 	return out, nil
 }
 
+func (c *analyticsService) GetCampaignStats(ctx context.Context, in *CampaignStatsRequest, opts ...client.CallOption) (*CampaignStatsResponse, error) {
+	req := c.c.NewRequest(c.name, "AnalyticsService.GetCampaignStats", in)
+	out := new(CampaignStatsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *analyticsService) Health(ctx context.Context, in *HealthRequest, opts ...client.CallOption) (*HealthResponse, error) {
 	req := c.c.NewRequest(c.name, "AnalyticsService.Health", in)
 	out := new(HealthResponse)
@@ -113,6 +125,8 @@ type AnalyticsServiceHandler interface {
 	GetTopURLs(context.Context, *TopURLsRequest, *TopURLsResponse) error
 	// Get analytics dashboard data
 	GetDashboard(context.Context, *DashboardRequest, *DashboardResponse) error
+	// Get campaign statistics grouped by the UTM values sent
+	GetCampaignStats(context.Context, *CampaignStatsRequest, *CampaignStatsResponse) error
 	// Health check
 	Health(context.Context, *HealthRequest, *HealthResponse) error
 }
@@ -123,6 +137,7 @@ func RegisterAnalyticsServiceHandler(s server.Server, hdlr AnalyticsServiceHandl
 		GetURLStats(ctx context.Context, in *StatsRequest, out *StatsResponse) error
 		GetTopURLs(ctx context.Context, in *TopURLsRequest, out *TopURLsResponse) error
 		GetDashboard(ctx context.Context, in *DashboardRequest, out *DashboardResponse) error
+		GetCampaignStats(ctx context.Context, in *CampaignStatsRequest, out *CampaignStatsResponse) error
 		Health(ctx context.Context, in *HealthRequest, out *HealthResponse) error
 	}
 	type AnalyticsService struct {
@@ -152,6 +167,10 @@ func (h *analyticsServiceHandler) GetDashboard(ctx context.Context, in *Dashboar
 	return h.AnalyticsServiceHandler.GetDashboard(ctx, in, out)
 }
 
+func (h *analyticsServiceHandler) GetCampaignStats(ctx context.Context, in *CampaignStatsRequest, out *CampaignStatsResponse) error {
+	return h.AnalyticsServiceHandler.GetCampaignStats(ctx, in, out)
+}
+
 func (h *analyticsServiceHandler) Health(ctx context.Context, in *HealthRequest, out *HealthResponse) error {
 	return h.AnalyticsServiceHandler.Health(ctx, in, out)
 }
@@ -23,15 +23,20 @@ const (
 
 // Request to resolve a short URL
 type ResolveRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShortCode     string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`    // Short code to resolve (e.g., "abc123")
-	ClientIp      string                 `protobuf:"bytes,2,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`       // Client IP for analytics
-	UserAgent     string                 `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`    // User agent string
-	Referrer      string                 `protobuf:"bytes,4,opt,name=referrer,proto3" json:"referrer,omitempty"`                       // HTTP referrer
-	Country       string                 `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"`                         // Country code (optional)
-	DeviceType    string                 `protobuf:"bytes,6,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"` // mobile, desktop, tablet
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ShortCode      string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`                 // Short code to resolve (e.g., "abc123")
+	ClientIp       string                 `protobuf:"bytes,2,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`                    // Client IP for analytics
+	UserAgent      string                 `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`                 // User agent string
+	Referrer       string                 `protobuf:"bytes,4,opt,name=referrer,proto3" json:"referrer,omitempty"`                                    // HTTP referrer
+	Country        string                 `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"`                                      // Country code (optional)
+	DeviceType     string                 `protobuf:"bytes,6,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`              // mobile, desktop, tablet
+	AccessToken    string                 `protobuf:"bytes,7,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`           // Token issued by VerifyPassword (password-protected links)
+	Confirmed      bool                   `protobuf:"varint,8,opt,name=confirmed,proto3" json:"confirmed,omitempty"`                                 // Visitor chose to continue past the interstitial warning
+	Host           string                 `protobuf:"bytes,9,opt,name=host,proto3" json:"host,omitempty"`                                            // Host header the link was requested on (selects the short domain)
+	AcceptLanguage string                 `protobuf:"bytes,10,opt,name=accept_language,json=acceptLanguage,proto3" json:"accept_language,omitempty"` // Accept-Language header, for fraud scoring
+	Accept         string                 `protobuf:"bytes,11,opt,name=accept,proto3" json:"accept,omitempty"`                                       // Accept header, for fraud scoring
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ResolveRequest) Reset() {
@@ -106,18 +111,65 @@ func (x *ResolveRequest) GetDeviceType() string {
 	return ""
 }
 
+func (x *ResolveRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ResolveRequest) GetConfirmed() bool {
+	if x != nil {
+		return x.Confirmed
+	}
+	return false
+}
+
+func (x *ResolveRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ResolveRequest) GetAcceptLanguage() string {
+	if x != nil {
+		return x.AcceptLanguage
+	}
+	return ""
+}
+
+func (x *ResolveRequest) GetAccept() string {
+	if x != nil {
+		return x.Accept
+	}
+	return ""
+}
+
 // Response with resolved URL
 type ResolveResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LongUrl       string                 `protobuf:"bytes,1,opt,name=long_url,json=longUrl,proto3" json:"long_url,omitempty"`           // Original long URL
-	Found         bool                   `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`                             // Whether URL was found
-	Expired       bool                   `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"`                         // Whether URL has expired
-	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`    // When URL was created
-	ExpiresAt     int64                  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`    // When URL expires (if any)
-	ClickCount    int64                  `protobuf:"varint,6,opt,name=click_count,json=clickCount,proto3" json:"click_count,omitempty"` // Total clicks (cached)
-	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`                              // Error message if any
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	LongUrl            string                 `protobuf:"bytes,1,opt,name=long_url,json=longUrl,proto3" json:"long_url,omitempty"`                             // Original long URL
+	Found              bool                   `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`                                               // Whether URL was found
+	Expired            bool                   `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"`                                           // Whether URL has expired
+	CreatedAt          int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                      // When URL was created
+	ExpiresAt          int64                  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                      // When URL expires (if any)
+	ClickCount         int64                  `protobuf:"varint,6,opt,name=click_count,json=clickCount,proto3" json:"click_count,omitempty"`                   // Total clicks (cached)
+	Error              string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`                                                // Error message if any
+	RequiresPassword   bool                   `protobuf:"varint,8,opt,name=requires_password,json=requiresPassword,proto3" json:"requires_password,omitempty"` // Password required, long_url is withheld
+	NotYetActive       bool                   `protobuf:"varint,9,opt,name=not_yet_active,json=notYetActive,proto3" json:"not_yet_active,omitempty"`           // Activation time not reached, long_url is withheld
+	ActivatesAt        int64                  `protobuf:"varint,10,opt,name=activates_at,json=activatesAt,proto3" json:"activates_at,omitempty"`               // When the URL goes live (if scheduled)
+	FallbackUrl        string                 `protobuf:"bytes,11,opt,name=fallback_url,json=fallbackUrl,proto3" json:"fallback_url,omitempty"`                // Where to send visitors before activation (optional)
+	Interstitial       bool                   `protobuf:"varint,12,opt,name=interstitial,proto3" json:"interstitial,omitempty"`                                // Show a "you are leaving" warning before redirecting to long_url
+	DestinationHost    string                 `protobuf:"bytes,13,opt,name=destination_host,json=destinationHost,proto3" json:"destination_host,omitempty"`    // Host of long_url, for the warning page
+	Unfurl             bool                   `protobuf:"varint,14,opt,name=unfurl,proto3" json:"unfurl,omitempty"`                                            // Link preview bot: serve the preview page instead of redirecting
+	PreviewTitle       string                 `protobuf:"bytes,15,opt,name=preview_title,json=previewTitle,proto3" json:"preview_title,omitempty"`             // Unfurl metadata (owner overrides applied)
+	PreviewDescription string                 `protobuf:"bytes,16,opt,name=preview_description,json=previewDescription,proto3" json:"preview_description,omitempty"`
+	PreviewImage       string                 `protobuf:"bytes,17,opt,name=preview_image,json=previewImage,proto3" json:"preview_image,omitempty"`
+	Domain             string                 `protobuf:"bytes,18,opt,name=domain,proto3" json:"domain,omitempty"`                        // Short domain the code was resolved on, empty for the default
+	ShortCode          string                 `protobuf:"bytes,19,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"` // Stored spelling of the code (case-insensitive domains accept any case)
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *ResolveResponse) Reset() {
@@ -199,27 +251,354 @@ func (x *ResolveResponse) GetError() string {
 	return ""
 }
 
-// Request to track a click event
-type ClickRequest struct {
+func (x *ResolveResponse) GetRequiresPassword() bool {
+	if x != nil {
+		return x.RequiresPassword
+	}
+	return false
+}
+
+func (x *ResolveResponse) GetNotYetActive() bool {
+	if x != nil {
+		return x.NotYetActive
+	}
+	return false
+}
+
+func (x *ResolveResponse) GetActivatesAt() int64 {
+	if x != nil {
+		return x.ActivatesAt
+	}
+	return 0
+}
+
+func (x *ResolveResponse) GetFallbackUrl() string {
+	if x != nil {
+		return x.FallbackUrl
+	}
+	return ""
+}
+
+func (x *ResolveResponse) GetInterstitial() bool {
+	if x != nil {
+		return x.Interstitial
+	}
+	return false
+}
+
+func (x *ResolveResponse) GetDestinationHost() string {
+	if x != nil {
+		return x.DestinationHost
+	}
+	return ""
+}
+
+func (x *ResolveResponse) GetUnfurl() bool {
+	if x != nil {
+		return x.Unfurl
+	}
+	return false
+}
+
+func (x *ResolveResponse) GetPreviewTitle() string {
+	if x != nil {
+		return x.PreviewTitle
+	}
+	return ""
+}
+
+func (x *ResolveResponse) GetPreviewDescription() string {
+	if x != nil {
+		return x.PreviewDescription
+	}
+	return ""
+}
+
+func (x *ResolveResponse) GetPreviewImage() string {
+	if x != nil {
+		return x.PreviewImage
+	}
+	return ""
+}
+
+func (x *ResolveResponse) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ResolveResponse) GetShortCode() string {
+	if x != nil {
+		return x.ShortCode
+	}
+	return ""
+}
+
+// Lifecycle event published when a link changes state (topic: url.lifecycle)
+type LifecycleEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShortCode     string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`    // Short code that was clicked
-	LongUrl       string                 `protobuf:"bytes,2,opt,name=long_url,json=longUrl,proto3" json:"long_url,omitempty"`          // Resolved long URL
-	ClientIp      string                 `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`       // Client IP address
-	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`    // Full user agent string
-	Referrer      string                 `protobuf:"bytes,5,opt,name=referrer,proto3" json:"referrer,omitempty"`                       // HTTP referrer URL
-	Country       string                 `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`                         // Country code (GeoIP)
-	City          string                 `protobuf:"bytes,7,opt,name=city,proto3" json:"city,omitempty"`                               // City name (GeoIP)
-	DeviceType    string                 `protobuf:"bytes,8,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"` // mobile, desktop, tablet
-	Browser       string                 `protobuf:"bytes,9,opt,name=browser,proto3" json:"browser,omitempty"`                         // Chrome, Firefox, Safari, etc.
-	Os            string                 `protobuf:"bytes,10,opt,name=os,proto3" json:"os,omitempty"`                                  // Windows, macOS, Linux, iOS, Android
-	Timestamp     int64                  `protobuf:"varint,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                   // Click timestamp
+	ShortCode     string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`     // Short code of the link
+	Event         string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`                              // Lifecycle transition, e.g. "exhausted"
+	Timestamp     int64                  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                     // When the transition happened
+	ClickCount    int64                  `protobuf:"varint,4,opt,name=click_count,json=clickCount,proto3" json:"click_count,omitempty"` // Click count at the transition
+	MaxClicks     int64                  `protobuf:"varint,5,opt,name=max_clicks,json=maxClicks,proto3" json:"max_clicks,omitempty"`    // Click limit (if any)
+	Domain        string                 `protobuf:"bytes,6,opt,name=domain,proto3" json:"domain,omitempty"`                            // Short domain of the link, empty for the default
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *LifecycleEvent) Reset() {
+	*x = LifecycleEvent{}
+	mi := &file_proto_redirect_redirect_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LifecycleEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LifecycleEvent) ProtoMessage() {}
+
+func (x *LifecycleEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_redirect_redirect_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LifecycleEvent.ProtoReflect.Descriptor instead.
+func (*LifecycleEvent) Descriptor() ([]byte, []int) {
+	return file_proto_redirect_redirect_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LifecycleEvent) GetShortCode() string {
+	if x != nil {
+		return x.ShortCode
+	}
+	return ""
+}
+
+func (x *LifecycleEvent) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *LifecycleEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *LifecycleEvent) GetClickCount() int64 {
+	if x != nil {
+		return x.ClickCount
+	}
+	return 0
+}
+
+func (x *LifecycleEvent) GetMaxClicks() int64 {
+	if x != nil {
+		return x.MaxClicks
+	}
+	return 0
+}
+
+func (x *LifecycleEvent) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+// Request to verify a link password
+type VerifyPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortCode     string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"` // Short code being unlocked
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`                    // Password entered by the visitor
+	ClientIp      string                 `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`    // Client IP for rate limiting
+	Host          string                 `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`                            // Host header the link was requested on
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPasswordRequest) Reset() {
+	*x = VerifyPasswordRequest{}
+	mi := &file_proto_redirect_redirect_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPasswordRequest) ProtoMessage() {}
+
+func (x *VerifyPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_redirect_redirect_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPasswordRequest.ProtoReflect.Descriptor instead.
+func (*VerifyPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_proto_redirect_redirect_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VerifyPasswordRequest) GetShortCode() string {
+	if x != nil {
+		return x.ShortCode
+	}
+	return ""
+}
+
+func (x *VerifyPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *VerifyPasswordRequest) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *VerifyPasswordRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+// Response for password verification
+type VerifyPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                           // Whether the password was correct
+	AccessToken   string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"` // Signed token to send with ResolveRequest
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`      // When the access token expires
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`                                // Error message if any
+	RetryAfter    int64                  `protobuf:"varint,5,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`   // Seconds until more attempts are allowed (rate limited)
+	ShortCode     string                 `protobuf:"bytes,6,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`       // Stored spelling of the code (case-insensitive domains accept any case)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPasswordResponse) Reset() {
+	*x = VerifyPasswordResponse{}
+	mi := &file_proto_redirect_redirect_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPasswordResponse) ProtoMessage() {}
+
+func (x *VerifyPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_redirect_redirect_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPasswordResponse.ProtoReflect.Descriptor instead.
+func (*VerifyPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_proto_redirect_redirect_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *VerifyPasswordResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *VerifyPasswordResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *VerifyPasswordResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *VerifyPasswordResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *VerifyPasswordResponse) GetRetryAfter() int64 {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return 0
+}
+
+func (x *VerifyPasswordResponse) GetShortCode() string {
+	if x != nil {
+		return x.ShortCode
+	}
+	return ""
+}
+
+// Request to track a click event
+type ClickRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ShortCode      string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`                 // Short code that was clicked
+	LongUrl        string                 `protobuf:"bytes,2,opt,name=long_url,json=longUrl,proto3" json:"long_url,omitempty"`                       // Resolved long URL
+	ClientIp       string                 `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`                    // Client IP address
+	UserAgent      string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`                 // Full user agent string
+	Referrer       string                 `protobuf:"bytes,5,opt,name=referrer,proto3" json:"referrer,omitempty"`                                    // HTTP referrer URL
+	Country        string                 `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`                                      // Country code (GeoIP)
+	City           string                 `protobuf:"bytes,7,opt,name=city,proto3" json:"city,omitempty"`                                            // City name (GeoIP)
+	DeviceType     string                 `protobuf:"bytes,8,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`              // mobile, desktop, tablet
+	Browser        string                 `protobuf:"bytes,9,opt,name=browser,proto3" json:"browser,omitempty"`                                      // Chrome, Firefox, Safari, etc.
+	Os             string                 `protobuf:"bytes,10,opt,name=os,proto3" json:"os,omitempty"`                                               // Windows, macOS, Linux, iOS, Android
+	Timestamp      int64                  `protobuf:"varint,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                                // Click timestamp
+	Source         string                 `protobuf:"bytes,12,opt,name=source,proto3" json:"source,omitempty"`                                       // Click source marker from ?src=, e.g. "qr"
+	Domain         string                 `protobuf:"bytes,13,opt,name=domain,proto3" json:"domain,omitempty"`                                       // Short domain of the link, empty for the default
+	AcceptLanguage string                 `protobuf:"bytes,14,opt,name=accept_language,json=acceptLanguage,proto3" json:"accept_language,omitempty"` // Accept-Language header, for fraud scoring
+	Accept         string                 `protobuf:"bytes,15,opt,name=accept,proto3" json:"accept,omitempty"`                                       // Accept header, for fraud scoring
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *ClickRequest) Reset() {
 	*x = ClickRequest{}
-	mi := &file_proto_redirect_redirect_proto_msgTypes[2]
+	mi := &file_proto_redirect_redirect_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -231,7 +610,7 @@ func (x *ClickRequest) String() string {
 func (*ClickRequest) ProtoMessage() {}
 
 func (x *ClickRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_redirect_redirect_proto_msgTypes[2]
+	mi := &file_proto_redirect_redirect_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -244,7 +623,7 @@ func (x *ClickRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClickRequest.ProtoReflect.Descriptor instead.
 func (*ClickRequest) Descriptor() ([]byte, []int) {
-	return file_proto_redirect_redirect_proto_rawDescGZIP(), []int{2}
+	return file_proto_redirect_redirect_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ClickRequest) GetShortCode() string {
@@ -324,6 +703,34 @@ func (x *ClickRequest) GetTimestamp() int64 {
 	return 0
 }
 
+func (x *ClickRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ClickRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ClickRequest) GetAcceptLanguage() string {
+	if x != nil {
+		return x.AcceptLanguage
+	}
+	return ""
+}
+
+func (x *ClickRequest) GetAccept() string {
+	if x != nil {
+		return x.Accept
+	}
+	return ""
+}
+
 // Response for click tracking
 type ClickResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -335,7 +742,7 @@ type ClickResponse struct {
 
 func (x *ClickResponse) Reset() {
 	*x = ClickResponse{}
-	mi := &file_proto_redirect_redirect_proto_msgTypes[3]
+	mi := &file_proto_redirect_redirect_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -347,7 +754,7 @@ func (x *ClickResponse) String() string {
 func (*ClickResponse) ProtoMessage() {}
 
 func (x *ClickResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_redirect_redirect_proto_msgTypes[3]
+	mi := &file_proto_redirect_redirect_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +767,7 @@ func (x *ClickResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClickResponse.ProtoReflect.Descriptor instead.
 func (*ClickResponse) Descriptor() ([]byte, []int) {
-	return file_proto_redirect_redirect_proto_rawDescGZIP(), []int{3}
+	return file_proto_redirect_redirect_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ClickResponse) GetSuccess() bool {
@@ -386,7 +793,7 @@ type HealthRequest struct {
 
 func (x *HealthRequest) Reset() {
 	*x = HealthRequest{}
-	mi := &file_proto_redirect_redirect_proto_msgTypes[4]
+	mi := &file_proto_redirect_redirect_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -398,7 +805,7 @@ func (x *HealthRequest) String() string {
 func (*HealthRequest) ProtoMessage() {}
 
 func (x *HealthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_redirect_redirect_proto_msgTypes[4]
+	mi := &file_proto_redirect_redirect_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,7 +818,7 @@ func (x *HealthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthRequest.ProtoReflect.Descriptor instead.
 func (*HealthRequest) Descriptor() ([]byte, []int) {
-	return file_proto_redirect_redirect_proto_rawDescGZIP(), []int{4}
+	return file_proto_redirect_redirect_proto_rawDescGZIP(), []int{7}
 }
 
 // Health check response
@@ -427,7 +834,7 @@ type HealthResponse struct {
 
 func (x *HealthResponse) Reset() {
 	*x = HealthResponse{}
-	mi := &file_proto_redirect_redirect_proto_msgTypes[5]
+	mi := &file_proto_redirect_redirect_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -439,7 +846,7 @@ func (x *HealthResponse) String() string {
 func (*HealthResponse) ProtoMessage() {}
 
 func (x *HealthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_redirect_redirect_proto_msgTypes[5]
+	mi := &file_proto_redirect_redirect_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -452,7 +859,7 @@ func (x *HealthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
 func (*HealthResponse) Descriptor() ([]byte, []int) {
-	return file_proto_redirect_redirect_proto_rawDescGZIP(), []int{5}
+	return file_proto_redirect_redirect_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *HealthResponse) GetStatus() string {
@@ -485,27 +892,36 @@ func (x *HealthResponse) GetTimestamp() int64 {
 
 // Click analytics event for NATS publishing
 type ClickEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShortCode     string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
-	LongUrl       string                 `protobuf:"bytes,2,opt,name=long_url,json=longUrl,proto3" json:"long_url,omitempty"`
-	ClientIp      string                 `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
-	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
-	Referrer      string                 `protobuf:"bytes,5,opt,name=referrer,proto3" json:"referrer,omitempty"`
-	Country       string                 `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`
-	City          string                 `protobuf:"bytes,7,opt,name=city,proto3" json:"city,omitempty"`
-	DeviceType    string                 `protobuf:"bytes,8,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
-	Browser       string                 `protobuf:"bytes,9,opt,name=browser,proto3" json:"browser,omitempty"`
-	Os            string                 `protobuf:"bytes,10,opt,name=os,proto3" json:"os,omitempty"`
-	Timestamp     int64                  `protobuf:"varint,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	SessionId     string                 `protobuf:"bytes,12,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // User session ID
-	IsUnique      bool                   `protobuf:"varint,13,opt,name=is_unique,json=isUnique,proto3" json:"is_unique,omitempty"`   // First time this IP clicked this URL
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ShortCode      string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
+	LongUrl        string                 `protobuf:"bytes,2,opt,name=long_url,json=longUrl,proto3" json:"long_url,omitempty"`
+	ClientIp       string                 `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	UserAgent      string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Referrer       string                 `protobuf:"bytes,5,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	Country        string                 `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`
+	City           string                 `protobuf:"bytes,7,opt,name=city,proto3" json:"city,omitempty"`
+	DeviceType     string                 `protobuf:"bytes,8,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
+	Browser        string                 `protobuf:"bytes,9,opt,name=browser,proto3" json:"browser,omitempty"`
+	Os             string                 `protobuf:"bytes,10,opt,name=os,proto3" json:"os,omitempty"`
+	Timestamp      int64                  `protobuf:"varint,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	SessionId      string                 `protobuf:"bytes,12,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // User session ID
+	IsUnique       bool                   `protobuf:"varint,13,opt,name=is_unique,json=isUnique,proto3" json:"is_unique,omitempty"`   // First time this IP clicked this URL
+	UtmSource      string                 `protobuf:"bytes,14,opt,name=utm_source,json=utmSource,proto3" json:"utm_source,omitempty"` // UTM values actually sent to the destination
+	UtmMedium      string                 `protobuf:"bytes,15,opt,name=utm_medium,json=utmMedium,proto3" json:"utm_medium,omitempty"`
+	UtmCampaign    string                 `protobuf:"bytes,16,opt,name=utm_campaign,json=utmCampaign,proto3" json:"utm_campaign,omitempty"`
+	UtmTerm        string                 `protobuf:"bytes,17,opt,name=utm_term,json=utmTerm,proto3" json:"utm_term,omitempty"`
+	UtmContent     string                 `protobuf:"bytes,18,opt,name=utm_content,json=utmContent,proto3" json:"utm_content,omitempty"`
+	Source         string                 `protobuf:"bytes,19,opt,name=source,proto3" json:"source,omitempty"`                                       // Click source marker, e.g. "qr" for QR code scans
+	Domain         string                 `protobuf:"bytes,20,opt,name=domain,proto3" json:"domain,omitempty"`                                       // Short domain of the link, empty for the default
+	AcceptLanguage string                 `protobuf:"bytes,21,opt,name=accept_language,json=acceptLanguage,proto3" json:"accept_language,omitempty"` // Accept-Language header, for fraud scoring
+	Accept         string                 `protobuf:"bytes,22,opt,name=accept,proto3" json:"accept,omitempty"`                                       // Accept header, for fraud scoring
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ClickEvent) Reset() {
 	*x = ClickEvent{}
-	mi := &file_proto_redirect_redirect_proto_msgTypes[6]
+	mi := &file_proto_redirect_redirect_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -517,7 +933,7 @@ func (x *ClickEvent) String() string {
 func (*ClickEvent) ProtoMessage() {}
 
 func (x *ClickEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_redirect_redirect_proto_msgTypes[6]
+	mi := &file_proto_redirect_redirect_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -530,7 +946,7 @@ func (x *ClickEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClickEvent.ProtoReflect.Descriptor instead.
 func (*ClickEvent) Descriptor() ([]byte, []int) {
-	return file_proto_redirect_redirect_proto_rawDescGZIP(), []int{6}
+	return file_proto_redirect_redirect_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ClickEvent) GetShortCode() string {
@@ -624,6 +1040,69 @@ func (x *ClickEvent) GetIsUnique() bool {
 	return false
 }
 
+func (x *ClickEvent) GetUtmSource() string {
+	if x != nil {
+		return x.UtmSource
+	}
+	return ""
+}
+
+func (x *ClickEvent) GetUtmMedium() string {
+	if x != nil {
+		return x.UtmMedium
+	}
+	return ""
+}
+
+func (x *ClickEvent) GetUtmCampaign() string {
+	if x != nil {
+		return x.UtmCampaign
+	}
+	return ""
+}
+
+func (x *ClickEvent) GetUtmTerm() string {
+	if x != nil {
+		return x.UtmTerm
+	}
+	return ""
+}
+
+func (x *ClickEvent) GetUtmContent() string {
+	if x != nil {
+		return x.UtmContent
+	}
+	return ""
+}
+
+func (x *ClickEvent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ClickEvent) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ClickEvent) GetAcceptLanguage() string {
+	if x != nil {
+		return x.AcceptLanguage
+	}
+	return ""
+}
+
+func (x *ClickEvent) GetAccept() string {
+	if x != nil {
+		return x.Accept
+	}
+	return ""
+}
+
 // Cache entry for URL mapping
 type URLCacheEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -639,7 +1118,7 @@ type URLCacheEntry struct {
 
 func (x *URLCacheEntry) Reset() {
 	*x = URLCacheEntry{}
-	mi := &file_proto_redirect_redirect_proto_msgTypes[7]
+	mi := &file_proto_redirect_redirect_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -651,7 +1130,7 @@ func (x *URLCacheEntry) String() string {
 func (*URLCacheEntry) ProtoMessage() {}
 
 func (x *URLCacheEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_redirect_redirect_proto_msgTypes[7]
+	mi := &file_proto_redirect_redirect_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -664,7 +1143,7 @@ func (x *URLCacheEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use URLCacheEntry.ProtoReflect.Descriptor instead.
 func (*URLCacheEntry) Descriptor() ([]byte, []int) {
-	return file_proto_redirect_redirect_proto_rawDescGZIP(), []int{7}
+	return file_proto_redirect_redirect_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *URLCacheEntry) GetShortCode() string {
@@ -713,7 +1192,7 @@ var File_proto_redirect_redirect_proto protoreflect.FileDescriptor
 
 const file_proto_redirect_redirect_proto_rawDesc = "" +
 	"\n" +
-	"\x1dproto/redirect/redirect.proto\x12\bredirect\"\xc2\x01\n" +
+	"\x1dproto/redirect/redirect.proto\x12\bredirect\"\xd8\x02\n" +
 	"\x0eResolveRequest\x12\x1d\n" +
 	"\n" +
 	"short_code\x18\x01 \x01(\tR\tshortCode\x12\x1b\n" +
@@ -723,7 +1202,13 @@ const file_proto_redirect_redirect_proto_rawDesc = "" +
 	"\breferrer\x18\x04 \x01(\tR\breferrer\x12\x18\n" +
 	"\acountry\x18\x05 \x01(\tR\acountry\x12\x1f\n" +
 	"\vdevice_type\x18\x06 \x01(\tR\n" +
-	"deviceType\"\xd1\x01\n" +
+	"deviceType\x12!\n" +
+	"\faccess_token\x18\a \x01(\tR\vaccessToken\x12\x1c\n" +
+	"\tconfirmed\x18\b \x01(\bR\tconfirmed\x12\x12\n" +
+	"\x04host\x18\t \x01(\tR\x04host\x12'\n" +
+	"\x0faccept_language\x18\n" +
+	" \x01(\tR\x0eacceptLanguage\x12\x16\n" +
+	"\x06accept\x18\v \x01(\tR\x06accept\"\x83\x05\n" +
 	"\x0fResolveResponse\x12\x19\n" +
 	"\blong_url\x18\x01 \x01(\tR\alongUrl\x12\x14\n" +
 	"\x05found\x18\x02 \x01(\bR\x05found\x12\x18\n" +
@@ -734,7 +1219,47 @@ const file_proto_redirect_redirect_proto_rawDesc = "" +
 	"expires_at\x18\x05 \x01(\x03R\texpiresAt\x12\x1f\n" +
 	"\vclick_count\x18\x06 \x01(\x03R\n" +
 	"clickCount\x12\x14\n" +
-	"\x05error\x18\a \x01(\tR\x05error\"\xb7\x02\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x12+\n" +
+	"\x11requires_password\x18\b \x01(\bR\x10requiresPassword\x12$\n" +
+	"\x0enot_yet_active\x18\t \x01(\bR\fnotYetActive\x12!\n" +
+	"\factivates_at\x18\n" +
+	" \x01(\x03R\vactivatesAt\x12!\n" +
+	"\ffallback_url\x18\v \x01(\tR\vfallbackUrl\x12\"\n" +
+	"\finterstitial\x18\f \x01(\bR\finterstitial\x12)\n" +
+	"\x10destination_host\x18\r \x01(\tR\x0fdestinationHost\x12\x16\n" +
+	"\x06unfurl\x18\x0e \x01(\bR\x06unfurl\x12#\n" +
+	"\rpreview_title\x18\x0f \x01(\tR\fpreviewTitle\x12/\n" +
+	"\x13preview_description\x18\x10 \x01(\tR\x12previewDescription\x12#\n" +
+	"\rpreview_image\x18\x11 \x01(\tR\fpreviewImage\x12\x16\n" +
+	"\x06domain\x18\x12 \x01(\tR\x06domain\x12\x1d\n" +
+	"\n" +
+	"short_code\x18\x13 \x01(\tR\tshortCode\"\xbb\x01\n" +
+	"\x0eLifecycleEvent\x12\x1d\n" +
+	"\n" +
+	"short_code\x18\x01 \x01(\tR\tshortCode\x12\x14\n" +
+	"\x05event\x18\x02 \x01(\tR\x05event\x12\x1c\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\x12\x1f\n" +
+	"\vclick_count\x18\x04 \x01(\x03R\n" +
+	"clickCount\x12\x1d\n" +
+	"\n" +
+	"max_clicks\x18\x05 \x01(\x03R\tmaxClicks\x12\x16\n" +
+	"\x06domain\x18\x06 \x01(\tR\x06domain\"\x83\x01\n" +
+	"\x15VerifyPasswordRequest\x12\x1d\n" +
+	"\n" +
+	"short_code\x18\x01 \x01(\tR\tshortCode\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1b\n" +
+	"\tclient_ip\x18\x03 \x01(\tR\bclientIp\x12\x12\n" +
+	"\x04host\x18\x04 \x01(\tR\x04host\"\xca\x01\n" +
+	"\x16VerifyPasswordResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03R\texpiresAt\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x1f\n" +
+	"\vretry_after\x18\x05 \x01(\x03R\n" +
+	"retryAfter\x12\x1d\n" +
+	"\n" +
+	"short_code\x18\x06 \x01(\tR\tshortCode\"\xa8\x03\n" +
 	"\fClickRequest\x12\x1d\n" +
 	"\n" +
 	"short_code\x18\x01 \x01(\tR\tshortCode\x12\x19\n" +
@@ -750,7 +1275,11 @@ const file_proto_redirect_redirect_proto_rawDesc = "" +
 	"\abrowser\x18\t \x01(\tR\abrowser\x12\x0e\n" +
 	"\x02os\x18\n" +
 	" \x01(\tR\x02os\x12\x1c\n" +
-	"\ttimestamp\x18\v \x01(\x03R\ttimestamp\"?\n" +
+	"\ttimestamp\x18\v \x01(\x03R\ttimestamp\x12\x16\n" +
+	"\x06source\x18\f \x01(\tR\x06source\x12\x16\n" +
+	"\x06domain\x18\r \x01(\tR\x06domain\x12'\n" +
+	"\x0faccept_language\x18\x0e \x01(\tR\x0eacceptLanguage\x12\x16\n" +
+	"\x06accept\x18\x0f \x01(\tR\x06accept\"?\n" +
 	"\rClickResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
 	"\x05error\x18\x02 \x01(\tR\x05error\"\x0f\n" +
@@ -759,7 +1288,7 @@ const file_proto_redirect_redirect_proto_rawDesc = "" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
 	"\aservice\x18\x02 \x01(\tR\aservice\x12\x18\n" +
 	"\aversion\x18\x03 \x01(\tR\aversion\x12\x1c\n" +
-	"\ttimestamp\x18\x04 \x01(\x03R\ttimestamp\"\xf1\x02\n" +
+	"\ttimestamp\x18\x04 \x01(\x03R\ttimestamp\"\xff\x04\n" +
 	"\n" +
 	"ClickEvent\x12\x1d\n" +
 	"\n" +
@@ -779,7 +1308,19 @@ const file_proto_redirect_redirect_proto_rawDesc = "" +
 	"\ttimestamp\x18\v \x01(\x03R\ttimestamp\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\f \x01(\tR\tsessionId\x12\x1b\n" +
-	"\tis_unique\x18\r \x01(\bR\bisUnique\"\xc5\x01\n" +
+	"\tis_unique\x18\r \x01(\bR\bisUnique\x12\x1d\n" +
+	"\n" +
+	"utm_source\x18\x0e \x01(\tR\tutmSource\x12\x1d\n" +
+	"\n" +
+	"utm_medium\x18\x0f \x01(\tR\tutmMedium\x12!\n" +
+	"\futm_campaign\x18\x10 \x01(\tR\vutmCampaign\x12\x19\n" +
+	"\butm_term\x18\x11 \x01(\tR\autmTerm\x12\x1f\n" +
+	"\vutm_content\x18\x12 \x01(\tR\n" +
+	"utmContent\x12\x16\n" +
+	"\x06source\x18\x13 \x01(\tR\x06source\x12\x16\n" +
+	"\x06domain\x18\x14 \x01(\tR\x06domain\x12'\n" +
+	"\x0faccept_language\x18\x15 \x01(\tR\x0eacceptLanguage\x12\x16\n" +
+	"\x06accept\x18\x16 \x01(\tR\x06accept\"\xc5\x01\n" +
 	"\rURLCacheEntry\x12\x1d\n" +
 	"\n" +
 	"short_code\x18\x01 \x01(\tR\tshortCode\x12\x19\n" +
@@ -790,10 +1331,11 @@ const file_proto_redirect_redirect_proto_rawDesc = "" +
 	"expires_at\x18\x04 \x01(\x03R\texpiresAt\x12\x1f\n" +
 	"\vclick_count\x18\x05 \x01(\x03R\n" +
 	"clickCount\x12\x1b\n" +
-	"\tis_active\x18\x06 \x01(\bR\bisActive2\xd0\x01\n" +
+	"\tis_active\x18\x06 \x01(\bR\bisActive2\xa5\x02\n" +
 	"\x0fRedirectService\x12A\n" +
 	"\n" +
-	"ResolveURL\x12\x18.redirect.ResolveRequest\x1a\x19.redirect.ResolveResponse\x12=\n" +
+	"ResolveURL\x12\x18.redirect.ResolveRequest\x1a\x19.redirect.ResolveResponse\x12S\n" +
+	"\x0eVerifyPassword\x12\x1f.redirect.VerifyPasswordRequest\x1a .redirect.VerifyPasswordResponse\x12=\n" +
 	"\n" +
 	"TrackClick\x12\x16.redirect.ClickRequest\x1a\x17.redirect.ClickResponse\x12;\n" +
 	"\x06Health\x12\x17.redirect.HealthRequest\x1a\x18.redirect.HealthResponseB;Z9github.com/go-systems-lab/go-url-shortener/proto/redirectb\x06proto3"
@@ -810,26 +1352,31 @@ func file_proto_redirect_redirect_proto_rawDescGZIP() []byte {
 	return file_proto_redirect_redirect_proto_rawDescData
 }
 
-var file_proto_redirect_redirect_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_redirect_redirect_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_proto_redirect_redirect_proto_goTypes = []any{
-	(*ResolveRequest)(nil),  // 0: redirect.ResolveRequest
-	(*ResolveResponse)(nil), // 1: redirect.ResolveResponse
-	(*ClickRequest)(nil),    // 2: redirect.ClickRequest
-	(*ClickResponse)(nil),   // 3: redirect.ClickResponse
-	(*HealthRequest)(nil),   // 4: redirect.HealthRequest
-	(*HealthResponse)(nil),  // 5: redirect.HealthResponse
-	(*ClickEvent)(nil),      // 6: redirect.ClickEvent
-	(*URLCacheEntry)(nil),   // 7: redirect.URLCacheEntry
+	(*ResolveRequest)(nil),         // 0: redirect.ResolveRequest
+	(*ResolveResponse)(nil),        // 1: redirect.ResolveResponse
+	(*LifecycleEvent)(nil),         // 2: redirect.LifecycleEvent
+	(*VerifyPasswordRequest)(nil),  // 3: redirect.VerifyPasswordRequest
+	(*VerifyPasswordResponse)(nil), // 4: redirect.VerifyPasswordResponse
+	(*ClickRequest)(nil),           // 5: redirect.ClickRequest
+	(*ClickResponse)(nil),          // 6: redirect.ClickResponse
+	(*HealthRequest)(nil),          // 7: redirect.HealthRequest
+	(*HealthResponse)(nil),         // 8: redirect.HealthResponse
+	(*ClickEvent)(nil),             // 9: redirect.ClickEvent
+	(*URLCacheEntry)(nil),          // 10: redirect.URLCacheEntry
 }
 var file_proto_redirect_redirect_proto_depIdxs = []int32{
 	0, // 0: redirect.RedirectService.ResolveURL:input_type -> redirect.ResolveRequest
-	2, // 1: redirect.RedirectService.TrackClick:input_type -> redirect.ClickRequest
-	4, // 2: redirect.RedirectService.Health:input_type -> redirect.HealthRequest
-	1, // 3: redirect.RedirectService.ResolveURL:output_type -> redirect.ResolveResponse
-	3, // 4: redirect.RedirectService.TrackClick:output_type -> redirect.ClickResponse
-	5, // 5: redirect.RedirectService.Health:output_type -> redirect.HealthResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
+	3, // 1: redirect.RedirectService.VerifyPassword:input_type -> redirect.VerifyPasswordRequest
+	5, // 2: redirect.RedirectService.TrackClick:input_type -> redirect.ClickRequest
+	7, // 3: redirect.RedirectService.Health:input_type -> redirect.HealthRequest
+	1, // 4: redirect.RedirectService.ResolveURL:output_type -> redirect.ResolveResponse
+	4, // 5: redirect.RedirectService.VerifyPassword:output_type -> redirect.VerifyPasswordResponse
+	6, // 6: redirect.RedirectService.TrackClick:output_type -> redirect.ClickResponse
+	8, // 7: redirect.RedirectService.Health:output_type -> redirect.HealthResponse
+	4, // [4:8] is the sub-list for method output_type
+	0, // [0:4] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -846,7 +1393,7 @@ func file_proto_redirect_redirect_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_redirect_redirect_proto_rawDesc), len(file_proto_redirect_redirect_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -30,6 +30,8 @@ var _ server.Option
 type RedirectService interface {
 	// Resolve short code to original URL
 	ResolveURL(ctx context.Context, in *ResolveRequest, opts ...client.CallOption) (*ResolveResponse, error)
+	// Verify a link password and issue a short-lived access token
+	VerifyPassword(ctx context.Context, in *VerifyPasswordRequest, opts ...client.CallOption) (*VerifyPasswordResponse, error)
 	// Track click analytics (async)
 	TrackClick(ctx context.Context, in *ClickRequest, opts ...client.CallOption) (*ClickResponse, error)
 	// Health check
@@ -58,6 +60,16 @@ func (c *redirectService) ResolveURL(ctx context.Context, in *ResolveRequest, op
 	return out, nil
 }
 
+func (c *redirectService) VerifyPassword(ctx context.Context, in *VerifyPasswordRequest, opts ...client.CallOption) (*VerifyPasswordResponse, error) {
+	req := c.c.NewRequest(c.name, "RedirectService.VerifyPassword", in)
+	out := new(VerifyPasswordResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *redirectService) TrackClick(ctx context.Context, in *ClickRequest, opts ...client.CallOption) (*ClickResponse, error) {
 	req := c.c.NewRequest(c.name, "RedirectService.TrackClick", in)
 	out := new(ClickResponse)
@@ -83,6 +95,8 @@ func (c *redirectService) Health(ctx context.Context, in *HealthRequest, opts ..
 type RedirectServiceHandler interface {
 	// Resolve short code to original URL
 	ResolveURL(context.Context, *ResolveRequest, *ResolveResponse) error
+	// Verify a link password and issue a short-lived access token
+	VerifyPassword(context.Context, *VerifyPasswordRequest, *VerifyPasswordResponse) error
 	// Track click analytics (async)
 	TrackClick(context.Context, *ClickRequest, *ClickResponse) error
 	// Health check
@@ -92,6 +106,7 @@ type RedirectServiceHandler interface {
 func RegisterRedirectServiceHandler(s server.Server, hdlr RedirectServiceHandler, opts ...server.HandlerOption) error {
 	type redirectService interface {
 		ResolveURL(ctx context.Context, in *ResolveRequest, out *ResolveResponse) error
+		VerifyPassword(ctx context.Context, in *VerifyPasswordRequest, out *VerifyPasswordResponse) error
 		TrackClick(ctx context.Context, in *ClickRequest, out *ClickResponse) error
 		Health(ctx context.Context, in *HealthRequest, out *HealthResponse) error
 	}
@@ -110,6 +125,10 @@ func (h *redirectServiceHandler) ResolveURL(ctx context.Context, in *ResolveRequ
 	return h.RedirectServiceHandler.ResolveURL(ctx, in, out)
 }
 
+func (h *redirectServiceHandler) VerifyPassword(ctx context.Context, in *VerifyPasswordRequest, out *VerifyPasswordResponse) error {
+	return h.RedirectServiceHandler.VerifyPassword(ctx, in, out)
+}
+
 func (h *redirectServiceHandler) TrackClick(ctx context.Context, in *ClickRequest, out *ClickResponse) error {
 	return h.RedirectServiceHandler.TrackClick(ctx, in, out)
 }
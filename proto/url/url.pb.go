@@ -23,14 +23,24 @@ const (
 
 // Shorten URL Request
 type ShortenRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	LongUrl        string                 `protobuf:"bytes,1,opt,name=long_url,json=longUrl,proto3" json:"long_url,omitempty"`
-	CustomAlias    string                 `protobuf:"bytes,2,opt,name=custom_alias,json=customAlias,proto3" json:"custom_alias,omitempty"`           // optional custom short code
-	ExpirationTime int64                  `protobuf:"varint,3,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"` // unix timestamp, 0 for no expiration
-	UserId         string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Metadata       map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // additional metadata
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LongUrl         string                 `protobuf:"bytes,1,opt,name=long_url,json=longUrl,proto3" json:"long_url,omitempty"`
+	CustomAlias     string                 `protobuf:"bytes,2,opt,name=custom_alias,json=customAlias,proto3" json:"custom_alias,omitempty"`           // optional custom short code
+	ExpirationTime  int64                  `protobuf:"varint,3,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"` // unix timestamp, 0 for no expiration
+	UserId          string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Metadata        map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`                          // additional metadata
+	WorkspaceId     string                 `protobuf:"bytes,6,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`                                                                           // optional workspace the link belongs to
+	UtmTemplate     map[string]string      `protobuf:"bytes,7,rep,name=utm_template,json=utmTemplate,proto3" json:"utm_template,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // optional link-level UTM template
+	Password        string                 `protobuf:"bytes,8,opt,name=password,proto3" json:"password,omitempty"`                                                                                                    // optional, visitors must enter it before being redirected
+	ActivationTime  int64                  `protobuf:"varint,9,opt,name=activation_time,json=activationTime,proto3" json:"activation_time,omitempty"`                                                                 // unix timestamp, 0 to activate immediately
+	FallbackUrl     string                 `protobuf:"bytes,10,opt,name=fallback_url,json=fallbackUrl,proto3" json:"fallback_url,omitempty"`                                                                          // optional, where visitors go before activation
+	MaxClicks       int64                  `protobuf:"varint,11,opt,name=max_clicks,json=maxClicks,proto3" json:"max_clicks,omitempty"`                                                                               // optional, link stops working after this many clicks (1 = one-time link)
+	PreviewOverride *LinkPreview           `protobuf:"bytes,12,opt,name=preview_override,json=previewOverride,proto3" json:"preview_override,omitempty"`                                                              // optional, replaces scraped unfurl metadata field by field
+	Domain          string                 `protobuf:"bytes,13,opt,name=domain,proto3" json:"domain,omitempty"`                                                                                                       // optional branded short domain of the workspace, empty for the default domain
+	Tags            []string               `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`                                                                                                           // optional, tags missing for the user are created
+	FolderId        int64                  `protobuf:"varint,15,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`                                                                                  // optional folder of the user, 0 for none
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ShortenRequest) Reset() {
@@ -98,22 +108,158 @@ func (x *ShortenRequest) GetMetadata() map[string]string {
 	return nil
 }
 
-// Shorten URL Response
-type ShortenResponse struct {
+func (x *ShortenRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *ShortenRequest) GetUtmTemplate() map[string]string {
+	if x != nil {
+		return x.UtmTemplate
+	}
+	return nil
+}
+
+func (x *ShortenRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ShortenRequest) GetActivationTime() int64 {
+	if x != nil {
+		return x.ActivationTime
+	}
+	return 0
+}
+
+func (x *ShortenRequest) GetFallbackUrl() string {
+	if x != nil {
+		return x.FallbackUrl
+	}
+	return ""
+}
+
+func (x *ShortenRequest) GetMaxClicks() int64 {
+	if x != nil {
+		return x.MaxClicks
+	}
+	return 0
+}
+
+func (x *ShortenRequest) GetPreviewOverride() *LinkPreview {
+	if x != nil {
+		return x.PreviewOverride
+	}
+	return nil
+}
+
+func (x *ShortenRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ShortenRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ShortenRequest) GetFolderId() int64 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+// Open Graph / Twitter card metadata shown when a link is unfurled
+type LinkPreview struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShortCode     string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
-	ShortUrl      string                 `protobuf:"bytes,2,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`
-	LongUrl       string                 `protobuf:"bytes,3,opt,name=long_url,json=longUrl,proto3" json:"long_url,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	ExpiresAt     int64                  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	UserId        string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"` // absolute http(s) URL
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *LinkPreview) Reset() {
+	*x = LinkPreview{}
+	mi := &file_proto_url_url_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkPreview) ProtoMessage() {}
+
+func (x *LinkPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkPreview.ProtoReflect.Descriptor instead.
+func (*LinkPreview) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LinkPreview) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+// Shorten URL Response
+type ShortenResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ShortCode         string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
+	ShortUrl          string                 `protobuf:"bytes,2,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`
+	LongUrl           string                 `protobuf:"bytes,3,opt,name=long_url,json=longUrl,proto3" json:"long_url,omitempty"`
+	CreatedAt         int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt         int64                  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	UserId            string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WorkspaceId       string                 `protobuf:"bytes,7,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	PasswordProtected bool                   `protobuf:"varint,8,opt,name=password_protected,json=passwordProtected,proto3" json:"password_protected,omitempty"`
+	ActivatesAt       int64                  `protobuf:"varint,9,opt,name=activates_at,json=activatesAt,proto3" json:"activates_at,omitempty"`
+	MaxClicks         int64                  `protobuf:"varint,10,opt,name=max_clicks,json=maxClicks,proto3" json:"max_clicks,omitempty"`
+	Domain            string                 `protobuf:"bytes,11,opt,name=domain,proto3" json:"domain,omitempty"` // empty for the default short domain
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
 func (x *ShortenResponse) Reset() {
 	*x = ShortenResponse{}
-	mi := &file_proto_url_url_proto_msgTypes[1]
+	mi := &file_proto_url_url_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -125,7 +271,7 @@ func (x *ShortenResponse) String() string {
 func (*ShortenResponse) ProtoMessage() {}
 
 func (x *ShortenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_url_url_proto_msgTypes[1]
+	mi := &file_proto_url_url_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -138,7 +284,7 @@ func (x *ShortenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShortenResponse.ProtoReflect.Descriptor instead.
 func (*ShortenResponse) Descriptor() ([]byte, []int) {
-	return file_proto_url_url_proto_rawDescGZIP(), []int{1}
+	return file_proto_url_url_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ShortenResponse) GetShortCode() string {
@@ -183,18 +329,54 @@ func (x *ShortenResponse) GetUserId() string {
 	return ""
 }
 
+func (x *ShortenResponse) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *ShortenResponse) GetPasswordProtected() bool {
+	if x != nil {
+		return x.PasswordProtected
+	}
+	return false
+}
+
+func (x *ShortenResponse) GetActivatesAt() int64 {
+	if x != nil {
+		return x.ActivatesAt
+	}
+	return 0
+}
+
+func (x *ShortenResponse) GetMaxClicks() int64 {
+	if x != nil {
+		return x.MaxClicks
+	}
+	return 0
+}
+
+func (x *ShortenResponse) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
 // Get URL Information Request
 type GetURLRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ShortCode     string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // for authorization
+	Domain        string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`               // short domain of the link, empty for the default
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetURLRequest) Reset() {
 	*x = GetURLRequest{}
-	mi := &file_proto_url_url_proto_msgTypes[2]
+	mi := &file_proto_url_url_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -206,7 +388,7 @@ func (x *GetURLRequest) String() string {
 func (*GetURLRequest) ProtoMessage() {}
 
 func (x *GetURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_url_url_proto_msgTypes[2]
+	mi := &file_proto_url_url_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -219,7 +401,7 @@ func (x *GetURLRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetURLRequest.ProtoReflect.Descriptor instead.
 func (*GetURLRequest) Descriptor() ([]byte, []int) {
-	return file_proto_url_url_proto_rawDescGZIP(), []int{2}
+	return file_proto_url_url_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetURLRequest) GetShortCode() string {
@@ -236,25 +418,50 @@ func (x *GetURLRequest) GetUserId() string {
 	return ""
 }
 
+func (x *GetURLRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
 // URL Information
 type URLInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShortCode     string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
-	ShortUrl      string                 `protobuf:"bytes,2,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`
-	LongUrl       string                 `protobuf:"bytes,3,opt,name=long_url,json=longUrl,proto3" json:"long_url,omitempty"`
-	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	ExpiresAt     int64                  `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	ClickCount    int64                  `protobuf:"varint,7,opt,name=click_count,json=clickCount,proto3" json:"click_count,omitempty"`
-	IsActive      bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	Metadata      map[string]string      `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ShortCode         string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
+	ShortUrl          string                 `protobuf:"bytes,2,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`
+	LongUrl           string                 `protobuf:"bytes,3,opt,name=long_url,json=longUrl,proto3" json:"long_url,omitempty"`
+	UserId            string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt         int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt         int64                  `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ClickCount        int64                  `protobuf:"varint,7,opt,name=click_count,json=clickCount,proto3" json:"click_count,omitempty"`
+	IsActive          bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Metadata          map[string]string      `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	WorkspaceId       string                 `protobuf:"bytes,10,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	UtmTemplate       map[string]string      `protobuf:"bytes,11,rep,name=utm_template,json=utmTemplate,proto3" json:"utm_template,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	PasswordProtected bool                   `protobuf:"varint,12,opt,name=password_protected,json=passwordProtected,proto3" json:"password_protected,omitempty"`
+	ActivatesAt       int64                  `protobuf:"varint,13,opt,name=activates_at,json=activatesAt,proto3" json:"activates_at,omitempty"`
+	FallbackUrl       string                 `protobuf:"bytes,14,opt,name=fallback_url,json=fallbackUrl,proto3" json:"fallback_url,omitempty"`
+	MaxClicks         int64                  `protobuf:"varint,15,opt,name=max_clicks,json=maxClicks,proto3" json:"max_clicks,omitempty"`                     // 0 for unlimited
+	InterstitialMode  string                 `protobuf:"bytes,16,opt,name=interstitial_mode,json=interstitialMode,proto3" json:"interstitial_mode,omitempty"` // auto, always, never
+	DisabledReason    string                 `protobuf:"bytes,17,opt,name=disabled_reason,json=disabledReason,proto3" json:"disabled_reason,omitempty"`       // safety reason code when the link was flagged
+	DisabledAt        int64                  `protobuf:"varint,18,opt,name=disabled_at,json=disabledAt,proto3" json:"disabled_at,omitempty"`
+	Preview           *LinkPreview           `protobuf:"bytes,19,opt,name=preview,proto3" json:"preview,omitempty"`                                        // scraped from the destination
+	PreviewOverride   *LinkPreview           `protobuf:"bytes,20,opt,name=preview_override,json=previewOverride,proto3" json:"preview_override,omitempty"` // set by the owner
+	Domain            string                 `protobuf:"bytes,21,opt,name=domain,proto3" json:"domain,omitempty"`                                          // empty for the default short domain
+	Tags              []string               `protobuf:"bytes,22,rep,name=tags,proto3" json:"tags,omitempty"`
+	FolderId          int64                  `protobuf:"varint,23,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`                         // 0 when the link is not in a folder
+	DeletedAt         int64                  `protobuf:"varint,24,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`                      // set while the link is in the trash
+	PurgeAt           int64                  `protobuf:"varint,25,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`                            // when the trash retention purges it, 0 for never
+	InactivityExempt  bool                   `protobuf:"varint,26,opt,name=inactivity_exempt,json=inactivityExempt,proto3" json:"inactivity_exempt,omitempty"` // never archived by the workspace's inactivity policy
+	ArchivedAt        int64                  `protobuf:"varint,27,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`                   // set while the link is archived for inactivity
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *URLInfo) Reset() {
 	*x = URLInfo{}
-	mi := &file_proto_url_url_proto_msgTypes[3]
+	mi := &file_proto_url_url_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -266,7 +473,7 @@ func (x *URLInfo) String() string {
 func (*URLInfo) ProtoMessage() {}
 
 func (x *URLInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_url_url_proto_msgTypes[3]
+	mi := &file_proto_url_url_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -279,7 +486,7 @@ func (x *URLInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use URLInfo.ProtoReflect.Descriptor instead.
 func (*URLInfo) Descriptor() ([]byte, []int) {
-	return file_proto_url_url_proto_rawDescGZIP(), []int{3}
+	return file_proto_url_url_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *URLInfo) GetShortCode() string {
@@ -345,139 +552,4711 @@ func (x *URLInfo) GetMetadata() map[string]string {
 	return nil
 }
 
-// Delete URL Request
-type DeleteURLRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShortCode     string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // for authorization
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *URLInfo) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
 }
 
-func (x *DeleteURLRequest) Reset() {
-	*x = DeleteURLRequest{}
-	mi := &file_proto_url_url_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *URLInfo) GetUtmTemplate() map[string]string {
+	if x != nil {
+		return x.UtmTemplate
+	}
+	return nil
 }
 
-func (x *DeleteURLRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *URLInfo) GetPasswordProtected() bool {
+	if x != nil {
+		return x.PasswordProtected
+	}
+	return false
 }
 
-func (*DeleteURLRequest) ProtoMessage() {}
+func (x *URLInfo) GetActivatesAt() int64 {
+	if x != nil {
+		return x.ActivatesAt
+	}
+	return 0
+}
 
-func (x *DeleteURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_url_url_proto_msgTypes[4]
+func (x *URLInfo) GetFallbackUrl() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.FallbackUrl
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteURLRequest.ProtoReflect.Descriptor instead.
-func (*DeleteURLRequest) Descriptor() ([]byte, []int) {
-	return file_proto_url_url_proto_rawDescGZIP(), []int{4}
+func (x *URLInfo) GetMaxClicks() int64 {
+	if x != nil {
+		return x.MaxClicks
+	}
+	return 0
 }
 
-func (x *DeleteURLRequest) GetShortCode() string {
+func (x *URLInfo) GetInterstitialMode() string {
 	if x != nil {
-		return x.ShortCode
+		return x.InterstitialMode
 	}
 	return ""
 }
 
-func (x *DeleteURLRequest) GetUserId() string {
+func (x *URLInfo) GetDisabledReason() string {
 	if x != nil {
-		return x.UserId
+		return x.DisabledReason
 	}
 	return ""
 }
 
-// Delete URL Response
-type DeleteResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *URLInfo) GetDisabledAt() int64 {
+	if x != nil {
+		return x.DisabledAt
+	}
+	return 0
 }
 
-func (x *DeleteResponse) Reset() {
-	*x = DeleteResponse{}
-	mi := &file_proto_url_url_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *URLInfo) GetPreview() *LinkPreview {
+	if x != nil {
+		return x.Preview
+	}
+	return nil
 }
 
-func (x *DeleteResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *URLInfo) GetPreviewOverride() *LinkPreview {
+	if x != nil {
+		return x.PreviewOverride
+	}
+	return nil
 }
 
-func (*DeleteResponse) ProtoMessage() {}
-
-func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_url_url_proto_msgTypes[5]
+func (x *URLInfo) GetDomain() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Domain
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
-func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_url_url_proto_rawDescGZIP(), []int{5}
+func (x *URLInfo) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
 }
 
-func (x *DeleteResponse) GetSuccess() bool {
+func (x *URLInfo) GetFolderId() int64 {
 	if x != nil {
-		return x.Success
+		return x.FolderId
 	}
-	return false
+	return 0
 }
 
-func (x *DeleteResponse) GetMessage() string {
+func (x *URLInfo) GetDeletedAt() int64 {
 	if x != nil {
-		return x.Message
+		return x.DeletedAt
 	}
-	return ""
+	return 0
+}
+
+func (x *URLInfo) GetPurgeAt() int64 {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return 0
+}
+
+func (x *URLInfo) GetInactivityExempt() bool {
+	if x != nil {
+		return x.InactivityExempt
+	}
+	return false
+}
+
+func (x *URLInfo) GetArchivedAt() int64 {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return 0
+}
+
+// Delete URL Request
+type DeleteURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortCode     string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // for authorization
+	Domain        string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`               // short domain of the link, empty for the default
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteURLRequest) Reset() {
+	*x = DeleteURLRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteURLRequest) ProtoMessage() {}
+
+func (x *DeleteURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteURLRequest.ProtoReflect.Descriptor instead.
+func (*DeleteURLRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteURLRequest) GetShortCode() string {
+	if x != nil {
+		return x.ShortCode
+	}
+	return ""
+}
+
+func (x *DeleteURLRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteURLRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+// Delete URL Response
+type DeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResponse) Reset() {
+	*x = DeleteResponse{}
+	mi := &file_proto_url_url_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResponse) ProtoMessage() {}
+
+func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
 }
 
 // Get User URLs Request (pagination)
 type GetUserURLsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page              int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"` // deprecated: page numbers use OFFSET; use cursor
+	PageSize          int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	SortBy            string                 `protobuf:"bytes,4,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`                                   // created_at (default), click_count, last_accessed, alias
+	SortOrder         string                 `protobuf:"bytes,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`                          // desc (default), asc
+	Tag               string                 `protobuf:"bytes,6,opt,name=tag,proto3" json:"tag,omitempty"`                                                       // optional, only links with this tag
+	FolderId          int64                  `protobuf:"varint,7,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`                            // optional, only links in this folder
+	IncludeSubfolders bool                   `protobuf:"varint,8,opt,name=include_subfolders,json=includeSubfolders,proto3" json:"include_subfolders,omitempty"` // with folder_id, also links in nested folders
+	Cursor            string                 `protobuf:"bytes,9,opt,name=cursor,proto3" json:"cursor,omitempty"`                                                 // next_cursor or prev_cursor of a previous page; wins over page
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetUserURLsRequest) Reset() {
+	*x = GetUserURLsRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserURLsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserURLsRequest) ProtoMessage() {}
+
+func (x *GetUserURLsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserURLsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserURLsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetUserURLsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserURLsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetUserURLsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetUserURLsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *GetUserURLsRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *GetUserURLsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *GetUserURLsRequest) GetFolderId() int64 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *GetUserURLsRequest) GetIncludeSubfolders() bool {
+	if x != nil {
+		return x.IncludeSubfolders
+	}
+	return false
+}
+
+func (x *GetUserURLsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// Get User URLs Response
+type GetUserURLsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Urls          []*URLInfo             `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"` // 0 when paging with cursors
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	HasNext       bool                   `protobuf:"varint,5,opt,name=has_next,json=hasNext,proto3" json:"has_next,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,6,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // empty on the last page
+	PrevCursor    string                 `protobuf:"bytes,7,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"` // empty on the first page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserURLsResponse) Reset() {
+	*x = GetUserURLsResponse{}
+	mi := &file_proto_url_url_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserURLsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserURLsResponse) ProtoMessage() {}
+
+func (x *GetUserURLsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserURLsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserURLsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetUserURLsResponse) GetUrls() []*URLInfo {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *GetUserURLsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetUserURLsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetUserURLsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetUserURLsResponse) GetHasNext() bool {
+	if x != nil {
+		return x.HasNext
+	}
+	return false
+}
+
+func (x *GetUserURLsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetUserURLsResponse) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
+// Update URL Request
+type UpdateURLRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	ShortCode             string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
+	UserId                string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NewLongUrl            string                 `protobuf:"bytes,3,opt,name=new_long_url,json=newLongUrl,proto3" json:"new_long_url,omitempty"`                                                                            // optional
+	NewExpirationTime     int64                  `protobuf:"varint,4,opt,name=new_expiration_time,json=newExpirationTime,proto3" json:"new_expiration_time,omitempty"`                                                      // optional
+	Metadata              map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`                          // optional
+	UtmTemplate           map[string]string      `protobuf:"bytes,6,rep,name=utm_template,json=utmTemplate,proto3" json:"utm_template,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // optional, replaces the link-level template
+	ClearUtmTemplate      bool                   `protobuf:"varint,7,opt,name=clear_utm_template,json=clearUtmTemplate,proto3" json:"clear_utm_template,omitempty"`                                                         // remove the link-level template
+	NewPassword           string                 `protobuf:"bytes,8,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`                                                                           // optional, sets or replaces the link password
+	ClearPassword         bool                   `protobuf:"varint,9,opt,name=clear_password,json=clearPassword,proto3" json:"clear_password,omitempty"`                                                                    // remove password protection
+	NewActivationTime     int64                  `protobuf:"varint,10,opt,name=new_activation_time,json=newActivationTime,proto3" json:"new_activation_time,omitempty"`                                                     // optional
+	NewFallbackUrl        string                 `protobuf:"bytes,11,opt,name=new_fallback_url,json=newFallbackUrl,proto3" json:"new_fallback_url,omitempty"`                                                               // optional
+	NewMaxClicks          int64                  `protobuf:"varint,12,opt,name=new_max_clicks,json=newMaxClicks,proto3" json:"new_max_clicks,omitempty"`                                                                    // optional
+	ClearMaxClicks        bool                   `protobuf:"varint,13,opt,name=clear_max_clicks,json=clearMaxClicks,proto3" json:"clear_max_clicks,omitempty"`                                                              // remove the click limit
+	PreviewOverride       *LinkPreview           `protobuf:"bytes,14,opt,name=preview_override,json=previewOverride,proto3" json:"preview_override,omitempty"`                                                              // optional, replaces the preview overrides (empty message clears them)
+	Domain                string                 `protobuf:"bytes,15,opt,name=domain,proto3" json:"domain,omitempty"`                                                                                                       // short domain of the link, empty for the default
+	Tags                  []string               `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"`                                                                                                           // optional, replaces the tags
+	ClearTags             bool                   `protobuf:"varint,17,opt,name=clear_tags,json=clearTags,proto3" json:"clear_tags,omitempty"`                                                                               // remove all tags
+	NewFolderId           int64                  `protobuf:"varint,18,opt,name=new_folder_id,json=newFolderId,proto3" json:"new_folder_id,omitempty"`                                                                       // optional, moves the link into a folder
+	ClearFolder           bool                   `protobuf:"varint,19,opt,name=clear_folder,json=clearFolder,proto3" json:"clear_folder,omitempty"`                                                                         // take the link out of its folder
+	InactivityExempt      bool                   `protobuf:"varint,20,opt,name=inactivity_exempt,json=inactivityExempt,proto3" json:"inactivity_exempt,omitempty"`                                                          // exempt the link from the workspace's inactivity policy
+	ClearInactivityExempt bool                   `protobuf:"varint,21,opt,name=clear_inactivity_exempt,json=clearInactivityExempt,proto3" json:"clear_inactivity_exempt,omitempty"`                                         // make the policy apply to the link again
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpdateURLRequest) Reset() {
+	*x = UpdateURLRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateURLRequest) ProtoMessage() {}
+
+func (x *UpdateURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateURLRequest.ProtoReflect.Descriptor instead.
+func (*UpdateURLRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateURLRequest) GetShortCode() string {
+	if x != nil {
+		return x.ShortCode
+	}
+	return ""
+}
+
+func (x *UpdateURLRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateURLRequest) GetNewLongUrl() string {
+	if x != nil {
+		return x.NewLongUrl
+	}
+	return ""
+}
+
+func (x *UpdateURLRequest) GetNewExpirationTime() int64 {
+	if x != nil {
+		return x.NewExpirationTime
+	}
+	return 0
+}
+
+func (x *UpdateURLRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *UpdateURLRequest) GetUtmTemplate() map[string]string {
+	if x != nil {
+		return x.UtmTemplate
+	}
+	return nil
+}
+
+func (x *UpdateURLRequest) GetClearUtmTemplate() bool {
+	if x != nil {
+		return x.ClearUtmTemplate
+	}
+	return false
+}
+
+func (x *UpdateURLRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+func (x *UpdateURLRequest) GetClearPassword() bool {
+	if x != nil {
+		return x.ClearPassword
+	}
+	return false
+}
+
+func (x *UpdateURLRequest) GetNewActivationTime() int64 {
+	if x != nil {
+		return x.NewActivationTime
+	}
+	return 0
+}
+
+func (x *UpdateURLRequest) GetNewFallbackUrl() string {
+	if x != nil {
+		return x.NewFallbackUrl
+	}
+	return ""
+}
+
+func (x *UpdateURLRequest) GetNewMaxClicks() int64 {
+	if x != nil {
+		return x.NewMaxClicks
+	}
+	return 0
+}
+
+func (x *UpdateURLRequest) GetClearMaxClicks() bool {
+	if x != nil {
+		return x.ClearMaxClicks
+	}
+	return false
+}
+
+func (x *UpdateURLRequest) GetPreviewOverride() *LinkPreview {
+	if x != nil {
+		return x.PreviewOverride
+	}
+	return nil
+}
+
+func (x *UpdateURLRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *UpdateURLRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateURLRequest) GetClearTags() bool {
+	if x != nil {
+		return x.ClearTags
+	}
+	return false
+}
+
+func (x *UpdateURLRequest) GetNewFolderId() int64 {
+	if x != nil {
+		return x.NewFolderId
+	}
+	return 0
+}
+
+func (x *UpdateURLRequest) GetClearFolder() bool {
+	if x != nil {
+		return x.ClearFolder
+	}
+	return false
+}
+
+func (x *UpdateURLRequest) GetInactivityExempt() bool {
+	if x != nil {
+		return x.InactivityExempt
+	}
+	return false
+}
+
+func (x *UpdateURLRequest) GetClearInactivityExempt() bool {
+	if x != nil {
+		return x.ClearInactivityExempt
+	}
+	return false
+}
+
+// Update URL Response
+type UpdateURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	UpdatedUrl    *URLInfo               `protobuf:"bytes,3,opt,name=updated_url,json=updatedUrl,proto3" json:"updated_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateURLResponse) Reset() {
+	*x = UpdateURLResponse{}
+	mi := &file_proto_url_url_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateURLResponse) ProtoMessage() {}
+
+func (x *UpdateURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateURLResponse.ProtoReflect.Descriptor instead.
+func (*UpdateURLResponse) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateURLResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateURLResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateURLResponse) GetUpdatedUrl() *URLInfo {
+	if x != nil {
+		return x.UpdatedUrl
+	}
+	return nil
+}
+
+// Upsert Workspace Request
+type UpsertWorkspaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	UtmTemplate   map[string]string      `protobuf:"bytes,4,rep,name=utm_template,json=utmTemplate,proto3" json:"utm_template,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // default UTM template for links in the workspace
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertWorkspaceRequest) Reset() {
+	*x = UpsertWorkspaceRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertWorkspaceRequest) ProtoMessage() {}
+
+func (x *UpsertWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*UpsertWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpsertWorkspaceRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *UpsertWorkspaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpsertWorkspaceRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *UpsertWorkspaceRequest) GetUtmTemplate() map[string]string {
+	if x != nil {
+		return x.UtmTemplate
+	}
+	return nil
+}
+
+// Get Workspace Request
+type GetWorkspaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // for authorization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceRequest) Reset() {
+	*x = GetWorkspaceRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceRequest) ProtoMessage() {}
+
+func (x *GetWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetWorkspaceRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *GetWorkspaceRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Workspace Information
+type WorkspaceInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId      string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OwnerId          string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	UtmTemplate      map[string]string      `protobuf:"bytes,4,rep,name=utm_template,json=utmTemplate,proto3" json:"utm_template,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt        int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Plan             string                 `protobuf:"bytes,7,opt,name=plan,proto3" json:"plan,omitempty"`                                                    // decides the custom alias rules
+	InactivityDays   int32                  `protobuf:"varint,8,opt,name=inactivity_days,json=inactivityDays,proto3" json:"inactivity_days,omitempty"`         // archive links not clicked for this many days, 0 for never
+	InactivityDryRun bool                   `protobuf:"varint,9,opt,name=inactivity_dry_run,json=inactivityDryRun,proto3" json:"inactivity_dry_run,omitempty"` // only report the links the policy would archive
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WorkspaceInfo) Reset() {
+	*x = WorkspaceInfo{}
+	mi := &file_proto_url_url_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceInfo) ProtoMessage() {}
+
+func (x *WorkspaceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceInfo.ProtoReflect.Descriptor instead.
+func (*WorkspaceInfo) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WorkspaceInfo) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *WorkspaceInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkspaceInfo) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *WorkspaceInfo) GetUtmTemplate() map[string]string {
+	if x != nil {
+		return x.UtmTemplate
+	}
+	return nil
+}
+
+func (x *WorkspaceInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *WorkspaceInfo) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *WorkspaceInfo) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+func (x *WorkspaceInfo) GetInactivityDays() int32 {
+	if x != nil {
+		return x.InactivityDays
+	}
+	return 0
+}
+
+func (x *WorkspaceInfo) GetInactivityDryRun() bool {
+	if x != nil {
+		return x.InactivityDryRun
+	}
+	return false
+}
+
+// Set Workspace Plan Request (admin)
+type SetWorkspacePlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Plan          string                 `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWorkspacePlanRequest) Reset() {
+	*x = SetWorkspacePlanRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWorkspacePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWorkspacePlanRequest) ProtoMessage() {}
+
+func (x *SetWorkspacePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWorkspacePlanRequest.ProtoReflect.Descriptor instead.
+func (*SetWorkspacePlanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetWorkspacePlanRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *SetWorkspacePlanRequest) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+// Set Interstitial Mode Request (admin)
+type SetInterstitialModeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortCode     string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
+	Mode          string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`     // auto, always, never
+	Domain        string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"` // short domain of the link, empty for the default
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetInterstitialModeRequest) Reset() {
+	*x = SetInterstitialModeRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetInterstitialModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInterstitialModeRequest) ProtoMessage() {}
+
+func (x *SetInterstitialModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInterstitialModeRequest.ProtoReflect.Descriptor instead.
+func (*SetInterstitialModeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetInterstitialModeRequest) GetShortCode() string {
+	if x != nil {
+		return x.ShortCode
+	}
+	return ""
+}
+
+func (x *SetInterstitialModeRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *SetInterstitialModeRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+// Domain Review (admin review list for interstitial warnings)
+type DomainReview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // review (warn before leaving) or trusted (never warn in auto mode)
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,4,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DomainReview) Reset() {
+	*x = DomainReview{}
+	mi := &file_proto_url_url_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DomainReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DomainReview) ProtoMessage() {}
+
+func (x *DomainReview) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DomainReview.ProtoReflect.Descriptor instead.
+func (*DomainReview) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DomainReview) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *DomainReview) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DomainReview) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DomainReview) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *DomainReview) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *DomainReview) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// Delete Domain Review Request (admin)
+type DeleteDomainReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDomainReviewRequest) Reset() {
+	*x = DeleteDomainReviewRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDomainReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDomainReviewRequest) ProtoMessage() {}
+
+func (x *DeleteDomainReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDomainReviewRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDomainReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteDomainReviewRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+// List Domain Reviews Request (admin)
+type ListDomainReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // optional filter
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDomainReviewsRequest) Reset() {
+	*x = ListDomainReviewsRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDomainReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDomainReviewsRequest) ProtoMessage() {}
+
+func (x *ListDomainReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDomainReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListDomainReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListDomainReviewsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// Links disabled by the destination safety engine (admin operation)
+type ListFlaggedURLsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"` // deprecated: use cursor
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFlaggedURLsRequest) Reset() {
+	*x = ListFlaggedURLsRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFlaggedURLsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlaggedURLsRequest) ProtoMessage() {}
+
+func (x *ListFlaggedURLsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlaggedURLsRequest.ProtoReflect.Descriptor instead.
+func (*ListFlaggedURLsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListFlaggedURLsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFlaggedURLsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFlaggedURLsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// List Audit Events Request - the audit log, newest first (admin operation);
+// empty filters match all
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actor         string                 `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // url.create, url.update, url.delete, ...
+	Target        string                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"` // url:abc123, url:go.acme.com/abc123, workspace:marketing, ...
+	RequestId     string                 `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Since         int64                  `protobuf:"varint,5,opt,name=since,proto3" json:"since,omitempty"` // unix seconds, inclusive
+	Until         int64                  `protobuf:"varint,6,opt,name=until,proto3" json:"until,omitempty"` // unix seconds, exclusive
+	PageSize      int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        string                 `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListAuditEventsRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// Audit Event - an entry of the append-only, hash-chained audit log
+type AuditEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"` // user ID, "admin" or "system"
+	Ip            string                 `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,5,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	RequestId     string                 `protobuf:"bytes,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Action        string                 `protobuf:"bytes,7,opt,name=action,proto3" json:"action,omitempty"`
+	Target        string                 `protobuf:"bytes,8,opt,name=target,proto3" json:"target,omitempty"`
+	Before        string                 `protobuf:"bytes,9,opt,name=before,proto3" json:"before,omitempty"` // JSON state before the action, empty when there was none
+	After         string                 `protobuf:"bytes,10,opt,name=after,proto3" json:"after,omitempty"`  // JSON state after the action, empty when there is none
+	PrevHash      string                 `protobuf:"bytes,11,opt,name=prev_hash,json=prevHash,proto3" json:"prev_hash,omitempty"`
+	Hash          string                 `protobuf:"bytes,12,opt,name=hash,proto3" json:"hash,omitempty"` // sha256 over prev_hash and the event's fields
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_proto_url_url_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AuditEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetPrevHash() string {
+	if x != nil {
+		return x.PrevHash
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+// List Audit Events Response
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	HasNext       bool                   `protobuf:"varint,4,opt,name=has_next,json=hasNext,proto3" json:"has_next,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	PrevCursor    string                 `protobuf:"bytes,6,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_proto_url_url_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListAuditEventsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEventsResponse) GetHasNext() bool {
+	if x != nil {
+		return x.HasNext
+	}
+	return false
+}
+
+func (x *ListAuditEventsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListAuditEventsResponse) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
+// Verify Audit Log Request - checks the audit log's hash chain (admin operation)
+type VerifyAuditLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAuditLogRequest) Reset() {
+	*x = VerifyAuditLogRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAuditLogRequest) ProtoMessage() {}
+
+func (x *VerifyAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*VerifyAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{23}
+}
+
+// Audit Verification
+type AuditVerification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Checked       int64                  `protobuf:"varint,1,opt,name=checked,proto3" json:"checked,omitempty"`
+	Valid         bool                   `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	BrokenAt      int64                  `protobuf:"varint,3,opt,name=broken_at,json=brokenAt,proto3" json:"broken_at,omitempty"` // first event whose hash does not match
+	LastHash      string                 `protobuf:"bytes,4,opt,name=last_hash,json=lastHash,proto3" json:"last_hash,omitempty"`  // head of the chain
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditVerification) Reset() {
+	*x = AuditVerification{}
+	mi := &file_proto_url_url_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditVerification) ProtoMessage() {}
+
+func (x *AuditVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditVerification.ProtoReflect.Descriptor instead.
+func (*AuditVerification) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AuditVerification) GetChecked() int64 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *AuditVerification) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *AuditVerification) GetBrokenAt() int64 {
+	if x != nil {
+		return x.BrokenAt
+	}
+	return 0
+}
+
+func (x *AuditVerification) GetLastHash() string {
+	if x != nil {
+		return x.LastHash
+	}
+	return ""
+}
+
+// Get Link Health Request
+type GetLinkHealthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortCode     string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // for authorization
+	Domain        string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`               // short domain of the link, empty for the default
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLinkHealthRequest) Reset() {
+	*x = GetLinkHealthRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLinkHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLinkHealthRequest) ProtoMessage() {}
+
+func (x *GetLinkHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLinkHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetLinkHealthRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetLinkHealthRequest) GetShortCode() string {
+	if x != nil {
+		return x.ShortCode
+	}
+	return ""
+}
+
+func (x *GetLinkHealthRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetLinkHealthRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+// Latest background check of a link destination
+type LinkHealthInfo struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ShortCode           string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
+	Status              string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // healthy, failing, broken or unknown (never checked)
+	StatusCode          int32                  `protobuf:"varint,3,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	LatencyMs           int64                  `protobuf:"varint,4,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	RedirectChain       []string               `protobuf:"bytes,5,rep,name=redirect_chain,json=redirectChain,proto3" json:"redirect_chain,omitempty"`
+	Error               string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	ConsecutiveFailures int32                  `protobuf:"varint,7,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	CheckedAt           int64                  `protobuf:"varint,8,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	LastHealthyAt       int64                  `protobuf:"varint,9,opt,name=last_healthy_at,json=lastHealthyAt,proto3" json:"last_healthy_at,omitempty"`
+	Domain              string                 `protobuf:"bytes,10,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *LinkHealthInfo) Reset() {
+	*x = LinkHealthInfo{}
+	mi := &file_proto_url_url_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkHealthInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkHealthInfo) ProtoMessage() {}
+
+func (x *LinkHealthInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkHealthInfo.ProtoReflect.Descriptor instead.
+func (*LinkHealthInfo) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *LinkHealthInfo) GetShortCode() string {
+	if x != nil {
+		return x.ShortCode
+	}
+	return ""
+}
+
+func (x *LinkHealthInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LinkHealthInfo) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *LinkHealthInfo) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *LinkHealthInfo) GetRedirectChain() []string {
+	if x != nil {
+		return x.RedirectChain
+	}
+	return nil
+}
+
+func (x *LinkHealthInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *LinkHealthInfo) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *LinkHealthInfo) GetCheckedAt() int64 {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return 0
+}
+
+func (x *LinkHealthInfo) GetLastHealthyAt() int64 {
+	if x != nil {
+		return x.LastHealthyAt
+	}
+	return 0
+}
+
+func (x *LinkHealthInfo) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+// Get QR Code Request
+type GetQRCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortCode     string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                     // for authorization
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`                                   // png (default) or svg
+	Size          int32                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`                                      // pixels, 0 for the default
+	Margin        *int32                 `protobuf:"varint,5,opt,name=margin,proto3,oneof" json:"margin,omitempty"`                            // quiet zone in modules, unset for the default
+	Level         string                 `protobuf:"bytes,6,opt,name=level,proto3" json:"level,omitempty"`                                     // error correction: L, M (default), Q or H
+	Foreground    string                 `protobuf:"bytes,7,opt,name=foreground,proto3" json:"foreground,omitempty"`                           // hex colour, e.g. #000000
+	Background    string                 `protobuf:"bytes,8,opt,name=background,proto3" json:"background,omitempty"`                           // hex colour, e.g. #ffffff
+	LogoUrl       string                 `protobuf:"bytes,9,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`                  // optional image drawn in the centre
+	SourceMarker  bool                   `protobuf:"varint,10,opt,name=source_marker,json=sourceMarker,proto3" json:"source_marker,omitempty"` // encode ?src=qr so scans can be counted separately
+	Domain        string                 `protobuf:"bytes,11,opt,name=domain,proto3" json:"domain,omitempty"`                                  // short domain of the link, empty for the default
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQRCodeRequest) Reset() {
+	*x = GetQRCodeRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQRCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQRCodeRequest) ProtoMessage() {}
+
+func (x *GetQRCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQRCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetQRCodeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetQRCodeRequest) GetShortCode() string {
+	if x != nil {
+		return x.ShortCode
+	}
+	return ""
+}
+
+func (x *GetQRCodeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetQRCodeRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *GetQRCodeRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetQRCodeRequest) GetMargin() int32 {
+	if x != nil && x.Margin != nil {
+		return *x.Margin
+	}
+	return 0
+}
+
+func (x *GetQRCodeRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *GetQRCodeRequest) GetForeground() string {
+	if x != nil {
+		return x.Foreground
+	}
+	return ""
+}
+
+func (x *GetQRCodeRequest) GetBackground() string {
+	if x != nil {
+		return x.Background
+	}
+	return ""
+}
+
+func (x *GetQRCodeRequest) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *GetQRCodeRequest) GetSourceMarker() bool {
+	if x != nil {
+		return x.SourceMarker
+	}
+	return false
+}
+
+func (x *GetQRCodeRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+// Rendered QR code
+type QRCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   string                 `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"` // the URL encoded in the symbol
+	Etag          string                 `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QRCodeResponse) Reset() {
+	*x = QRCodeResponse{}
+	mi := &file_proto_url_url_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QRCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QRCodeResponse) ProtoMessage() {}
+
+func (x *QRCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QRCodeResponse.ProtoReflect.Descriptor instead.
+func (*QRCodeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *QRCodeResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *QRCodeResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *QRCodeResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *QRCodeResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// List Domain Reviews Response
+type ListDomainReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*DomainReview        `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDomainReviewsResponse) Reset() {
+	*x = ListDomainReviewsResponse{}
+	mi := &file_proto_url_url_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDomainReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDomainReviewsResponse) ProtoMessage() {}
+
+func (x *ListDomainReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDomainReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListDomainReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListDomainReviewsResponse) GetReviews() []*DomainReview {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+// Add or remove a branded short domain of a workspace
+type BrandedDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // for authorization, must own the workspace
+	Domain        string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	CodePolicy    string                 `protobuf:"bytes,4,opt,name=code_policy,json=codePolicy,proto3" json:"code_policy,omitempty"` // mixed (default) or readable
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrandedDomainRequest) Reset() {
+	*x = BrandedDomainRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrandedDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrandedDomainRequest) ProtoMessage() {}
+
+func (x *BrandedDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrandedDomainRequest.ProtoReflect.Descriptor instead.
+func (*BrandedDomainRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BrandedDomainRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *BrandedDomainRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BrandedDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *BrandedDomainRequest) GetCodePolicy() string {
+	if x != nil {
+		return x.CodePolicy
+	}
+	return ""
+}
+
+// Branded short domain attached to a workspace
+type BrandedDomain struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkspaceId   string                 `protobuf:"bytes,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ShortUrl      string                 `protobuf:"bytes,5,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`       // base of the short URLs on this domain
+	CodePolicy    string                 `protobuf:"bytes,6,opt,name=code_policy,json=codePolicy,proto3" json:"code_policy,omitempty"` // mixed or readable (case-insensitive, no look-alike characters)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrandedDomain) Reset() {
+	*x = BrandedDomain{}
+	mi := &file_proto_url_url_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrandedDomain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrandedDomain) ProtoMessage() {}
+
+func (x *BrandedDomain) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrandedDomain.ProtoReflect.Descriptor instead.
+func (*BrandedDomain) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BrandedDomain) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *BrandedDomain) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *BrandedDomain) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *BrandedDomain) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *BrandedDomain) GetShortUrl() string {
+	if x != nil {
+		return x.ShortUrl
+	}
+	return ""
+}
+
+func (x *BrandedDomain) GetCodePolicy() string {
+	if x != nil {
+		return x.CodePolicy
+	}
+	return ""
+}
+
+// Code Policy Change (result of switching a branded domain's code policy)
+type CodePolicyChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        *BrandedDomain         `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	CaseConflicts []string               `protobuf:"bytes,2,rep,name=case_conflicts,json=caseConflicts,proto3" json:"case_conflicts,omitempty"` // existing codes that differ only in case
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CodePolicyChange) Reset() {
+	*x = CodePolicyChange{}
+	mi := &file_proto_url_url_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodePolicyChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodePolicyChange) ProtoMessage() {}
+
+func (x *CodePolicyChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodePolicyChange.ProtoReflect.Descriptor instead.
+func (*CodePolicyChange) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CodePolicyChange) GetDomain() *BrandedDomain {
+	if x != nil {
+		return x.Domain
+	}
+	return nil
+}
+
+func (x *CodePolicyChange) GetCaseConflicts() []string {
+	if x != nil {
+		return x.CaseConflicts
+	}
+	return nil
+}
+
+// List Branded Domains Request
+type ListBrandedDomainsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // for authorization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBrandedDomainsRequest) Reset() {
+	*x = ListBrandedDomainsRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBrandedDomainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBrandedDomainsRequest) ProtoMessage() {}
+
+func (x *ListBrandedDomainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBrandedDomainsRequest.ProtoReflect.Descriptor instead.
+func (*ListBrandedDomainsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListBrandedDomainsRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *ListBrandedDomainsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// List Branded Domains Response
+type ListBrandedDomainsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domains       []*BrandedDomain       `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBrandedDomainsResponse) Reset() {
+	*x = ListBrandedDomainsResponse{}
+	mi := &file_proto_url_url_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBrandedDomainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBrandedDomainsResponse) ProtoMessage() {}
+
+func (x *ListBrandedDomainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBrandedDomainsResponse.ProtoReflect.Descriptor instead.
+func (*ListBrandedDomainsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListBrandedDomainsResponse) GetDomains() []*BrandedDomain {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+// Suggest Aliases Request
+type SuggestAliasesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alias         string                 `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`                                // desired custom alias
+	LongUrl       string                 `protobuf:"bytes,2,opt,name=long_url,json=longUrl,proto3" json:"long_url,omitempty"`             // optional destination; its page title seeds slug suggestions
+	Domain        string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`                              // short domain, empty for the default
+	WorkspaceId   string                 `protobuf:"bytes,4,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"` // decides the alias rules via the workspace plan
+	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // for authorization
+	Count         int32                  `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`                               // number of suggestions (default 5, max 20)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestAliasesRequest) Reset() {
+	*x = SuggestAliasesRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestAliasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestAliasesRequest) ProtoMessage() {}
+
+func (x *SuggestAliasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestAliasesRequest.ProtoReflect.Descriptor instead.
+func (*SuggestAliasesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SuggestAliasesRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *SuggestAliasesRequest) GetLongUrl() string {
+	if x != nil {
+		return x.LongUrl
+	}
+	return ""
+}
+
+func (x *SuggestAliasesRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *SuggestAliasesRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *SuggestAliasesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SuggestAliasesRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Suggest Aliases Response
+type SuggestAliasesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alias         string                 `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	Available     bool                   `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"` // the desired alias itself can be used
+	Suggestions   []string               `protobuf:"bytes,3,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestAliasesResponse) Reset() {
+	*x = SuggestAliasesResponse{}
+	mi := &file_proto_url_url_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestAliasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestAliasesResponse) ProtoMessage() {}
+
+func (x *SuggestAliasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestAliasesResponse.ProtoReflect.Descriptor instead.
+func (*SuggestAliasesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SuggestAliasesResponse) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *SuggestAliasesResponse) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *SuggestAliasesResponse) GetSuggestions() []string {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+// Tag of a user's links
+type Tag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LinkCount     int64                  `protobuf:"varint,5,opt,name=link_count,json=linkCount,proto3" json:"link_count,omitempty"` // active links with the tag
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_proto_url_url_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Tag) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Tag) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Tag) GetLinkCount() int64 {
+	if x != nil {
+		return x.LinkCount
+	}
+	return 0
+}
+
+// Tag Request (create, rename, delete)
+type TagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	NewName       string                 `protobuf:"bytes,3,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"` // rename only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagRequest) Reset() {
+	*x = TagRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagRequest) ProtoMessage() {}
+
+func (x *TagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagRequest.ProtoReflect.Descriptor instead.
+func (*TagRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TagRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagRequest) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+// List Tags Request
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListTagsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// List Tags Response
+type ListTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_proto_url_url_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// Folder of a user's links; folders nest
+type Folder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ParentId      int64                  `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // 0 for top-level folders
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Path          string                 `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"` // names from the top-level folder down, joined with "/"
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LinkCount     int64                  `protobuf:"varint,7,opt,name=link_count,json=linkCount,proto3" json:"link_count,omitempty"` // active links directly in the folder
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Folder) Reset() {
+	*x = Folder{}
+	mi := &file_proto_url_url_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Folder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Folder) ProtoMessage() {}
+
+func (x *Folder) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Folder.ProtoReflect.Descriptor instead.
+func (*Folder) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Folder) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Folder) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Folder) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Folder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Folder) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Folder) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Folder) GetLinkCount() int64 {
+	if x != nil {
+		return x.LinkCount
+	}
+	return 0
+}
+
+// Folder Request (create, update, delete)
+type FolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"` // update and delete only
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId      int64                  `protobuf:"varint,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // 0 for the top level; update moves the folder
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FolderRequest) Reset() {
+	*x = FolderRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FolderRequest) ProtoMessage() {}
+
+func (x *FolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FolderRequest.ProtoReflect.Descriptor instead.
+func (*FolderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *FolderRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FolderRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FolderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FolderRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+// List Folders Request
+type ListFoldersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFoldersRequest) Reset() {
+	*x = ListFoldersRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFoldersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFoldersRequest) ProtoMessage() {}
+
+func (x *ListFoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFoldersRequest.ProtoReflect.Descriptor instead.
+func (*ListFoldersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListFoldersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// List Folders Response
+type ListFoldersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folders       []*Folder              `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFoldersResponse) Reset() {
+	*x = ListFoldersResponse{}
+	mi := &file_proto_url_url_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFoldersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFoldersResponse) ProtoMessage() {}
+
+func (x *ListFoldersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFoldersResponse.ProtoReflect.Descriptor instead.
+func (*ListFoldersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListFoldersResponse) GetFolders() []*Folder {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
+// Search URLs Request - every word of the query must match a link's short code,
+// destination host or path, title, a tag or a metadata value
+type SearchURLsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Tag           string                 `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	Domain        string                 `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"` // short domain; the default domain by its host
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // active, expired, disabled or archived; any when empty
+	Page          int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`    // deprecated: use cursor
+	PageSize      int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        string                 `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchURLsRequest) Reset() {
+	*x = SearchURLsRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchURLsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchURLsRequest) ProtoMessage() {}
+
+func (x *SearchURLsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchURLsRequest.ProtoReflect.Descriptor instead.
+func (*SearchURLsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SearchURLsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SearchURLsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchURLsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *SearchURLsRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *SearchURLsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SearchURLsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchURLsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchURLsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// Search Hit
+type SearchHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           *URLInfo               `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Rank          float64                `protobuf:"fixed64,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_proto_url_url_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SearchHit) GetUrl() *URLInfo {
+	if x != nil {
+		return x.Url
+	}
+	return nil
+}
+
+func (x *SearchHit) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+// Facet Count
+type FacetCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetCount) Reset() {
+	*x = FacetCount{}
+	mi := &file_proto_url_url_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetCount) ProtoMessage() {}
+
+func (x *FacetCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetCount.ProtoReflect.Descriptor instead.
+func (*FacetCount) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *FacetCount) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FacetCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Search URLs Response - ranked hits and facet counts over all matches
+type SearchURLsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*SearchHit           `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	HasNext       bool                   `protobuf:"varint,5,opt,name=has_next,json=hasNext,proto3" json:"has_next,omitempty"`
+	TagFacets     []*FacetCount          `protobuf:"bytes,6,rep,name=tag_facets,json=tagFacets,proto3" json:"tag_facets,omitempty"`
+	DomainFacets  []*FacetCount          `protobuf:"bytes,7,rep,name=domain_facets,json=domainFacets,proto3" json:"domain_facets,omitempty"`
+	StatusFacets  []*FacetCount          `protobuf:"bytes,8,rep,name=status_facets,json=statusFacets,proto3" json:"status_facets,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,9,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	PrevCursor    string                 `protobuf:"bytes,10,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchURLsResponse) Reset() {
+	*x = SearchURLsResponse{}
+	mi := &file_proto_url_url_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchURLsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchURLsResponse) ProtoMessage() {}
+
+func (x *SearchURLsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchURLsResponse.ProtoReflect.Descriptor instead.
+func (*SearchURLsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SearchURLsResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchURLsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *SearchURLsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchURLsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchURLsResponse) GetHasNext() bool {
+	if x != nil {
+		return x.HasNext
+	}
+	return false
+}
+
+func (x *SearchURLsResponse) GetTagFacets() []*FacetCount {
+	if x != nil {
+		return x.TagFacets
+	}
+	return nil
+}
+
+func (x *SearchURLsResponse) GetDomainFacets() []*FacetCount {
+	if x != nil {
+		return x.DomainFacets
+	}
+	return nil
+}
+
+func (x *SearchURLsResponse) GetStatusFacets() []*FacetCount {
+	if x != nil {
+		return x.StatusFacets
+	}
+	return nil
+}
+
+func (x *SearchURLsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *SearchURLsResponse) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
+// List Deleted URLs Request - a user's trash, most recently deleted first
+type ListDeletedURLsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"` // deprecated: use cursor
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedURLsRequest) Reset() {
+	*x = ListDeletedURLsRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedURLsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedURLsRequest) ProtoMessage() {}
+
+func (x *ListDeletedURLsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedURLsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedURLsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListDeletedURLsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListDeletedURLsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDeletedURLsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeletedURLsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// Restore URL Request - takes a link out of the trash (RestoreURL) or the
+// inactivity archive (ReviveURL)
+type RestoreURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortCode     string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // for authorization
+	Domain        string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`               // short domain of the link, empty for the default
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreURLRequest) Reset() {
+	*x = RestoreURLRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreURLRequest) ProtoMessage() {}
+
+func (x *RestoreURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreURLRequest.ProtoReflect.Descriptor instead.
+func (*RestoreURLRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RestoreURLRequest) GetShortCode() string {
+	if x != nil {
+		return x.ShortCode
+	}
+	return ""
+}
+
+func (x *RestoreURLRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RestoreURLRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+// Set Inactivity Policy Request - archive workspace links that go unused
+type SetInactivityPolicyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId    string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                          // for authorization
+	InactivityDays int32                  `protobuf:"varint,3,opt,name=inactivity_days,json=inactivityDays,proto3" json:"inactivity_days,omitempty"` // 0 turns the policy off
+	DryRun         bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                         // only report the links the policy would archive
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetInactivityPolicyRequest) Reset() {
+	*x = SetInactivityPolicyRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetInactivityPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInactivityPolicyRequest) ProtoMessage() {}
+
+func (x *SetInactivityPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInactivityPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetInactivityPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SetInactivityPolicyRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *SetInactivityPolicyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetInactivityPolicyRequest) GetInactivityDays() int32 {
+	if x != nil {
+		return x.InactivityDays
+	}
+	return 0
+}
+
+func (x *SetInactivityPolicyRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// Inactivity Report Request - which links an inactivity policy would archive now
+type InactivityReportRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId    string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                          // for authorization
+	InactivityDays int32                  `protobuf:"varint,3,opt,name=inactivity_days,json=inactivityDays,proto3" json:"inactivity_days,omitempty"` // previews another policy; 0 uses the workspace's
+	Limit          int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                                         // links listed, 100 by default
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InactivityReportRequest) Reset() {
+	*x = InactivityReportRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InactivityReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InactivityReportRequest) ProtoMessage() {}
+
+func (x *InactivityReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InactivityReportRequest.ProtoReflect.Descriptor instead.
+func (*InactivityReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *InactivityReportRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *InactivityReportRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *InactivityReportRequest) GetInactivityDays() int32 {
+	if x != nil {
+		return x.InactivityDays
+	}
+	return 0
+}
+
+func (x *InactivityReportRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Inactivity Report - least recently used links first
+type InactivityReport struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId    string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	InactivityDays int32                  `protobuf:"varint,2,opt,name=inactivity_days,json=inactivityDays,proto3" json:"inactivity_days,omitempty"`
+	DryRun         bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // the workspace's policy only reports
+	TotalCount     int32                  `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Urls           []*URLInfo             `protobuf:"bytes,5,rep,name=urls,proto3" json:"urls,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InactivityReport) Reset() {
+	*x = InactivityReport{}
+	mi := &file_proto_url_url_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InactivityReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InactivityReport) ProtoMessage() {}
+
+func (x *InactivityReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InactivityReport.ProtoReflect.Descriptor instead.
+func (*InactivityReport) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *InactivityReport) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *InactivityReport) GetInactivityDays() int32 {
+	if x != nil {
+		return x.InactivityDays
+	}
+	return 0
+}
+
+func (x *InactivityReport) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *InactivityReport) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *InactivityReport) GetUrls() []*URLInfo {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+// List URL Revisions Request - the change history of a link, newest first
+type ListURLRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortCode     string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // for authorization
+	Domain        string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`               // short domain of the link, empty for the default
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        string                 `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListURLRevisionsRequest) Reset() {
+	*x = ListURLRevisionsRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListURLRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListURLRevisionsRequest) ProtoMessage() {}
+
+func (x *ListURLRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListURLRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListURLRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListURLRevisionsRequest) GetShortCode() string {
+	if x != nil {
+		return x.ShortCode
+	}
+	return ""
+}
+
+func (x *ListURLRevisionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListURLRevisionsRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ListURLRevisionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListURLRevisionsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// Field Change - a field a revision changed; passwords show as "set" or "none"
+type FieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	OldValue      string                 `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"` // empty when unset
+	NewValue      string                 `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_proto_url_url_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *FieldChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+// URL Revision - an immutable record of a change made to a link
+type URLRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Revision      int32                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"` // 1, 2, ... per link
+	ChangedBy     string                 `protobuf:"bytes,3,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Changes       []*FieldChange         `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`
+	RollbackOf    int64                  `protobuf:"varint,6,opt,name=rollback_of,json=rollbackOf,proto3" json:"rollback_of,omitempty"` // the revision rolled back, 0 for edits
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *URLRevision) Reset() {
+	*x = URLRevision{}
+	mi := &file_proto_url_url_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *URLRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*URLRevision) ProtoMessage() {}
+
+func (x *URLRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use URLRevision.ProtoReflect.Descriptor instead.
+func (*URLRevision) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *URLRevision) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *URLRevision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *URLRevision) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *URLRevision) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *URLRevision) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *URLRevision) GetRollbackOf() int64 {
+	if x != nil {
+		return x.RollbackOf
+	}
+	return 0
+}
+
+// List URL Revisions Response
+type ListURLRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*URLRevision         `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	HasNext       bool                   `protobuf:"varint,4,opt,name=has_next,json=hasNext,proto3" json:"has_next,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	PrevCursor    string                 `protobuf:"bytes,6,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListURLRevisionsResponse) Reset() {
+	*x = ListURLRevisionsResponse{}
+	mi := &file_proto_url_url_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListURLRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListURLRevisionsResponse) ProtoMessage() {}
+
+func (x *ListURLRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListURLRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListURLRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListURLRevisionsResponse) GetRevisions() []*URLRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListURLRevisionsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListURLRevisionsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListURLRevisionsResponse) GetHasNext() bool {
+	if x != nil {
+		return x.HasNext
+	}
+	return false
+}
+
+func (x *ListURLRevisionsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListURLRevisionsResponse) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
+// Rollback URL Request - restores a link to its state before a revision
+type RollbackURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortCode     string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // for authorization
+	Domain        string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`               // short domain of the link, empty for the default
+	RevisionId    int64                  `protobuf:"varint,4,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackURLRequest) Reset() {
+	*x = RollbackURLRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackURLRequest) ProtoMessage() {}
+
+func (x *RollbackURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackURLRequest.ProtoReflect.Descriptor instead.
+func (*RollbackURLRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RollbackURLRequest) GetShortCode() string {
+	if x != nil {
+		return x.ShortCode
+	}
+	return ""
+}
+
+func (x *RollbackURLRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RollbackURLRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *RollbackURLRequest) GetRevisionId() int64 {
+	if x != nil {
+		return x.RevisionId
+	}
+	return 0
+}
+
+// Webhook of a workspace: an endpoint its link events are delivered to
+type Webhook struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorkspaceId         string                 `protobuf:"bytes,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Url                 string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Secret              string                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"` // signing secret, only returned on creation and rotation
+	Events              []string               `protobuf:"bytes,5,rep,name=events,proto3" json:"events,omitempty"` // event types delivered, empty for all
+	IsActive            bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	ConsecutiveFailures int32                  `protobuf:"varint,7,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	DisabledAt          int64                  `protobuf:"varint,8,opt,name=disabled_at,json=disabledAt,proto3" json:"disabled_at,omitempty"` // when repeated failures turned the webhook off
+	CreatedBy           string                 `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt           int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           int64                  `protobuf:"varint,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_proto_url_url_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Webhook) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Webhook) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Webhook) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Webhook) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Webhook) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *Webhook) GetDisabledAt() int64 {
+	if x != nil {
+		return x.DisabledAt
+	}
+	return 0
+}
+
+func (x *Webhook) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Webhook) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Webhook) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// Webhook Request (create, update, delete)
+type WebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                    // for authorization, must own the workspace
+	Id            int64                  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`                                         // update and delete only
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`                                        // empty keeps the current endpoint on update
+	Events        []string               `protobuf:"bytes,5,rep,name=events,proto3" json:"events,omitempty"`                                  // empty subscribes to all events on create, keeps the filter on update
+	ClearEvents   bool                   `protobuf:"varint,6,opt,name=clear_events,json=clearEvents,proto3" json:"clear_events,omitempty"`    // update only: subscribe to all events
+	Enable        bool                   `protobuf:"varint,7,opt,name=enable,proto3" json:"enable,omitempty"`                                 // update only: turn the webhook on, resetting its failures
+	Disable       bool                   `protobuf:"varint,8,opt,name=disable,proto3" json:"disable,omitempty"`                               // update only: turn the webhook off
+	RotateSecret  bool                   `protobuf:"varint,9,opt,name=rotate_secret,json=rotateSecret,proto3" json:"rotate_secret,omitempty"` // update only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookRequest) Reset() {
+	*x = WebhookRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookRequest) ProtoMessage() {}
+
+func (x *WebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookRequest.ProtoReflect.Descriptor instead.
+func (*WebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *WebhookRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *WebhookRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WebhookRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookRequest) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *WebhookRequest) GetClearEvents() bool {
+	if x != nil {
+		return x.ClearEvents
+	}
+	return false
+}
+
+func (x *WebhookRequest) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *WebhookRequest) GetDisable() bool {
+	if x != nil {
+		return x.Disable
+	}
+	return false
+}
+
+func (x *WebhookRequest) GetRotateSecret() bool {
+	if x != nil {
+		return x.RotateSecret
+	}
+	return false
+}
+
+// List Webhooks Request
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // for authorization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListWebhooksRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *ListWebhooksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// List Webhooks Response
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_proto_url_url_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+// Webhook Delivery - an event queued for, sent to or abandoned by a webhook
+type WebhookDelivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId      int64                  `protobuf:"varint,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	EventId        string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"` // the same for retries and redeliveries of an event
+	EventType      string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Payload        string                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"` // JSON body
+	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`   // pending, succeeded or failed
+	Attempts       int32                  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttemptAt  int64                  `protobuf:"varint,8,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"` // pending deliveries only
+	LastAttemptAt  int64                  `protobuf:"varint,9,opt,name=last_attempt_at,json=lastAttemptAt,proto3" json:"last_attempt_at,omitempty"`
+	ResponseStatus int32                  `protobuf:"varint,10,opt,name=response_status,json=responseStatus,proto3" json:"response_status,omitempty"`
+	ResponseBody   string                 `protobuf:"bytes,11,opt,name=response_body,json=responseBody,proto3" json:"response_body,omitempty"` // truncated
+	Error          string                 `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeliveredAt    int64                  `protobuf:"varint,14,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_proto_url_url_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *WebhookDelivery) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetWebhookId() int64 {
+	if x != nil {
+		return x.WebhookId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() int64 {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastAttemptAt() int64 {
+	if x != nil {
+		return x.LastAttemptAt
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetResponseStatus() int32 {
+	if x != nil {
+		return x.ResponseStatus
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetResponseBody() string {
+	if x != nil {
+		return x.ResponseBody
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() int64 {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return 0
+}
+
+// List Webhook Deliveries Request - the delivery log of a webhook, newest first
+type ListWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // for authorization
+	WebhookId     int64                  `protobuf:"varint,3,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // pending, succeeded or failed; empty for all
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        string                 `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetWebhookId() int64 {
+	if x != nil {
+		return x.WebhookId
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// List Webhook Deliveries Response
+type ListWebhookDeliveriesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	SortBy        string                 `protobuf:"bytes,4,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`          // created_at, click_count, etc.
-	SortOrder     string                 `protobuf:"bytes,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"` // asc, desc
+	HasNext       bool                   `protobuf:"varint,4,opt,name=has_next,json=hasNext,proto3" json:"has_next,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	PrevCursor    string                 `protobuf:"bytes,6,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserURLsRequest) Reset() {
-	*x = GetUserURLsRequest{}
-	mi := &file_proto_url_url_proto_msgTypes[6]
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_proto_url_url_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserURLsRequest) String() string {
+func (x *ListWebhookDeliveriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserURLsRequest) ProtoMessage() {}
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
 
-func (x *GetUserURLsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_url_url_proto_msgTypes[6]
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -488,73 +5267,79 @@ func (x *GetUserURLsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserURLsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserURLsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_url_url_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetUserURLsRequest) GetUserId() string {
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
 	if x != nil {
-		return x.UserId
+		return x.Deliveries
 	}
-	return ""
+	return nil
 }
 
-func (x *GetUserURLsRequest) GetPage() int32 {
+func (x *ListWebhookDeliveriesResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.Page
+		return x.TotalCount
 	}
 	return 0
 }
 
-func (x *GetUserURLsRequest) GetPageSize() int32 {
+func (x *ListWebhookDeliveriesResponse) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *GetUserURLsRequest) GetSortBy() string {
+func (x *ListWebhookDeliveriesResponse) GetHasNext() bool {
 	if x != nil {
-		return x.SortBy
+		return x.HasNext
+	}
+	return false
+}
+
+func (x *ListWebhookDeliveriesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
 	}
 	return ""
 }
 
-func (x *GetUserURLsRequest) GetSortOrder() string {
+func (x *ListWebhookDeliveriesResponse) GetPrevCursor() string {
 	if x != nil {
-		return x.SortOrder
+		return x.PrevCursor
 	}
 	return ""
 }
 
-// Get User URLs Response
-type GetUserURLsResponse struct {
+// Redeliver Webhook Request - queues a delivery again
+type RedeliverWebhookRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Urls          []*URLInfo             `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	HasNext       bool                   `protobuf:"varint,5,opt,name=has_next,json=hasNext,proto3" json:"has_next,omitempty"`
+	WorkspaceId   string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // for authorization
+	WebhookId     int64                  `protobuf:"varint,3,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	DeliveryId    int64                  `protobuf:"varint,4,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserURLsResponse) Reset() {
-	*x = GetUserURLsResponse{}
-	mi := &file_proto_url_url_proto_msgTypes[7]
+func (x *RedeliverWebhookRequest) Reset() {
+	*x = RedeliverWebhookRequest{}
+	mi := &file_proto_url_url_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserURLsResponse) String() string {
+func (x *RedeliverWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserURLsResponse) ProtoMessage() {}
+func (*RedeliverWebhookRequest) ProtoMessage() {}
 
-func (x *GetUserURLsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_url_url_proto_msgTypes[7]
+func (x *RedeliverWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -565,73 +5350,138 @@ func (x *GetUserURLsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserURLsResponse.ProtoReflect.Descriptor instead.
-func (*GetUserURLsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_url_url_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use RedeliverWebhookRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *GetUserURLsResponse) GetUrls() []*URLInfo {
+func (x *RedeliverWebhookRequest) GetWorkspaceId() string {
 	if x != nil {
-		return x.Urls
+		return x.WorkspaceId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetUserURLsResponse) GetTotalCount() int32 {
+func (x *RedeliverWebhookRequest) GetUserId() string {
 	if x != nil {
-		return x.TotalCount
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetUserURLsResponse) GetPage() int32 {
+func (x *RedeliverWebhookRequest) GetWebhookId() int64 {
 	if x != nil {
-		return x.Page
+		return x.WebhookId
 	}
 	return 0
 }
 
-func (x *GetUserURLsResponse) GetPageSize() int32 {
+func (x *RedeliverWebhookRequest) GetDeliveryId() int64 {
 	if x != nil {
-		return x.PageSize
+		return x.DeliveryId
 	}
 	return 0
 }
 
-func (x *GetUserURLsResponse) GetHasNext() bool {
+// Purge event published when the trash retention removes a link for good (topic: url.purged)
+type PurgeEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Domain          string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"` // short domain of the link, empty for the default
+	ShortCode       string                 `protobuf:"bytes,2,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
+	DeleteAnalytics bool                   `protobuf:"varint,3,opt,name=delete_analytics,json=deleteAnalytics,proto3" json:"delete_analytics,omitempty"` // the trash policy removes the link's click analytics
+	Timestamp       int64                  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PurgeEvent) Reset() {
+	*x = PurgeEvent{}
+	mi := &file_proto_url_url_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeEvent) ProtoMessage() {}
+
+func (x *PurgeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[67]
 	if x != nil {
-		return x.HasNext
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeEvent.ProtoReflect.Descriptor instead.
+func (*PurgeEvent) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *PurgeEvent) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *PurgeEvent) GetShortCode() string {
+	if x != nil {
+		return x.ShortCode
+	}
+	return ""
+}
+
+func (x *PurgeEvent) GetDeleteAnalytics() bool {
+	if x != nil {
+		return x.DeleteAnalytics
 	}
 	return false
 }
 
-// Update URL Request
-type UpdateURLRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	ShortCode         string                 `protobuf:"bytes,1,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
-	UserId            string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	NewLongUrl        string                 `protobuf:"bytes,3,opt,name=new_long_url,json=newLongUrl,proto3" json:"new_long_url,omitempty"`                                                   // optional
-	NewExpirationTime int64                  `protobuf:"varint,4,opt,name=new_expiration_time,json=newExpirationTime,proto3" json:"new_expiration_time,omitempty"`                             // optional
-	Metadata          map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // optional
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *PurgeEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
 }
 
-func (x *UpdateURLRequest) Reset() {
-	*x = UpdateURLRequest{}
-	mi := &file_proto_url_url_proto_msgTypes[8]
+// Expiry event published by the expiry sweeper when a link expired (topic:
+// url.expired) or will expire soon (topic: url.expiring)
+type ExpiryEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"` // short domain of the link, empty for the default
+	ShortCode     string                 `protobuf:"bytes,2,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WorkspaceId   string                 `protobuf:"bytes,4,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	LongUrl       string                 `protobuf:"bytes,5,opt,name=long_url,json=longUrl,proto3" json:"long_url,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpiryEvent) Reset() {
+	*x = ExpiryEvent{}
+	mi := &file_proto_url_url_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateURLRequest) String() string {
+func (x *ExpiryEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateURLRequest) ProtoMessage() {}
+func (*ExpiryEvent) ProtoMessage() {}
 
-func (x *UpdateURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_url_url_proto_msgTypes[8]
+func (x *ExpiryEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -642,71 +5492,89 @@ func (x *UpdateURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateURLRequest.ProtoReflect.Descriptor instead.
-func (*UpdateURLRequest) Descriptor() ([]byte, []int) {
-	return file_proto_url_url_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ExpiryEvent.ProtoReflect.Descriptor instead.
+func (*ExpiryEvent) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *UpdateURLRequest) GetShortCode() string {
+func (x *ExpiryEvent) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ExpiryEvent) GetShortCode() string {
 	if x != nil {
 		return x.ShortCode
 	}
 	return ""
 }
 
-func (x *UpdateURLRequest) GetUserId() string {
+func (x *ExpiryEvent) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *UpdateURLRequest) GetNewLongUrl() string {
+func (x *ExpiryEvent) GetWorkspaceId() string {
 	if x != nil {
-		return x.NewLongUrl
+		return x.WorkspaceId
 	}
 	return ""
 }
 
-func (x *UpdateURLRequest) GetNewExpirationTime() int64 {
+func (x *ExpiryEvent) GetLongUrl() string {
 	if x != nil {
-		return x.NewExpirationTime
+		return x.LongUrl
+	}
+	return ""
+}
+
+func (x *ExpiryEvent) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
 	}
 	return 0
 }
 
-func (x *UpdateURLRequest) GetMetadata() map[string]string {
+func (x *ExpiryEvent) GetTimestamp() int64 {
 	if x != nil {
-		return x.Metadata
+		return x.Timestamp
 	}
-	return nil
+	return 0
 }
 
-// Update URL Response
-type UpdateURLResponse struct {
+// Link alert published by other services about a link, e.g. a click
+// threshold reached (topic: url.alert); delivered to the webhooks of the
+// link's workspace
+type LinkAlertEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	UpdatedUrl    *URLInfo               `protobuf:"bytes,3,opt,name=updated_url,json=updatedUrl,proto3" json:"updated_url,omitempty"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"` // short domain of the link, empty for the default
+	ShortCode     string                 `protobuf:"bytes,2,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
+	Event         string                 `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"` // webhook event type, e.g. url.click_threshold
+	Data          string                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`   // JSON object describing the alert
+	Timestamp     int64                  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateURLResponse) Reset() {
-	*x = UpdateURLResponse{}
-	mi := &file_proto_url_url_proto_msgTypes[9]
+func (x *LinkAlertEvent) Reset() {
+	*x = LinkAlertEvent{}
+	mi := &file_proto_url_url_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateURLResponse) String() string {
+func (x *LinkAlertEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateURLResponse) ProtoMessage() {}
+func (*LinkAlertEvent) ProtoMessage() {}
 
-func (x *UpdateURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_url_url_proto_msgTypes[9]
+func (x *LinkAlertEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_url_url_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -717,46 +5585,79 @@ func (x *UpdateURLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateURLResponse.ProtoReflect.Descriptor instead.
-func (*UpdateURLResponse) Descriptor() ([]byte, []int) {
-	return file_proto_url_url_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use LinkAlertEvent.ProtoReflect.Descriptor instead.
+func (*LinkAlertEvent) Descriptor() ([]byte, []int) {
+	return file_proto_url_url_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *UpdateURLResponse) GetSuccess() bool {
+func (x *LinkAlertEvent) GetDomain() string {
 	if x != nil {
-		return x.Success
+		return x.Domain
 	}
-	return false
+	return ""
 }
 
-func (x *UpdateURLResponse) GetMessage() string {
+func (x *LinkAlertEvent) GetShortCode() string {
 	if x != nil {
-		return x.Message
+		return x.ShortCode
 	}
 	return ""
 }
 
-func (x *UpdateURLResponse) GetUpdatedUrl() *URLInfo {
+func (x *LinkAlertEvent) GetEvent() string {
 	if x != nil {
-		return x.UpdatedUrl
+		return x.Event
 	}
-	return nil
+	return ""
+}
+
+func (x *LinkAlertEvent) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *LinkAlertEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
 }
 
 var File_proto_url_url_proto protoreflect.FileDescriptor
 
 const file_proto_url_url_proto_rawDesc = "" +
 	"\n" +
-	"\x13proto/url/url.proto\x12\x03url\"\x8c\x02\n" +
+	"\x13proto/url/url.proto\x12\x03url\"\xc5\x05\n" +
 	"\x0eShortenRequest\x12\x19\n" +
 	"\blong_url\x18\x01 \x01(\tR\alongUrl\x12!\n" +
 	"\fcustom_alias\x18\x02 \x01(\tR\vcustomAlias\x12'\n" +
 	"\x0fexpiration_time\x18\x03 \x01(\x03R\x0eexpirationTime\x12\x17\n" +
 	"\auser_id\x18\x04 \x01(\tR\x06userId\x12=\n" +
-	"\bmetadata\x18\x05 \x03(\v2!.url.ShortenRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\bmetadata\x18\x05 \x03(\v2!.url.ShortenRequest.MetadataEntryR\bmetadata\x12!\n" +
+	"\fworkspace_id\x18\x06 \x01(\tR\vworkspaceId\x12G\n" +
+	"\futm_template\x18\a \x03(\v2$.url.ShortenRequest.UtmTemplateEntryR\vutmTemplate\x12\x1a\n" +
+	"\bpassword\x18\b \x01(\tR\bpassword\x12'\n" +
+	"\x0factivation_time\x18\t \x01(\x03R\x0eactivationTime\x12!\n" +
+	"\ffallback_url\x18\n" +
+	" \x01(\tR\vfallbackUrl\x12\x1d\n" +
+	"\n" +
+	"max_clicks\x18\v \x01(\x03R\tmaxClicks\x12;\n" +
+	"\x10preview_override\x18\f \x01(\v2\x10.url.LinkPreviewR\x0fpreviewOverride\x12\x16\n" +
+	"\x06domain\x18\r \x01(\tR\x06domain\x12\x12\n" +
+	"\x04tags\x18\x0e \x03(\tR\x04tags\x12\x1b\n" +
+	"\tfolder_id\x18\x0f \x01(\x03R\bfolderId\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbf\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10UtmTemplateEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"[\n" +
+	"\vLinkPreview\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\"\xeb\x02\n" +
 	"\x0fShortenResponse\x12\x1d\n" +
 	"\n" +
 	"short_code\x18\x01 \x01(\tR\tshortCode\x12\x1b\n" +
@@ -766,11 +5667,19 @@ const file_proto_url_url_proto_rawDesc = "" +
 	"created_at\x18\x04 \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"expires_at\x18\x05 \x01(\x03R\texpiresAt\x12\x17\n" +
-	"\auser_id\x18\x06 \x01(\tR\x06userId\"G\n" +
+	"\auser_id\x18\x06 \x01(\tR\x06userId\x12!\n" +
+	"\fworkspace_id\x18\a \x01(\tR\vworkspaceId\x12-\n" +
+	"\x12password_protected\x18\b \x01(\bR\x11passwordProtected\x12!\n" +
+	"\factivates_at\x18\t \x01(\x03R\vactivatesAt\x12\x1d\n" +
+	"\n" +
+	"max_clicks\x18\n" +
+	" \x01(\x03R\tmaxClicks\x12\x16\n" +
+	"\x06domain\x18\v \x01(\tR\x06domain\"_\n" +
 	"\rGetURLRequest\x12\x1d\n" +
 	"\n" +
 	"short_code\x18\x01 \x01(\tR\tshortCode\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xea\x02\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\"\xd4\b\n" +
 	"\aURLInfo\x12\x1d\n" +
 	"\n" +
 	"short_code\x18\x01 \x01(\tR\tshortCode\x12\x1b\n" +
@@ -784,31 +5693,66 @@ const file_proto_url_url_proto_rawDesc = "" +
 	"\vclick_count\x18\a \x01(\x03R\n" +
 	"clickCount\x12\x1b\n" +
 	"\tis_active\x18\b \x01(\bR\bisActive\x126\n" +
-	"\bmetadata\x18\t \x03(\v2\x1a.url.URLInfo.MetadataEntryR\bmetadata\x1a;\n" +
+	"\bmetadata\x18\t \x03(\v2\x1a.url.URLInfo.MetadataEntryR\bmetadata\x12!\n" +
+	"\fworkspace_id\x18\n" +
+	" \x01(\tR\vworkspaceId\x12@\n" +
+	"\futm_template\x18\v \x03(\v2\x1d.url.URLInfo.UtmTemplateEntryR\vutmTemplate\x12-\n" +
+	"\x12password_protected\x18\f \x01(\bR\x11passwordProtected\x12!\n" +
+	"\factivates_at\x18\r \x01(\x03R\vactivatesAt\x12!\n" +
+	"\ffallback_url\x18\x0e \x01(\tR\vfallbackUrl\x12\x1d\n" +
+	"\n" +
+	"max_clicks\x18\x0f \x01(\x03R\tmaxClicks\x12+\n" +
+	"\x11interstitial_mode\x18\x10 \x01(\tR\x10interstitialMode\x12'\n" +
+	"\x0fdisabled_reason\x18\x11 \x01(\tR\x0edisabledReason\x12\x1f\n" +
+	"\vdisabled_at\x18\x12 \x01(\x03R\n" +
+	"disabledAt\x12*\n" +
+	"\apreview\x18\x13 \x01(\v2\x10.url.LinkPreviewR\apreview\x12;\n" +
+	"\x10preview_override\x18\x14 \x01(\v2\x10.url.LinkPreviewR\x0fpreviewOverride\x12\x16\n" +
+	"\x06domain\x18\x15 \x01(\tR\x06domain\x12\x12\n" +
+	"\x04tags\x18\x16 \x03(\tR\x04tags\x12\x1b\n" +
+	"\tfolder_id\x18\x17 \x01(\x03R\bfolderId\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x18 \x01(\x03R\tdeletedAt\x12\x19\n" +
+	"\bpurge_at\x18\x19 \x01(\x03R\apurgeAt\x12+\n" +
+	"\x11inactivity_exempt\x18\x1a \x01(\bR\x10inactivityExempt\x12\x1f\n" +
+	"\varchived_at\x18\x1b \x01(\x03R\n" +
+	"archivedAt\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"J\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10UtmTemplateEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"b\n" +
 	"\x10DeleteURLRequest\x12\x1d\n" +
 	"\n" +
 	"short_code\x18\x01 \x01(\tR\tshortCode\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"D\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\"D\n" +
 	"\x0eDeleteResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x96\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x8c\x02\n" +
 	"\x12GetUserURLsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x17\n" +
 	"\asort_by\x18\x04 \x01(\tR\x06sortBy\x12\x1d\n" +
 	"\n" +
-	"sort_order\x18\x05 \x01(\tR\tsortOrder\"\xa4\x01\n" +
+	"sort_order\x18\x05 \x01(\tR\tsortOrder\x12\x10\n" +
+	"\x03tag\x18\x06 \x01(\tR\x03tag\x12\x1b\n" +
+	"\tfolder_id\x18\a \x01(\x03R\bfolderId\x12-\n" +
+	"\x12include_subfolders\x18\b \x01(\bR\x11includeSubfolders\x12\x16\n" +
+	"\x06cursor\x18\t \x01(\tR\x06cursor\"\xe6\x01\n" +
 	"\x13GetUserURLsResponse\x12 \n" +
 	"\x04urls\x18\x01 \x03(\v2\f.url.URLInfoR\x04urls\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x19\n" +
-	"\bhas_next\x18\x05 \x01(\bR\ahasNext\"\x9a\x02\n" +
+	"\bhas_next\x18\x05 \x01(\bR\ahasNext\x12\x1f\n" +
+	"\vnext_cursor\x18\x06 \x01(\tR\n" +
+	"nextCursor\x12\x1f\n" +
+	"\vprev_cursor\x18\a \x01(\tR\n" +
+	"prevCursor\"\xfb\a\n" +
 	"\x10UpdateURLRequest\x12\x1d\n" +
 	"\n" +
 	"short_code\x18\x01 \x01(\tR\tshortCode\x12\x17\n" +
@@ -816,15 +5760,449 @@ const file_proto_url_url_proto_rawDesc = "" +
 	"\fnew_long_url\x18\x03 \x01(\tR\n" +
 	"newLongUrl\x12.\n" +
 	"\x13new_expiration_time\x18\x04 \x01(\x03R\x11newExpirationTime\x12?\n" +
-	"\bmetadata\x18\x05 \x03(\v2#.url.UpdateURLRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\bmetadata\x18\x05 \x03(\v2#.url.UpdateURLRequest.MetadataEntryR\bmetadata\x12I\n" +
+	"\futm_template\x18\x06 \x03(\v2&.url.UpdateURLRequest.UtmTemplateEntryR\vutmTemplate\x12,\n" +
+	"\x12clear_utm_template\x18\a \x01(\bR\x10clearUtmTemplate\x12!\n" +
+	"\fnew_password\x18\b \x01(\tR\vnewPassword\x12%\n" +
+	"\x0eclear_password\x18\t \x01(\bR\rclearPassword\x12.\n" +
+	"\x13new_activation_time\x18\n" +
+	" \x01(\x03R\x11newActivationTime\x12(\n" +
+	"\x10new_fallback_url\x18\v \x01(\tR\x0enewFallbackUrl\x12$\n" +
+	"\x0enew_max_clicks\x18\f \x01(\x03R\fnewMaxClicks\x12(\n" +
+	"\x10clear_max_clicks\x18\r \x01(\bR\x0eclearMaxClicks\x12;\n" +
+	"\x10preview_override\x18\x0e \x01(\v2\x10.url.LinkPreviewR\x0fpreviewOverride\x12\x16\n" +
+	"\x06domain\x18\x0f \x01(\tR\x06domain\x12\x12\n" +
+	"\x04tags\x18\x10 \x03(\tR\x04tags\x12\x1d\n" +
+	"\n" +
+	"clear_tags\x18\x11 \x01(\bR\tclearTags\x12\"\n" +
+	"\rnew_folder_id\x18\x12 \x01(\x03R\vnewFolderId\x12!\n" +
+	"\fclear_folder\x18\x13 \x01(\bR\vclearFolder\x12+\n" +
+	"\x11inactivity_exempt\x18\x14 \x01(\bR\x10inactivityExempt\x126\n" +
+	"\x17clear_inactivity_exempt\x18\x15 \x01(\bR\x15clearInactivityExempt\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10UtmTemplateEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"v\n" +
 	"\x11UpdateURLResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12-\n" +
 	"\vupdated_url\x18\x03 \x01(\v2\f.url.URLInfoR\n" +
-	"updatedUrl2\xae\x02\n" +
+	"updatedUrl\"\xfb\x01\n" +
+	"\x16UpsertWorkspaceRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\tR\vworkspaceId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12O\n" +
+	"\futm_template\x18\x04 \x03(\v2,.url.UpsertWorkspaceRequest.UtmTemplateEntryR\vutmTemplate\x1a>\n" +
+	"\x10UtmTemplateEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"Q\n" +
+	"\x13GetWorkspaceRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\tR\vworkspaceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x92\x03\n" +
+	"\rWorkspaceInfo\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\tR\vworkspaceId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12F\n" +
+	"\futm_template\x18\x04 \x03(\v2#.url.WorkspaceInfo.UtmTemplateEntryR\vutmTemplate\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\x12\x12\n" +
+	"\x04plan\x18\a \x01(\tR\x04plan\x12'\n" +
+	"\x0finactivity_days\x18\b \x01(\x05R\x0einactivityDays\x12,\n" +
+	"\x12inactivity_dry_run\x18\t \x01(\bR\x10inactivityDryRun\x1a>\n" +
+	"\x10UtmTemplateEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"P\n" +
+	"\x17SetWorkspacePlanRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\tR\vworkspaceId\x12\x12\n" +
+	"\x04plan\x18\x02 \x01(\tR\x04plan\"g\n" +
+	"\x1aSetInterstitialModeRequest\x12\x1d\n" +
+	"\n" +
+	"short_code\x18\x01 \x01(\tR\tshortCode\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\"\xb3\x01\n" +
+	"\fDomainReview\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x04 \x01(\tR\tupdatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\"3\n" +
+	"\x19DeleteDomainReviewRequest\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\"2\n" +
+	"\x18ListDomainReviewsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"a\n" +
+	"\x16ListFlaggedURLsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"\xde\x01\n" +
+	"\x16ListAuditEventsRequest\x12\x14\n" +
+	"\x05actor\x18\x01 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x04 \x01(\tR\trequestId\x12\x14\n" +
+	"\x05since\x18\x05 \x01(\x03R\x05since\x12\x14\n" +
+	"\x05until\x18\x06 \x01(\x03R\x05until\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\b \x01(\tR\x06cursor\"\xae\x02\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\x03R\tcreatedAt\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x0e\n" +
+	"\x02ip\x18\x04 \x01(\tR\x02ip\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x05 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x06 \x01(\tR\trequestId\x12\x16\n" +
+	"\x06action\x18\a \x01(\tR\x06action\x12\x16\n" +
+	"\x06target\x18\b \x01(\tR\x06target\x12\x16\n" +
+	"\x06before\x18\t \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\n" +
+	" \x01(\tR\x05after\x12\x1b\n" +
+	"\tprev_hash\x18\v \x01(\tR\bprevHash\x12\x12\n" +
+	"\x04hash\x18\f \x01(\tR\x04hash\"\xdd\x01\n" +
+	"\x17ListAuditEventsResponse\x12'\n" +
+	"\x06events\x18\x01 \x03(\v2\x0f.url.AuditEventR\x06events\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x19\n" +
+	"\bhas_next\x18\x04 \x01(\bR\ahasNext\x12\x1f\n" +
+	"\vnext_cursor\x18\x05 \x01(\tR\n" +
+	"nextCursor\x12\x1f\n" +
+	"\vprev_cursor\x18\x06 \x01(\tR\n" +
+	"prevCursor\"\x17\n" +
+	"\x15VerifyAuditLogRequest\"}\n" +
+	"\x11AuditVerification\x12\x18\n" +
+	"\achecked\x18\x01 \x01(\x03R\achecked\x12\x14\n" +
+	"\x05valid\x18\x02 \x01(\bR\x05valid\x12\x1b\n" +
+	"\tbroken_at\x18\x03 \x01(\x03R\bbrokenAt\x12\x1b\n" +
+	"\tlast_hash\x18\x04 \x01(\tR\blastHash\"f\n" +
+	"\x14GetLinkHealthRequest\x12\x1d\n" +
+	"\n" +
+	"short_code\x18\x01 \x01(\tR\tshortCode\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\"\xd6\x02\n" +
+	"\x0eLinkHealthInfo\x12\x1d\n" +
+	"\n" +
+	"short_code\x18\x01 \x01(\tR\tshortCode\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1f\n" +
+	"\vstatus_code\x18\x03 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\x04 \x01(\x03R\tlatencyMs\x12%\n" +
+	"\x0eredirect_chain\x18\x05 \x03(\tR\rredirectChain\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x121\n" +
+	"\x14consecutive_failures\x18\a \x01(\x05R\x13consecutiveFailures\x12\x1d\n" +
+	"\n" +
+	"checked_at\x18\b \x01(\x03R\tcheckedAt\x12&\n" +
+	"\x0flast_healthy_at\x18\t \x01(\x03R\rlastHealthyAt\x12\x16\n" +
+	"\x06domain\x18\n" +
+	" \x01(\tR\x06domain\"\xcc\x02\n" +
+	"\x10GetQRCodeRequest\x12\x1d\n" +
+	"\n" +
+	"short_code\x18\x01 \x01(\tR\tshortCode\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x05R\x04size\x12\x1b\n" +
+	"\x06margin\x18\x05 \x01(\x05H\x00R\x06margin\x88\x01\x01\x12\x14\n" +
+	"\x05level\x18\x06 \x01(\tR\x05level\x12\x1e\n" +
+	"\n" +
+	"foreground\x18\a \x01(\tR\n" +
+	"foreground\x12\x1e\n" +
+	"\n" +
+	"background\x18\b \x01(\tR\n" +
+	"background\x12\x19\n" +
+	"\blogo_url\x18\t \x01(\tR\alogoUrl\x12#\n" +
+	"\rsource_marker\x18\n" +
+	" \x01(\bR\fsourceMarker\x12\x16\n" +
+	"\x06domain\x18\v \x01(\tR\x06domainB\t\n" +
+	"\a_margin\"u\n" +
+	"\x0eQRCodeResponse\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\"H\n" +
+	"\x19ListDomainReviewsResponse\x12+\n" +
+	"\areviews\x18\x01 \x03(\v2\x11.url.DomainReviewR\areviews\"\x8b\x01\n" +
+	"\x14BrandedDomainRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\tR\vworkspaceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\x12\x1f\n" +
+	"\vcode_policy\x18\x04 \x01(\tR\n" +
+	"codePolicy\"\xc6\x01\n" +
+	"\rBrandedDomain\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12!\n" +
+	"\fworkspace_id\x18\x02 \x01(\tR\vworkspaceId\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\x12\x1b\n" +
+	"\tshort_url\x18\x05 \x01(\tR\bshortUrl\x12\x1f\n" +
+	"\vcode_policy\x18\x06 \x01(\tR\n" +
+	"codePolicy\"e\n" +
+	"\x10CodePolicyChange\x12*\n" +
+	"\x06domain\x18\x01 \x01(\v2\x12.url.BrandedDomainR\x06domain\x12%\n" +
+	"\x0ecase_conflicts\x18\x02 \x03(\tR\rcaseConflicts\"W\n" +
+	"\x19ListBrandedDomainsRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\tR\vworkspaceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"J\n" +
+	"\x1aListBrandedDomainsResponse\x12,\n" +
+	"\adomains\x18\x01 \x03(\v2\x12.url.BrandedDomainR\adomains\"\xb2\x01\n" +
+	"\x15SuggestAliasesRequest\x12\x14\n" +
+	"\x05alias\x18\x01 \x01(\tR\x05alias\x12\x19\n" +
+	"\blong_url\x18\x02 \x01(\tR\alongUrl\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\x12!\n" +
+	"\fworkspace_id\x18\x04 \x01(\tR\vworkspaceId\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05count\x18\x06 \x01(\x05R\x05count\"n\n" +
+	"\x16SuggestAliasesResponse\x12\x14\n" +
+	"\x05alias\x18\x01 \x01(\tR\x05alias\x12\x1c\n" +
+	"\tavailable\x18\x02 \x01(\bR\tavailable\x12 \n" +
+	"\vsuggestions\x18\x03 \x03(\tR\vsuggestions\"\x80\x01\n" +
+	"\x03Tag\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"link_count\x18\x05 \x01(\x03R\tlinkCount\"T\n" +
+	"\n" +
+	"TagRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bnew_name\x18\x03 \x01(\tR\anewName\"*\n" +
+	"\x0fListTagsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"0\n" +
+	"\x10ListTagsResponse\x12\x1c\n" +
+	"\x04tags\x18\x01 \x03(\v2\b.url.TagR\x04tags\"\xb4\x01\n" +
+	"\x06Folder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\x03R\bparentId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x12\n" +
+	"\x04path\x18\x05 \x01(\tR\x04path\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"link_count\x18\a \x01(\x03R\tlinkCount\"i\n" +
+	"\rFolderRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\x03R\bparentId\"-\n" +
+	"\x12ListFoldersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"<\n" +
+	"\x13ListFoldersResponse\x12%\n" +
+	"\afolders\x18\x01 \x03(\v2\v.url.FolderR\afolders\"\xcd\x01\n" +
+	"\x11SearchURLsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x10\n" +
+	"\x03tag\x18\x03 \x01(\tR\x03tag\x12\x16\n" +
+	"\x06domain\x18\x04 \x01(\tR\x06domain\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\b \x01(\tR\x06cursor\"?\n" +
+	"\tSearchHit\x12\x1e\n" +
+	"\x03url\x18\x01 \x01(\v2\f.url.URLInfoR\x03url\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x01R\x04rank\"8\n" +
+	"\n" +
+	"FacetCount\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\x83\x03\n" +
+	"\x12SearchURLsResponse\x12\"\n" +
+	"\x04hits\x18\x01 \x03(\v2\x0e.url.SearchHitR\x04hits\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x03R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x19\n" +
+	"\bhas_next\x18\x05 \x01(\bR\ahasNext\x12.\n" +
+	"\n" +
+	"tag_facets\x18\x06 \x03(\v2\x0f.url.FacetCountR\ttagFacets\x124\n" +
+	"\rdomain_facets\x18\a \x03(\v2\x0f.url.FacetCountR\fdomainFacets\x124\n" +
+	"\rstatus_facets\x18\b \x03(\v2\x0f.url.FacetCountR\fstatusFacets\x12\x1f\n" +
+	"\vnext_cursor\x18\t \x01(\tR\n" +
+	"nextCursor\x12\x1f\n" +
+	"\vprev_cursor\x18\n" +
+	" \x01(\tR\n" +
+	"prevCursor\"z\n" +
+	"\x16ListDeletedURLsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\"c\n" +
+	"\x11RestoreURLRequest\x12\x1d\n" +
+	"\n" +
+	"short_code\x18\x01 \x01(\tR\tshortCode\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\"\x9a\x01\n" +
+	"\x1aSetInactivityPolicyRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\tR\vworkspaceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12'\n" +
+	"\x0finactivity_days\x18\x03 \x01(\x05R\x0einactivityDays\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"\x94\x01\n" +
+	"\x17InactivityReportRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\tR\vworkspaceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12'\n" +
+	"\x0finactivity_days\x18\x03 \x01(\x05R\x0einactivityDays\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\xba\x01\n" +
+	"\x10InactivityReport\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\tR\vworkspaceId\x12'\n" +
+	"\x0finactivity_days\x18\x02 \x01(\x05R\x0einactivityDays\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\x05R\n" +
+	"totalCount\x12 \n" +
+	"\x04urls\x18\x05 \x03(\v2\f.url.URLInfoR\x04urls\"\x9e\x01\n" +
+	"\x17ListURLRevisionsRequest\x12\x1d\n" +
+	"\n" +
+	"short_code\x18\x01 \x01(\tR\tshortCode\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\"]\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1b\n" +
+	"\told_value\x18\x02 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x03 \x01(\tR\bnewValue\"\xc4\x01\n" +
+	"\vURLRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x05R\brevision\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x03 \x01(\tR\tchangedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\x12*\n" +
+	"\achanges\x18\x05 \x03(\v2\x10.url.FieldChangeR\achanges\x12\x1f\n" +
+	"\vrollback_of\x18\x06 \x01(\x03R\n" +
+	"rollbackOf\"\xe5\x01\n" +
+	"\x18ListURLRevisionsResponse\x12.\n" +
+	"\trevisions\x18\x01 \x03(\v2\x10.url.URLRevisionR\trevisions\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x19\n" +
+	"\bhas_next\x18\x04 \x01(\bR\ahasNext\x12\x1f\n" +
+	"\vnext_cursor\x18\x05 \x01(\tR\n" +
+	"nextCursor\x12\x1f\n" +
+	"\vprev_cursor\x18\x06 \x01(\tR\n" +
+	"prevCursor\"\x85\x01\n" +
+	"\x12RollbackURLRequest\x12\x1d\n" +
+	"\n" +
+	"short_code\x18\x01 \x01(\tR\tshortCode\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\x12\x1f\n" +
+	"\vrevision_id\x18\x04 \x01(\x03R\n" +
+	"revisionId\"\xcc\x02\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\fworkspace_id\x18\x02 \x01(\tR\vworkspaceId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12\x16\n" +
+	"\x06events\x18\x05 \x03(\tR\x06events\x12\x1b\n" +
+	"\tis_active\x18\x06 \x01(\bR\bisActive\x121\n" +
+	"\x14consecutive_failures\x18\a \x01(\x05R\x13consecutiveFailures\x12\x1f\n" +
+	"\vdisabled_at\x18\b \x01(\x03R\n" +
+	"disabledAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\t \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\x03R\tupdatedAt\"\x80\x02\n" +
+	"\x0eWebhookRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\tR\vworkspaceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\x03R\x02id\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12\x16\n" +
+	"\x06events\x18\x05 \x03(\tR\x06events\x12!\n" +
+	"\fclear_events\x18\x06 \x01(\bR\vclearEvents\x12\x16\n" +
+	"\x06enable\x18\a \x01(\bR\x06enable\x12\x18\n" +
+	"\adisable\x18\b \x01(\bR\adisable\x12#\n" +
+	"\rrotate_secret\x18\t \x01(\bR\frotateSecret\"Q\n" +
+	"\x13ListWebhooksRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\tR\vworkspaceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"@\n" +
+	"\x14ListWebhooksResponse\x12(\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\f.url.WebhookR\bwebhooks\"\xbe\x03\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\x03R\twebhookId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\tR\apayload\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12&\n" +
+	"\x0fnext_attempt_at\x18\b \x01(\x03R\rnextAttemptAt\x12&\n" +
+	"\x0flast_attempt_at\x18\t \x01(\x03R\rlastAttemptAt\x12'\n" +
+	"\x0fresponse_status\x18\n" +
+	" \x01(\x05R\x0eresponseStatus\x12#\n" +
+	"\rresponse_body\x18\v \x01(\tR\fresponseBody\x12\x14\n" +
+	"\x05error\x18\f \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\x03R\tcreatedAt\x12!\n" +
+	"\fdelivered_at\x18\x0e \x01(\x03R\vdeliveredAt\"\xc6\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\tR\vworkspaceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x03 \x01(\x03R\twebhookId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x06 \x01(\tR\x06cursor\"\xf0\x01\n" +
+	"\x1dListWebhookDeliveriesResponse\x124\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x14.url.WebhookDeliveryR\n" +
+	"deliveries\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x19\n" +
+	"\bhas_next\x18\x04 \x01(\bR\ahasNext\x12\x1f\n" +
+	"\vnext_cursor\x18\x05 \x01(\tR\n" +
+	"nextCursor\x12\x1f\n" +
+	"\vprev_cursor\x18\x06 \x01(\tR\n" +
+	"prevCursor\"\x95\x01\n" +
+	"\x17RedeliverWebhookRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\tR\vworkspaceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x03 \x01(\x03R\twebhookId\x12\x1f\n" +
+	"\vdelivery_id\x18\x04 \x01(\x03R\n" +
+	"deliveryId\"\x8c\x01\n" +
+	"\n" +
+	"PurgeEvent\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x1d\n" +
+	"\n" +
+	"short_code\x18\x02 \x01(\tR\tshortCode\x12)\n" +
+	"\x10delete_analytics\x18\x03 \x01(\bR\x0fdeleteAnalytics\x12\x1c\n" +
+	"\ttimestamp\x18\x04 \x01(\x03R\ttimestamp\"\xd8\x01\n" +
+	"\vExpiryEvent\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x1d\n" +
+	"\n" +
+	"short_code\x18\x02 \x01(\tR\tshortCode\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12!\n" +
+	"\fworkspace_id\x18\x04 \x01(\tR\vworkspaceId\x12\x19\n" +
+	"\blong_url\x18\x05 \x01(\tR\alongUrl\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\x03R\texpiresAt\x12\x1c\n" +
+	"\ttimestamp\x18\a \x01(\x03R\ttimestamp\"\x8f\x01\n" +
+	"\x0eLinkAlertEvent\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x1d\n" +
+	"\n" +
+	"short_code\x18\x02 \x01(\tR\tshortCode\x12\x14\n" +
+	"\x05event\x18\x03 \x01(\tR\x05event\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\tR\x04data\x12\x1c\n" +
+	"\ttimestamp\x18\x05 \x01(\x03R\ttimestamp2\xad\x16\n" +
 	"\fURLShortener\x127\n" +
 	"\n" +
 	"ShortenURL\x12\x13.url.ShortenRequest\x1a\x14.url.ShortenResponse\x12.\n" +
@@ -832,7 +6210,48 @@ const file_proto_url_url_proto_rawDesc = "" +
 	"GetURLInfo\x12\x12.url.GetURLRequest\x1a\f.url.URLInfo\x127\n" +
 	"\tDeleteURL\x12\x15.url.DeleteURLRequest\x1a\x13.url.DeleteResponse\x12@\n" +
 	"\vGetUserURLs\x12\x17.url.GetUserURLsRequest\x1a\x18.url.GetUserURLsResponse\x12:\n" +
-	"\tUpdateURL\x12\x15.url.UpdateURLRequest\x1a\x16.url.UpdateURLResponseB6Z4github.com/go-systems-lab/go-url-shortener/proto/urlb\x06proto3"
+	"\tUpdateURL\x12\x15.url.UpdateURLRequest\x1a\x16.url.UpdateURLResponse\x12B\n" +
+	"\x0fUpsertWorkspace\x12\x1b.url.UpsertWorkspaceRequest\x1a\x12.url.WorkspaceInfo\x12<\n" +
+	"\fGetWorkspace\x12\x18.url.GetWorkspaceRequest\x1a\x12.url.WorkspaceInfo\x12?\n" +
+	"\rGetLinkHealth\x12\x19.url.GetLinkHealthRequest\x1a\x13.url.LinkHealthInfo\x127\n" +
+	"\tGetQRCode\x12\x15.url.GetQRCodeRequest\x1a\x13.url.QRCodeResponse\x12A\n" +
+	"\x10AddBrandedDomain\x12\x19.url.BrandedDomainRequest\x1a\x12.url.BrandedDomain\x12E\n" +
+	"\x13RemoveBrandedDomain\x12\x19.url.BrandedDomainRequest\x1a\x13.url.DeleteResponse\x12U\n" +
+	"\x12ListBrandedDomains\x12\x1e.url.ListBrandedDomainsRequest\x1a\x1f.url.ListBrandedDomainsResponse\x12N\n" +
+	"\x1aSetBrandedDomainCodePolicy\x12\x19.url.BrandedDomainRequest\x1a\x15.url.CodePolicyChange\x12I\n" +
+	"\x0eSuggestAliases\x12\x1a.url.SuggestAliasesRequest\x1a\x1b.url.SuggestAliasesResponse\x12&\n" +
+	"\tCreateTag\x12\x0f.url.TagRequest\x1a\b.url.Tag\x127\n" +
+	"\bListTags\x12\x14.url.ListTagsRequest\x1a\x15.url.ListTagsResponse\x12&\n" +
+	"\tRenameTag\x12\x0f.url.TagRequest\x1a\b.url.Tag\x121\n" +
+	"\tDeleteTag\x12\x0f.url.TagRequest\x1a\x13.url.DeleteResponse\x12/\n" +
+	"\fCreateFolder\x12\x12.url.FolderRequest\x1a\v.url.Folder\x12@\n" +
+	"\vListFolders\x12\x17.url.ListFoldersRequest\x1a\x18.url.ListFoldersResponse\x12/\n" +
+	"\fUpdateFolder\x12\x12.url.FolderRequest\x1a\v.url.Folder\x127\n" +
+	"\fDeleteFolder\x12\x12.url.FolderRequest\x1a\x13.url.DeleteResponse\x12=\n" +
+	"\n" +
+	"SearchURLs\x12\x16.url.SearchURLsRequest\x1a\x17.url.SearchURLsResponse\x12H\n" +
+	"\x0fListDeletedURLs\x12\x1b.url.ListDeletedURLsRequest\x1a\x18.url.GetUserURLsResponse\x122\n" +
+	"\n" +
+	"RestoreURL\x12\x16.url.RestoreURLRequest\x1a\f.url.URLInfo\x12J\n" +
+	"\x13SetInactivityPolicy\x12\x1f.url.SetInactivityPolicyRequest\x1a\x12.url.WorkspaceInfo\x12J\n" +
+	"\x13GetInactivityReport\x12\x1c.url.InactivityReportRequest\x1a\x15.url.InactivityReport\x121\n" +
+	"\tReviveURL\x12\x16.url.RestoreURLRequest\x1a\f.url.URLInfo\x12O\n" +
+	"\x10ListURLRevisions\x12\x1c.url.ListURLRevisionsRequest\x1a\x1d.url.ListURLRevisionsResponse\x124\n" +
+	"\vRollbackURL\x12\x17.url.RollbackURLRequest\x1a\f.url.URLInfo\x122\n" +
+	"\rCreateWebhook\x12\x13.url.WebhookRequest\x1a\f.url.Webhook\x12C\n" +
+	"\fListWebhooks\x12\x18.url.ListWebhooksRequest\x1a\x19.url.ListWebhooksResponse\x122\n" +
+	"\rUpdateWebhook\x12\x13.url.WebhookRequest\x1a\f.url.Webhook\x129\n" +
+	"\rDeleteWebhook\x12\x13.url.WebhookRequest\x1a\x13.url.DeleteResponse\x12^\n" +
+	"\x15ListWebhookDeliveries\x12!.url.ListWebhookDeliveriesRequest\x1a\".url.ListWebhookDeliveriesResponse\x12F\n" +
+	"\x10RedeliverWebhook\x12\x1c.url.RedeliverWebhookRequest\x1a\x14.url.WebhookDelivery\x12N\n" +
+	"\x13SetInterstitialMode\x12\x1f.url.SetInterstitialModeRequest\x1a\x16.url.UpdateURLResponse\x12:\n" +
+	"\x12UpsertDomainReview\x12\x11.url.DomainReview\x1a\x11.url.DomainReview\x12I\n" +
+	"\x12DeleteDomainReview\x12\x1e.url.DeleteDomainReviewRequest\x1a\x13.url.DeleteResponse\x12R\n" +
+	"\x11ListDomainReviews\x12\x1d.url.ListDomainReviewsRequest\x1a\x1e.url.ListDomainReviewsResponse\x12H\n" +
+	"\x0fListFlaggedURLs\x12\x1b.url.ListFlaggedURLsRequest\x1a\x18.url.GetUserURLsResponse\x12D\n" +
+	"\x10SetWorkspacePlan\x12\x1c.url.SetWorkspacePlanRequest\x1a\x12.url.WorkspaceInfo\x12L\n" +
+	"\x0fListAuditEvents\x12\x1b.url.ListAuditEventsRequest\x1a\x1c.url.ListAuditEventsResponse\x12D\n" +
+	"\x0eVerifyAuditLog\x12\x1a.url.VerifyAuditLogRequest\x1a\x16.url.AuditVerificationB6Z4github.com/go-systems-lab/go-url-shortener/proto/urlb\x06proto3"
 
 var (
 	file_proto_url_url_proto_rawDescOnce sync.Once
@@ -846,43 +6265,211 @@ func file_proto_url_url_proto_rawDescGZIP() []byte {
 	return file_proto_url_url_proto_rawDescData
 }
 
-var file_proto_url_url_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_proto_url_url_proto_msgTypes = make([]protoimpl.MessageInfo, 78)
 var file_proto_url_url_proto_goTypes = []any{
-	(*ShortenRequest)(nil),      // 0: url.ShortenRequest
-	(*ShortenResponse)(nil),     // 1: url.ShortenResponse
-	(*GetURLRequest)(nil),       // 2: url.GetURLRequest
-	(*URLInfo)(nil),             // 3: url.URLInfo
-	(*DeleteURLRequest)(nil),    // 4: url.DeleteURLRequest
-	(*DeleteResponse)(nil),      // 5: url.DeleteResponse
-	(*GetUserURLsRequest)(nil),  // 6: url.GetUserURLsRequest
-	(*GetUserURLsResponse)(nil), // 7: url.GetUserURLsResponse
-	(*UpdateURLRequest)(nil),    // 8: url.UpdateURLRequest
-	(*UpdateURLResponse)(nil),   // 9: url.UpdateURLResponse
-	nil,                         // 10: url.ShortenRequest.MetadataEntry
-	nil,                         // 11: url.URLInfo.MetadataEntry
-	nil,                         // 12: url.UpdateURLRequest.MetadataEntry
+	(*ShortenRequest)(nil),                // 0: url.ShortenRequest
+	(*LinkPreview)(nil),                   // 1: url.LinkPreview
+	(*ShortenResponse)(nil),               // 2: url.ShortenResponse
+	(*GetURLRequest)(nil),                 // 3: url.GetURLRequest
+	(*URLInfo)(nil),                       // 4: url.URLInfo
+	(*DeleteURLRequest)(nil),              // 5: url.DeleteURLRequest
+	(*DeleteResponse)(nil),                // 6: url.DeleteResponse
+	(*GetUserURLsRequest)(nil),            // 7: url.GetUserURLsRequest
+	(*GetUserURLsResponse)(nil),           // 8: url.GetUserURLsResponse
+	(*UpdateURLRequest)(nil),              // 9: url.UpdateURLRequest
+	(*UpdateURLResponse)(nil),             // 10: url.UpdateURLResponse
+	(*UpsertWorkspaceRequest)(nil),        // 11: url.UpsertWorkspaceRequest
+	(*GetWorkspaceRequest)(nil),           // 12: url.GetWorkspaceRequest
+	(*WorkspaceInfo)(nil),                 // 13: url.WorkspaceInfo
+	(*SetWorkspacePlanRequest)(nil),       // 14: url.SetWorkspacePlanRequest
+	(*SetInterstitialModeRequest)(nil),    // 15: url.SetInterstitialModeRequest
+	(*DomainReview)(nil),                  // 16: url.DomainReview
+	(*DeleteDomainReviewRequest)(nil),     // 17: url.DeleteDomainReviewRequest
+	(*ListDomainReviewsRequest)(nil),      // 18: url.ListDomainReviewsRequest
+	(*ListFlaggedURLsRequest)(nil),        // 19: url.ListFlaggedURLsRequest
+	(*ListAuditEventsRequest)(nil),        // 20: url.ListAuditEventsRequest
+	(*AuditEvent)(nil),                    // 21: url.AuditEvent
+	(*ListAuditEventsResponse)(nil),       // 22: url.ListAuditEventsResponse
+	(*VerifyAuditLogRequest)(nil),         // 23: url.VerifyAuditLogRequest
+	(*AuditVerification)(nil),             // 24: url.AuditVerification
+	(*GetLinkHealthRequest)(nil),          // 25: url.GetLinkHealthRequest
+	(*LinkHealthInfo)(nil),                // 26: url.LinkHealthInfo
+	(*GetQRCodeRequest)(nil),              // 27: url.GetQRCodeRequest
+	(*QRCodeResponse)(nil),                // 28: url.QRCodeResponse
+	(*ListDomainReviewsResponse)(nil),     // 29: url.ListDomainReviewsResponse
+	(*BrandedDomainRequest)(nil),          // 30: url.BrandedDomainRequest
+	(*BrandedDomain)(nil),                 // 31: url.BrandedDomain
+	(*CodePolicyChange)(nil),              // 32: url.CodePolicyChange
+	(*ListBrandedDomainsRequest)(nil),     // 33: url.ListBrandedDomainsRequest
+	(*ListBrandedDomainsResponse)(nil),    // 34: url.ListBrandedDomainsResponse
+	(*SuggestAliasesRequest)(nil),         // 35: url.SuggestAliasesRequest
+	(*SuggestAliasesResponse)(nil),        // 36: url.SuggestAliasesResponse
+	(*Tag)(nil),                           // 37: url.Tag
+	(*TagRequest)(nil),                    // 38: url.TagRequest
+	(*ListTagsRequest)(nil),               // 39: url.ListTagsRequest
+	(*ListTagsResponse)(nil),              // 40: url.ListTagsResponse
+	(*Folder)(nil),                        // 41: url.Folder
+	(*FolderRequest)(nil),                 // 42: url.FolderRequest
+	(*ListFoldersRequest)(nil),            // 43: url.ListFoldersRequest
+	(*ListFoldersResponse)(nil),           // 44: url.ListFoldersResponse
+	(*SearchURLsRequest)(nil),             // 45: url.SearchURLsRequest
+	(*SearchHit)(nil),                     // 46: url.SearchHit
+	(*FacetCount)(nil),                    // 47: url.FacetCount
+	(*SearchURLsResponse)(nil),            // 48: url.SearchURLsResponse
+	(*ListDeletedURLsRequest)(nil),        // 49: url.ListDeletedURLsRequest
+	(*RestoreURLRequest)(nil),             // 50: url.RestoreURLRequest
+	(*SetInactivityPolicyRequest)(nil),    // 51: url.SetInactivityPolicyRequest
+	(*InactivityReportRequest)(nil),       // 52: url.InactivityReportRequest
+	(*InactivityReport)(nil),              // 53: url.InactivityReport
+	(*ListURLRevisionsRequest)(nil),       // 54: url.ListURLRevisionsRequest
+	(*FieldChange)(nil),                   // 55: url.FieldChange
+	(*URLRevision)(nil),                   // 56: url.URLRevision
+	(*ListURLRevisionsResponse)(nil),      // 57: url.ListURLRevisionsResponse
+	(*RollbackURLRequest)(nil),            // 58: url.RollbackURLRequest
+	(*Webhook)(nil),                       // 59: url.Webhook
+	(*WebhookRequest)(nil),                // 60: url.WebhookRequest
+	(*ListWebhooksRequest)(nil),           // 61: url.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),          // 62: url.ListWebhooksResponse
+	(*WebhookDelivery)(nil),               // 63: url.WebhookDelivery
+	(*ListWebhookDeliveriesRequest)(nil),  // 64: url.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil), // 65: url.ListWebhookDeliveriesResponse
+	(*RedeliverWebhookRequest)(nil),       // 66: url.RedeliverWebhookRequest
+	(*PurgeEvent)(nil),                    // 67: url.PurgeEvent
+	(*ExpiryEvent)(nil),                   // 68: url.ExpiryEvent
+	(*LinkAlertEvent)(nil),                // 69: url.LinkAlertEvent
+	nil,                                   // 70: url.ShortenRequest.MetadataEntry
+	nil,                                   // 71: url.ShortenRequest.UtmTemplateEntry
+	nil,                                   // 72: url.URLInfo.MetadataEntry
+	nil,                                   // 73: url.URLInfo.UtmTemplateEntry
+	nil,                                   // 74: url.UpdateURLRequest.MetadataEntry
+	nil,                                   // 75: url.UpdateURLRequest.UtmTemplateEntry
+	nil,                                   // 76: url.UpsertWorkspaceRequest.UtmTemplateEntry
+	nil,                                   // 77: url.WorkspaceInfo.UtmTemplateEntry
 }
 var file_proto_url_url_proto_depIdxs = []int32{
-	10, // 0: url.ShortenRequest.metadata:type_name -> url.ShortenRequest.MetadataEntry
-	11, // 1: url.URLInfo.metadata:type_name -> url.URLInfo.MetadataEntry
-	3,  // 2: url.GetUserURLsResponse.urls:type_name -> url.URLInfo
-	12, // 3: url.UpdateURLRequest.metadata:type_name -> url.UpdateURLRequest.MetadataEntry
-	3,  // 4: url.UpdateURLResponse.updated_url:type_name -> url.URLInfo
-	0,  // 5: url.URLShortener.ShortenURL:input_type -> url.ShortenRequest
-	2,  // 6: url.URLShortener.GetURLInfo:input_type -> url.GetURLRequest
-	4,  // 7: url.URLShortener.DeleteURL:input_type -> url.DeleteURLRequest
-	6,  // 8: url.URLShortener.GetUserURLs:input_type -> url.GetUserURLsRequest
-	8,  // 9: url.URLShortener.UpdateURL:input_type -> url.UpdateURLRequest
-	1,  // 10: url.URLShortener.ShortenURL:output_type -> url.ShortenResponse
-	3,  // 11: url.URLShortener.GetURLInfo:output_type -> url.URLInfo
-	5,  // 12: url.URLShortener.DeleteURL:output_type -> url.DeleteResponse
-	7,  // 13: url.URLShortener.GetUserURLs:output_type -> url.GetUserURLsResponse
-	9,  // 14: url.URLShortener.UpdateURL:output_type -> url.UpdateURLResponse
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	70, // 0: url.ShortenRequest.metadata:type_name -> url.ShortenRequest.MetadataEntry
+	71, // 1: url.ShortenRequest.utm_template:type_name -> url.ShortenRequest.UtmTemplateEntry
+	1,  // 2: url.ShortenRequest.preview_override:type_name -> url.LinkPreview
+	72, // 3: url.URLInfo.metadata:type_name -> url.URLInfo.MetadataEntry
+	73, // 4: url.URLInfo.utm_template:type_name -> url.URLInfo.UtmTemplateEntry
+	1,  // 5: url.URLInfo.preview:type_name -> url.LinkPreview
+	1,  // 6: url.URLInfo.preview_override:type_name -> url.LinkPreview
+	4,  // 7: url.GetUserURLsResponse.urls:type_name -> url.URLInfo
+	74, // 8: url.UpdateURLRequest.metadata:type_name -> url.UpdateURLRequest.MetadataEntry
+	75, // 9: url.UpdateURLRequest.utm_template:type_name -> url.UpdateURLRequest.UtmTemplateEntry
+	1,  // 10: url.UpdateURLRequest.preview_override:type_name -> url.LinkPreview
+	4,  // 11: url.UpdateURLResponse.updated_url:type_name -> url.URLInfo
+	76, // 12: url.UpsertWorkspaceRequest.utm_template:type_name -> url.UpsertWorkspaceRequest.UtmTemplateEntry
+	77, // 13: url.WorkspaceInfo.utm_template:type_name -> url.WorkspaceInfo.UtmTemplateEntry
+	21, // 14: url.ListAuditEventsResponse.events:type_name -> url.AuditEvent
+	16, // 15: url.ListDomainReviewsResponse.reviews:type_name -> url.DomainReview
+	31, // 16: url.CodePolicyChange.domain:type_name -> url.BrandedDomain
+	31, // 17: url.ListBrandedDomainsResponse.domains:type_name -> url.BrandedDomain
+	37, // 18: url.ListTagsResponse.tags:type_name -> url.Tag
+	41, // 19: url.ListFoldersResponse.folders:type_name -> url.Folder
+	4,  // 20: url.SearchHit.url:type_name -> url.URLInfo
+	46, // 21: url.SearchURLsResponse.hits:type_name -> url.SearchHit
+	47, // 22: url.SearchURLsResponse.tag_facets:type_name -> url.FacetCount
+	47, // 23: url.SearchURLsResponse.domain_facets:type_name -> url.FacetCount
+	47, // 24: url.SearchURLsResponse.status_facets:type_name -> url.FacetCount
+	4,  // 25: url.InactivityReport.urls:type_name -> url.URLInfo
+	55, // 26: url.URLRevision.changes:type_name -> url.FieldChange
+	56, // 27: url.ListURLRevisionsResponse.revisions:type_name -> url.URLRevision
+	59, // 28: url.ListWebhooksResponse.webhooks:type_name -> url.Webhook
+	63, // 29: url.ListWebhookDeliveriesResponse.deliveries:type_name -> url.WebhookDelivery
+	0,  // 30: url.URLShortener.ShortenURL:input_type -> url.ShortenRequest
+	3,  // 31: url.URLShortener.GetURLInfo:input_type -> url.GetURLRequest
+	5,  // 32: url.URLShortener.DeleteURL:input_type -> url.DeleteURLRequest
+	7,  // 33: url.URLShortener.GetUserURLs:input_type -> url.GetUserURLsRequest
+	9,  // 34: url.URLShortener.UpdateURL:input_type -> url.UpdateURLRequest
+	11, // 35: url.URLShortener.UpsertWorkspace:input_type -> url.UpsertWorkspaceRequest
+	12, // 36: url.URLShortener.GetWorkspace:input_type -> url.GetWorkspaceRequest
+	25, // 37: url.URLShortener.GetLinkHealth:input_type -> url.GetLinkHealthRequest
+	27, // 38: url.URLShortener.GetQRCode:input_type -> url.GetQRCodeRequest
+	30, // 39: url.URLShortener.AddBrandedDomain:input_type -> url.BrandedDomainRequest
+	30, // 40: url.URLShortener.RemoveBrandedDomain:input_type -> url.BrandedDomainRequest
+	33, // 41: url.URLShortener.ListBrandedDomains:input_type -> url.ListBrandedDomainsRequest
+	30, // 42: url.URLShortener.SetBrandedDomainCodePolicy:input_type -> url.BrandedDomainRequest
+	35, // 43: url.URLShortener.SuggestAliases:input_type -> url.SuggestAliasesRequest
+	38, // 44: url.URLShortener.CreateTag:input_type -> url.TagRequest
+	39, // 45: url.URLShortener.ListTags:input_type -> url.ListTagsRequest
+	38, // 46: url.URLShortener.RenameTag:input_type -> url.TagRequest
+	38, // 47: url.URLShortener.DeleteTag:input_type -> url.TagRequest
+	42, // 48: url.URLShortener.CreateFolder:input_type -> url.FolderRequest
+	43, // 49: url.URLShortener.ListFolders:input_type -> url.ListFoldersRequest
+	42, // 50: url.URLShortener.UpdateFolder:input_type -> url.FolderRequest
+	42, // 51: url.URLShortener.DeleteFolder:input_type -> url.FolderRequest
+	45, // 52: url.URLShortener.SearchURLs:input_type -> url.SearchURLsRequest
+	49, // 53: url.URLShortener.ListDeletedURLs:input_type -> url.ListDeletedURLsRequest
+	50, // 54: url.URLShortener.RestoreURL:input_type -> url.RestoreURLRequest
+	51, // 55: url.URLShortener.SetInactivityPolicy:input_type -> url.SetInactivityPolicyRequest
+	52, // 56: url.URLShortener.GetInactivityReport:input_type -> url.InactivityReportRequest
+	50, // 57: url.URLShortener.ReviveURL:input_type -> url.RestoreURLRequest
+	54, // 58: url.URLShortener.ListURLRevisions:input_type -> url.ListURLRevisionsRequest
+	58, // 59: url.URLShortener.RollbackURL:input_type -> url.RollbackURLRequest
+	60, // 60: url.URLShortener.CreateWebhook:input_type -> url.WebhookRequest
+	61, // 61: url.URLShortener.ListWebhooks:input_type -> url.ListWebhooksRequest
+	60, // 62: url.URLShortener.UpdateWebhook:input_type -> url.WebhookRequest
+	60, // 63: url.URLShortener.DeleteWebhook:input_type -> url.WebhookRequest
+	64, // 64: url.URLShortener.ListWebhookDeliveries:input_type -> url.ListWebhookDeliveriesRequest
+	66, // 65: url.URLShortener.RedeliverWebhook:input_type -> url.RedeliverWebhookRequest
+	15, // 66: url.URLShortener.SetInterstitialMode:input_type -> url.SetInterstitialModeRequest
+	16, // 67: url.URLShortener.UpsertDomainReview:input_type -> url.DomainReview
+	17, // 68: url.URLShortener.DeleteDomainReview:input_type -> url.DeleteDomainReviewRequest
+	18, // 69: url.URLShortener.ListDomainReviews:input_type -> url.ListDomainReviewsRequest
+	19, // 70: url.URLShortener.ListFlaggedURLs:input_type -> url.ListFlaggedURLsRequest
+	14, // 71: url.URLShortener.SetWorkspacePlan:input_type -> url.SetWorkspacePlanRequest
+	20, // 72: url.URLShortener.ListAuditEvents:input_type -> url.ListAuditEventsRequest
+	23, // 73: url.URLShortener.VerifyAuditLog:input_type -> url.VerifyAuditLogRequest
+	2,  // 74: url.URLShortener.ShortenURL:output_type -> url.ShortenResponse
+	4,  // 75: url.URLShortener.GetURLInfo:output_type -> url.URLInfo
+	6,  // 76: url.URLShortener.DeleteURL:output_type -> url.DeleteResponse
+	8,  // 77: url.URLShortener.GetUserURLs:output_type -> url.GetUserURLsResponse
+	10, // 78: url.URLShortener.UpdateURL:output_type -> url.UpdateURLResponse
+	13, // 79: url.URLShortener.UpsertWorkspace:output_type -> url.WorkspaceInfo
+	13, // 80: url.URLShortener.GetWorkspace:output_type -> url.WorkspaceInfo
+	26, // 81: url.URLShortener.GetLinkHealth:output_type -> url.LinkHealthInfo
+	28, // 82: url.URLShortener.GetQRCode:output_type -> url.QRCodeResponse
+	31, // 83: url.URLShortener.AddBrandedDomain:output_type -> url.BrandedDomain
+	6,  // 84: url.URLShortener.RemoveBrandedDomain:output_type -> url.DeleteResponse
+	34, // 85: url.URLShortener.ListBrandedDomains:output_type -> url.ListBrandedDomainsResponse
+	32, // 86: url.URLShortener.SetBrandedDomainCodePolicy:output_type -> url.CodePolicyChange
+	36, // 87: url.URLShortener.SuggestAliases:output_type -> url.SuggestAliasesResponse
+	37, // 88: url.URLShortener.CreateTag:output_type -> url.Tag
+	40, // 89: url.URLShortener.ListTags:output_type -> url.ListTagsResponse
+	37, // 90: url.URLShortener.RenameTag:output_type -> url.Tag
+	6,  // 91: url.URLShortener.DeleteTag:output_type -> url.DeleteResponse
+	41, // 92: url.URLShortener.CreateFolder:output_type -> url.Folder
+	44, // 93: url.URLShortener.ListFolders:output_type -> url.ListFoldersResponse
+	41, // 94: url.URLShortener.UpdateFolder:output_type -> url.Folder
+	6,  // 95: url.URLShortener.DeleteFolder:output_type -> url.DeleteResponse
+	48, // 96: url.URLShortener.SearchURLs:output_type -> url.SearchURLsResponse
+	8,  // 97: url.URLShortener.ListDeletedURLs:output_type -> url.GetUserURLsResponse
+	4,  // 98: url.URLShortener.RestoreURL:output_type -> url.URLInfo
+	13, // 99: url.URLShortener.SetInactivityPolicy:output_type -> url.WorkspaceInfo
+	53, // 100: url.URLShortener.GetInactivityReport:output_type -> url.InactivityReport
+	4,  // 101: url.URLShortener.ReviveURL:output_type -> url.URLInfo
+	57, // 102: url.URLShortener.ListURLRevisions:output_type -> url.ListURLRevisionsResponse
+	4,  // 103: url.URLShortener.RollbackURL:output_type -> url.URLInfo
+	59, // 104: url.URLShortener.CreateWebhook:output_type -> url.Webhook
+	62, // 105: url.URLShortener.ListWebhooks:output_type -> url.ListWebhooksResponse
+	59, // 106: url.URLShortener.UpdateWebhook:output_type -> url.Webhook
+	6,  // 107: url.URLShortener.DeleteWebhook:output_type -> url.DeleteResponse
+	65, // 108: url.URLShortener.ListWebhookDeliveries:output_type -> url.ListWebhookDeliveriesResponse
+	63, // 109: url.URLShortener.RedeliverWebhook:output_type -> url.WebhookDelivery
+	10, // 110: url.URLShortener.SetInterstitialMode:output_type -> url.UpdateURLResponse
+	16, // 111: url.URLShortener.UpsertDomainReview:output_type -> url.DomainReview
+	6,  // 112: url.URLShortener.DeleteDomainReview:output_type -> url.DeleteResponse
+	29, // 113: url.URLShortener.ListDomainReviews:output_type -> url.ListDomainReviewsResponse
+	8,  // 114: url.URLShortener.ListFlaggedURLs:output_type -> url.GetUserURLsResponse
+	13, // 115: url.URLShortener.SetWorkspacePlan:output_type -> url.WorkspaceInfo
+	22, // 116: url.URLShortener.ListAuditEvents:output_type -> url.ListAuditEventsResponse
+	24, // 117: url.URLShortener.VerifyAuditLog:output_type -> url.AuditVerification
+	74, // [74:118] is the sub-list for method output_type
+	30, // [30:74] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_proto_url_url_proto_init() }
@@ -890,13 +6477,14 @@ func file_proto_url_url_proto_init() {
 	if File_proto_url_url_proto != nil {
 		return
 	}
+	file_proto_url_url_proto_msgTypes[27].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_url_url_proto_rawDesc), len(file_proto_url_url_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   78,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -33,6 +33,46 @@ type URLShortenerService interface {
 	DeleteURL(ctx context.Context, in *DeleteURLRequest, opts ...client.CallOption) (*DeleteResponse, error)
 	GetUserURLs(ctx context.Context, in *GetUserURLsRequest, opts ...client.CallOption) (*GetUserURLsResponse, error)
 	UpdateURL(ctx context.Context, in *UpdateURLRequest, opts ...client.CallOption) (*UpdateURLResponse, error)
+	UpsertWorkspace(ctx context.Context, in *UpsertWorkspaceRequest, opts ...client.CallOption) (*WorkspaceInfo, error)
+	GetWorkspace(ctx context.Context, in *GetWorkspaceRequest, opts ...client.CallOption) (*WorkspaceInfo, error)
+	GetLinkHealth(ctx context.Context, in *GetLinkHealthRequest, opts ...client.CallOption) (*LinkHealthInfo, error)
+	GetQRCode(ctx context.Context, in *GetQRCodeRequest, opts ...client.CallOption) (*QRCodeResponse, error)
+	AddBrandedDomain(ctx context.Context, in *BrandedDomainRequest, opts ...client.CallOption) (*BrandedDomain, error)
+	RemoveBrandedDomain(ctx context.Context, in *BrandedDomainRequest, opts ...client.CallOption) (*DeleteResponse, error)
+	ListBrandedDomains(ctx context.Context, in *ListBrandedDomainsRequest, opts ...client.CallOption) (*ListBrandedDomainsResponse, error)
+	SetBrandedDomainCodePolicy(ctx context.Context, in *BrandedDomainRequest, opts ...client.CallOption) (*CodePolicyChange, error)
+	SuggestAliases(ctx context.Context, in *SuggestAliasesRequest, opts ...client.CallOption) (*SuggestAliasesResponse, error)
+	CreateTag(ctx context.Context, in *TagRequest, opts ...client.CallOption) (*Tag, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...client.CallOption) (*ListTagsResponse, error)
+	RenameTag(ctx context.Context, in *TagRequest, opts ...client.CallOption) (*Tag, error)
+	DeleteTag(ctx context.Context, in *TagRequest, opts ...client.CallOption) (*DeleteResponse, error)
+	CreateFolder(ctx context.Context, in *FolderRequest, opts ...client.CallOption) (*Folder, error)
+	ListFolders(ctx context.Context, in *ListFoldersRequest, opts ...client.CallOption) (*ListFoldersResponse, error)
+	UpdateFolder(ctx context.Context, in *FolderRequest, opts ...client.CallOption) (*Folder, error)
+	DeleteFolder(ctx context.Context, in *FolderRequest, opts ...client.CallOption) (*DeleteResponse, error)
+	SearchURLs(ctx context.Context, in *SearchURLsRequest, opts ...client.CallOption) (*SearchURLsResponse, error)
+	ListDeletedURLs(ctx context.Context, in *ListDeletedURLsRequest, opts ...client.CallOption) (*GetUserURLsResponse, error)
+	RestoreURL(ctx context.Context, in *RestoreURLRequest, opts ...client.CallOption) (*URLInfo, error)
+	SetInactivityPolicy(ctx context.Context, in *SetInactivityPolicyRequest, opts ...client.CallOption) (*WorkspaceInfo, error)
+	GetInactivityReport(ctx context.Context, in *InactivityReportRequest, opts ...client.CallOption) (*InactivityReport, error)
+	ReviveURL(ctx context.Context, in *RestoreURLRequest, opts ...client.CallOption) (*URLInfo, error)
+	ListURLRevisions(ctx context.Context, in *ListURLRevisionsRequest, opts ...client.CallOption) (*ListURLRevisionsResponse, error)
+	RollbackURL(ctx context.Context, in *RollbackURLRequest, opts ...client.CallOption) (*URLInfo, error)
+	CreateWebhook(ctx context.Context, in *WebhookRequest, opts ...client.CallOption) (*Webhook, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...client.CallOption) (*ListWebhooksResponse, error)
+	UpdateWebhook(ctx context.Context, in *WebhookRequest, opts ...client.CallOption) (*Webhook, error)
+	DeleteWebhook(ctx context.Context, in *WebhookRequest, opts ...client.CallOption) (*DeleteResponse, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...client.CallOption) (*ListWebhookDeliveriesResponse, error)
+	RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...client.CallOption) (*WebhookDelivery, error)
+	// Admin operations
+	SetInterstitialMode(ctx context.Context, in *SetInterstitialModeRequest, opts ...client.CallOption) (*UpdateURLResponse, error)
+	UpsertDomainReview(ctx context.Context, in *DomainReview, opts ...client.CallOption) (*DomainReview, error)
+	DeleteDomainReview(ctx context.Context, in *DeleteDomainReviewRequest, opts ...client.CallOption) (*DeleteResponse, error)
+	ListDomainReviews(ctx context.Context, in *ListDomainReviewsRequest, opts ...client.CallOption) (*ListDomainReviewsResponse, error)
+	ListFlaggedURLs(ctx context.Context, in *ListFlaggedURLsRequest, opts ...client.CallOption) (*GetUserURLsResponse, error)
+	SetWorkspacePlan(ctx context.Context, in *SetWorkspacePlanRequest, opts ...client.CallOption) (*WorkspaceInfo, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...client.CallOption) (*ListAuditEventsResponse, error)
+	VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...client.CallOption) (*AuditVerification, error)
 }
 
 type uRLShortenerService struct {
@@ -97,6 +137,396 @@ func (c *uRLShortenerService) UpdateURL(ctx context.Context, in *UpdateURLReques
 	return out, nil
 }
 
+func (c *uRLShortenerService) UpsertWorkspace(ctx context.Context, in *UpsertWorkspaceRequest, opts ...client.CallOption) (*WorkspaceInfo, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.UpsertWorkspace", in)
+	out := new(WorkspaceInfo)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) GetWorkspace(ctx context.Context, in *GetWorkspaceRequest, opts ...client.CallOption) (*WorkspaceInfo, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.GetWorkspace", in)
+	out := new(WorkspaceInfo)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) GetLinkHealth(ctx context.Context, in *GetLinkHealthRequest, opts ...client.CallOption) (*LinkHealthInfo, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.GetLinkHealth", in)
+	out := new(LinkHealthInfo)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) GetQRCode(ctx context.Context, in *GetQRCodeRequest, opts ...client.CallOption) (*QRCodeResponse, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.GetQRCode", in)
+	out := new(QRCodeResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) AddBrandedDomain(ctx context.Context, in *BrandedDomainRequest, opts ...client.CallOption) (*BrandedDomain, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.AddBrandedDomain", in)
+	out := new(BrandedDomain)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) RemoveBrandedDomain(ctx context.Context, in *BrandedDomainRequest, opts ...client.CallOption) (*DeleteResponse, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.RemoveBrandedDomain", in)
+	out := new(DeleteResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) ListBrandedDomains(ctx context.Context, in *ListBrandedDomainsRequest, opts ...client.CallOption) (*ListBrandedDomainsResponse, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.ListBrandedDomains", in)
+	out := new(ListBrandedDomainsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) SetBrandedDomainCodePolicy(ctx context.Context, in *BrandedDomainRequest, opts ...client.CallOption) (*CodePolicyChange, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.SetBrandedDomainCodePolicy", in)
+	out := new(CodePolicyChange)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) SuggestAliases(ctx context.Context, in *SuggestAliasesRequest, opts ...client.CallOption) (*SuggestAliasesResponse, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.SuggestAliases", in)
+	out := new(SuggestAliasesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) CreateTag(ctx context.Context, in *TagRequest, opts ...client.CallOption) (*Tag, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.CreateTag", in)
+	out := new(Tag)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) ListTags(ctx context.Context, in *ListTagsRequest, opts ...client.CallOption) (*ListTagsResponse, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.ListTags", in)
+	out := new(ListTagsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) RenameTag(ctx context.Context, in *TagRequest, opts ...client.CallOption) (*Tag, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.RenameTag", in)
+	out := new(Tag)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) DeleteTag(ctx context.Context, in *TagRequest, opts ...client.CallOption) (*DeleteResponse, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.DeleteTag", in)
+	out := new(DeleteResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) CreateFolder(ctx context.Context, in *FolderRequest, opts ...client.CallOption) (*Folder, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.CreateFolder", in)
+	out := new(Folder)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) ListFolders(ctx context.Context, in *ListFoldersRequest, opts ...client.CallOption) (*ListFoldersResponse, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.ListFolders", in)
+	out := new(ListFoldersResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) UpdateFolder(ctx context.Context, in *FolderRequest, opts ...client.CallOption) (*Folder, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.UpdateFolder", in)
+	out := new(Folder)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) DeleteFolder(ctx context.Context, in *FolderRequest, opts ...client.CallOption) (*DeleteResponse, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.DeleteFolder", in)
+	out := new(DeleteResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) SearchURLs(ctx context.Context, in *SearchURLsRequest, opts ...client.CallOption) (*SearchURLsResponse, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.SearchURLs", in)
+	out := new(SearchURLsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) ListDeletedURLs(ctx context.Context, in *ListDeletedURLsRequest, opts ...client.CallOption) (*GetUserURLsResponse, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.ListDeletedURLs", in)
+	out := new(GetUserURLsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) RestoreURL(ctx context.Context, in *RestoreURLRequest, opts ...client.CallOption) (*URLInfo, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.RestoreURL", in)
+	out := new(URLInfo)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) SetInactivityPolicy(ctx context.Context, in *SetInactivityPolicyRequest, opts ...client.CallOption) (*WorkspaceInfo, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.SetInactivityPolicy", in)
+	out := new(WorkspaceInfo)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) GetInactivityReport(ctx context.Context, in *InactivityReportRequest, opts ...client.CallOption) (*InactivityReport, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.GetInactivityReport", in)
+	out := new(InactivityReport)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) ReviveURL(ctx context.Context, in *RestoreURLRequest, opts ...client.CallOption) (*URLInfo, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.ReviveURL", in)
+	out := new(URLInfo)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) ListURLRevisions(ctx context.Context, in *ListURLRevisionsRequest, opts ...client.CallOption) (*ListURLRevisionsResponse, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.ListURLRevisions", in)
+	out := new(ListURLRevisionsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) RollbackURL(ctx context.Context, in *RollbackURLRequest, opts ...client.CallOption) (*URLInfo, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.RollbackURL", in)
+	out := new(URLInfo)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) CreateWebhook(ctx context.Context, in *WebhookRequest, opts ...client.CallOption) (*Webhook, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.CreateWebhook", in)
+	out := new(Webhook)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...client.CallOption) (*ListWebhooksResponse, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.ListWebhooks", in)
+	out := new(ListWebhooksResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) UpdateWebhook(ctx context.Context, in *WebhookRequest, opts ...client.CallOption) (*Webhook, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.UpdateWebhook", in)
+	out := new(Webhook)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) DeleteWebhook(ctx context.Context, in *WebhookRequest, opts ...client.CallOption) (*DeleteResponse, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.DeleteWebhook", in)
+	out := new(DeleteResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...client.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.ListWebhookDeliveries", in)
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...client.CallOption) (*WebhookDelivery, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.RedeliverWebhook", in)
+	out := new(WebhookDelivery)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) SetInterstitialMode(ctx context.Context, in *SetInterstitialModeRequest, opts ...client.CallOption) (*UpdateURLResponse, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.SetInterstitialMode", in)
+	out := new(UpdateURLResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) UpsertDomainReview(ctx context.Context, in *DomainReview, opts ...client.CallOption) (*DomainReview, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.UpsertDomainReview", in)
+	out := new(DomainReview)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) DeleteDomainReview(ctx context.Context, in *DeleteDomainReviewRequest, opts ...client.CallOption) (*DeleteResponse, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.DeleteDomainReview", in)
+	out := new(DeleteResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) ListDomainReviews(ctx context.Context, in *ListDomainReviewsRequest, opts ...client.CallOption) (*ListDomainReviewsResponse, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.ListDomainReviews", in)
+	out := new(ListDomainReviewsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) ListFlaggedURLs(ctx context.Context, in *ListFlaggedURLsRequest, opts ...client.CallOption) (*GetUserURLsResponse, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.ListFlaggedURLs", in)
+	out := new(GetUserURLsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) SetWorkspacePlan(ctx context.Context, in *SetWorkspacePlanRequest, opts ...client.CallOption) (*WorkspaceInfo, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.SetWorkspacePlan", in)
+	out := new(WorkspaceInfo)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...client.CallOption) (*ListAuditEventsResponse, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.ListAuditEvents", in)
+	out := new(ListAuditEventsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLShortenerService) VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...client.CallOption) (*AuditVerification, error) {
+	req := c.c.NewRequest(c.name, "URLShortener.VerifyAuditLog", in)
+	out := new(AuditVerification)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for URLShortener service
 
 type URLShortenerHandler interface {
@@ -105,6 +535,46 @@ type URLShortenerHandler interface {
 	DeleteURL(context.Context, *DeleteURLRequest, *DeleteResponse) error
 	GetUserURLs(context.Context, *GetUserURLsRequest, *GetUserURLsResponse) error
 	UpdateURL(context.Context, *UpdateURLRequest, *UpdateURLResponse) error
+	UpsertWorkspace(context.Context, *UpsertWorkspaceRequest, *WorkspaceInfo) error
+	GetWorkspace(context.Context, *GetWorkspaceRequest, *WorkspaceInfo) error
+	GetLinkHealth(context.Context, *GetLinkHealthRequest, *LinkHealthInfo) error
+	GetQRCode(context.Context, *GetQRCodeRequest, *QRCodeResponse) error
+	AddBrandedDomain(context.Context, *BrandedDomainRequest, *BrandedDomain) error
+	RemoveBrandedDomain(context.Context, *BrandedDomainRequest, *DeleteResponse) error
+	ListBrandedDomains(context.Context, *ListBrandedDomainsRequest, *ListBrandedDomainsResponse) error
+	SetBrandedDomainCodePolicy(context.Context, *BrandedDomainRequest, *CodePolicyChange) error
+	SuggestAliases(context.Context, *SuggestAliasesRequest, *SuggestAliasesResponse) error
+	CreateTag(context.Context, *TagRequest, *Tag) error
+	ListTags(context.Context, *ListTagsRequest, *ListTagsResponse) error
+	RenameTag(context.Context, *TagRequest, *Tag) error
+	DeleteTag(context.Context, *TagRequest, *DeleteResponse) error
+	CreateFolder(context.Context, *FolderRequest, *Folder) error
+	ListFolders(context.Context, *ListFoldersRequest, *ListFoldersResponse) error
+	UpdateFolder(context.Context, *FolderRequest, *Folder) error
+	DeleteFolder(context.Context, *FolderRequest, *DeleteResponse) error
+	SearchURLs(context.Context, *SearchURLsRequest, *SearchURLsResponse) error
+	ListDeletedURLs(context.Context, *ListDeletedURLsRequest, *GetUserURLsResponse) error
+	RestoreURL(context.Context, *RestoreURLRequest, *URLInfo) error
+	SetInactivityPolicy(context.Context, *SetInactivityPolicyRequest, *WorkspaceInfo) error
+	GetInactivityReport(context.Context, *InactivityReportRequest, *InactivityReport) error
+	ReviveURL(context.Context, *RestoreURLRequest, *URLInfo) error
+	ListURLRevisions(context.Context, *ListURLRevisionsRequest, *ListURLRevisionsResponse) error
+	RollbackURL(context.Context, *RollbackURLRequest, *URLInfo) error
+	CreateWebhook(context.Context, *WebhookRequest, *Webhook) error
+	ListWebhooks(context.Context, *ListWebhooksRequest, *ListWebhooksResponse) error
+	UpdateWebhook(context.Context, *WebhookRequest, *Webhook) error
+	DeleteWebhook(context.Context, *WebhookRequest, *DeleteResponse) error
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest, *ListWebhookDeliveriesResponse) error
+	RedeliverWebhook(context.Context, *RedeliverWebhookRequest, *WebhookDelivery) error
+	// Admin operations
+	SetInterstitialMode(context.Context, *SetInterstitialModeRequest, *UpdateURLResponse) error
+	UpsertDomainReview(context.Context, *DomainReview, *DomainReview) error
+	DeleteDomainReview(context.Context, *DeleteDomainReviewRequest, *DeleteResponse) error
+	ListDomainReviews(context.Context, *ListDomainReviewsRequest, *ListDomainReviewsResponse) error
+	ListFlaggedURLs(context.Context, *ListFlaggedURLsRequest, *GetUserURLsResponse) error
+	SetWorkspacePlan(context.Context, *SetWorkspacePlanRequest, *WorkspaceInfo) error
+	ListAuditEvents(context.Context, *ListAuditEventsRequest, *ListAuditEventsResponse) error
+	VerifyAuditLog(context.Context, *VerifyAuditLogRequest, *AuditVerification) error
 }
 
 func RegisterURLShortenerHandler(s server.Server, hdlr URLShortenerHandler, opts ...server.HandlerOption) error {
@@ -114,6 +584,45 @@ func RegisterURLShortenerHandler(s server.Server, hdlr URLShortenerHandler, opts
 		DeleteURL(ctx context.Context, in *DeleteURLRequest, out *DeleteResponse) error
 		GetUserURLs(ctx context.Context, in *GetUserURLsRequest, out *GetUserURLsResponse) error
 		UpdateURL(ctx context.Context, in *UpdateURLRequest, out *UpdateURLResponse) error
+		UpsertWorkspace(ctx context.Context, in *UpsertWorkspaceRequest, out *WorkspaceInfo) error
+		GetWorkspace(ctx context.Context, in *GetWorkspaceRequest, out *WorkspaceInfo) error
+		GetLinkHealth(ctx context.Context, in *GetLinkHealthRequest, out *LinkHealthInfo) error
+		GetQRCode(ctx context.Context, in *GetQRCodeRequest, out *QRCodeResponse) error
+		AddBrandedDomain(ctx context.Context, in *BrandedDomainRequest, out *BrandedDomain) error
+		RemoveBrandedDomain(ctx context.Context, in *BrandedDomainRequest, out *DeleteResponse) error
+		ListBrandedDomains(ctx context.Context, in *ListBrandedDomainsRequest, out *ListBrandedDomainsResponse) error
+		SetBrandedDomainCodePolicy(ctx context.Context, in *BrandedDomainRequest, out *CodePolicyChange) error
+		SuggestAliases(ctx context.Context, in *SuggestAliasesRequest, out *SuggestAliasesResponse) error
+		CreateTag(ctx context.Context, in *TagRequest, out *Tag) error
+		ListTags(ctx context.Context, in *ListTagsRequest, out *ListTagsResponse) error
+		RenameTag(ctx context.Context, in *TagRequest, out *Tag) error
+		DeleteTag(ctx context.Context, in *TagRequest, out *DeleteResponse) error
+		CreateFolder(ctx context.Context, in *FolderRequest, out *Folder) error
+		ListFolders(ctx context.Context, in *ListFoldersRequest, out *ListFoldersResponse) error
+		UpdateFolder(ctx context.Context, in *FolderRequest, out *Folder) error
+		DeleteFolder(ctx context.Context, in *FolderRequest, out *DeleteResponse) error
+		SearchURLs(ctx context.Context, in *SearchURLsRequest, out *SearchURLsResponse) error
+		ListDeletedURLs(ctx context.Context, in *ListDeletedURLsRequest, out *GetUserURLsResponse) error
+		RestoreURL(ctx context.Context, in *RestoreURLRequest, out *URLInfo) error
+		SetInactivityPolicy(ctx context.Context, in *SetInactivityPolicyRequest, out *WorkspaceInfo) error
+		GetInactivityReport(ctx context.Context, in *InactivityReportRequest, out *InactivityReport) error
+		ReviveURL(ctx context.Context, in *RestoreURLRequest, out *URLInfo) error
+		ListURLRevisions(ctx context.Context, in *ListURLRevisionsRequest, out *ListURLRevisionsResponse) error
+		RollbackURL(ctx context.Context, in *RollbackURLRequest, out *URLInfo) error
+		CreateWebhook(ctx context.Context, in *WebhookRequest, out *Webhook) error
+		ListWebhooks(ctx context.Context, in *ListWebhooksRequest, out *ListWebhooksResponse) error
+		UpdateWebhook(ctx context.Context, in *WebhookRequest, out *Webhook) error
+		DeleteWebhook(ctx context.Context, in *WebhookRequest, out *DeleteResponse) error
+		ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, out *ListWebhookDeliveriesResponse) error
+		RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, out *WebhookDelivery) error
+		SetInterstitialMode(ctx context.Context, in *SetInterstitialModeRequest, out *UpdateURLResponse) error
+		UpsertDomainReview(ctx context.Context, in *DomainReview, out *DomainReview) error
+		DeleteDomainReview(ctx context.Context, in *DeleteDomainReviewRequest, out *DeleteResponse) error
+		ListDomainReviews(ctx context.Context, in *ListDomainReviewsRequest, out *ListDomainReviewsResponse) error
+		ListFlaggedURLs(ctx context.Context, in *ListFlaggedURLsRequest, out *GetUserURLsResponse) error
+		SetWorkspacePlan(ctx context.Context, in *SetWorkspacePlanRequest, out *WorkspaceInfo) error
+		ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, out *ListAuditEventsResponse) error
+		VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, out *AuditVerification) error
 	}
 	type URLShortener struct {
 		uRLShortener
@@ -145,3 +654,159 @@ func (h *uRLShortenerHandler) GetUserURLs(ctx context.Context, in *GetUserURLsRe
 func (h *uRLShortenerHandler) UpdateURL(ctx context.Context, in *UpdateURLRequest, out *UpdateURLResponse) error {
 	return h.URLShortenerHandler.UpdateURL(ctx, in, out)
 }
+
+func (h *uRLShortenerHandler) UpsertWorkspace(ctx context.Context, in *UpsertWorkspaceRequest, out *WorkspaceInfo) error {
+	return h.URLShortenerHandler.UpsertWorkspace(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) GetWorkspace(ctx context.Context, in *GetWorkspaceRequest, out *WorkspaceInfo) error {
+	return h.URLShortenerHandler.GetWorkspace(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) GetLinkHealth(ctx context.Context, in *GetLinkHealthRequest, out *LinkHealthInfo) error {
+	return h.URLShortenerHandler.GetLinkHealth(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) GetQRCode(ctx context.Context, in *GetQRCodeRequest, out *QRCodeResponse) error {
+	return h.URLShortenerHandler.GetQRCode(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) AddBrandedDomain(ctx context.Context, in *BrandedDomainRequest, out *BrandedDomain) error {
+	return h.URLShortenerHandler.AddBrandedDomain(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) RemoveBrandedDomain(ctx context.Context, in *BrandedDomainRequest, out *DeleteResponse) error {
+	return h.URLShortenerHandler.RemoveBrandedDomain(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) ListBrandedDomains(ctx context.Context, in *ListBrandedDomainsRequest, out *ListBrandedDomainsResponse) error {
+	return h.URLShortenerHandler.ListBrandedDomains(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) SetBrandedDomainCodePolicy(ctx context.Context, in *BrandedDomainRequest, out *CodePolicyChange) error {
+	return h.URLShortenerHandler.SetBrandedDomainCodePolicy(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) SuggestAliases(ctx context.Context, in *SuggestAliasesRequest, out *SuggestAliasesResponse) error {
+	return h.URLShortenerHandler.SuggestAliases(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) CreateTag(ctx context.Context, in *TagRequest, out *Tag) error {
+	return h.URLShortenerHandler.CreateTag(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) ListTags(ctx context.Context, in *ListTagsRequest, out *ListTagsResponse) error {
+	return h.URLShortenerHandler.ListTags(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) RenameTag(ctx context.Context, in *TagRequest, out *Tag) error {
+	return h.URLShortenerHandler.RenameTag(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) DeleteTag(ctx context.Context, in *TagRequest, out *DeleteResponse) error {
+	return h.URLShortenerHandler.DeleteTag(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) CreateFolder(ctx context.Context, in *FolderRequest, out *Folder) error {
+	return h.URLShortenerHandler.CreateFolder(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) ListFolders(ctx context.Context, in *ListFoldersRequest, out *ListFoldersResponse) error {
+	return h.URLShortenerHandler.ListFolders(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) UpdateFolder(ctx context.Context, in *FolderRequest, out *Folder) error {
+	return h.URLShortenerHandler.UpdateFolder(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) DeleteFolder(ctx context.Context, in *FolderRequest, out *DeleteResponse) error {
+	return h.URLShortenerHandler.DeleteFolder(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) SearchURLs(ctx context.Context, in *SearchURLsRequest, out *SearchURLsResponse) error {
+	return h.URLShortenerHandler.SearchURLs(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) ListDeletedURLs(ctx context.Context, in *ListDeletedURLsRequest, out *GetUserURLsResponse) error {
+	return h.URLShortenerHandler.ListDeletedURLs(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) RestoreURL(ctx context.Context, in *RestoreURLRequest, out *URLInfo) error {
+	return h.URLShortenerHandler.RestoreURL(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) SetInactivityPolicy(ctx context.Context, in *SetInactivityPolicyRequest, out *WorkspaceInfo) error {
+	return h.URLShortenerHandler.SetInactivityPolicy(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) GetInactivityReport(ctx context.Context, in *InactivityReportRequest, out *InactivityReport) error {
+	return h.URLShortenerHandler.GetInactivityReport(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) ReviveURL(ctx context.Context, in *RestoreURLRequest, out *URLInfo) error {
+	return h.URLShortenerHandler.ReviveURL(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) ListURLRevisions(ctx context.Context, in *ListURLRevisionsRequest, out *ListURLRevisionsResponse) error {
+	return h.URLShortenerHandler.ListURLRevisions(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) RollbackURL(ctx context.Context, in *RollbackURLRequest, out *URLInfo) error {
+	return h.URLShortenerHandler.RollbackURL(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) CreateWebhook(ctx context.Context, in *WebhookRequest, out *Webhook) error {
+	return h.URLShortenerHandler.CreateWebhook(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, out *ListWebhooksResponse) error {
+	return h.URLShortenerHandler.ListWebhooks(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) UpdateWebhook(ctx context.Context, in *WebhookRequest, out *Webhook) error {
+	return h.URLShortenerHandler.UpdateWebhook(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) DeleteWebhook(ctx context.Context, in *WebhookRequest, out *DeleteResponse) error {
+	return h.URLShortenerHandler.DeleteWebhook(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, out *ListWebhookDeliveriesResponse) error {
+	return h.URLShortenerHandler.ListWebhookDeliveries(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, out *WebhookDelivery) error {
+	return h.URLShortenerHandler.RedeliverWebhook(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) SetInterstitialMode(ctx context.Context, in *SetInterstitialModeRequest, out *UpdateURLResponse) error {
+	return h.URLShortenerHandler.SetInterstitialMode(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) UpsertDomainReview(ctx context.Context, in *DomainReview, out *DomainReview) error {
+	return h.URLShortenerHandler.UpsertDomainReview(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) DeleteDomainReview(ctx context.Context, in *DeleteDomainReviewRequest, out *DeleteResponse) error {
+	return h.URLShortenerHandler.DeleteDomainReview(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) ListDomainReviews(ctx context.Context, in *ListDomainReviewsRequest, out *ListDomainReviewsResponse) error {
+	return h.URLShortenerHandler.ListDomainReviews(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) ListFlaggedURLs(ctx context.Context, in *ListFlaggedURLsRequest, out *GetUserURLsResponse) error {
+	return h.URLShortenerHandler.ListFlaggedURLs(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) SetWorkspacePlan(ctx context.Context, in *SetWorkspacePlanRequest, out *WorkspaceInfo) error {
+	return h.URLShortenerHandler.SetWorkspacePlan(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, out *ListAuditEventsResponse) error {
+	return h.URLShortenerHandler.ListAuditEvents(ctx, in, out)
+}
+
+func (h *uRLShortenerHandler) VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, out *AuditVerification) error {
+	return h.URLShortenerHandler.VerifyAuditLog(ctx, in, out)
+}
@@ -0,0 +1,260 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Link alert types, the webhook events the URL shortener delivers them as
+const (
+	AlertClickThreshold = "url.click_threshold"
+	AlertTrafficSpike   = "url.traffic_spike"
+)
+
+// AlertBaselineHours is how many complete hours before the current one make
+// up a link's traffic baseline
+const AlertBaselineHours = 24
+
+// AlertRules configures when clicks raise link alerts
+type AlertRules struct {
+	// ClickThresholds are the lifetime click counts announced once per link
+	ClickThresholds []int64
+	// SpikeFactor is how many times its hourly baseline a link's clicks in the
+	// current hour must reach to be a spike; 0 turns spike alerts off
+	SpikeFactor float64
+	// SpikeMinClicks keeps quiet links from spiking on a handful of clicks
+	SpikeMinClicks int64
+	// SpikeMinHistory is how many hours a link must have been tracked before
+	// its baseline is trusted
+	SpikeMinHistory int
+	// SpikeCooldown is the minimum time between two spike alerts of a link
+	SpikeCooldown time.Duration
+}
+
+// DefaultAlertRules are the rules applied to every link
+var DefaultAlertRules = AlertRules{
+	ClickThresholds: []int64{1000},
+	SpikeFactor:     5,
+	SpikeMinClicks:  100,
+	SpikeMinHistory: 6,
+	SpikeCooldown:   time.Hour,
+}
+
+// ConfigureAlertRules replaces DefaultAlertRules
+func ConfigureAlertRules(thresholds []int64, spikeFactor float64, spikeMinClicks int64, spikeMinHistory int, spikeCooldown time.Duration) error {
+	for _, threshold := range thresholds {
+		if threshold < 1 {
+			return fmt.Errorf("click thresholds must be positive")
+		}
+	}
+	if spikeFactor != 0 && spikeFactor <= 1 {
+		return fmt.Errorf("spike factor must be greater than 1 or 0 to disable spike alerts")
+	}
+	if spikeMinClicks < 1 || spikeMinHistory < 1 || spikeMinHistory > AlertBaselineHours {
+		return fmt.Errorf("spike minimum clicks must be positive and minimum history between 1 and %d hours", AlertBaselineHours)
+	}
+	if spikeCooldown < 0 {
+		return fmt.Errorf("spike cooldown cannot be negative")
+	}
+
+	DefaultAlertRules = AlertRules{
+		ClickThresholds: thresholds,
+		SpikeFactor:     spikeFactor,
+		SpikeMinClicks:  spikeMinClicks,
+		SpikeMinHistory: spikeMinHistory,
+		SpikeCooldown:   spikeCooldown,
+	}
+	return nil
+}
+
+// ParseClickThresholds parses a comma separated list of click counts into
+// sorted, distinct thresholds. "none" turns threshold alerts off.
+func ParseClickThresholds(value string) ([]int64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || strings.EqualFold(value, "none") {
+		return nil, nil
+	}
+
+	seen := make(map[int64]bool)
+	var thresholds []int64
+	for _, part := range strings.Split(value, ",") {
+		threshold, err := strconv.ParseInt(strings.TrimSpace(part), 10, 64)
+		if err != nil || threshold < 1 {
+			return nil, fmt.Errorf("invalid click threshold %q", part)
+		}
+		if !seen[threshold] {
+			seen[threshold] = true
+			thresholds = append(thresholds, threshold)
+		}
+	}
+	sort.Slice(thresholds, func(i, j int) bool { return thresholds[i] < thresholds[j] })
+	return thresholds, nil
+}
+
+// LinkTraffic is a link's rolling click state right after a click was counted
+type LinkTraffic struct {
+	Total int64 // clicks since the link was first tracked, including this one
+	Hour  int64 // clicks in the current hour, including this one
+	// History holds the clicks of the hours before the current one, the
+	// previous hour first
+	History []int64
+	// TrackedHours is how many complete hours the link has been tracked for
+	TrackedHours int
+}
+
+// LinkAlert is an alert raised about a link
+type LinkAlert struct {
+	Domain    string
+	ShortCode string
+	Type      string
+	Data      interface{} // describes what triggered the alert
+	Timestamp time.Time
+}
+
+// AlertPublisher hands link alerts to the service that delivers them
+type AlertPublisher interface {
+	PublishLinkAlert(ctx context.Context, alert *LinkAlert) error
+}
+
+// ClickThresholdAlert describes a link passing a lifetime click count
+type ClickThresholdAlert struct {
+	Rule        string `json:"rule"`
+	Threshold   int64  `json:"threshold"`
+	TotalClicks int64  `json:"total_clicks"`
+}
+
+// TrafficSpikeAlert describes a link's hourly clicks far above its baseline
+type TrafficSpikeAlert struct {
+	Rule          string    `json:"rule"`
+	HourStart     time.Time `json:"hour_start"`
+	Clicks        int64     `json:"clicks"`         // in the current hour so far
+	Baseline      float64   `json:"baseline"`       // mean clicks per hour before it
+	BaselineHours int       `json:"baseline_hours"` // hours the baseline covers
+	Ratio         float64   `json:"ratio"`          // clicks over baseline
+}
+
+// crossedThresholds returns the thresholds passed going from previous to
+// current lifetime clicks
+func crossedThresholds(thresholds []int64, previous, current int64) []int64 {
+	var crossed []int64
+	for _, threshold := range thresholds {
+		if previous < threshold && threshold <= current {
+			crossed = append(crossed, threshold)
+		}
+	}
+	return crossed
+}
+
+// detectTrafficSpike reports whether the current hour's clicks are a spike
+// over the mean of the tracked hours before it
+func detectTrafficSpike(rules AlertRules, traffic *LinkTraffic, hourStart time.Time) (*TrafficSpikeAlert, bool) {
+	if rules.SpikeFactor == 0 || traffic.Hour < rules.SpikeMinClicks || traffic.TrackedHours < rules.SpikeMinHistory {
+		return nil, false
+	}
+
+	hours := traffic.TrackedHours
+	if hours > len(traffic.History) {
+		hours = len(traffic.History)
+	}
+	if hours == 0 {
+		return nil, false
+	}
+	var sum int64
+	for _, clicks := range traffic.History[:hours] {
+		sum += clicks
+	}
+	baseline := float64(sum) / float64(hours)
+
+	// A link without baseline traffic spikes once it reaches the minimum
+	ratio := float64(traffic.Hour) / math.Max(baseline, 1)
+	if ratio < rules.SpikeFactor {
+		return nil, false
+	}
+
+	return &TrafficSpikeAlert{
+		Rule:          "traffic_spike",
+		HourStart:     hourStart,
+		Clicks:        traffic.Hour,
+		Baseline:      math.Round(baseline*100) / 100,
+		BaselineHours: hours,
+		Ratio:         math.Round(ratio*100) / 100,
+	}, true
+}
+
+// evaluateAlerts counts a click towards its link's rolling traffic and raises
+// the alerts it triggers. Threshold alerts fire once per link and threshold;
+// spike alerts at most once per cooldown. Failures are logged, since the click
+// itself was recorded.
+func (s *AnalyticsServiceImpl) evaluateAlerts(ctx context.Context, event *ClickEvent) {
+	rules := DefaultAlertRules
+	if s.alerts == nil || (len(rules.ClickThresholds) == 0 && rules.SpikeFactor == 0) {
+		return
+	}
+
+	log := s.log.WithFields(logrus.Fields{
+		"domain":     event.Domain,
+		"short_code": event.ShortCode,
+	})
+
+	traffic, err := s.store.RecordAlertClick(ctx, event.Domain, event.ShortCode, event.Timestamp)
+	if err != nil {
+		log.WithError(err).Error("Failed to record click for alerts")
+		return
+	}
+
+	for _, threshold := range crossedThresholds(rules.ClickThresholds, traffic.Total-1, traffic.Total) {
+		s.raiseAlert(ctx, log, event, AlertClickThreshold, fmt.Sprintf("%s:%d", AlertClickThreshold, threshold), 0, &ClickThresholdAlert{
+			Rule:        "click_threshold",
+			Threshold:   threshold,
+			TotalClicks: traffic.Total,
+		})
+	}
+
+	hourStart := event.Timestamp.UTC().Truncate(time.Hour)
+	if spike, ok := detectTrafficSpike(rules, traffic, hourStart); ok {
+		s.raiseAlert(ctx, log, event, AlertTrafficSpike, AlertTrafficSpike, rules.SpikeCooldown, spike)
+	}
+}
+
+// raiseAlert publishes an alert unless the same alert (dedupKey) was raised
+// within ttl, 0 meaning ever
+func (s *AnalyticsServiceImpl) raiseAlert(ctx context.Context, log *logrus.Entry, event *ClickEvent, alertType, dedupKey string, ttl time.Duration, data interface{}) {
+	claimed, err := s.store.ClaimAlert(ctx, event.Domain, event.ShortCode, dedupKey, ttl)
+	if err != nil {
+		log.WithError(err).WithField("alert", alertType).Error("Failed to claim link alert")
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	err = s.alerts.PublishLinkAlert(ctx, &LinkAlert{
+		Domain:    event.Domain,
+		ShortCode: event.ShortCode,
+		Type:      alertType,
+		Data:      data,
+		Timestamp: time.Now(),
+	})
+	if err != nil {
+		log.WithError(err).WithField("alert", alertType).Error("Failed to publish link alert")
+		// Let the next click raise it again
+		if err := s.store.ReleaseAlert(ctx, event.Domain, event.ShortCode, dedupKey); err != nil {
+			log.WithError(err).Warn("Failed to release link alert claim")
+		}
+		return
+	}
+
+	payload, _ := json.Marshal(data)
+	log.WithFields(logrus.Fields{
+		"alert": alertType,
+		"data":  string(payload),
+	}).Info("Raised link alert")
+}
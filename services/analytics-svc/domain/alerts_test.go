@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseClickThresholds(t *testing.T) {
+	thresholds, err := ParseClickThresholds("10000, 1000,1000,100")
+	require.NoError(t, err)
+	assert.Equal(t, []int64{100, 1000, 10000}, thresholds)
+
+	thresholds, err = ParseClickThresholds("none")
+	require.NoError(t, err)
+	assert.Empty(t, thresholds)
+
+	_, err = ParseClickThresholds("1000,0")
+	assert.Error(t, err)
+	_, err = ParseClickThresholds("1k")
+	assert.Error(t, err)
+}
+
+func TestCrossedThresholds(t *testing.T) {
+	thresholds := []int64{100, 1000}
+
+	assert.Equal(t, []int64{1000}, crossedThresholds(thresholds, 999, 1000))
+	assert.Empty(t, crossedThresholds(thresholds, 1000, 1001))
+	assert.Empty(t, crossedThresholds(thresholds, 500, 501))
+	// A jump past several thresholds announces each of them
+	assert.Equal(t, []int64{100, 1000}, crossedThresholds(thresholds, 0, 1500))
+}
+
+func TestDetectTrafficSpike(t *testing.T) {
+	rules := AlertRules{SpikeFactor: 5, SpikeMinClicks: 100, SpikeMinHistory: 6, SpikeCooldown: time.Hour}
+	hour := time.Date(2026, time.January, 1, 12, 0, 0, 0, time.UTC)
+	history := make([]int64, AlertBaselineHours)
+	for i := range history {
+		history[i] = 40
+	}
+
+	spike, ok := detectTrafficSpike(rules, &LinkTraffic{Hour: 200, History: history, TrackedHours: 24}, hour)
+	require.True(t, ok)
+	assert.Equal(t, 40.0, spike.Baseline)
+	assert.Equal(t, 5.0, spike.Ratio)
+	assert.Equal(t, 24, spike.BaselineHours)
+	assert.Equal(t, hour, spike.HourStart)
+
+	// Below the factor
+	_, ok = detectTrafficSpike(rules, &LinkTraffic{Hour: 199, History: history, TrackedHours: 24}, hour)
+	assert.False(t, ok)
+
+	// The baseline only covers the hours the link was tracked
+	quiet := make([]int64, AlertBaselineHours)
+	quiet[0], quiet[1], quiet[2], quiet[3], quiet[4], quiet[5] = 10, 10, 10, 10, 10, 10
+	quiet[10] = 1000
+	spike, ok = detectTrafficSpike(rules, &LinkTraffic{Hour: 100, History: quiet, TrackedHours: 6}, hour)
+	require.True(t, ok)
+	assert.Equal(t, 10.0, spike.Baseline)
+
+	// Too little history or too few clicks
+	_, ok = detectTrafficSpike(rules, &LinkTraffic{Hour: 500, History: quiet, TrackedHours: 5}, hour)
+	assert.False(t, ok)
+	_, ok = detectTrafficSpike(rules, &LinkTraffic{Hour: 99, History: make([]int64, AlertBaselineHours), TrackedHours: 24}, hour)
+	assert.False(t, ok)
+
+	// Turned off
+	rules.SpikeFactor = 0
+	_, ok = detectTrafficSpike(rules, &LinkTraffic{Hour: 200, History: history, TrackedHours: 24}, hour)
+	assert.False(t, ok)
+}
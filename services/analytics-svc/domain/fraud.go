@@ -0,0 +1,227 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/ua-parser/uap-go/uaparser"
+)
+
+// Fraud signals, as recorded in the reasons of an assessment
+const (
+	FraudIPBurst          = "ip_burst"
+	FraudNetworkBurst     = "network_burst"
+	FraudUserAgentStorm   = "user_agent_storm"
+	FraudHostingNetwork   = "hosting_network"
+	FraudMissingUserAgent = "missing_user_agent"
+	FraudMissingLanguage  = "missing_accept_language"
+	FraudMissingAccept    = "missing_accept"
+	FraudImpossibleDevice = "impossible_device"
+)
+
+// fraudWeights is how much each signal adds to a click's fraud score
+var fraudWeights = map[string]int{
+	FraudIPBurst:          40,
+	FraudNetworkBurst:     25,
+	FraudUserAgentStorm:   25,
+	FraudHostingNetwork:   35,
+	FraudMissingUserAgent: 30,
+	FraudMissingLanguage:  15,
+	FraudMissingAccept:    10,
+	FraudImpossibleDevice: 40,
+}
+
+// MaxFraudScore is the score of a click that is certainly not a person's
+const MaxFraudScore = 100
+
+// FraudRules configures how clicks are scored
+type FraudRules struct {
+	// Window is the period the burst and storm limits count clicks over
+	Window time.Duration
+	// IPBurstLimit is how many clicks one address may make on a link per window
+	IPBurstLimit int64
+	// NetworkBurstLimit is how many clicks one network (autonomous system, or
+	// /24 and /48 when unknown) may make on a link per window
+	NetworkBurstLimit int64
+	// UserAgentStormLimit is how many clicks with the very same user agent a
+	// link may receive per window
+	UserAgentStormLimit int64
+	// SuspiciousScore is the score from which a click is flagged suspicious
+	SuspiciousScore int
+}
+
+// DefaultFraudRules are the rules every click is scored with
+var DefaultFraudRules = FraudRules{
+	Window:              time.Minute,
+	IPBurstLimit:        10,
+	NetworkBurstLimit:   100,
+	UserAgentStormLimit: 200,
+	SuspiciousScore:     50,
+}
+
+// ConfigureFraudRules replaces DefaultFraudRules
+func ConfigureFraudRules(rules FraudRules) error {
+	if rules.Window < time.Second {
+		return fmt.Errorf("fraud window must be at least a second")
+	}
+	if rules.IPBurstLimit < 1 || rules.NetworkBurstLimit < 1 || rules.UserAgentStormLimit < 1 {
+		return fmt.Errorf("fraud burst and storm limits must be positive")
+	}
+	if rules.SuspiciousScore < 1 || rules.SuspiciousScore > MaxFraudScore {
+		return fmt.Errorf("suspicious score must be between 1 and %d", MaxFraudScore)
+	}
+	DefaultFraudRules = rules
+	return nil
+}
+
+// FraudKeys identify what a click's recent clicks are counted by
+type FraudKeys struct {
+	ClientIP  string
+	Network   string
+	UserAgent string
+}
+
+// FraudCounters are the clicks on a link in the current window, including
+// the one being scored
+type FraudCounters struct {
+	IPClicks        int64
+	NetworkClicks   int64
+	UserAgentClicks int64
+}
+
+// FraudSignals are what is known about a click when it is scored
+type FraudSignals struct {
+	Counters         *FraudCounters // nil when they could not be counted
+	HostingNetwork   string         // organization of the hosting network the click came from
+	UserAgent        string
+	AcceptLanguage   string
+	Accept           string
+	ImpossibleDevice string // why the referrer and device cannot go together
+}
+
+// FraudAssessment is a click's fraud score with the signals that made it up
+type FraudAssessment struct {
+	Score      int
+	Suspicious bool
+	Reasons    []string
+}
+
+// scoreFraud combines the signals of a click into its fraud score
+func scoreFraud(rules FraudRules, signals *FraudSignals) *FraudAssessment {
+	var reasons []string
+	if counters := signals.Counters; counters != nil {
+		if counters.IPClicks > rules.IPBurstLimit {
+			reasons = append(reasons, FraudIPBurst)
+		}
+		if counters.NetworkClicks > rules.NetworkBurstLimit {
+			reasons = append(reasons, FraudNetworkBurst)
+		}
+		if counters.UserAgentClicks > rules.UserAgentStormLimit {
+			reasons = append(reasons, FraudUserAgentStorm)
+		}
+	}
+	if signals.HostingNetwork != "" {
+		reasons = append(reasons, FraudHostingNetwork)
+	}
+	if strings.TrimSpace(signals.UserAgent) == "" {
+		reasons = append(reasons, FraudMissingUserAgent)
+	}
+	if strings.TrimSpace(signals.AcceptLanguage) == "" {
+		reasons = append(reasons, FraudMissingLanguage)
+	}
+	if strings.TrimSpace(signals.Accept) == "" {
+		reasons = append(reasons, FraudMissingAccept)
+	}
+	if signals.ImpossibleDevice != "" {
+		reasons = append(reasons, FraudImpossibleDevice)
+	}
+
+	score := 0
+	for _, reason := range reasons {
+		score += fraudWeights[reason]
+	}
+	if score > MaxFraudScore {
+		score = MaxFraudScore
+	}
+
+	return &FraudAssessment{
+		Score:      score,
+		Suspicious: score >= rules.SuspiciousScore,
+		Reasons:    reasons,
+	}
+}
+
+// desktopOperatingSystems are families uap-go reports for desktop systems
+var desktopOperatingSystems = map[string]bool{
+	"Windows":   true,
+	"Mac OS X":  true,
+	"Linux":     true,
+	"Ubuntu":    true,
+	"Fedora":    true,
+	"Chrome OS": true,
+}
+
+// impossibleDevice explains why a referrer and the browser, operating system
+// and device family parsed from the user agent cannot belong to one click,
+// or returns "" when they can
+func impossibleDevice(referrer, browser, operatingSystem, device string) string {
+	referrer = strings.ToLower(strings.TrimSpace(referrer))
+	switch {
+	case strings.HasPrefix(referrer, "android-app://") && operatingSystem != "Android":
+		return fmt.Sprintf("Android app referrer on %s", operatingSystem)
+	case strings.HasPrefix(referrer, "ios-app://") && operatingSystem != "iOS":
+		return fmt.Sprintf("iOS app referrer on %s", operatingSystem)
+	case strings.Contains(browser, "Mobile") && desktopOperatingSystems[operatingSystem]:
+		return fmt.Sprintf("%s on %s", browser, operatingSystem)
+	case (device == "iPhone" || device == "iPad") && operatingSystem != "iOS" && operatingSystem != "Other":
+		return fmt.Sprintf("%s running %s", device, operatingSystem)
+	}
+	return ""
+}
+
+// assessFraud scores a click before it is saved. Counting failures only drop
+// the burst and storm signals, so clicks are never lost to Redis errors.
+func (s *AnalyticsServiceImpl) assessFraud(ctx context.Context, event *ClickEvent, client *uaparser.Client) *FraudAssessment {
+	rules := DefaultFraudRules
+	signals := &FraudSignals{
+		UserAgent:        event.UserAgent,
+		AcceptLanguage:   event.AcceptLanguage,
+		Accept:           event.Accept,
+		ImpossibleDevice: impossibleDevice(event.Referrer, client.UserAgent.Family, client.Os.Family, client.Device.Family),
+	}
+
+	keys := FraudKeys{ClientIP: event.ClientIP, UserAgent: event.UserAgent}
+	if addr, err := netip.ParseAddr(event.ClientIP); err == nil {
+		if network, ok := s.networks.Lookup(addr); ok {
+			signals.HostingNetwork = network.Org
+			if signals.HostingNetwork == "" {
+				signals.HostingNetwork = fmt.Sprintf("AS%d", network.ASN)
+			}
+		}
+		keys.Network = s.networks.GroupKey(addr)
+	}
+
+	counters, err := s.store.RecordFraudCounters(ctx, event.ShortCode, keys, rules.Window, event.Timestamp)
+	if err != nil {
+		s.log.WithError(err).WithField("short_code", event.ShortCode).Warn("Failed to count recent clicks, scoring without bursts")
+	} else {
+		signals.Counters = counters
+	}
+
+	assessment := scoreFraud(rules, signals)
+	if assessment.Suspicious {
+		s.log.WithFields(logrus.Fields{
+			"short_code":  event.ShortCode,
+			"client_ip":   event.ClientIP,
+			"fraud_score": assessment.Score,
+			"reasons":     assessment.Reasons,
+			"hosting":     signals.HostingNetwork,
+			"device":      signals.ImpossibleDevice,
+		}).Warn("Suspicious click")
+	}
+	return assessment
+}
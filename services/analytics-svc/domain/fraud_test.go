@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScoreFraud(t *testing.T) {
+	rules := DefaultFraudRules
+	browser := FraudSignals{
+		Counters:       &FraudCounters{IPClicks: 1, NetworkClicks: 3, UserAgentClicks: 2},
+		UserAgent:      "Mozilla/5.0 (Windows NT 10.0; Win64; x64)",
+		AcceptLanguage: "en-US,en;q=0.9",
+		Accept:         "text/html",
+	}
+
+	assessment := scoreFraud(rules, &browser)
+	assert.Equal(t, 0, assessment.Score)
+	assert.False(t, assessment.Suspicious)
+	assert.Empty(t, assessment.Reasons)
+
+	// A burst from one address alone is not enough
+	burst := browser
+	burst.Counters = &FraudCounters{IPClicks: 11, NetworkClicks: 11, UserAgentClicks: 11}
+	assessment = scoreFraud(rules, &burst)
+	assert.Equal(t, 40, assessment.Score)
+	assert.False(t, assessment.Suspicious)
+	assert.Equal(t, []string{FraudIPBurst}, assessment.Reasons)
+
+	// A bare client in a data center is
+	bot := FraudSignals{HostingNetwork: "Amazon"}
+	assessment = scoreFraud(rules, &bot)
+	assert.Equal(t, 90, assessment.Score)
+	assert.True(t, assessment.Suspicious)
+	assert.Equal(t, []string{FraudHostingNetwork, FraudMissingUserAgent, FraudMissingLanguage, FraudMissingAccept}, assessment.Reasons)
+
+	// Scores are capped
+	bot.Counters = &FraudCounters{IPClicks: 50, NetworkClicks: 500, UserAgentClicks: 500}
+	assessment = scoreFraud(rules, &bot)
+	assert.Equal(t, MaxFraudScore, assessment.Score)
+}
+
+func TestImpossibleDevice(t *testing.T) {
+	assert.Empty(t, impossibleDevice("android-app://com.google.android.gm", "Chrome Mobile", "Android", "Generic Smartphone"))
+	assert.Empty(t, impossibleDevice("https://example.com/", "Chrome", "Windows", "Other"))
+	assert.Empty(t, impossibleDevice("", "Mobile Safari", "iOS", "iPhone"))
+
+	assert.Equal(t, "Android app referrer on Windows", impossibleDevice("android-app://com.twitter.android", "Chrome", "Windows", "Other"))
+	assert.Equal(t, "iOS app referrer on Android", impossibleDevice("ios-app://284882215/fb", "Chrome Mobile", "Android", "Generic Smartphone"))
+	assert.Equal(t, "Chrome Mobile on Windows", impossibleDevice("", "Chrome Mobile", "Windows", "Other"))
+	assert.Equal(t, "iPhone running Android", impossibleDevice("", "Chrome Mobile", "Android", "iPhone"))
+}
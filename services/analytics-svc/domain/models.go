@@ -6,21 +6,29 @@ import (
 
 // ClickRecord represents a single click event in our domain
 type ClickRecord struct {
-	ID         int64     `json:"id" db:"id"`
-	ShortCode  string    `json:"short_code" db:"short_code"`
-	LongURL    string    `json:"long_url" db:"long_url"`
-	ClientIP   string    `json:"client_ip" db:"client_ip"`
-	UserAgent  string    `json:"user_agent" db:"user_agent"`
-	Referrer   string    `json:"referrer" db:"referrer"`
-	Country    string    `json:"country" db:"country"`
-	City       string    `json:"city" db:"city"`
-	DeviceType string    `json:"device_type" db:"device_type"`
-	Browser    string    `json:"browser" db:"browser"`
-	OS         string    `json:"os" db:"os"`
-	Timestamp  time.Time `json:"timestamp" db:"timestamp"`
-	SessionID  string    `json:"session_id" db:"session_id"`
-	IsUnique   bool      `json:"is_unique" db:"is_unique"`
-	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+	ID           int64     `json:"id" db:"id"`
+	ShortCode    string    `json:"short_code" db:"short_code"`
+	LongURL      string    `json:"long_url" db:"long_url"`
+	ClientIP     string    `json:"client_ip" db:"client_ip"`
+	UserAgent    string    `json:"user_agent" db:"user_agent"`
+	Referrer     string    `json:"referrer" db:"referrer"`
+	Country      string    `json:"country" db:"country"`
+	City         string    `json:"city" db:"city"`
+	DeviceType   string    `json:"device_type" db:"device_type"`
+	Browser      string    `json:"browser" db:"browser"`
+	OS           string    `json:"os" db:"os"`
+	Timestamp    time.Time `json:"timestamp" db:"timestamp"`
+	SessionID    string    `json:"session_id" db:"session_id"`
+	IsUnique     bool      `json:"is_unique" db:"is_unique"`
+	UTMSource    string    `json:"utm_source" db:"utm_source"`
+	UTMMedium    string    `json:"utm_medium" db:"utm_medium"`
+	UTMCampaign  string    `json:"utm_campaign" db:"utm_campaign"`
+	UTMTerm      string    `json:"utm_term" db:"utm_term"`
+	UTMContent   string    `json:"utm_content" db:"utm_content"`
+	Source       string    `json:"source" db:"source"`               // click source marker, e.g. "qr"
+	FraudScore   uint8     `json:"fraud_score" db:"fraud_score"`     // 0 to 100
+	IsSuspicious bool      `json:"is_suspicious" db:"is_suspicious"` // fraud score reached the suspicious score
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
 }
 
 // URLStats represents aggregated statistics for a URL
@@ -60,6 +68,15 @@ type ReferrerMetrics struct {
 	Percentage float64 `json:"percentage" db:"percentage"`
 }
 
+// CampaignMetrics represents click metrics grouped by the UTM values sent
+type CampaignMetrics struct {
+	UTMSource    string `json:"utm_source" db:"utm_source"`
+	UTMMedium    string `json:"utm_medium" db:"utm_medium"`
+	UTMCampaign  string `json:"utm_campaign" db:"utm_campaign"`
+	Clicks       int64  `json:"clicks" db:"clicks"`
+	UniqueClicks int64  `json:"unique_clicks" db:"unique_clicks"`
+}
+
 // TimeSeriesData represents time-based analytics data
 type TimeSeriesData struct {
 	Timestamp    time.Time `json:"timestamp" db:"timestamp"`
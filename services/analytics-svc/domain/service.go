@@ -9,32 +9,48 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/ua-parser/uap-go/uaparser"
+
+	"github.com/go-systems-lab/go-url-shortener/utils/asn"
 )
 
 // AnalyticsService interface defines the business logic operations
 type AnalyticsService interface {
 	ProcessClick(ctx context.Context, event *ClickEvent) error
-	GetURLStats(ctx context.Context, shortCode string, startTime, endTime time.Time, granularity string) (*URLStatsReport, error)
-	GetTopURLs(ctx context.Context, limit int, startTime, endTime time.Time, sortBy string) ([]*URLStats, error)
-	GetDashboard(ctx context.Context, startTime, endTime time.Time) (*DashboardMetrics, error)
+	GetURLStats(ctx context.Context, shortCode string, startTime, endTime time.Time, granularity string, excludeSuspicious bool) (*URLStatsReport, error)
+	GetTopURLs(ctx context.Context, limit int, startTime, endTime time.Time, sortBy string, excludeSuspicious bool) ([]*URLStats, error)
+	GetDashboard(ctx context.Context, startTime, endTime time.Time, excludeSuspicious bool) (*DashboardMetrics, error)
+	GetCampaignStats(ctx context.Context, shortCode string, startTime, endTime time.Time, limit int, excludeSuspicious bool) ([]*CampaignMetrics, error)
+	PurgeURL(ctx context.Context, shortCode string) error
 }
 
-// AnalyticsStore interface defines data access operations
+// AnalyticsStore interface defines data access operations. Queries taking
+// excludeSuspicious leave out the clicks flagged by fraud scoring when it is set.
 type AnalyticsStore interface {
 	SaveClick(ctx context.Context, click *ClickRecord) error
-	GetURLStats(ctx context.Context, shortCode string, startTime, endTime time.Time) (*URLStats, error)
-	GetTimeSeriesData(ctx context.Context, shortCode string, startTime, endTime time.Time, granularity string) ([]*TimeSeriesData, error)
-	GetCountryStats(ctx context.Context, shortCode string, startTime, endTime time.Time) ([]*CountryMetrics, error)
-	GetDeviceStats(ctx context.Context, shortCode string, startTime, endTime time.Time) ([]*DeviceMetrics, error)
-	GetBrowserStats(ctx context.Context, shortCode string, startTime, endTime time.Time) ([]*BrowserMetrics, error)
-	GetReferrerStats(ctx context.Context, shortCode string, startTime, endTime time.Time) ([]*ReferrerMetrics, error)
-	GetTopURLs(ctx context.Context, limit int, startTime, endTime time.Time, sortBy string) ([]*URLStats, error)
-	GetDashboardMetrics(ctx context.Context, startTime, endTime time.Time) (*DashboardMetrics, error)
+	GetURLStats(ctx context.Context, shortCode string, startTime, endTime time.Time, excludeSuspicious bool) (*URLStats, error)
+	GetTimeSeriesData(ctx context.Context, shortCode string, startTime, endTime time.Time, granularity string, excludeSuspicious bool) ([]*TimeSeriesData, error)
+	GetCountryStats(ctx context.Context, shortCode string, startTime, endTime time.Time, excludeSuspicious bool) ([]*CountryMetrics, error)
+	GetDeviceStats(ctx context.Context, shortCode string, startTime, endTime time.Time, excludeSuspicious bool) ([]*DeviceMetrics, error)
+	GetBrowserStats(ctx context.Context, shortCode string, startTime, endTime time.Time, excludeSuspicious bool) ([]*BrowserMetrics, error)
+	GetReferrerStats(ctx context.Context, shortCode string, startTime, endTime time.Time, excludeSuspicious bool) ([]*ReferrerMetrics, error)
+	GetTopURLs(ctx context.Context, limit int, startTime, endTime time.Time, sortBy string, excludeSuspicious bool) ([]*URLStats, error)
+	GetDashboardMetrics(ctx context.Context, startTime, endTime time.Time, excludeSuspicious bool) (*DashboardMetrics, error)
+	GetCampaignStats(ctx context.Context, shortCode string, startTime, endTime time.Time, limit int, excludeSuspicious bool) ([]*CampaignMetrics, error)
 	IsUniqueVisitor(ctx context.Context, shortCode, sessionID string) (bool, error)
+	DeleteClicks(ctx context.Context, shortCode string) error
+
+	// Rolling link traffic and alert deduplication
+	RecordAlertClick(ctx context.Context, shortDomain, shortCode string, at time.Time) (*LinkTraffic, error)
+	ClaimAlert(ctx context.Context, shortDomain, shortCode, key string, ttl time.Duration) (bool, error)
+	ReleaseAlert(ctx context.Context, shortDomain, shortCode, key string) error
+
+	// Recent clicks of a link by address, network and user agent
+	RecordFraudCounters(ctx context.Context, shortCode string, keys FraudKeys, window time.Duration, at time.Time) (*FraudCounters, error)
 }
 
 // ClickEvent represents an incoming click event
 type ClickEvent struct {
+	Domain    string // short domain of the link, empty for the default
 	ShortCode string
 	LongURL   string
 	ClientIP  string
@@ -42,6 +58,20 @@ type ClickEvent struct {
 	Referrer  string
 	Timestamp time.Time
 	SessionID string
+
+	// UTM values actually sent to the destination
+	UTMSource   string
+	UTMMedium   string
+	UTMCampaign string
+	UTMTerm     string
+	UTMContent  string
+
+	// Click source marker, e.g. "qr" for QR code scans
+	Source string
+
+	// Request headers browsers always send, for fraud scoring
+	AcceptLanguage string
+	Accept         string
 }
 
 // URLStatsReport represents comprehensive URL statistics
@@ -56,18 +86,27 @@ type URLStatsReport struct {
 
 // AnalyticsServiceImpl implements the AnalyticsService interface
 type AnalyticsServiceImpl struct {
-	store  AnalyticsStore
-	parser *uaparser.Parser
-	log    *logrus.Logger
+	store    AnalyticsStore
+	alerts   AlertPublisher
+	networks *asn.Table
+	parser   *uaparser.Parser
+	log      *logrus.Logger
 }
 
-// NewAnalyticsService creates a new analytics service instance
-func NewAnalyticsService(store AnalyticsStore, log *logrus.Logger) AnalyticsService {
+// NewAnalyticsService creates a new analytics service instance. Link alerts
+// are not evaluated when alerts is nil; clicks are grouped by the networks
+// table for fraud scoring, the well-known hosting networks when it is nil.
+func NewAnalyticsService(store AnalyticsStore, alerts AlertPublisher, networks *asn.Table, log *logrus.Logger) AnalyticsService {
+	if networks == nil {
+		networks = asn.DefaultHostingTable()
+	}
 	parser := uaparser.NewFromSaved()
 	return &AnalyticsServiceImpl{
-		store:  store,
-		parser: parser,
-		log:    log,
+		store:    store,
+		alerts:   alerts,
+		networks: networks,
+		parser:   parser,
+		log:      log,
 	}
 }
 
@@ -89,22 +128,33 @@ func (s *AnalyticsServiceImpl) ProcessClick(ctx context.Context, event *ClickEve
 		isUnique = false // Default to false on error
 	}
 
+	// Score the click for fraud before it is stored
+	fraud := s.assessFraud(ctx, event, client)
+
 	// Create enriched click record
 	clickRecord := &ClickRecord{
-		ShortCode:  event.ShortCode,
-		LongURL:    event.LongURL,
-		ClientIP:   event.ClientIP,
-		UserAgent:  event.UserAgent,
-		Referrer:   event.Referrer,
-		Country:    s.getCountryFromIP(event.ClientIP),
-		City:       s.getCityFromIP(event.ClientIP),
-		DeviceType: s.getDeviceType(client),
-		Browser:    fmt.Sprintf("%s %s", client.UserAgent.Family, client.UserAgent.Major),
-		OS:         fmt.Sprintf("%s %s", client.Os.Family, client.Os.Major),
-		Timestamp:  event.Timestamp,
-		SessionID:  event.SessionID,
-		IsUnique:   isUnique,
-		CreatedAt:  time.Now(),
+		ShortCode:    event.ShortCode,
+		LongURL:      event.LongURL,
+		ClientIP:     event.ClientIP,
+		UserAgent:    event.UserAgent,
+		Referrer:     event.Referrer,
+		Country:      s.getCountryFromIP(event.ClientIP),
+		City:         s.getCityFromIP(event.ClientIP),
+		DeviceType:   s.getDeviceType(client),
+		Browser:      fmt.Sprintf("%s %s", client.UserAgent.Family, client.UserAgent.Major),
+		OS:           fmt.Sprintf("%s %s", client.Os.Family, client.Os.Major),
+		Timestamp:    event.Timestamp,
+		SessionID:    event.SessionID,
+		IsUnique:     isUnique,
+		UTMSource:    event.UTMSource,
+		UTMMedium:    event.UTMMedium,
+		UTMCampaign:  event.UTMCampaign,
+		UTMTerm:      event.UTMTerm,
+		UTMContent:   event.UTMContent,
+		Source:       event.Source,
+		FraudScore:   uint8(fraud.Score),
+		IsSuspicious: fraud.Suspicious,
+		CreatedAt:    time.Now(),
 	}
 
 	// Save the click record
@@ -113,9 +163,12 @@ func (s *AnalyticsServiceImpl) ProcessClick(ctx context.Context, event *ClickEve
 		return fmt.Errorf("failed to save click: %w", err)
 	}
 
+	s.evaluateAlerts(ctx, event)
+
 	s.log.WithFields(logrus.Fields{
 		"short_code": event.ShortCode,
 		"is_unique":  isUnique,
+		"suspicious": fraud.Suspicious,
 		"country":    clickRecord.Country,
 		"device":     clickRecord.DeviceType,
 	}).Info("Click processed successfully")
@@ -124,46 +177,47 @@ func (s *AnalyticsServiceImpl) ProcessClick(ctx context.Context, event *ClickEve
 }
 
 // GetURLStats retrieves comprehensive statistics for a URL
-func (s *AnalyticsServiceImpl) GetURLStats(ctx context.Context, shortCode string, startTime, endTime time.Time, granularity string) (*URLStatsReport, error) {
+func (s *AnalyticsServiceImpl) GetURLStats(ctx context.Context, shortCode string, startTime, endTime time.Time, granularity string, excludeSuspicious bool) (*URLStatsReport, error) {
 	s.log.WithFields(logrus.Fields{
-		"short_code":  shortCode,
-		"start_time":  startTime,
-		"end_time":    endTime,
-		"granularity": granularity,
+		"short_code":         shortCode,
+		"start_time":         startTime,
+		"end_time":           endTime,
+		"granularity":        granularity,
+		"exclude_suspicious": excludeSuspicious,
 	}).Info("Getting URL statistics")
 
 	// Get basic URL stats
-	urlStats, err := s.store.GetURLStats(ctx, shortCode, startTime, endTime)
+	urlStats, err := s.store.GetURLStats(ctx, shortCode, startTime, endTime, excludeSuspicious)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get URL stats: %w", err)
 	}
 
 	// Get time series data
-	timeSeries, err := s.store.GetTimeSeriesData(ctx, shortCode, startTime, endTime, granularity)
+	timeSeries, err := s.store.GetTimeSeriesData(ctx, shortCode, startTime, endTime, granularity, excludeSuspicious)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get time series data: %w", err)
 	}
 
 	// Get country stats
-	countryStats, err := s.store.GetCountryStats(ctx, shortCode, startTime, endTime)
+	countryStats, err := s.store.GetCountryStats(ctx, shortCode, startTime, endTime, excludeSuspicious)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get country stats: %w", err)
 	}
 
 	// Get device stats
-	deviceStats, err := s.store.GetDeviceStats(ctx, shortCode, startTime, endTime)
+	deviceStats, err := s.store.GetDeviceStats(ctx, shortCode, startTime, endTime, excludeSuspicious)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get device stats: %w", err)
 	}
 
 	// Get browser stats
-	browserStats, err := s.store.GetBrowserStats(ctx, shortCode, startTime, endTime)
+	browserStats, err := s.store.GetBrowserStats(ctx, shortCode, startTime, endTime, excludeSuspicious)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get browser stats: %w", err)
 	}
 
 	// Get referrer stats
-	referrerStats, err := s.store.GetReferrerStats(ctx, shortCode, startTime, endTime)
+	referrerStats, err := s.store.GetReferrerStats(ctx, shortCode, startTime, endTime, excludeSuspicious)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get referrer stats: %w", err)
 	}
@@ -179,25 +233,50 @@ func (s *AnalyticsServiceImpl) GetURLStats(ctx context.Context, shortCode string
 }
 
 // GetTopURLs retrieves the top performing URLs
-func (s *AnalyticsServiceImpl) GetTopURLs(ctx context.Context, limit int, startTime, endTime time.Time, sortBy string) ([]*URLStats, error) {
+func (s *AnalyticsServiceImpl) GetTopURLs(ctx context.Context, limit int, startTime, endTime time.Time, sortBy string, excludeSuspicious bool) ([]*URLStats, error) {
 	s.log.WithFields(logrus.Fields{
-		"limit":      limit,
-		"start_time": startTime,
-		"end_time":   endTime,
-		"sort_by":    sortBy,
+		"limit":              limit,
+		"start_time":         startTime,
+		"end_time":           endTime,
+		"sort_by":            sortBy,
+		"exclude_suspicious": excludeSuspicious,
 	}).Info("Getting top URLs")
 
-	return s.store.GetTopURLs(ctx, limit, startTime, endTime, sortBy)
+	return s.store.GetTopURLs(ctx, limit, startTime, endTime, sortBy, excludeSuspicious)
 }
 
 // GetDashboard retrieves comprehensive dashboard metrics
-func (s *AnalyticsServiceImpl) GetDashboard(ctx context.Context, startTime, endTime time.Time) (*DashboardMetrics, error) {
+func (s *AnalyticsServiceImpl) GetDashboard(ctx context.Context, startTime, endTime time.Time, excludeSuspicious bool) (*DashboardMetrics, error) {
 	s.log.WithFields(logrus.Fields{
-		"start_time": startTime,
-		"end_time":   endTime,
+		"start_time":         startTime,
+		"end_time":           endTime,
+		"exclude_suspicious": excludeSuspicious,
 	}).Info("Getting dashboard metrics")
 
-	return s.store.GetDashboardMetrics(ctx, startTime, endTime)
+	return s.store.GetDashboardMetrics(ctx, startTime, endTime, excludeSuspicious)
+}
+
+// GetCampaignStats retrieves click metrics grouped by UTM source, medium and campaign
+func (s *AnalyticsServiceImpl) GetCampaignStats(ctx context.Context, shortCode string, startTime, endTime time.Time, limit int, excludeSuspicious bool) ([]*CampaignMetrics, error) {
+	s.log.WithFields(logrus.Fields{
+		"short_code":         shortCode,
+		"start_time":         startTime,
+		"end_time":           endTime,
+		"limit":              limit,
+		"exclude_suspicious": excludeSuspicious,
+	}).Info("Getting campaign statistics")
+
+	return s.store.GetCampaignStats(ctx, shortCode, startTime, endTime, limit, excludeSuspicious)
+}
+
+// PurgeURL deletes the click analytics of a link purged from the URL shortener's trash
+func (s *AnalyticsServiceImpl) PurgeURL(ctx context.Context, shortCode string) error {
+	s.log.WithField("short_code", shortCode).Info("Purging URL analytics")
+
+	if shortCode == "" {
+		return fmt.Errorf("short code is required")
+	}
+	return s.store.DeleteClicks(ctx, shortCode)
 }
 
 // Helper methods for data enrichment
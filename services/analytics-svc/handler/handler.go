@@ -47,6 +47,17 @@ func (h *AnalyticsHandler) ProcessClick(ctx context.Context, req *pb.ClickEvent,
 		Referrer:  req.Referrer,
 		Timestamp: timestamp,
 		SessionID: req.SessionId,
+
+		UTMSource:   req.UtmSource,
+		UTMMedium:   req.UtmMedium,
+		UTMCampaign: req.UtmCampaign,
+		UTMTerm:     req.UtmTerm,
+		UTMContent:  req.UtmContent,
+
+		Source: req.Source,
+
+		AcceptLanguage: req.AcceptLanguage,
+		Accept:         req.Accept,
 	}
 
 	// Process the click event
@@ -87,7 +98,7 @@ func (h *AnalyticsHandler) GetURLStats(ctx context.Context, req *pb.StatsRequest
 	}
 
 	// Get comprehensive URL stats
-	statsReport, err := h.service.GetURLStats(ctx, req.ShortCode, startTime, endTime, granularity)
+	statsReport, err := h.service.GetURLStats(ctx, req.ShortCode, startTime, endTime, granularity, req.ExcludeSuspicious)
 	if err != nil {
 		h.log.WithError(err).Error("Failed to get URL statistics")
 		return err
@@ -147,7 +158,7 @@ func (h *AnalyticsHandler) GetTopURLs(ctx context.Context, req *pb.TopURLsReques
 	}
 
 	// Get top URLs
-	topURLs, err := h.service.GetTopURLs(ctx, limit, startTime, endTime, sortBy)
+	topURLs, err := h.service.GetTopURLs(ctx, limit, startTime, endTime, sortBy, req.ExcludeSuspicious)
 	if err != nil {
 		h.log.WithError(err).Error("Failed to get top URLs")
 		return err
@@ -194,7 +205,7 @@ func (h *AnalyticsHandler) GetDashboard(ctx context.Context, req *pb.DashboardRe
 	}).Info("Calling store.GetDashboardMetrics")
 
 	// Get dashboard metrics
-	dashboard, err := h.service.GetDashboard(ctx, startTime, endTime)
+	dashboard, err := h.service.GetDashboard(ctx, startTime, endTime, req.ExcludeSuspicious)
 	if err != nil {
 		h.log.WithError(err).Error("Failed to get dashboard metrics")
 		return err
@@ -226,6 +237,51 @@ func (h *AnalyticsHandler) GetDashboard(ctx context.Context, req *pb.DashboardRe
 	return nil
 }
 
+// GetCampaignStats retrieves click statistics grouped by UTM source, medium and campaign
+func (h *AnalyticsHandler) GetCampaignStats(ctx context.Context, req *pb.CampaignStatsRequest, rsp *pb.CampaignStatsResponse) error {
+	h.log.WithFields(logrus.Fields{
+		"short_code": req.ShortCode,
+		"limit":      req.Limit,
+	}).Info("Getting campaign statistics")
+
+	// Parse time range
+	startTime := time.Now().AddDate(0, 0, -30) // Default to last 30 days
+	endTime := time.Now()
+
+	if req.StartTime > 0 {
+		startTime = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime > 0 {
+		endTime = time.Unix(req.EndTime, 0)
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 20 // Default limit
+	}
+
+	campaigns, err := h.service.GetCampaignStats(ctx, req.ShortCode, startTime, endTime, limit, req.ExcludeSuspicious)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to get campaign statistics")
+		return err
+	}
+
+	// Convert to protobuf response
+	for _, campaign := range campaigns {
+		rsp.Campaigns = append(rsp.Campaigns, &pb.CampaignStats{
+			UtmSource:    campaign.UTMSource,
+			UtmMedium:    campaign.UTMMedium,
+			UtmCampaign:  campaign.UTMCampaign,
+			Clicks:       campaign.Clicks,
+			UniqueClicks: campaign.UniqueClicks,
+		})
+	}
+
+	h.log.WithField("count", len(campaigns)).Info("Campaign statistics retrieved successfully")
+
+	return nil
+}
+
 // Health returns the service health status
 func (h *AnalyticsHandler) Health(ctx context.Context, req *pb.HealthRequest, rsp *pb.HealthResponse) error {
 	rsp.Status = "OK"
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/ClickHouse/clickhouse-go/v2"
@@ -16,16 +17,23 @@ import (
 	"github.com/sirupsen/logrus"
 	"go-micro.dev/v5"
 	"go-micro.dev/v5/broker"
+	"go-micro.dev/v5/client"
 
 	"encoding/base64"
 
 	pb "github.com/go-systems-lab/go-url-shortener/proto/analytics"
+	urlpb "github.com/go-systems-lab/go-url-shortener/proto/url"
 	"github.com/go-systems-lab/go-url-shortener/services/analytics-svc/domain"
 	"github.com/go-systems-lab/go-url-shortener/services/analytics-svc/handler"
 	"github.com/go-systems-lab/go-url-shortener/services/analytics-svc/store"
+	"github.com/go-systems-lab/go-url-shortener/utils/asn"
 	"github.com/go-systems-lab/go-url-shortener/utils/tracing"
 )
 
+// linkAlertEventTopic is where link alerts are published for the URL
+// shortener to deliver to the webhooks of the links' workspaces
+const linkAlertEventTopic = "url.alert"
+
 // Microservice represents the analytics microservice
 type Microservice struct {
 	service          micro.Service
@@ -75,14 +83,8 @@ func Init(opts *ClientOptions) (*Microservice, error) {
 		return nil, fmt.Errorf("failed to initialize Redis: %w", err)
 	}
 
-	// Create ClickHouse analytics store (following HLD architecture)
-	analyticsStore := store.NewClickHouseStore(clickhouseConn, redisClient, logger)
-
-	// Create analytics service (business logic)
-	analyticsService := domain.NewAnalyticsService(analyticsStore, logger)
-
-	// Create analytics handler
-	analyticsHandler := handler.NewAnalyticsHandler(analyticsService, logger)
+	configureAlertRules(logger)
+	networks := configureFraudRules(logger)
 
 	// Create Go Micro service with NATS plugins (production-ready configuration)
 	service := micro.NewService(
@@ -102,6 +104,15 @@ func Init(opts *ClientOptions) (*Microservice, error) {
 		}),
 	)
 
+	// Create ClickHouse analytics store (following HLD architecture)
+	analyticsStore := store.NewClickHouseStore(clickhouseConn, redisClient, logger)
+
+	// Create analytics service (business logic)
+	analyticsService := domain.NewAnalyticsService(analyticsStore, &linkAlertPublisher{client: service.Client()}, networks, logger)
+
+	// Create analytics handler
+	analyticsHandler := handler.NewAnalyticsHandler(analyticsService, logger)
+
 	// Register analytics service handler
 	if err := pb.RegisterAnalyticsServiceHandler(service.Server(), analyticsHandler); err != nil {
 		return nil, fmt.Errorf("failed to register analytics handler: %w", err)
@@ -125,7 +136,7 @@ func (m *Microservice) Run() error {
 	// Initialize service (this will start the server and connect broker)
 	m.service.Init()
 
-	// Subscribe to click events in a goroutine after service starts
+	// Subscribe to click and purge events in a goroutine after service starts
 	go func() {
 		// Wait a moment for the service to fully start
 		time.Sleep(2 * time.Second)
@@ -133,6 +144,9 @@ func (m *Microservice) Run() error {
 		if err := subscribeToClickEvents(m.service, m.analyticsService, m.log); err != nil {
 			m.log.WithError(err).Error("Failed to subscribe to click events")
 		}
+		if err := subscribeToPurgeEvents(m.service, m.analyticsService, m.log); err != nil {
+			m.log.WithError(err).Error("Failed to subscribe to purge events")
+		}
 	}()
 
 	// Run the service
@@ -274,6 +288,14 @@ func createClickHouseSchema(conn clickhouse.Conn, log *logrus.Logger) error {
 		timestamp DateTime64(3),
 		session_id String,
 		is_unique UInt8,
+		utm_source String DEFAULT '',
+		utm_medium String DEFAULT '',
+		utm_campaign String DEFAULT '',
+		utm_term String DEFAULT '',
+		utm_content String DEFAULT '',
+		source LowCardinality(String) DEFAULT '',
+		fraud_score UInt8 DEFAULT 0,
+		is_suspicious UInt8 DEFAULT 0,
 		created_at DateTime64(3) DEFAULT now()
 	) ENGINE = MergeTree()
 	PARTITION BY toYYYYMM(timestamp)
@@ -286,6 +308,27 @@ func createClickHouseSchema(conn clickhouse.Conn, log *logrus.Logger) error {
 		return fmt.Errorf("failed to create analytics table: %w", err)
 	}
 
+	// Add UTM columns to tables created before campaign tracking existed
+	for _, column := range []string{"utm_source", "utm_medium", "utm_campaign", "utm_term", "utm_content"} {
+		alterQuery := fmt.Sprintf("ALTER TABLE click_analytics ADD COLUMN IF NOT EXISTS %s String DEFAULT ''", column)
+		if err := conn.Exec(context.Background(), alterQuery); err != nil {
+			return fmt.Errorf("failed to add %s column: %w", column, err)
+		}
+	}
+
+	// Add the click source column to tables created before QR code tracking existed
+	if err := conn.Exec(context.Background(), "ALTER TABLE click_analytics ADD COLUMN IF NOT EXISTS source LowCardinality(String) DEFAULT ''"); err != nil {
+		return fmt.Errorf("failed to add source column: %w", err)
+	}
+
+	// Add the fraud scoring columns to tables created before clicks were scored
+	for _, column := range []string{"fraud_score", "is_suspicious"} {
+		alterQuery := fmt.Sprintf("ALTER TABLE click_analytics ADD COLUMN IF NOT EXISTS %s UInt8 DEFAULT 0", column)
+		if err := conn.Exec(context.Background(), alterQuery); err != nil {
+			return fmt.Errorf("failed to add %s column: %w", column, err)
+		}
+	}
+
 	// Create materialized views for real-time aggregations (production optimization)
 	createAggregateViews := `
 	CREATE MATERIALIZED VIEW IF NOT EXISTS click_analytics_hourly_mv
@@ -332,28 +375,10 @@ func subscribeToClickEvents(service micro.Service, analyticsService domain.Analy
 		}).Debug("Received click event")
 
 		// Get message body
-		messageBody := event.Message().Body
-
-		// Check if the message is base64 encoded (Go Micro broker behavior)
-		var jsonData []byte
-		if len(messageBody) > 0 && messageBody[0] == '"' {
-			// Message is JSON-encoded string, need to unmarshal and then base64 decode
-			var encodedString string
-			if err := json.Unmarshal(messageBody, &encodedString); err != nil {
-				log.WithError(err).WithField("body", string(messageBody)).Error("Failed to unmarshal encoded string")
-				return nil
-			}
-
-			// Decode base64
-			decoded, err := base64.StdEncoding.DecodeString(encodedString)
-			if err != nil {
-				log.WithError(err).WithField("encoded", encodedString).Error("Failed to decode base64")
-				return nil
-			}
-			jsonData = decoded
-		} else {
-			// Message is already JSON
-			jsonData = messageBody
+		jsonData, err := decodeEventBody(event.Message().Body)
+		if err != nil {
+			log.WithError(err).WithField("body", string(event.Message().Body)).Error("Failed to decode click event")
+			return nil
 		}
 
 		// Parse click event from JSON
@@ -365,6 +390,7 @@ func subscribeToClickEvents(service micro.Service, analyticsService domain.Analy
 
 		// Create domain click event with safe type conversions
 		clickEvent := &domain.ClickEvent{
+			Domain:    getStringFromMap(clickData, "domain"),
 			ShortCode: getStringFromMap(clickData, "short_code"),
 			LongURL:   getStringFromMap(clickData, "long_url"),
 			ClientIP:  getStringFromMap(clickData, "client_ip"),
@@ -372,6 +398,17 @@ func subscribeToClickEvents(service micro.Service, analyticsService domain.Analy
 			Referrer:  getStringFromMap(clickData, "referrer"),
 			SessionID: getStringFromMap(clickData, "session_id"),
 			Timestamp: time.Now(),
+
+			UTMSource:   getStringFromMap(clickData, "utm_source"),
+			UTMMedium:   getStringFromMap(clickData, "utm_medium"),
+			UTMCampaign: getStringFromMap(clickData, "utm_campaign"),
+			UTMTerm:     getStringFromMap(clickData, "utm_term"),
+			UTMContent:  getStringFromMap(clickData, "utm_content"),
+
+			Source: getStringFromMap(clickData, "source"),
+
+			AcceptLanguage: getStringFromMap(clickData, "accept_language"),
+			Accept:         getStringFromMap(clickData, "accept"),
 		}
 
 		// Extract timestamp if provided
@@ -409,6 +446,203 @@ func subscribeToClickEvents(service micro.Service, analyticsService domain.Analy
 	return nil
 }
 
+// subscribeToPurgeEvents deletes the analytics of links purged from the URL
+// shortener's trash when the trash policy asks for it
+func subscribeToPurgeEvents(service micro.Service, analyticsService domain.AnalyticsService, log *logrus.Logger) error {
+	_, err := service.Options().Broker.Subscribe("url.purged", func(event broker.Event) error {
+		jsonData, err := decodeEventBody(event.Message().Body)
+		if err != nil {
+			log.WithError(err).WithField("body", string(event.Message().Body)).Error("Failed to decode purge event")
+			return nil
+		}
+
+		var purgeData map[string]interface{}
+		if err := json.Unmarshal(jsonData, &purgeData); err != nil {
+			log.WithError(err).WithField("body", string(jsonData)).Error("Failed to parse purge event JSON")
+			return nil // Don't return error to avoid reprocessing
+		}
+
+		shortCode := getStringFromMap(purgeData, "short_code")
+		if deleteAnalytics, _ := purgeData["delete_analytics"].(bool); !deleteAnalytics {
+			log.WithField("short_code", shortCode).Debug("Keeping analytics of purged URL")
+			return nil
+		}
+
+		if err := analyticsService.PurgeURL(context.Background(), shortCode); err != nil {
+			log.WithError(err).WithField("short_code", shortCode).Error("Failed to purge URL analytics")
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to purge events: %w", err)
+	}
+
+	log.Info("Successfully subscribed to purge events")
+	return nil
+}
+
+// configureAlertRules applies the link alert rules: CLICK_ALERT_THRESHOLDS
+// (comma separated lifetime click counts, or none), TRAFFIC_SPIKE_FACTOR (how
+// many times its hourly baseline a link's clicks in an hour must reach, 0 to
+// turn spike alerts off), TRAFFIC_SPIKE_MIN_CLICKS, TRAFFIC_SPIKE_MIN_HISTORY
+// (hours a link must have been tracked) and TRAFFIC_SPIKE_COOLDOWN
+func configureAlertRules(log *logrus.Logger) {
+	rules := domain.DefaultAlertRules
+
+	thresholds := rules.ClickThresholds
+	if value := os.Getenv("CLICK_ALERT_THRESHOLDS"); value != "" {
+		parsed, err := domain.ParseClickThresholds(value)
+		if err != nil {
+			log.WithError(err).Fatal("Invalid CLICK_ALERT_THRESHOLDS")
+		}
+		thresholds = parsed
+	}
+
+	spikeFactor := rules.SpikeFactor
+	if value := os.Getenv("TRAFFIC_SPIKE_FACTOR"); value != "" {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			log.WithError(err).Fatal("Invalid TRAFFIC_SPIKE_FACTOR")
+		}
+		spikeFactor = parsed
+	}
+
+	err := domain.ConfigureAlertRules(
+		thresholds,
+		spikeFactor,
+		int64(envInt(log, "TRAFFIC_SPIKE_MIN_CLICKS", int(rules.SpikeMinClicks))),
+		envInt(log, "TRAFFIC_SPIKE_MIN_HISTORY", rules.SpikeMinHistory),
+		envDuration(log, "TRAFFIC_SPIKE_COOLDOWN", rules.SpikeCooldown),
+	)
+	if err != nil {
+		log.WithError(err).Fatal("Invalid link alert rules")
+	}
+	log.WithFields(logrus.Fields{
+		"click_thresholds":  domain.DefaultAlertRules.ClickThresholds,
+		"spike_factor":      domain.DefaultAlertRules.SpikeFactor,
+		"spike_min_clicks":  domain.DefaultAlertRules.SpikeMinClicks,
+		"spike_min_history": domain.DefaultAlertRules.SpikeMinHistory,
+		"spike_cooldown":    domain.DefaultAlertRules.SpikeCooldown,
+	}).Info("Link alert rules configured")
+}
+
+// configureFraudRules applies the click fraud scoring rules: FRAUD_WINDOW (the
+// period bursts are counted over), FRAUD_IP_BURST_LIMIT,
+// FRAUD_NETWORK_BURST_LIMIT and FRAUD_USER_AGENT_STORM_LIMIT (clicks per link
+// and window) and FRAUD_SUSPICIOUS_SCORE. It returns the hosting networks
+// clicks are matched against, extended with FRAUD_HOSTING_NETWORKS_FILE.
+func configureFraudRules(log *logrus.Logger) *asn.Table {
+	rules := domain.DefaultFraudRules
+	err := domain.ConfigureFraudRules(domain.FraudRules{
+		Window:              envDuration(log, "FRAUD_WINDOW", rules.Window),
+		IPBurstLimit:        int64(envInt(log, "FRAUD_IP_BURST_LIMIT", int(rules.IPBurstLimit))),
+		NetworkBurstLimit:   int64(envInt(log, "FRAUD_NETWORK_BURST_LIMIT", int(rules.NetworkBurstLimit))),
+		UserAgentStormLimit: int64(envInt(log, "FRAUD_USER_AGENT_STORM_LIMIT", int(rules.UserAgentStormLimit))),
+		SuspiciousScore:     envInt(log, "FRAUD_SUSPICIOUS_SCORE", rules.SuspiciousScore),
+	})
+	if err != nil {
+		log.WithError(err).Fatal("Invalid fraud scoring rules")
+	}
+
+	var extra []asn.Network
+	if path := os.Getenv("FRAUD_HOSTING_NETWORKS_FILE"); path != "" {
+		extra, err = asn.LoadFile(path)
+		if err != nil {
+			log.WithError(err).Fatal("Invalid FRAUD_HOSTING_NETWORKS_FILE")
+		}
+	}
+	networks := asn.DefaultHostingTable(extra...)
+
+	log.WithFields(logrus.Fields{
+		"window":                 domain.DefaultFraudRules.Window,
+		"ip_burst_limit":         domain.DefaultFraudRules.IPBurstLimit,
+		"network_burst_limit":    domain.DefaultFraudRules.NetworkBurstLimit,
+		"user_agent_storm_limit": domain.DefaultFraudRules.UserAgentStormLimit,
+		"suspicious_score":       domain.DefaultFraudRules.SuspiciousScore,
+		"hosting_networks":       networks.Len(),
+	}).Info("Fraud scoring rules configured")
+	return networks
+}
+
+// linkAlertPublisher publishes link alerts on the url.alert topic
+type linkAlertPublisher struct {
+	client client.Client
+}
+
+// PublishLinkAlert implements domain.AlertPublisher
+func (p *linkAlertPublisher) PublishLinkAlert(ctx context.Context, alert *domain.LinkAlert) error {
+	data, err := json.Marshal(alert.Data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal alert data: %w", err)
+	}
+
+	eventData, err := json.Marshal(&urlpb.LinkAlertEvent{
+		Domain:    alert.Domain,
+		ShortCode: alert.ShortCode,
+		Event:     alert.Type,
+		Data:      string(data),
+		Timestamp: alert.Timestamp.Unix(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal link alert event: %w", err)
+	}
+
+	message := p.client.NewMessage(linkAlertEventTopic, eventData)
+	if err := p.client.Publish(ctx, message); err != nil {
+		return fmt.Errorf("failed to publish link alert event: %w", err)
+	}
+	return nil
+}
+
+// envDuration reads a Go duration from the environment, falling back to def
+func envDuration(log *logrus.Logger, name string, def time.Duration) time.Duration {
+	value := os.Getenv(name)
+	if value == "" {
+		return def
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		log.WithError(err).Warnf("Invalid %s, using default", name)
+		return def
+	}
+	return duration
+}
+
+// envInt reads an integer from the environment, falling back to def
+func envInt(log *logrus.Logger, name string, def int) int {
+	value := os.Getenv(name)
+	if value == "" {
+		return def
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		log.WithError(err).Warnf("Invalid %s, using default", name)
+		return def
+	}
+	return number
+}
+
+// decodeEventBody returns the JSON of a broker message. Go Micro may deliver
+// the published bytes as a JSON string holding their base64 encoding.
+func decodeEventBody(messageBody []byte) ([]byte, error) {
+	if len(messageBody) == 0 || messageBody[0] != '"' {
+		// Message is already JSON
+		return messageBody, nil
+	}
+
+	var encodedString string
+	if err := json.Unmarshal(messageBody, &encodedString); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal encoded string: %w", err)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encodedString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64: %w", err)
+	}
+	return decoded, nil
+}
+
 // Helper function to safely extract string values from map
 func getStringFromMap(data map[string]interface{}, key string) string {
 	if val, exists := data[key]; exists {
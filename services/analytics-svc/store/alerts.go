@@ -0,0 +1,112 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/go-systems-lab/go-url-shortener/services/analytics-svc/domain"
+)
+
+// Hourly click buckets outlive the baseline window by an hour so the oldest
+// baseline hour is still there at the end of the current one
+const alertBucketTTL = (domain.AlertBaselineHours + 2) * time.Hour
+
+// alertKey builds the Redis key of a link's alert state. Keys start with the
+// short code so purging a code's analytics can find them.
+func alertKey(shortDomain, shortCode, suffix string) string {
+	return fmt.Sprintf("alert:%s:%s:%s", shortCode, shortDomain, suffix)
+}
+
+// recordAlertClick counts a click in a link's total and hourly bucket and
+// reads the hours before it. The total starts from 0 the first time a link is
+// seen: stored clicks are not split by short domain, so they cannot tell a
+// branded link apart from a default-domain link with the same code.
+func recordAlertClick(ctx context.Context, client *redis.Client, shortDomain, shortCode string, at time.Time) (*domain.LinkTraffic, error) {
+	hour := at.UTC().Truncate(time.Hour).Unix()
+	totalKey := alertKey(shortDomain, shortCode, "total")
+	sinceKey := alertKey(shortDomain, shortCode, "since")
+
+	historyKeys := make([]string, domain.AlertBaselineHours)
+	for i := range historyKeys {
+		historyKeys[i] = alertKey(shortDomain, shortCode, "hour:"+strconv.FormatInt(hour-int64(i+1)*3600, 10))
+	}
+	bucketKey := alertKey(shortDomain, shortCode, "hour:"+strconv.FormatInt(hour, 10))
+
+	pipe := client.TxPipeline()
+	total := pipe.Incr(ctx, totalKey)
+	bucket := pipe.Incr(ctx, bucketKey)
+	pipe.Expire(ctx, bucketKey, alertBucketTTL)
+	pipe.SetNX(ctx, sinceKey, hour, 0)
+	since := pipe.Get(ctx, sinceKey)
+	history := pipe.MGet(ctx, historyKeys...)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to record click traffic: %w", err)
+	}
+
+	traffic := &domain.LinkTraffic{
+		Total:   total.Val(),
+		Hour:    bucket.Val(),
+		History: make([]int64, len(historyKeys)),
+	}
+	for i, value := range history.Val() {
+		if str, ok := value.(string); ok {
+			traffic.History[i], _ = strconv.ParseInt(str, 10, 64)
+		}
+	}
+	if first, err := since.Int64(); err == nil && first < hour {
+		traffic.TrackedHours = int((hour - first) / 3600)
+	}
+	return traffic, nil
+}
+
+// claimAlert marks an alert raised, returning false if it already was within
+// ttl (0 for ever)
+func claimAlert(ctx context.Context, client *redis.Client, shortDomain, shortCode, key string, ttl time.Duration) (bool, error) {
+	claimed, err := client.SetNX(ctx, alertKey(shortDomain, shortCode, "fired:"+key), time.Now().Unix(), ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to claim alert: %w", err)
+	}
+	return claimed, nil
+}
+
+// releaseAlert forgets an alert claim so the alert can be raised again
+func releaseAlert(ctx context.Context, client *redis.Client, shortDomain, shortCode, key string) error {
+	if err := client.Del(ctx, alertKey(shortDomain, shortCode, "fired:"+key)).Err(); err != nil {
+		return fmt.Errorf("failed to release alert: %w", err)
+	}
+	return nil
+}
+
+// RecordAlertClick counts a click towards a link's rolling traffic
+func (s *ClickHouseStoreImpl) RecordAlertClick(ctx context.Context, shortDomain, shortCode string, at time.Time) (*domain.LinkTraffic, error) {
+	return recordAlertClick(ctx, s.redis, shortDomain, shortCode, at)
+}
+
+// ClaimAlert marks a link alert raised for ttl
+func (s *ClickHouseStoreImpl) ClaimAlert(ctx context.Context, shortDomain, shortCode, key string, ttl time.Duration) (bool, error) {
+	return claimAlert(ctx, s.redis, shortDomain, shortCode, key, ttl)
+}
+
+// ReleaseAlert forgets a link alert claim
+func (s *ClickHouseStoreImpl) ReleaseAlert(ctx context.Context, shortDomain, shortCode, key string) error {
+	return releaseAlert(ctx, s.redis, shortDomain, shortCode, key)
+}
+
+// RecordAlertClick counts a click towards a link's rolling traffic
+func (s *AnalyticsStoreImpl) RecordAlertClick(ctx context.Context, shortDomain, shortCode string, at time.Time) (*domain.LinkTraffic, error) {
+	return recordAlertClick(ctx, s.redis, shortDomain, shortCode, at)
+}
+
+// ClaimAlert marks a link alert raised for ttl
+func (s *AnalyticsStoreImpl) ClaimAlert(ctx context.Context, shortDomain, shortCode, key string, ttl time.Duration) (bool, error) {
+	return claimAlert(ctx, s.redis, shortDomain, shortCode, key, ttl)
+}
+
+// ReleaseAlert forgets a link alert claim
+func (s *AnalyticsStoreImpl) ReleaseAlert(ctx context.Context, shortDomain, shortCode, key string) error {
+	return releaseAlert(ctx, s.redis, shortDomain, shortCode, key)
+}
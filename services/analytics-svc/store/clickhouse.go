@@ -36,8 +36,10 @@ func (s *ClickHouseStoreImpl) SaveClick(ctx context.Context, click *domain.Click
 		INSERT INTO click_analytics (
 			short_code, long_url, client_ip, user_agent, referrer,
 			country, city, device_type, browser, os,
-			timestamp, session_id, is_unique, created_at
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+			timestamp, session_id, is_unique,
+			utm_source, utm_medium, utm_campaign, utm_term, utm_content,
+			source, fraud_score, is_suspicious, created_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	err := s.db.Exec(ctx, query,
 		click.ShortCode,
@@ -53,6 +55,14 @@ func (s *ClickHouseStoreImpl) SaveClick(ctx context.Context, click *domain.Click
 		click.Timestamp,
 		click.SessionID,
 		click.IsUnique,
+		click.UTMSource,
+		click.UTMMedium,
+		click.UTMCampaign,
+		click.UTMTerm,
+		click.UTMContent,
+		click.Source,
+		click.FraudScore,
+		click.IsSuspicious,
 		click.CreatedAt,
 	)
 
@@ -68,8 +78,8 @@ func (s *ClickHouseStoreImpl) SaveClick(ctx context.Context, click *domain.Click
 }
 
 // GetURLStats retrieves analytics statistics for a specific URL using ClickHouse aggregations
-func (s *ClickHouseStoreImpl) GetURLStats(ctx context.Context, shortCode string, startTime, endTime time.Time) (*domain.URLStats, error) {
-	query := `
+func (s *ClickHouseStoreImpl) GetURLStats(ctx context.Context, shortCode string, startTime, endTime time.Time, excludeSuspicious bool) (*domain.URLStats, error) {
+	query := fmt.Sprintf(`
 		SELECT 
 			short_code,
 			toInt64(count()) as total_clicks,
@@ -79,7 +89,8 @@ func (s *ClickHouseStoreImpl) GetURLStats(ctx context.Context, shortCode string,
 		FROM click_analytics 
 		WHERE short_code = ? 
 			AND timestamp BETWEEN ? AND ?
-		GROUP BY short_code`
+			%s
+		GROUP BY short_code`, suspiciousFilter(excludeSuspicious))
 
 	var stats domain.URLStats
 	row := s.db.QueryRow(ctx, query, shortCode, startTime, endTime)
@@ -109,7 +120,7 @@ func (s *ClickHouseStoreImpl) GetURLStats(ctx context.Context, shortCode string,
 }
 
 // GetTimeSeriesData retrieves time-based analytics data using ClickHouse time functions
-func (s *ClickHouseStoreImpl) GetTimeSeriesData(ctx context.Context, shortCode string, startTime, endTime time.Time, granularity string) ([]*domain.TimeSeriesData, error) {
+func (s *ClickHouseStoreImpl) GetTimeSeriesData(ctx context.Context, shortCode string, startTime, endTime time.Time, granularity string, excludeSuspicious bool) ([]*domain.TimeSeriesData, error) {
 	var intervalFunc string
 	switch granularity {
 	case "hour":
@@ -132,8 +143,9 @@ func (s *ClickHouseStoreImpl) GetTimeSeriesData(ctx context.Context, shortCode s
 		FROM click_analytics 
 		WHERE short_code = ? 
 			AND timestamp BETWEEN ? AND ?
+			%s
 		GROUP BY timestamp
-		ORDER BY timestamp`, intervalFunc)
+		ORDER BY timestamp`, intervalFunc, suspiciousFilter(excludeSuspicious))
 
 	rows, err := s.db.Query(ctx, query, shortCode, startTime, endTime)
 	if err != nil {
@@ -154,8 +166,8 @@ func (s *ClickHouseStoreImpl) GetTimeSeriesData(ctx context.Context, shortCode s
 }
 
 // GetCountryStats retrieves click statistics by country using ClickHouse aggregations
-func (s *ClickHouseStoreImpl) GetCountryStats(ctx context.Context, shortCode string, startTime, endTime time.Time) ([]*domain.CountryMetrics, error) {
-	query := `
+func (s *ClickHouseStoreImpl) GetCountryStats(ctx context.Context, shortCode string, startTime, endTime time.Time, excludeSuspicious bool) ([]*domain.CountryMetrics, error) {
+	query := fmt.Sprintf(`
 		SELECT 
 			country,
 			toInt64(count()) as clicks,
@@ -163,10 +175,11 @@ func (s *ClickHouseStoreImpl) GetCountryStats(ctx context.Context, shortCode str
 		FROM click_analytics 
 		WHERE short_code = ? 
 			AND timestamp BETWEEN ? AND ?
+			%s
 			AND country != ''
 		GROUP BY country
 		ORDER BY clicks DESC
-		LIMIT 10`
+		LIMIT 10`, suspiciousFilter(excludeSuspicious))
 
 	rows, err := s.db.Query(ctx, query, shortCode, startTime, endTime)
 	if err != nil {
@@ -187,8 +200,8 @@ func (s *ClickHouseStoreImpl) GetCountryStats(ctx context.Context, shortCode str
 }
 
 // GetDeviceStats retrieves click statistics by device type
-func (s *ClickHouseStoreImpl) GetDeviceStats(ctx context.Context, shortCode string, startTime, endTime time.Time) ([]*domain.DeviceMetrics, error) {
-	query := `
+func (s *ClickHouseStoreImpl) GetDeviceStats(ctx context.Context, shortCode string, startTime, endTime time.Time, excludeSuspicious bool) ([]*domain.DeviceMetrics, error) {
+	query := fmt.Sprintf(`
 		SELECT 
 			device_type,
 			toInt64(count()) as clicks,
@@ -196,9 +209,10 @@ func (s *ClickHouseStoreImpl) GetDeviceStats(ctx context.Context, shortCode stri
 		FROM click_analytics 
 		WHERE short_code = ? 
 			AND timestamp BETWEEN ? AND ?
+			%s
 			AND device_type != ''
 		GROUP BY device_type
-		ORDER BY clicks DESC`
+		ORDER BY clicks DESC`, suspiciousFilter(excludeSuspicious))
 
 	rows, err := s.db.Query(ctx, query, shortCode, startTime, endTime)
 	if err != nil {
@@ -219,8 +233,8 @@ func (s *ClickHouseStoreImpl) GetDeviceStats(ctx context.Context, shortCode stri
 }
 
 // GetBrowserStats retrieves click statistics by browser
-func (s *ClickHouseStoreImpl) GetBrowserStats(ctx context.Context, shortCode string, startTime, endTime time.Time) ([]*domain.BrowserMetrics, error) {
-	query := `
+func (s *ClickHouseStoreImpl) GetBrowserStats(ctx context.Context, shortCode string, startTime, endTime time.Time, excludeSuspicious bool) ([]*domain.BrowserMetrics, error) {
+	query := fmt.Sprintf(`
 		SELECT 
 			browser,
 			toInt64(count()) as clicks,
@@ -228,10 +242,11 @@ func (s *ClickHouseStoreImpl) GetBrowserStats(ctx context.Context, shortCode str
 		FROM click_analytics 
 		WHERE short_code = ? 
 			AND timestamp BETWEEN ? AND ?
+			%s
 			AND browser != ''
 		GROUP BY browser
 		ORDER BY clicks DESC
-		LIMIT 10`
+		LIMIT 10`, suspiciousFilter(excludeSuspicious))
 
 	rows, err := s.db.Query(ctx, query, shortCode, startTime, endTime)
 	if err != nil {
@@ -252,8 +267,8 @@ func (s *ClickHouseStoreImpl) GetBrowserStats(ctx context.Context, shortCode str
 }
 
 // GetReferrerStats retrieves click statistics by referrer
-func (s *ClickHouseStoreImpl) GetReferrerStats(ctx context.Context, shortCode string, startTime, endTime time.Time) ([]*domain.ReferrerMetrics, error) {
-	query := `
+func (s *ClickHouseStoreImpl) GetReferrerStats(ctx context.Context, shortCode string, startTime, endTime time.Time, excludeSuspicious bool) ([]*domain.ReferrerMetrics, error) {
+	query := fmt.Sprintf(`
 		SELECT 
 			CASE 
 				WHEN referrer = '' OR referrer IS NULL THEN 'Direct'
@@ -264,9 +279,10 @@ func (s *ClickHouseStoreImpl) GetReferrerStats(ctx context.Context, shortCode st
 		FROM click_analytics 
 		WHERE short_code = ? 
 			AND timestamp BETWEEN ? AND ?
+			%s
 		GROUP BY referrer
 		ORDER BY clicks DESC
-		LIMIT 10`
+		LIMIT 10`, suspiciousFilter(excludeSuspicious))
 
 	rows, err := s.db.Query(ctx, query, shortCode, startTime, endTime)
 	if err != nil {
@@ -286,8 +302,44 @@ func (s *ClickHouseStoreImpl) GetReferrerStats(ctx context.Context, shortCode st
 	return referrerStats, nil
 }
 
+// GetCampaignStats retrieves click statistics grouped by the UTM values sent
+func (s *ClickHouseStoreImpl) GetCampaignStats(ctx context.Context, shortCode string, startTime, endTime time.Time, limit int, excludeSuspicious bool) ([]*domain.CampaignMetrics, error) {
+	query := fmt.Sprintf(`
+		SELECT 
+			utm_source,
+			utm_medium,
+			utm_campaign,
+			toInt64(count()) as clicks,
+			toInt64(uniq(session_id)) as unique_clicks
+		FROM click_analytics 
+		WHERE (? = '' OR short_code = ?)
+			AND timestamp BETWEEN ? AND ?
+			%s
+			AND (utm_source != '' OR utm_medium != '' OR utm_campaign != '')
+		GROUP BY utm_source, utm_medium, utm_campaign
+		ORDER BY clicks DESC
+		LIMIT ?`, suspiciousFilter(excludeSuspicious))
+
+	rows, err := s.db.Query(ctx, query, shortCode, shortCode, startTime, endTime, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get campaign stats from ClickHouse: %w", err)
+	}
+	defer rows.Close()
+
+	var campaignStats []*domain.CampaignMetrics
+	for rows.Next() {
+		var cs domain.CampaignMetrics
+		if err := rows.Scan(&cs.UTMSource, &cs.UTMMedium, &cs.UTMCampaign, &cs.Clicks, &cs.UniqueClicks); err != nil {
+			return nil, fmt.Errorf("failed to scan campaign stats row: %w", err)
+		}
+		campaignStats = append(campaignStats, &cs)
+	}
+
+	return campaignStats, nil
+}
+
 // GetTopURLs retrieves the top performing URLs using ClickHouse aggregations
-func (s *ClickHouseStoreImpl) GetTopURLs(ctx context.Context, limit int, startTime, endTime time.Time, sortBy string) ([]*domain.URLStats, error) {
+func (s *ClickHouseStoreImpl) GetTopURLs(ctx context.Context, limit int, startTime, endTime time.Time, sortBy string, excludeSuspicious bool) ([]*domain.URLStats, error) {
 	var orderClause string
 	switch strings.ToLower(sortBy) {
 	case "unique_clicks":
@@ -307,9 +359,10 @@ func (s *ClickHouseStoreImpl) GetTopURLs(ctx context.Context, limit int, startTi
 			min(created_at) as created_at
 		FROM click_analytics 
 		WHERE timestamp BETWEEN ? AND ?
+			%s
 		GROUP BY short_code
 		ORDER BY %s
-		LIMIT ?`, orderClause)
+		LIMIT ?`, suspiciousFilter(excludeSuspicious), orderClause)
 
 	rows, err := s.db.Query(ctx, query, startTime, endTime, limit)
 	if err != nil {
@@ -330,16 +383,17 @@ func (s *ClickHouseStoreImpl) GetTopURLs(ctx context.Context, limit int, startTi
 }
 
 // GetDashboardMetrics retrieves comprehensive dashboard metrics
-func (s *ClickHouseStoreImpl) GetDashboardMetrics(ctx context.Context, startTime, endTime time.Time) (*domain.DashboardMetrics, error) {
+func (s *ClickHouseStoreImpl) GetDashboardMetrics(ctx context.Context, startTime, endTime time.Time, excludeSuspicious bool) (*domain.DashboardMetrics, error) {
 	// Get basic metrics using ClickHouse aggregations
-	basicQuery := `
+	basicQuery := fmt.Sprintf(`
 		SELECT 
 			toInt64(uniq(short_code)) as total_urls,
 			toInt64(count()) as total_clicks,
 			toInt64(uniq(session_id)) as unique_clicks,
 			toInt64(uniqIf(short_code, timestamp >= now() - INTERVAL 7 DAY)) as active_urls
 		FROM click_analytics 
-		WHERE timestamp BETWEEN ? AND ?`
+		WHERE timestamp BETWEEN ? AND ?
+			%s`, suspiciousFilter(excludeSuspicious))
 
 	var metrics domain.DashboardMetrics
 	row := s.db.QueryRow(ctx, basicQuery, startTime, endTime)
@@ -349,15 +403,16 @@ func (s *ClickHouseStoreImpl) GetDashboardMetrics(ctx context.Context, startTime
 	}
 
 	// Get click timeline (daily aggregation)
-	timelineQuery := `
+	timelineQuery := fmt.Sprintf(`
 		SELECT 
 			toStartOfDay(timestamp) as timestamp,
 			toInt64(count()) as clicks,
 			toInt64(uniq(session_id)) as unique_clicks
 		FROM click_analytics 
 		WHERE timestamp BETWEEN ? AND ?
+			%s
 		GROUP BY timestamp
-		ORDER BY timestamp`
+		ORDER BY timestamp`, suspiciousFilter(excludeSuspicious))
 
 	rows, err := s.db.Query(ctx, timelineQuery, startTime, endTime)
 	if err != nil {
@@ -374,7 +429,7 @@ func (s *ClickHouseStoreImpl) GetDashboardMetrics(ctx context.Context, startTime
 	}
 
 	// Get top countries (reuse existing method)
-	topCountries, err := s.GetCountryStats(ctx, "", startTime, endTime) // Empty shortCode for all URLs
+	topCountries, err := s.GetCountryStats(ctx, "", startTime, endTime, excludeSuspicious) // Empty shortCode for all URLs
 	if err != nil {
 		return nil, fmt.Errorf("failed to get top countries: %w", err)
 	}
@@ -386,7 +441,7 @@ func (s *ClickHouseStoreImpl) GetDashboardMetrics(ctx context.Context, startTime
 	}
 
 	// Get device breakdown
-	deviceBreakdown, err := s.GetDeviceStats(ctx, "", startTime, endTime) // Empty shortCode for all URLs
+	deviceBreakdown, err := s.GetDeviceStats(ctx, "", startTime, endTime, excludeSuspicious) // Empty shortCode for all URLs
 	if err != nil {
 		return nil, fmt.Errorf("failed to get device breakdown: %w", err)
 	}
@@ -430,6 +485,35 @@ func (s *ClickHouseStoreImpl) IsUniqueVisitor(ctx context.Context, shortCode, se
 	return false, nil
 }
 
+// DeleteClicks removes every click of a short code and its cached stats. The
+// deletes are ClickHouse mutations, applied in the background.
+func (s *ClickHouseStoreImpl) DeleteClicks(ctx context.Context, shortCode string) error {
+	if err := s.db.Exec(ctx, "ALTER TABLE click_analytics DELETE WHERE short_code = ?", shortCode); err != nil {
+		return fmt.Errorf("failed to delete clicks: %w", err)
+	}
+
+	// The rollup views are an optional optimization (see createClickHouseSchema)
+	for _, view := range []string{"click_analytics_hourly_mv", "click_analytics_daily_mv"} {
+		if err := s.db.Exec(ctx, fmt.Sprintf("ALTER TABLE %s DELETE WHERE short_code = ?", view), shortCode); err != nil {
+			s.log.WithError(err).WithField("view", view).Warn("Failed to delete clicks from rollup view")
+		}
+	}
+
+	if err := clearCachedStats(ctx, s.redis, shortCode); err != nil {
+		s.log.WithError(err).WithField("short_code", shortCode).Warn("Failed to clear cached stats")
+	}
+	return nil
+}
+
+// suspiciousFilter returns the condition leaving out clicks flagged by fraud
+// scoring, or nothing when they are kept
+func suspiciousFilter(excludeSuspicious bool) string {
+	if excludeSuspicious {
+		return "AND is_suspicious = 0"
+	}
+	return ""
+}
+
 // updateCachedStats updates cached statistics (async)
 func (s *ClickHouseStoreImpl) updateCachedStats(shortCode string) {
 	ctx := context.Background()
@@ -0,0 +1,60 @@
+package store
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/go-systems-lab/go-url-shortener/services/analytics-svc/domain"
+)
+
+// recordFraudCounters counts a click on a link per address, network and user
+// agent in the fixed window it falls in, returning the counts so far
+func recordFraudCounters(ctx context.Context, client *redis.Client, shortCode string, keys domain.FraudKeys, window time.Duration, at time.Time) (*domain.FraudCounters, error) {
+	bucket := strconv.FormatInt(at.Unix()/int64(window/time.Second), 10)
+	userAgent := sha1.Sum([]byte(keys.UserAgent))
+
+	counter := func(kind, value string) string {
+		return fmt.Sprintf("fraud:%s:%s:%s:%s", shortCode, kind, value, bucket)
+	}
+
+	pipe := client.Pipeline()
+	incr := func(key string) *redis.IntCmd {
+		cmd := pipe.Incr(ctx, key)
+		pipe.Expire(ctx, key, 2*window)
+		return cmd
+	}
+	ipClicks := incr(counter("ip", keys.ClientIP))
+	userAgentClicks := incr(counter("ua", hex.EncodeToString(userAgent[:8])))
+	var networkClicks *redis.IntCmd
+	if keys.Network != "" {
+		networkClicks = incr(counter("net", keys.Network))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to count recent clicks: %w", err)
+	}
+
+	counters := &domain.FraudCounters{
+		IPClicks:        ipClicks.Val(),
+		UserAgentClicks: userAgentClicks.Val(),
+	}
+	if networkClicks != nil {
+		counters.NetworkClicks = networkClicks.Val()
+	}
+	return counters, nil
+}
+
+// RecordFraudCounters counts a click towards its link's recent clicks
+func (s *ClickHouseStoreImpl) RecordFraudCounters(ctx context.Context, shortCode string, keys domain.FraudKeys, window time.Duration, at time.Time) (*domain.FraudCounters, error) {
+	return recordFraudCounters(ctx, s.redis, shortCode, keys, window, at)
+}
+
+// RecordFraudCounters counts a click towards its link's recent clicks
+func (s *AnalyticsStoreImpl) RecordFraudCounters(ctx context.Context, shortCode string, keys domain.FraudKeys, window time.Duration, at time.Time) (*domain.FraudCounters, error) {
+	return recordFraudCounters(ctx, s.redis, shortCode, keys, window, at)
+}
@@ -36,11 +36,15 @@ func (s *AnalyticsStoreImpl) SaveClick(ctx context.Context, click *domain.ClickR
 		INSERT INTO click_analytics (
 			short_code, long_url, client_ip, user_agent, referrer,
 			country, city, device_type, browser, os,
-			timestamp, session_id, is_unique, created_at
+			timestamp, session_id, is_unique,
+			utm_source, utm_medium, utm_campaign, utm_term, utm_content,
+			source, fraud_score, is_suspicious, created_at
 		) VALUES (
 			:short_code, :long_url, :client_ip, :user_agent, :referrer,
 			:country, :city, :device_type, :browser, :os,
-			:timestamp, :session_id, :is_unique, :created_at
+			:timestamp, :session_id, :is_unique,
+			:utm_source, :utm_medium, :utm_campaign, :utm_term, :utm_content,
+			:source, :fraud_score, :is_suspicious, :created_at
 		)`
 
 	_, err := s.db.NamedExecContext(ctx, query, click)
@@ -56,8 +60,8 @@ func (s *AnalyticsStoreImpl) SaveClick(ctx context.Context, click *domain.ClickR
 }
 
 // GetURLStats retrieves basic statistics for a URL
-func (s *AnalyticsStoreImpl) GetURLStats(ctx context.Context, shortCode string, startTime, endTime time.Time) (*domain.URLStats, error) {
-	query := `
+func (s *AnalyticsStoreImpl) GetURLStats(ctx context.Context, shortCode string, startTime, endTime time.Time, excludeSuspicious bool) (*domain.URLStats, error) {
+	query := fmt.Sprintf(`
 		SELECT 
 			short_code,
 			COUNT(*) as total_clicks,
@@ -67,7 +71,8 @@ func (s *AnalyticsStoreImpl) GetURLStats(ctx context.Context, shortCode string,
 		FROM click_analytics 
 		WHERE short_code = $1 
 			AND timestamp BETWEEN $2 AND $3
-		GROUP BY short_code`
+			%s
+		GROUP BY short_code`, postgresSuspiciousFilter(excludeSuspicious))
 
 	var stats domain.URLStats
 	err := s.db.GetContext(ctx, &stats, query, shortCode, startTime, endTime)
@@ -88,7 +93,7 @@ func (s *AnalyticsStoreImpl) GetURLStats(ctx context.Context, shortCode string,
 }
 
 // GetTimeSeriesData retrieves time-based analytics data
-func (s *AnalyticsStoreImpl) GetTimeSeriesData(ctx context.Context, shortCode string, startTime, endTime time.Time, granularity string) ([]*domain.TimeSeriesData, error) {
+func (s *AnalyticsStoreImpl) GetTimeSeriesData(ctx context.Context, shortCode string, startTime, endTime time.Time, granularity string, excludeSuspicious bool) ([]*domain.TimeSeriesData, error) {
 	var intervalClause string
 	switch granularity {
 	case "hour":
@@ -111,8 +116,9 @@ func (s *AnalyticsStoreImpl) GetTimeSeriesData(ctx context.Context, shortCode st
 		FROM click_analytics 
 		WHERE short_code = $1 
 			AND timestamp BETWEEN $2 AND $3
+			%s
 		GROUP BY %s
-		ORDER BY timestamp`, intervalClause, intervalClause)
+		ORDER BY timestamp`, intervalClause, postgresSuspiciousFilter(excludeSuspicious), intervalClause)
 
 	var timeSeries []*domain.TimeSeriesData
 	err := s.db.SelectContext(ctx, &timeSeries, query, shortCode, startTime, endTime)
@@ -124,8 +130,8 @@ func (s *AnalyticsStoreImpl) GetTimeSeriesData(ctx context.Context, shortCode st
 }
 
 // GetCountryStats retrieves click statistics by country
-func (s *AnalyticsStoreImpl) GetCountryStats(ctx context.Context, shortCode string, startTime, endTime time.Time) ([]*domain.CountryMetrics, error) {
-	query := `
+func (s *AnalyticsStoreImpl) GetCountryStats(ctx context.Context, shortCode string, startTime, endTime time.Time, excludeSuspicious bool) ([]*domain.CountryMetrics, error) {
+	query := fmt.Sprintf(`
 		WITH country_counts AS (
 			SELECT 
 				country,
@@ -133,6 +139,7 @@ func (s *AnalyticsStoreImpl) GetCountryStats(ctx context.Context, shortCode stri
 			FROM click_analytics 
 			WHERE short_code = $1 
 				AND timestamp BETWEEN $2 AND $3
+				%s
 			GROUP BY country
 		),
 		total_clicks AS (
@@ -148,7 +155,7 @@ func (s *AnalyticsStoreImpl) GetCountryStats(ctx context.Context, shortCode stri
 		FROM country_counts cc
 		CROSS JOIN total_clicks tc
 		ORDER BY cc.clicks DESC
-		LIMIT 10`
+		LIMIT 10`, postgresSuspiciousFilter(excludeSuspicious))
 
 	var countryStats []*domain.CountryMetrics
 	err := s.db.SelectContext(ctx, &countryStats, query, shortCode, startTime, endTime)
@@ -160,8 +167,8 @@ func (s *AnalyticsStoreImpl) GetCountryStats(ctx context.Context, shortCode stri
 }
 
 // GetDeviceStats retrieves click statistics by device type
-func (s *AnalyticsStoreImpl) GetDeviceStats(ctx context.Context, shortCode string, startTime, endTime time.Time) ([]*domain.DeviceMetrics, error) {
-	query := `
+func (s *AnalyticsStoreImpl) GetDeviceStats(ctx context.Context, shortCode string, startTime, endTime time.Time, excludeSuspicious bool) ([]*domain.DeviceMetrics, error) {
+	query := fmt.Sprintf(`
 		WITH device_counts AS (
 			SELECT 
 				device_type,
@@ -169,6 +176,7 @@ func (s *AnalyticsStoreImpl) GetDeviceStats(ctx context.Context, shortCode strin
 			FROM click_analytics 
 			WHERE short_code = $1 
 				AND timestamp BETWEEN $2 AND $3
+				%s
 			GROUP BY device_type
 		),
 		total_clicks AS (
@@ -183,7 +191,7 @@ func (s *AnalyticsStoreImpl) GetDeviceStats(ctx context.Context, shortCode strin
 			END as percentage
 		FROM device_counts dc
 		CROSS JOIN total_clicks tc
-		ORDER BY dc.clicks DESC`
+		ORDER BY dc.clicks DESC`, postgresSuspiciousFilter(excludeSuspicious))
 
 	var deviceStats []*domain.DeviceMetrics
 	err := s.db.SelectContext(ctx, &deviceStats, query, shortCode, startTime, endTime)
@@ -195,8 +203,8 @@ func (s *AnalyticsStoreImpl) GetDeviceStats(ctx context.Context, shortCode strin
 }
 
 // GetBrowserStats retrieves click statistics by browser
-func (s *AnalyticsStoreImpl) GetBrowserStats(ctx context.Context, shortCode string, startTime, endTime time.Time) ([]*domain.BrowserMetrics, error) {
-	query := `
+func (s *AnalyticsStoreImpl) GetBrowserStats(ctx context.Context, shortCode string, startTime, endTime time.Time, excludeSuspicious bool) ([]*domain.BrowserMetrics, error) {
+	query := fmt.Sprintf(`
 		WITH browser_counts AS (
 			SELECT 
 				browser,
@@ -204,6 +212,7 @@ func (s *AnalyticsStoreImpl) GetBrowserStats(ctx context.Context, shortCode stri
 			FROM click_analytics 
 			WHERE short_code = $1 
 				AND timestamp BETWEEN $2 AND $3
+				%s
 			GROUP BY browser
 		),
 		total_clicks AS (
@@ -219,7 +228,7 @@ func (s *AnalyticsStoreImpl) GetBrowserStats(ctx context.Context, shortCode stri
 		FROM browser_counts bc
 		CROSS JOIN total_clicks tc
 		ORDER BY bc.clicks DESC
-		LIMIT 10`
+		LIMIT 10`, postgresSuspiciousFilter(excludeSuspicious))
 
 	var browserStats []*domain.BrowserMetrics
 	err := s.db.SelectContext(ctx, &browserStats, query, shortCode, startTime, endTime)
@@ -231,8 +240,8 @@ func (s *AnalyticsStoreImpl) GetBrowserStats(ctx context.Context, shortCode stri
 }
 
 // GetReferrerStats retrieves click statistics by referrer
-func (s *AnalyticsStoreImpl) GetReferrerStats(ctx context.Context, shortCode string, startTime, endTime time.Time) ([]*domain.ReferrerMetrics, error) {
-	query := `
+func (s *AnalyticsStoreImpl) GetReferrerStats(ctx context.Context, shortCode string, startTime, endTime time.Time, excludeSuspicious bool) ([]*domain.ReferrerMetrics, error) {
+	query := fmt.Sprintf(`
 		WITH referrer_counts AS (
 			SELECT 
 				CASE 
@@ -243,6 +252,7 @@ func (s *AnalyticsStoreImpl) GetReferrerStats(ctx context.Context, shortCode str
 			FROM click_analytics 
 			WHERE short_code = $1 
 				AND timestamp BETWEEN $2 AND $3
+				%s
 			GROUP BY referrer
 		),
 		total_clicks AS (
@@ -258,7 +268,7 @@ func (s *AnalyticsStoreImpl) GetReferrerStats(ctx context.Context, shortCode str
 		FROM referrer_counts rc
 		CROSS JOIN total_clicks tc
 		ORDER BY rc.clicks DESC
-		LIMIT 10`
+		LIMIT 10`, postgresSuspiciousFilter(excludeSuspicious))
 
 	var referrerStats []*domain.ReferrerMetrics
 	err := s.db.SelectContext(ctx, &referrerStats, query, shortCode, startTime, endTime)
@@ -269,8 +279,35 @@ func (s *AnalyticsStoreImpl) GetReferrerStats(ctx context.Context, shortCode str
 	return referrerStats, nil
 }
 
+// GetCampaignStats retrieves click statistics grouped by the UTM values sent
+func (s *AnalyticsStoreImpl) GetCampaignStats(ctx context.Context, shortCode string, startTime, endTime time.Time, limit int, excludeSuspicious bool) ([]*domain.CampaignMetrics, error) {
+	query := fmt.Sprintf(`
+		SELECT 
+			utm_source,
+			utm_medium,
+			utm_campaign,
+			COUNT(*) as clicks,
+			COUNT(DISTINCT CASE WHEN is_unique = true THEN session_id END) as unique_clicks
+		FROM click_analytics 
+		WHERE ($1 = '' OR short_code = $1)
+			AND timestamp BETWEEN $2 AND $3
+			%s
+			AND (utm_source <> '' OR utm_medium <> '' OR utm_campaign <> '')
+		GROUP BY utm_source, utm_medium, utm_campaign
+		ORDER BY clicks DESC
+		LIMIT $4`, postgresSuspiciousFilter(excludeSuspicious))
+
+	var campaignStats []*domain.CampaignMetrics
+	err := s.db.SelectContext(ctx, &campaignStats, query, shortCode, startTime, endTime, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get campaign stats: %w", err)
+	}
+
+	return campaignStats, nil
+}
+
 // GetTopURLs retrieves the top performing URLs
-func (s *AnalyticsStoreImpl) GetTopURLs(ctx context.Context, limit int, startTime, endTime time.Time, sortBy string) ([]*domain.URLStats, error) {
+func (s *AnalyticsStoreImpl) GetTopURLs(ctx context.Context, limit int, startTime, endTime time.Time, sortBy string, excludeSuspicious bool) ([]*domain.URLStats, error) {
 	var orderClause string
 	switch strings.ToLower(sortBy) {
 	case "unique_clicks":
@@ -290,9 +327,10 @@ func (s *AnalyticsStoreImpl) GetTopURLs(ctx context.Context, limit int, startTim
 			MIN(created_at) as created_at
 		FROM click_analytics 
 		WHERE timestamp BETWEEN $1 AND $2
+			%s
 		GROUP BY short_code
 		ORDER BY %s
-		LIMIT $3`, orderClause)
+		LIMIT $3`, postgresSuspiciousFilter(excludeSuspicious), orderClause)
 
 	var topURLs []*domain.URLStats
 	err := s.db.SelectContext(ctx, &topURLs, query, startTime, endTime, limit)
@@ -304,16 +342,17 @@ func (s *AnalyticsStoreImpl) GetTopURLs(ctx context.Context, limit int, startTim
 }
 
 // GetDashboardMetrics retrieves comprehensive dashboard metrics
-func (s *AnalyticsStoreImpl) GetDashboardMetrics(ctx context.Context, startTime, endTime time.Time) (*domain.DashboardMetrics, error) {
+func (s *AnalyticsStoreImpl) GetDashboardMetrics(ctx context.Context, startTime, endTime time.Time, excludeSuspicious bool) (*domain.DashboardMetrics, error) {
 	// Get basic metrics
-	basicQuery := `
+	basicQuery := fmt.Sprintf(`
 		SELECT 
 			COUNT(DISTINCT short_code) as total_urls,
 			COUNT(*) as total_clicks,
 			COUNT(DISTINCT CASE WHEN is_unique = true THEN session_id END) as unique_clicks,
 			COUNT(DISTINCT CASE WHEN timestamp >= NOW() - INTERVAL '7 days' THEN short_code END) as active_urls
 		FROM click_analytics 
-		WHERE timestamp BETWEEN $1 AND $2`
+		WHERE timestamp BETWEEN $1 AND $2
+			%s`, postgresSuspiciousFilter(excludeSuspicious))
 
 	var metrics domain.DashboardMetrics
 	err := s.db.GetContext(ctx, &metrics, basicQuery, startTime, endTime)
@@ -322,15 +361,16 @@ func (s *AnalyticsStoreImpl) GetDashboardMetrics(ctx context.Context, startTime,
 	}
 
 	// Get click timeline (daily aggregation)
-	timelineQuery := `
+	timelineQuery := fmt.Sprintf(`
 		SELECT 
 			DATE_TRUNC('day', timestamp) as timestamp,
 			COUNT(*) as clicks,
 			COUNT(DISTINCT CASE WHEN is_unique = true THEN session_id END) as unique_clicks
 		FROM click_analytics 
 		WHERE timestamp BETWEEN $1 AND $2
+			%s
 		GROUP BY DATE_TRUNC('day', timestamp)
-		ORDER BY timestamp`
+		ORDER BY timestamp`, postgresSuspiciousFilter(excludeSuspicious))
 
 	err = s.db.SelectContext(ctx, &metrics.ClickTimeline, timelineQuery, startTime, endTime)
 	if err != nil {
@@ -338,13 +378,14 @@ func (s *AnalyticsStoreImpl) GetDashboardMetrics(ctx context.Context, startTime,
 	}
 
 	// Get top countries
-	countryQuery := `
+	countryQuery := fmt.Sprintf(`
 		WITH country_counts AS (
 			SELECT 
 				country,
 				COUNT(*) as clicks
 			FROM click_analytics 
 			WHERE timestamp BETWEEN $1 AND $2
+				%s
 			GROUP BY country
 		),
 		total_clicks AS (
@@ -360,7 +401,7 @@ func (s *AnalyticsStoreImpl) GetDashboardMetrics(ctx context.Context, startTime,
 		FROM country_counts cc
 		CROSS JOIN total_clicks tc
 		ORDER BY cc.clicks DESC
-		LIMIT 5`
+		LIMIT 5`, postgresSuspiciousFilter(excludeSuspicious))
 
 	err = s.db.SelectContext(ctx, &metrics.TopCountries, countryQuery, startTime, endTime)
 	if err != nil {
@@ -368,13 +409,14 @@ func (s *AnalyticsStoreImpl) GetDashboardMetrics(ctx context.Context, startTime,
 	}
 
 	// Get device breakdown
-	deviceQuery := `
+	deviceQuery := fmt.Sprintf(`
 		WITH device_counts AS (
 			SELECT 
 				device_type,
 				COUNT(*) as clicks
 			FROM click_analytics 
 			WHERE timestamp BETWEEN $1 AND $2
+				%s
 			GROUP BY device_type
 		),
 		total_clicks AS (
@@ -389,7 +431,7 @@ func (s *AnalyticsStoreImpl) GetDashboardMetrics(ctx context.Context, startTime,
 			END as percentage
 		FROM device_counts dc
 		CROSS JOIN total_clicks tc
-		ORDER BY dc.clicks DESC`
+		ORDER BY dc.clicks DESC`, postgresSuspiciousFilter(excludeSuspicious))
 
 	err = s.db.SelectContext(ctx, &metrics.DeviceBreakdown, deviceQuery, startTime, endTime)
 	if err != nil {
@@ -431,6 +473,27 @@ func (s *AnalyticsStoreImpl) IsUniqueVisitor(ctx context.Context, shortCode, ses
 	return false, nil
 }
 
+// DeleteClicks removes every click of a short code and its cached stats
+func (s *AnalyticsStoreImpl) DeleteClicks(ctx context.Context, shortCode string) error {
+	if _, err := s.db.ExecContext(ctx, "DELETE FROM click_analytics WHERE short_code = $1", shortCode); err != nil {
+		return fmt.Errorf("failed to delete clicks: %w", err)
+	}
+
+	if err := clearCachedStats(ctx, s.redis, shortCode); err != nil {
+		s.log.WithError(err).WithField("short_code", shortCode).Warn("Failed to clear cached stats")
+	}
+	return nil
+}
+
+// postgresSuspiciousFilter returns the condition leaving out clicks flagged by fraud
+// scoring, or nothing when they are kept
+func postgresSuspiciousFilter(excludeSuspicious bool) string {
+	if excludeSuspicious {
+		return "AND NOT is_suspicious"
+	}
+	return ""
+}
+
 // updateCachedStats updates cached statistics (async)
 func (s *AnalyticsStoreImpl) updateCachedStats(shortCode string) {
 	ctx := context.Background()
@@ -443,3 +506,19 @@ func (s *AnalyticsStoreImpl) updateCachedStats(shortCode string) {
 	// Log successful cache update
 	s.log.WithField("short_code", shortCode).Debug("Updated cached stats")
 }
+
+// clearCachedStats drops the cached counters, visitor sessions and alert
+// state of a short code
+func clearCachedStats(ctx context.Context, client *redis.Client, shortCode string) error {
+	keys := []string{fmt.Sprintf("stats:%s:total_clicks", shortCode)}
+	for _, pattern := range []string{"session:%s:*", "alert:%s:*"} {
+		iter := client.Scan(ctx, 0, fmt.Sprintf(pattern, shortCode), 100).Iterator()
+		for iter.Next(ctx) {
+			keys = append(keys, iter.Val())
+		}
+		if err := iter.Err(); err != nil {
+			return err
+		}
+	}
+	return client.Del(ctx, keys...).Err()
+}
@@ -2,33 +2,67 @@ package domain
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"net"
+	"net/netip"
 	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/go-systems-lab/go-url-shortener/services/url-shortener-svc/domain"
+	"github.com/go-systems-lab/go-url-shortener/utils/alias"
+	"github.com/go-systems-lab/go-url-shortener/utils/cache"
+	"github.com/go-systems-lab/go-url-shortener/utils/geo"
+	"github.com/go-systems-lab/go-url-shortener/utils/preview"
+	"github.com/go-systems-lab/go-url-shortener/utils/safety"
 )
 
 // RedirectService handles URL resolution and click tracking business logic
 type RedirectService struct {
-	store UrlStore
+	store        UrlStore
+	accessSecret []byte
+	destinations *safety.HostCache
+	countries    *geo.Table
 }
 
+// Password-protected link rules (business rules)
+const (
+	accessTokenTTL         = 30 * time.Minute
+	maxPasswordAttempts    = 5
+	passwordAttemptsWindow = 15 * time.Minute
+)
+
+// Destination hostname verdicts are cached briefly so redirects do not wait on DNS
+const destinationVerdictTTL = time.Minute
+
+// Click source markers (?src=qr on QR code scans)
+const maxClickSourceLength = 32
+
+var clickSourcePattern = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
 // UrlStore interface for data access (following hexagonal architecture)
 type UrlStore interface {
-	ResolveURL(ctx context.Context, shortCode string) (*domain.URL, error)
-	IncrementClickCount(ctx context.Context, shortCode string) error
-	GetClickCount(ctx context.Context, shortCode string) (int64, error)
+	ResolveURL(ctx context.Context, shortDomain, shortCode string, codePolicy alias.CodePolicy) (*domain.URL, error)
+	BrandedDomainPolicy(ctx context.Context, host string) (alias.CodePolicy, bool, error)
+	IncrementClickCount(ctx context.Context, shortDomain, shortCode string) error
+	ConsumeClick(ctx context.Context, shortDomain, shortCode string) (int64, bool, error)
+	GetClickCount(ctx context.Context, shortDomain, shortCode string) (int64, error)
 	PrewarmCache(ctx context.Context, shortCodes []string) error
-	InvalidateCache(ctx context.Context, shortCode string) error
+	InvalidateCache(ctx context.Context, shortDomain, shortCode string) error
 	GetCacheStats(ctx context.Context) (map[string]interface{}, error)
+	RecordFailedPasswordAttempt(ctx context.Context, clientIP string, window time.Duration) (int64, error)
+	GetFailedPasswordAttempts(ctx context.Context, clientIP string) (int64, time.Duration, error)
 }
 
 // ClickInfo represents click analytics data
 type ClickInfo struct {
+	Domain     string // short domain the link was clicked on, "" for the default
 	ShortCode  string
 	LongURL    string
 	ClientIP   string
@@ -42,23 +76,57 @@ type ClickInfo struct {
 	Timestamp  time.Time
 	SessionID  string
 	IsUnique   bool
+	UTM        map[string]string // UTM parameters sent to the destination
+	Source     string            // click source marker, e.g. "qr" for QR code scans
+
+	AcceptLanguage string // Accept-Language header, for fraud scoring
+	Accept         string // Accept header, for fraud scoring
 }
 
 // RedirectResult represents the result of a URL resolution
 type RedirectResult struct {
-	LongURL    string
-	Found      bool
-	Expired    bool
-	CreatedAt  time.Time
-	ExpiresAt  *time.Time
-	ClickCount int64
-	Error      string
+	Domain           string // short domain the link was resolved on, "" for the default
+	ShortCode        string // stored spelling of the code, which may differ in case from the request
+	LongURL          string
+	Found            bool
+	Expired          bool
+	RequiresPassword bool // destination withheld until the visitor presents a valid access token
+	NotYetActive     bool // destination withheld until ActivatesAt
+	Exhausted        bool // this redirect used the last click of a click-limited URL
+	Interstitial     bool // show a warning page before redirecting to LongURL
+	Unfurl           bool // a link preview bot asked; serve Preview instead of redirecting
+	Preview          domain.LinkPreview
+	DestinationHost  string
+	CreatedAt        time.Time
+	ExpiresAt        *time.Time
+	ActivatesAt      *time.Time
+	FallbackURL      string // optional pre-activation destination
+	ClickCount       int64
+	MaxClicks        int64
+	Error            string
 }
 
-// NewRedirectService creates a new redirect service
-func NewRedirectService(store UrlStore) *RedirectService {
+// PasswordResult represents the outcome of a password check on a protected link
+type PasswordResult struct {
+	Success     bool
+	ShortCode   string // stored spelling of the code the token unlocks
+	AccessToken string
+	ExpiresAt   time.Time
+	Error       string
+	RetryAfter  time.Duration
+}
+
+// NewRedirectService creates a new redirect service.
+// accessSecret signs the access tokens issued for password-protected links;
+// network decides which destination networks may be redirected to;
+// countries resolves the visitor country when the caller did not send one
+// (nil leaves it empty).
+func NewRedirectService(store UrlStore, accessSecret string, network *safety.NetworkPolicy, countries *geo.Table) *RedirectService {
 	return &RedirectService{
-		store: store,
+		store:        store,
+		accessSecret: []byte(accessSecret),
+		destinations: safety.NewHostCache(network, destinationVerdictTTL),
+		countries:    countries,
 	}
 }
 
@@ -77,9 +145,13 @@ func (s *RedirectService) ResolveURL(ctx context.Context, shortCode string, clie
 
 	fmt.Printf("✅ [DEBUG] Short code validation passed\n")
 
-	// 2. Resolve URL from store (cache-first strategy)
-	fmt.Printf("🔍 [DEBUG] Calling store.ResolveURL for shortCode: %s\n", shortCode)
-	urlEntity, err := s.store.ResolveURL(ctx, shortCode)
+	// 2. Resolve URL from store (cache-first strategy); codes are unique per short domain
+	shortDomain, codePolicy, err := s.resolveShortDomain(ctx, clientInfo.Host)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Printf("🔍 [DEBUG] Calling store.ResolveURL for shortCode: %s (domain: %q)\n", shortCode, shortDomain)
+	urlEntity, err := s.store.ResolveURL(ctx, shortDomain, shortCode, codePolicy)
 	if err != nil {
 		fmt.Printf("❌ [DEBUG] store.ResolveURL failed: %v\n", err)
 		if strings.Contains(err.Error(), "not found") {
@@ -100,6 +172,9 @@ func (s *RedirectService) ResolveURL(ctx context.Context, shortCode string, clie
 
 	fmt.Printf("✅ [DEBUG] store.ResolveURL success: %s -> %s\n", shortCode, urlEntity.LongURL)
 
+	// Counters, tokens and analytics use the stored spelling of the code
+	shortCode = urlEntity.ShortCode
+
 	// 3. Apply business rules (from HLD design)
 	if err := urlEntity.IsValidForRedirect(); err != nil {
 		fmt.Printf("❌ [DEBUG] URL validation failed: %v\n", err)
@@ -113,6 +188,20 @@ func (s *RedirectService) ResolveURL(ctx context.Context, shortCode string, clie
 				Error:     "URL has expired",
 			}, nil
 		}
+		if err == domain.ErrURLExhausted {
+			return s.exhaustedResult(urlEntity), nil
+		}
+		if err == domain.ErrURLNotYetActive {
+			return &RedirectResult{
+				Found:        true,
+				NotYetActive: true,
+				CreatedAt:    urlEntity.CreatedAt,
+				ExpiresAt:    urlEntity.ExpiresAt,
+				ActivatesAt:  urlEntity.ActivatesAt,
+				FallbackURL:  urlEntity.FallbackURL,
+				Error:        "URL is not active yet",
+			}, nil
+		}
 		return &RedirectResult{
 			Found: false,
 			Error: err.Error(),
@@ -120,7 +209,7 @@ func (s *RedirectService) ResolveURL(ctx context.Context, shortCode string, clie
 	}
 
 	// 4. Validate destination URL (security check)
-	if err := s.validateDestinationURL(urlEntity.LongURL); err != nil {
+	if err := s.validateDestinationURL(ctx, urlEntity.LongURL); err != nil {
 		fmt.Printf("❌ [DEBUG] Destination URL validation failed: %v\n", err)
 		return &RedirectResult{
 			Found: false,
@@ -128,18 +217,86 @@ func (s *RedirectService) ResolveURL(ctx context.Context, shortCode string, clie
 		}, nil
 	}
 
+	// 5. Password-protected links need a valid access token before the destination is revealed
+	if urlEntity.RequiresPassword() && !s.verifyAccessToken(cache.LinkKey(shortDomain, shortCode), clientInfo.AccessToken) {
+		return &RedirectResult{
+			Found:            true,
+			RequiresPassword: true,
+			CreatedAt:        urlEntity.CreatedAt,
+			ExpiresAt:        urlEntity.ExpiresAt,
+		}, nil
+	}
+
+	// 6. Flagged domains and new links from untrusted accounts get a warning page first
+	if !clientInfo.Confirmed && urlEntity.NeedsInterstitial(time.Now()) {
+		return &RedirectResult{
+			LongURL:         urlEntity.LongURL,
+			Found:           true,
+			Interstitial:    true,
+			DestinationHost: domain.DestinationHost(urlEntity.LongURL),
+			CreatedAt:       urlEntity.CreatedAt,
+			ExpiresAt:       urlEntity.ExpiresAt,
+		}, nil
+	}
+
+	// 7. Chat apps and social networks get the link's preview metadata instead of a redirect,
+	// so unfurls show the (possibly owner-edited) card and do not count as clicks
+	if linkPreview := urlEntity.EffectivePreview(); !linkPreview.IsEmpty() && preview.IsUnfurlBot(clientInfo.UserAgent) {
+		return &RedirectResult{
+			LongURL:   urlEntity.LongURL,
+			Found:     true,
+			Unfurl:    true,
+			Preview:   linkPreview,
+			CreatedAt: urlEntity.CreatedAt,
+			ExpiresAt: urlEntity.ExpiresAt,
+		}, nil
+	}
+
 	fmt.Printf("✅ [DEBUG] All validations passed, returning success\n")
 
-	// 5. Increment click count (async for performance)
+	// 8. Apply the effective UTM template (link over workspace)
+	destinationURL := urlEntity.UTMTemplate.Apply(urlEntity.LongURL, domain.UTMContext{
+		ShortCode:      shortCode,
+		Country:        s.clientCountry(clientInfo),
+		ReferrerDomain: domain.ReferrerDomain(clientInfo.Referrer),
+	})
+
+	// 9. Count the click: click-limited URLs consume a click atomically before redirecting,
+	// everything else is incremented async for performance
+	if urlEntity.IsClickLimited() {
+		clickCount, ok, err := s.store.ConsumeClick(ctx, shortDomain, shortCode)
+		if err != nil {
+			return nil, fmt.Errorf("failed to consume click: %w", err)
+		}
+		if !ok {
+			// Another redirect used the last click since the URL was loaded
+			return s.exhaustedResult(urlEntity), nil
+		}
+
+		return &RedirectResult{
+			Domain:     shortDomain,
+			ShortCode:  shortCode,
+			LongURL:    destinationURL,
+			Found:      true,
+			Exhausted:  clickCount >= urlEntity.MaxClicks,
+			CreatedAt:  urlEntity.CreatedAt,
+			ExpiresAt:  urlEntity.ExpiresAt,
+			ClickCount: clickCount,
+			MaxClicks:  urlEntity.MaxClicks,
+		}, nil
+	}
+
 	go func() {
-		if err := s.store.IncrementClickCount(context.Background(), shortCode); err != nil {
+		if err := s.store.IncrementClickCount(context.Background(), shortDomain, shortCode); err != nil {
 			// Log error but don't fail the redirect
 			fmt.Printf("Failed to increment click count for %s: %v\n", shortCode, err)
 		}
 	}()
 
 	return &RedirectResult{
-		LongURL:    urlEntity.LongURL,
+		Domain:     shortDomain,
+		ShortCode:  shortCode,
+		LongURL:    destinationURL,
 		Found:      true,
 		Expired:    false,
 		CreatedAt:  urlEntity.CreatedAt,
@@ -148,8 +305,145 @@ func (s *RedirectService) ResolveURL(ctx context.Context, shortCode string, clie
 	}, nil
 }
 
+// exhaustedResult reports a click-limited URL with no clicks left; it behaves like an expired URL
+func (s *RedirectService) exhaustedResult(urlEntity *domain.URL) *RedirectResult {
+	return &RedirectResult{
+		Found:      true,
+		Expired:    true,
+		CreatedAt:  urlEntity.CreatedAt,
+		ExpiresAt:  urlEntity.ExpiresAt,
+		ClickCount: urlEntity.MaxClicks,
+		MaxClicks:  urlEntity.MaxClicks,
+		Error:      "URL has reached its click limit",
+	}
+}
+
+// VerifyPassword checks a visitor's password for a protected link on the request host and issues a short-lived access token
+func (s *RedirectService) VerifyPassword(ctx context.Context, host, shortCode, password, clientIP string) (*PasswordResult, error) {
+	if err := s.validateShortCode(shortCode); err != nil {
+		return &PasswordResult{Error: fmt.Sprintf("invalid short code: %v", err)}, nil
+	}
+
+	// 1. Enforce the per-IP attempt limit before touching the hash
+	attempts, resetIn, err := s.store.GetFailedPasswordAttempts(ctx, clientIP)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check password attempts: %w", err)
+	}
+	if attempts >= maxPasswordAttempts {
+		return &PasswordResult{
+			Error:      "Too many failed attempts, try again later",
+			RetryAfter: resetIn,
+		}, nil
+	}
+
+	// 2. Load the link
+	shortDomain, codePolicy, err := s.resolveShortDomain(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	urlEntity, err := s.store.ResolveURL(ctx, shortDomain, shortCode, codePolicy)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "expired") {
+			return &PasswordResult{Error: "Short URL not found"}, nil
+		}
+		return nil, fmt.Errorf("failed to resolve URL: %w", err)
+	}
+	if !urlEntity.RequiresPassword() {
+		return &PasswordResult{Error: "Short URL is not password protected"}, nil
+	}
+
+	// 3. Check the password and count failures
+	if !domain.CheckLinkPassword(urlEntity.PasswordHash, password) {
+		attempts, err := s.store.RecordFailedPasswordAttempt(ctx, clientIP, passwordAttemptsWindow)
+		if err != nil {
+			return nil, fmt.Errorf("failed to record password attempt: %w", err)
+		}
+		result := &PasswordResult{Error: "Incorrect password"}
+		if attempts >= maxPasswordAttempts {
+			result.RetryAfter = passwordAttemptsWindow
+		}
+		return result, nil
+	}
+
+	expiresAt := time.Now().Add(accessTokenTTL)
+	return &PasswordResult{
+		Success:     true,
+		ShortCode:   urlEntity.ShortCode,
+		AccessToken: s.signAccessToken(cache.LinkKey(shortDomain, urlEntity.ShortCode), expiresAt),
+		ExpiresAt:   expiresAt,
+	}, nil
+}
+
+// signAccessToken produces "<expiry>.<signature>" where the signature covers the link (domain and code) and expiry
+func (s *RedirectService) signAccessToken(linkKey string, expiresAt time.Time) string {
+	expiry := strconv.FormatInt(expiresAt.Unix(), 10)
+	return expiry + "." + s.accessSignature(linkKey, expiry)
+}
+
+// verifyAccessToken checks the token signature and expiry for a link
+func (s *RedirectService) verifyAccessToken(linkKey, token string) bool {
+	expiry, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return false
+	}
+
+	expiresAt, err := strconv.ParseInt(expiry, 10, 64)
+	if err != nil || time.Now().Unix() > expiresAt {
+		return false
+	}
+
+	return hmac.Equal([]byte(signature), []byte(s.accessSignature(linkKey, expiry)))
+}
+
+func (s *RedirectService) accessSignature(linkKey, expiry string) string {
+	mac := hmac.New(sha256.New, s.accessSecret)
+	mac.Write([]byte(linkKey + "|" + expiry))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// resolveShortDomain maps the request host to the short domain its links live on and
+// that domain's code policy: a registered branded domain, or "" with the default
+// policy for the default domain and any other host
+func (s *RedirectService) resolveShortDomain(ctx context.Context, host string) (string, alias.CodePolicy, error) {
+	host = normalizeHost(host)
+	if host == "" || domain.IsDefaultShortHost(host) {
+		return "", domain.DefaultCodePolicy, nil
+	}
+
+	codePolicy, branded, err := s.store.BrandedDomainPolicy(ctx, host)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to resolve short domain: %w", err)
+	}
+	if !branded {
+		return "", domain.DefaultCodePolicy, nil
+	}
+	return host, codePolicy, nil
+}
+
+// normalizeHost strips the port and trailing dot from a Host header and lower-cases it
+func normalizeHost(host string) string {
+	host = strings.TrimSpace(host)
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		host = hostname
+	}
+	return strings.TrimSuffix(strings.ToLower(host), ".")
+}
+
+// clientCountry returns the visitor country sent by the caller, falling back
+// to looking up the client IP in the country table
+func (s *RedirectService) clientCountry(clientInfo ClientInfo) string {
+	if clientInfo.Country != "" {
+		return clientInfo.Country
+	}
+	addr, err := netip.ParseAddr(clientInfo.ClientIP)
+	if err != nil {
+		return ""
+	}
+	return s.countries.Country(addr)
+}
+
 // TrackClick creates click analytics data for NATS publishing
-func (s *RedirectService) TrackClick(ctx context.Context, shortCode string, longURL string, clientInfo ClientInfo) (*ClickInfo, error) {
+func (s *RedirectService) TrackClick(ctx context.Context, shortDomain, shortCode string, longURL string, clientInfo ClientInfo) (*ClickInfo, error) {
 	// Parse user agent for device/browser information
 	deviceInfo := s.parseUserAgent(clientInfo.UserAgent)
 
@@ -157,30 +451,53 @@ func (s *RedirectService) TrackClick(ctx context.Context, shortCode string, long
 	isUnique := s.isUniqueClick(ctx, shortCode, clientInfo.ClientIP)
 
 	clickInfo := &ClickInfo{
+		Domain:     shortDomain,
 		ShortCode:  shortCode,
 		LongURL:    longURL,
 		ClientIP:   clientInfo.ClientIP,
 		UserAgent:  clientInfo.UserAgent,
 		Referrer:   clientInfo.Referrer,
-		Country:    clientInfo.Country,
+		Country:    s.clientCountry(clientInfo),
 		DeviceType: deviceInfo.DeviceType,
 		Browser:    deviceInfo.Browser,
 		OS:         deviceInfo.OS,
 		Timestamp:  time.Now(),
 		SessionID:  s.generateSessionID(clientInfo),
 		IsUnique:   isUnique,
+		UTM:        domain.ExtractUTMValues(longURL),
+		Source:     normalizeClickSource(clientInfo.Source),
+
+		AcceptLanguage: clientInfo.AcceptLanguage,
+		Accept:         clientInfo.Accept,
 	}
 
 	return clickInfo, nil
 }
 
+// normalizeClickSource keeps short lower-case source markers and drops anything else,
+// so arbitrary query strings cannot flood the analytics source column
+func normalizeClickSource(source string) string {
+	source = strings.ToLower(strings.TrimSpace(source))
+	if len(source) > maxClickSourceLength || !clickSourcePattern.MatchString(source) {
+		return ""
+	}
+	return source
+}
+
 // ClientInfo represents client request information
 type ClientInfo struct {
-	ClientIP   string
-	UserAgent  string
-	Referrer   string
-	Country    string
-	DeviceType string
+	ClientIP    string
+	UserAgent   string
+	Referrer    string
+	Country     string
+	DeviceType  string
+	AccessToken string // token issued by VerifyPassword for protected links
+	Confirmed   bool   // visitor chose to continue past the interstitial warning
+	Source      string // click source marker from ?src=, e.g. "qr"
+	Host        string // Host header the link was requested on; selects the short domain
+
+	AcceptLanguage string // Accept-Language header, for fraud scoring
+	Accept         string // Accept header, for fraud scoring
 }
 
 // DeviceInfo represents parsed device information
@@ -196,22 +513,17 @@ func (s *RedirectService) validateShortCode(shortCode string) error {
 		return fmt.Errorf("short code is empty")
 	}
 
-	// Check length (updated to be more flexible: 3-10 characters)
-	if len(shortCode) < 3 || len(shortCode) > 10 {
-		return fmt.Errorf("short code must be 3-10 characters long")
-	}
-
-	// Check characters (alphanumeric only)
-	matched, _ := regexp.MatchString("^[a-zA-Z0-9]+$", shortCode)
-	if !matched {
-		return fmt.Errorf("short code must contain only alphanumeric characters")
+	// Length and characters depend on the owner's plan, so only reject codes
+	// that no plan allows (letters, digits, inner - and _, up to 64 characters)
+	if !alias.WellFormed(shortCode) {
+		return fmt.Errorf("short code must be 1-%d letters, digits, - or _", alias.MaxLength)
 	}
 
 	return nil
 }
 
 // validateDestinationURL validates the destination URL for security
-func (s *RedirectService) validateDestinationURL(longURL string) error {
+func (s *RedirectService) validateDestinationURL(ctx context.Context, longURL string) error {
 	parsedURL, err := url.Parse(longURL)
 	if err != nil {
 		return fmt.Errorf("invalid URL format: %v", err)
@@ -227,43 +539,16 @@ func (s *RedirectService) validateDestinationURL(longURL string) error {
 		return fmt.Errorf("URL must have a valid host")
 	}
 
-	// Security check: block private IP ranges
-	if s.isPrivateIP(parsedURL.Host) {
-		return fmt.Errorf("cannot redirect to private IP addresses")
-	}
-
-	// Security check: block localhost
-	if strings.Contains(parsedURL.Host, "localhost") || strings.Contains(parsedURL.Host, "127.0.0.1") {
-		return fmt.Errorf("cannot redirect to localhost")
+	// Security check: block loopback, private, link-local, metadata and other internal networks.
+	// A host that does not resolve from here cannot be used to reach internal services, so it is allowed.
+	// Hostname verdicts come from a short-lived cache; IP literals are checked every time.
+	if err := s.destinations.ValidateHost(ctx, parsedURL.Hostname()); err != nil && !errors.Is(err, safety.ErrUnresolvableHost) {
+		return fmt.Errorf("cannot redirect to internal destinations: %w", err)
 	}
 
 	return nil
 }
 
-// isPrivateIP checks if the host is a private IP address
-func (s *RedirectService) isPrivateIP(host string) bool {
-	// Extract IP from host (could be host:port)
-	hostIP := host
-	if strings.Contains(host, ":") {
-		hostIP, _, _ = net.SplitHostPort(host)
-	}
-
-	ip := net.ParseIP(hostIP)
-	if ip == nil {
-		return false // Not an IP, probably a domain
-	}
-
-	// Check private IP ranges
-	private10 := net.ParseIP("10.0.0.0")
-	private172 := net.ParseIP("172.16.0.0")
-	private192 := net.ParseIP("192.168.0.0")
-
-	return ip.IsPrivate() ||
-		(ip.Mask(net.CIDRMask(8, 32)).Equal(private10)) ||
-		(ip.Mask(net.CIDRMask(12, 32)).Equal(private172)) ||
-		(ip.Mask(net.CIDRMask(16, 32)).Equal(private192))
-}
-
 // parseUserAgent extracts device and browser information from user agent
 func (s *RedirectService) parseUserAgent(userAgent string) DeviceInfo {
 	ua := strings.ToLower(userAgent)
@@ -326,13 +611,14 @@ func (s *RedirectService) generateSessionID(clientInfo ClientInfo) string {
 }
 
 // GetURLStats retrieves URL statistics for analytics
-func (s *RedirectService) GetURLStats(ctx context.Context, shortCode string) (map[string]interface{}, error) {
-	clickCount, err := s.store.GetClickCount(ctx, shortCode)
+func (s *RedirectService) GetURLStats(ctx context.Context, shortDomain, shortCode string) (map[string]interface{}, error) {
+	clickCount, err := s.store.GetClickCount(ctx, shortDomain, shortCode)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get click count: %w", err)
 	}
 
 	stats := map[string]interface{}{
+		"domain":      shortDomain,
 		"short_code":  shortCode,
 		"click_count": clickCount,
 		"timestamp":   time.Now(),
@@ -347,6 +633,6 @@ func (s *RedirectService) PrewarmPopularURLs(ctx context.Context, shortCodes []s
 }
 
 // InvalidateURL removes a URL from cache
-func (s *RedirectService) InvalidateURL(ctx context.Context, shortCode string) error {
-	return s.store.InvalidateCache(ctx, shortCode)
+func (s *RedirectService) InvalidateURL(ctx context.Context, shortDomain, shortCode string) error {
+	return s.store.InvalidateCache(ctx, shortDomain, shortCode)
 }
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"context"
+	"net/netip"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-systems-lab/go-url-shortener/services/url-shortener-svc/domain"
+	"github.com/go-systems-lab/go-url-shortener/utils/alias"
+	"github.com/go-systems-lab/go-url-shortener/utils/geo"
+	"github.com/go-systems-lab/go-url-shortener/utils/safety"
+)
+
+// fakeStore serves a single link; methods the redirect path does not use panic
+type fakeStore struct {
+	UrlStore
+	url *domain.URL
+}
+
+func (f *fakeStore) ResolveURL(_ context.Context, _, _ string, _ alias.CodePolicy) (*domain.URL, error) {
+	return f.url, nil
+}
+
+func (f *fakeStore) IncrementClickCount(context.Context, string, string) error {
+	return nil
+}
+
+type fakeResolver map[string]string
+
+func (f fakeResolver) LookupNetIP(_ context.Context, _, host string) ([]netip.Addr, error) {
+	return []netip.Addr{netip.MustParseAddr(f[host])}, nil
+}
+
+func TestResolveURLCountryPlaceholder(t *testing.T) {
+	store := &fakeStore{url: &domain.URL{
+		ShortCode:        "abc123",
+		LongURL:          "https://example.com/landing",
+		IsActive:         true,
+		InterstitialMode: "never",
+		UTMTemplate:      domain.UTMTemplate{"utm_campaign": "{short_code}-{country}"},
+	}}
+	network := safety.NewNetworkPolicy(fakeResolver{"example.com": "93.184.215.14"})
+	countries := geo.NewTable(geo.Network{Prefix: netip.MustParsePrefix("203.0.113.0/24"), Country: "DE"})
+	service := NewRedirectService(store, "secret", network, countries)
+
+	campaign := func(clientInfo ClientInfo) string {
+		result, err := service.ResolveURL(context.Background(), "abc123", clientInfo)
+		require.NoError(t, err)
+		require.True(t, result.Found, result.Error)
+		destination, err := url.Parse(result.LongURL)
+		require.NoError(t, err)
+		return destination.Query().Get("utm_campaign")
+	}
+
+	// Resolved from the client IP when the gateway sends no country
+	assert.Equal(t, "abc123-de", campaign(ClientInfo{ClientIP: "203.0.113.7"}))
+	// A country sent by the gateway wins
+	assert.Equal(t, "abc123-fr", campaign(ClientInfo{ClientIP: "203.0.113.7", Country: "FR"}))
+	// Unknown addresses leave the placeholder empty
+	assert.Equal(t, "abc123-", campaign(ClientInfo{ClientIP: "198.51.100.1"}))
+
+	click, err := service.TrackClick(context.Background(), "", "abc123", store.url.LongURL, ClientInfo{ClientIP: "203.0.113.7"})
+	require.NoError(t, err)
+	assert.Equal(t, "DE", click.Country)
+}
@@ -35,7 +35,9 @@ func NewRedirectHandler(service *domain.RedirectService, natsConn *nats.Conn, mi
 	}
 }
 
-// ResolveURL resolves a short code to the original URL (main redirect functionality)
+// ResolveURL resolves a short code to the original URL (main redirect functionality).
+// It does not publish click events: the gateway calls TrackClick once it redirects,
+// so every click reaches analytics exactly once.
 func (h *RedirectHandler) ResolveURL(ctx context.Context, req *pb.ResolveRequest, rsp *pb.ResolveResponse) error {
 	// DEBUG: Log incoming request
 	fmt.Printf("🔍 [DEBUG] ResolveURL called with shortCode: %s, clientIP: %s\n", req.ShortCode, req.ClientIp)
@@ -50,11 +52,17 @@ func (h *RedirectHandler) ResolveURL(ctx context.Context, req *pb.ResolveRequest
 
 	// 2. Extract client information
 	clientInfo := domain.ClientInfo{
-		ClientIP:   req.ClientIp,
-		UserAgent:  req.UserAgent,
-		Referrer:   req.Referrer,
-		Country:    req.Country,
-		DeviceType: req.DeviceType,
+		ClientIP:    req.ClientIp,
+		UserAgent:   req.UserAgent,
+		Referrer:    req.Referrer,
+		Country:     req.Country,
+		DeviceType:  req.DeviceType,
+		AccessToken: req.AccessToken,
+		Confirmed:   req.Confirmed,
+		Host:        req.Host,
+
+		AcceptLanguage: req.AcceptLanguage,
+		Accept:         req.Accept,
 	}
 
 	// If client IP is empty, try to extract from context (gRPC metadata)
@@ -75,15 +83,31 @@ func (h *RedirectHandler) ResolveURL(ctx context.Context, req *pb.ResolveRequest
 
 	fmt.Printf("✅ [DEBUG] service.ResolveURL result: Found=%v, LongURL=%s, Error=%s\n", result.Found, result.LongURL, result.Error)
 
-	// 4. If URL found and valid, track click event (async)
-	if result.Found && !result.Expired {
-		go h.publishClickEvent(req.ShortCode, result.LongURL, clientInfo)
+	// 4. Announce that a click-limited URL just used its last click
+	if result.Exhausted {
+		go h.publishLifecycleEvent(result.Domain, result.ShortCode, "exhausted", result.ClickCount, result.MaxClicks)
 	}
 
 	// 5. Build response
+	rsp.Domain = result.Domain
+	rsp.ShortCode = result.ShortCode
 	rsp.LongUrl = result.LongURL
 	rsp.Found = result.Found
 	rsp.Expired = result.Expired
+	rsp.RequiresPassword = result.RequiresPassword
+	rsp.NotYetActive = result.NotYetActive
+	rsp.FallbackUrl = result.FallbackURL
+	rsp.Interstitial = result.Interstitial
+	rsp.DestinationHost = result.DestinationHost
+	if result.Unfurl {
+		rsp.Unfurl = true
+		rsp.PreviewTitle = result.Preview.Title
+		rsp.PreviewDescription = result.Preview.Description
+		rsp.PreviewImage = result.Preview.Image
+	}
+	if result.ActivatesAt != nil {
+		rsp.ActivatesAt = result.ActivatesAt.Unix()
+	}
 	rsp.ClickCount = result.ClickCount
 	rsp.Error = result.Error
 
@@ -114,10 +138,14 @@ func (h *RedirectHandler) TrackClick(ctx context.Context, req *pb.ClickRequest,
 		Referrer:   req.Referrer,
 		Country:    req.Country,
 		DeviceType: req.DeviceType,
+		Source:     req.Source,
+
+		AcceptLanguage: req.AcceptLanguage,
+		Accept:         req.Accept,
 	}
 
 	// 3. Create click analytics data
-	clickInfo, err := h.service.TrackClick(ctx, req.ShortCode, req.LongUrl, clientInfo)
+	clickInfo, err := h.service.TrackClick(ctx, req.Domain, req.ShortCode, req.LongUrl, clientInfo)
 	if err != nil {
 		rsp.Success = false
 		rsp.Error = fmt.Sprintf("Failed to process click: %v", err)
@@ -135,60 +163,52 @@ func (h *RedirectHandler) TrackClick(ctx context.Context, req *pb.ClickRequest,
 	return nil
 }
 
-// Health check endpoint
-func (h *RedirectHandler) Health(ctx context.Context, req *pb.HealthRequest, rsp *pb.HealthResponse) error {
-	rsp.Status = "ok"
-	rsp.Service = h.serviceName
-	rsp.Version = h.version
-	rsp.Timestamp = time.Now().Unix()
-	return nil
-}
-
-// publishClickEvent publishes click event to NATS via Go Micro broker for analytics
-func (h *RedirectHandler) publishClickEvent(shortCode, longURL string, clientInfo domain.ClientInfo) {
-	// Create click event data
-	clickEvent := &pb.ClickEvent{
-		ShortCode:  shortCode,
-		LongUrl:    longURL,
-		ClientIp:   clientInfo.ClientIP,
-		UserAgent:  clientInfo.UserAgent,
-		Referrer:   clientInfo.Referrer,
-		Country:    clientInfo.Country,
-		DeviceType: clientInfo.DeviceType,
-		Timestamp:  time.Now().Unix(),
+// VerifyPassword checks the password of a protected link and issues an access token
+func (h *RedirectHandler) VerifyPassword(ctx context.Context, req *pb.VerifyPasswordRequest, rsp *pb.VerifyPasswordResponse) error {
+	if req.ShortCode == "" || req.Password == "" {
+		rsp.Success = false
+		rsp.Error = "Short code and password are required"
+		return nil
 	}
 
-	// Additional analytics data from domain service
-	clickInfo, err := h.service.TrackClick(context.Background(), shortCode, longURL, clientInfo)
-	if err == nil {
-		clickEvent.City = clickInfo.City
-		clickEvent.Browser = clickInfo.Browser
-		clickEvent.Os = clickInfo.OS
-		clickEvent.SessionId = clickInfo.SessionID
-		clickEvent.IsUnique = clickInfo.IsUnique
+	clientIP := req.ClientIp
+	if clientIP == "" {
+		clientIP = h.extractClientIP(ctx)
 	}
 
-	// JSON marshal the event for proper transmission
-	eventData, err := json.Marshal(clickEvent)
+	result, err := h.service.VerifyPassword(ctx, req.Host, req.ShortCode, req.Password, clientIP)
 	if err != nil {
-		fmt.Printf("Failed to marshal click event: %v\n", err)
-		return
+		rsp.Success = false
+		rsp.Error = fmt.Sprintf("Internal error: %v", err)
+		return nil
 	}
 
-	// Publish using Go Micro broker with JSON data
-	message := h.microService.Client().NewMessage("url.clicked", eventData)
-	if err := h.microService.Client().Publish(context.Background(), message); err != nil {
-		fmt.Printf("Failed to publish click event to NATS: %v\n", err)
-		return
+	rsp.Success = result.Success
+	rsp.Error = result.Error
+	rsp.RetryAfter = int64(result.RetryAfter.Seconds())
+	if result.Success {
+		rsp.ShortCode = result.ShortCode
+		rsp.AccessToken = result.AccessToken
+		rsp.ExpiresAt = result.ExpiresAt.Unix()
 	}
 
-	fmt.Printf("✅ Published click event for %s to NATS (JSON: %s)\n", shortCode, string(eventData))
+	return nil
+}
+
+// Health check endpoint
+func (h *RedirectHandler) Health(ctx context.Context, req *pb.HealthRequest, rsp *pb.HealthResponse) error {
+	rsp.Status = "ok"
+	rsp.Service = h.serviceName
+	rsp.Version = h.version
+	rsp.Timestamp = time.Now().Unix()
+	return nil
 }
 
 // publishClickEventFromInfo publishes click event from ClickInfo struct
 func (h *RedirectHandler) publishClickEventFromInfo(clickInfo *domain.ClickInfo) error {
 	// Create click event protobuf
 	clickEvent := &pb.ClickEvent{
+		Domain:     clickInfo.Domain,
 		ShortCode:  clickInfo.ShortCode,
 		LongUrl:    clickInfo.LongURL,
 		ClientIp:   clickInfo.ClientIP,
@@ -202,7 +222,12 @@ func (h *RedirectHandler) publishClickEventFromInfo(clickInfo *domain.ClickInfo)
 		Timestamp:  clickInfo.Timestamp.Unix(),
 		SessionId:  clickInfo.SessionID,
 		IsUnique:   clickInfo.IsUnique,
+		Source:     clickInfo.Source,
+
+		AcceptLanguage: clickInfo.AcceptLanguage,
+		Accept:         clickInfo.Accept,
 	}
+	setClickEventUTM(clickEvent, clickInfo.UTM)
 
 	// JSON marshal the event for proper transmission
 	eventData, err := json.Marshal(clickEvent)
@@ -219,6 +244,41 @@ func (h *RedirectHandler) publishClickEventFromInfo(clickInfo *domain.ClickInfo)
 	return nil
 }
 
+// publishLifecycleEvent publishes a link state transition to NATS via Go Micro broker
+func (h *RedirectHandler) publishLifecycleEvent(shortDomain, shortCode, event string, clickCount, maxClicks int64) {
+	lifecycleEvent := &pb.LifecycleEvent{
+		Domain:     shortDomain,
+		ShortCode:  shortCode,
+		Event:      event,
+		Timestamp:  time.Now().Unix(),
+		ClickCount: clickCount,
+		MaxClicks:  maxClicks,
+	}
+
+	eventData, err := json.Marshal(lifecycleEvent)
+	if err != nil {
+		fmt.Printf("Failed to marshal lifecycle event: %v\n", err)
+		return
+	}
+
+	message := h.microService.Client().NewMessage("url.lifecycle", eventData)
+	if err := h.microService.Client().Publish(context.Background(), message); err != nil {
+		fmt.Printf("Failed to publish lifecycle event to NATS: %v\n", err)
+		return
+	}
+
+	fmt.Printf("✅ Published %s lifecycle event for %s to NATS\n", event, shortCode)
+}
+
+// setClickEventUTM copies the UTM values sent to the destination onto the click event
+func setClickEventUTM(clickEvent *pb.ClickEvent, utm map[string]string) {
+	clickEvent.UtmSource = utm["utm_source"]
+	clickEvent.UtmMedium = utm["utm_medium"]
+	clickEvent.UtmCampaign = utm["utm_campaign"]
+	clickEvent.UtmTerm = utm["utm_term"]
+	clickEvent.UtmContent = utm["utm_content"]
+}
+
 // extractClientIP extracts client IP from gRPC context/metadata
 func (h *RedirectHandler) extractClientIP(ctx context.Context) string {
 	// Try to get IP from gRPC peer info
@@ -248,8 +308,8 @@ func (h *RedirectHandler) sanitizeClientIP(ip string) string {
 }
 
 // GetRedirectStats returns redirect service statistics (extension method)
-func (h *RedirectHandler) GetRedirectStats(ctx context.Context, shortCode string) (map[string]interface{}, error) {
-	return h.service.GetURLStats(ctx, shortCode)
+func (h *RedirectHandler) GetRedirectStats(ctx context.Context, shortDomain, shortCode string) (map[string]interface{}, error) {
+	return h.service.GetURLStats(ctx, shortDomain, shortCode)
 }
 
 // PrewarmCache preloads popular URLs into cache (extension method)
@@ -258,6 +318,6 @@ func (h *RedirectHandler) PrewarmCache(ctx context.Context, shortCodes []string)
 }
 
 // InvalidateURL removes a URL from cache (extension method)
-func (h *RedirectHandler) InvalidateURL(ctx context.Context, shortCode string) error {
-	return h.service.InvalidateURL(ctx, shortCode)
+func (h *RedirectHandler) InvalidateURL(ctx context.Context, shortDomain, shortCode string) error {
+	return h.service.InvalidateURL(ctx, shortDomain, shortCode)
 }
@@ -2,6 +2,8 @@ package microservice
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"time"
@@ -21,6 +23,9 @@ import (
 	"github.com/go-systems-lab/go-url-shortener/services/redirect-svc/domain"
 	"github.com/go-systems-lab/go-url-shortener/services/redirect-svc/handler"
 	"github.com/go-systems-lab/go-url-shortener/services/redirect-svc/store"
+	urlDomain "github.com/go-systems-lab/go-url-shortener/services/url-shortener-svc/domain"
+	"github.com/go-systems-lab/go-url-shortener/utils/geo"
+	"github.com/go-systems-lab/go-url-shortener/utils/safety"
 	"github.com/go-systems-lab/go-url-shortener/utils/tracing"
 )
 
@@ -53,6 +58,8 @@ func Init(opts *ClientOptions) (*Microservice, error) {
 	}
 
 	// Initialize dependencies
+	configureDefaultDomain(opts.Log)
+
 	db, err := initializePostgreSQL(opts.Log)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize PostgreSQL: %w", err)
@@ -70,7 +77,7 @@ func Init(opts *ClientOptions) (*Microservice, error) {
 
 	// Create service layers
 	redirectStore := store.NewRedirectStore(db, redisClient)
-	redirectService := domain.NewRedirectService(redirectStore)
+	redirectService := domain.NewRedirectService(redirectStore, accessSecret(opts.Log), networkPolicy(opts.Log), countryTable(opts.Log))
 
 	// Create Go Micro service with NATS plugins
 	service := micro.NewService(
@@ -136,6 +143,71 @@ func initializePostgreSQL(log *logrus.Logger) (*sqlx.DB, error) {
 	return db, nil
 }
 
+// accessSecret returns the key used to sign access tokens for password-protected links.
+// Without REDIRECT_ACCESS_SECRET a random per-process key is generated, so access
+// tokens only last as long as the process and are not shared between replicas.
+func accessSecret(log *logrus.Logger) string {
+	if secret := os.Getenv("REDIRECT_ACCESS_SECRET"); secret != "" {
+		return secret
+	}
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		log.WithError(err).Fatal("Failed to generate access token secret")
+	}
+	log.Warn("REDIRECT_ACCESS_SECRET not set, using a random per-process secret")
+	return hex.EncodeToString(key)
+}
+
+// configureDefaultDomain applies SHORT_URL_BASE, the origin of the default short
+// domain (requests on any other host that is not a branded domain fall back to
+// it), and SHORT_CODE_POLICY, the code policy of the default domain
+func configureDefaultDomain(log *logrus.Logger) {
+	if base := os.Getenv("SHORT_URL_BASE"); base != "" {
+		if err := urlDomain.ConfigureShortURLBase(base); err != nil {
+			log.WithError(err).Fatal("Invalid SHORT_URL_BASE")
+		}
+	}
+	if codePolicy := os.Getenv("SHORT_CODE_POLICY"); codePolicy != "" {
+		if err := urlDomain.ConfigureCodePolicy(codePolicy); err != nil {
+			log.WithError(err).Fatal("Invalid SHORT_CODE_POLICY")
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"short_url_base": urlDomain.DefaultShortURLBase,
+		"code_policy":    urlDomain.DefaultCodePolicy,
+	}).Info("Default short domain configured")
+}
+
+// networkPolicy builds the destination network policy; SAFETY_ALLOWED_NETWORKS
+// (comma-separated CIDRs) opens internal ranges for internal deployments
+func networkPolicy(log *logrus.Logger) *safety.NetworkPolicy {
+	allowed, err := safety.ParseNetworks(os.Getenv("SAFETY_ALLOWED_NETWORKS"))
+	if err != nil {
+		log.WithError(err).Fatal("Invalid SAFETY_ALLOWED_NETWORKS")
+	}
+	if len(allowed) > 0 {
+		log.WithField("allowed_networks", allowed).Warn("Internal destination networks are allowed")
+	}
+	return safety.NewNetworkPolicy(nil, allowed...)
+}
+
+// countryTable loads GEO_COUNTRY_FILE, a file of "CIDR country" lines used to
+// resolve the visitor country from the client IP. Without it the country is
+// only known when the gateway sends one.
+func countryTable(log *logrus.Logger) *geo.Table {
+	path := os.Getenv("GEO_COUNTRY_FILE")
+	if path == "" {
+		return nil
+	}
+	networks, err := geo.LoadFile(path)
+	if err != nil {
+		log.WithError(err).Fatal("Invalid GEO_COUNTRY_FILE")
+	}
+	table := geo.NewTable(networks...)
+	log.WithField("networks", table.Len()).Info("Country table loaded")
+	return table
+}
+
 // initializeRedis connects to Redis cache
 func initializeRedis(log *logrus.Logger) (*redis.Client, error) {
 	redisURL := os.Getenv("REDIS_URL")
@@ -2,14 +2,18 @@ package store
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/redis/go-redis/v9"
 
 	"github.com/go-systems-lab/go-url-shortener/services/url-shortener-svc/domain"
+	"github.com/go-systems-lab/go-url-shortener/utils/alias"
+	"github.com/go-systems-lab/go-url-shortener/utils/cache"
 )
 
 // RedirectStore handles URL resolution with cache-first strategy
@@ -20,12 +24,31 @@ type RedirectStore struct {
 
 // CacheEntry represents a cached URL mapping
 type CacheEntry struct {
-	ShortCode  string     `json:"short_code"`
-	LongURL    string     `json:"long_url"`
-	CreatedAt  time.Time  `json:"created_at"`
-	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
-	ClickCount int64      `json:"click_count"`
-	IsActive   bool       `json:"is_active"`
+	Domain       string             `json:"domain,omitempty"`
+	ShortCode    string             `json:"short_code"`
+	LongURL      string             `json:"long_url"`
+	CreatedAt    time.Time          `json:"created_at"`
+	ExpiresAt    *time.Time         `json:"expires_at,omitempty"`
+	ClickCount   int64              `json:"click_count"`
+	IsActive     bool               `json:"is_active"`
+	WorkspaceID  string             `json:"workspace_id,omitempty"`
+	UTMTemplate  domain.UTMTemplate `json:"utm_template,omitempty"` // link template merged over the workspace template
+	PasswordHash string             `json:"password_hash,omitempty"`
+	ActivatesAt  *time.Time         `json:"activates_at,omitempty"`
+	FallbackURL  string             `json:"fallback_url,omitempty"`
+	MaxClicks    int64              `json:"max_clicks,omitempty"`
+
+	// Interstitial inputs, resolved when the entry is cached
+	InterstitialMode string     `json:"interstitial_mode,omitempty"`
+	DomainStatus     string     `json:"domain_status,omitempty"`
+	OwnerSince       *time.Time `json:"owner_since,omitempty"`
+
+	Preview         domain.LinkPreview `json:"preview,omitempty"`
+	PreviewOverride domain.LinkPreview `json:"preview_override,omitempty"`
+
+	// CaseUnique marks codes with no case variants on their domain; only those
+	// may answer for other spellings under a case-insensitive code policy
+	CaseUnique bool `json:"case_unique,omitempty"`
 }
 
 // NewRedirectStore creates a new redirect store
@@ -36,17 +59,33 @@ func NewRedirectStore(db *sqlx.DB, redis *redis.Client) *RedirectStore {
 	}
 }
 
-// ResolveURL resolves a short code to long URL using cache-first strategy
-func (s *RedirectStore) ResolveURL(ctx context.Context, shortCode string) (*domain.URL, error) {
+// Branded domain lookups are cached briefly; the URL service drops the entry when a domain is added or removed
+const brandedDomainCacheTTL = 5 * time.Minute
+
+// ResolveURL resolves a short code on a short domain ("" for the default) to long URL using cache-first strategy.
+// Under a case-insensitive code policy any spelling of the code matches: an exact match wins, then the
+// oldest case variant (mixed-case codes created before the domain switched policy are kept as they are).
+// Entries are cached under the stored spelling; codes without case variants are also cached under their
+// lower-case spelling, so a variant never picks up another code's entry.
+func (s *RedirectStore) ResolveURL(ctx context.Context, shortDomain, shortCode string, codePolicy alias.CodePolicy) (*domain.URL, error) {
 	// 1. Check Redis cache first (95% hit ratio expected)
-	cacheKey := fmt.Sprintf("url:short:%s", shortCode)
+	cacheKeys := []string{cache.RedirectCacheKey(shortDomain, shortCode)}
+	if canonical := strings.ToLower(shortCode); codePolicy.CaseInsensitive() && canonical != shortCode {
+		cacheKeys = append(cacheKeys, cache.RedirectCacheKey(shortDomain, canonical))
+	}
 
-	// Try to get from cache
-	cached, err := s.redis.Get(ctx, cacheKey).Result()
-	if err == nil {
+	for _, cacheKey := range cacheKeys {
+		cached, err := s.redis.Get(ctx, cacheKey).Result()
+		if err != nil {
+			continue
+		}
 		// Cache hit - parse and return
 		var entry CacheEntry
 		if err := json.Unmarshal([]byte(cached), &entry); err == nil {
+			if entry.ShortCode != shortCode && !(codePolicy.CaseInsensitive() && entry.CaseUnique) {
+				continue
+			}
+
 			// Check if expired
 			if entry.ExpiresAt != nil && time.Now().After(*entry.ExpiresAt) {
 				// Expired - remove from cache and treat as miss
@@ -56,13 +95,27 @@ func (s *RedirectStore) ResolveURL(ctx context.Context, shortCode string) (*doma
 
 			// Valid cache hit
 			return &domain.URL{
-				ID:         0, // Not needed for redirect
-				ShortCode:  entry.ShortCode,
-				LongURL:    entry.LongURL,
-				CreatedAt:  entry.CreatedAt,
-				ExpiresAt:  entry.ExpiresAt,
-				ClickCount: entry.ClickCount,
-				IsActive:   entry.IsActive,
+				ID:           0, // Not needed for redirect
+				Domain:       entry.Domain,
+				ShortCode:    entry.ShortCode,
+				LongURL:      entry.LongURL,
+				CreatedAt:    entry.CreatedAt,
+				ExpiresAt:    entry.ExpiresAt,
+				ClickCount:   entry.ClickCount,
+				IsActive:     entry.IsActive,
+				WorkspaceID:  entry.WorkspaceID,
+				UTMTemplate:  entry.UTMTemplate,
+				PasswordHash: entry.PasswordHash,
+				ActivatesAt:  entry.ActivatesAt,
+				FallbackURL:  entry.FallbackURL,
+				MaxClicks:    entry.MaxClicks,
+
+				InterstitialMode: entry.InterstitialMode,
+				DomainStatus:     entry.DomainStatus,
+				OwnerSince:       entry.OwnerSince,
+
+				Preview:         entry.Preview,
+				PreviewOverride: entry.PreviewOverride,
 			}, nil
 		}
 	}
@@ -71,6 +124,7 @@ func (s *RedirectStore) ResolveURL(ctx context.Context, shortCode string) (*doma
 	// Use a temporary struct without metadata to avoid JSON unmarshaling issues
 	var dbResult struct {
 		ID           int64      `db:"id"`
+		Domain       string     `db:"domain"`
 		ShortCode    string     `db:"short_code"`
 		LongURL      string     `db:"long_url"`
 		UserID       string     `db:"user_id"`
@@ -79,16 +133,37 @@ func (s *RedirectStore) ResolveURL(ctx context.Context, shortCode string) (*doma
 		ClickCount   int64      `db:"click_count"`
 		LastAccessed *time.Time `db:"last_accessed"`
 		IsActive     bool       `db:"is_active"`
+		WorkspaceID  *string    `db:"workspace_id"`
+		UTMTemplate  string     `db:"utm_template"`
+		WorkspaceUTM string     `db:"workspace_utm_template"`
+		PasswordHash *string    `db:"password_hash"`
+		ActivatesAt  *time.Time `db:"activates_at"`
+		FallbackURL  *string    `db:"fallback_url"`
+		MaxClicks    *int64     `db:"max_clicks"`
+		Interstitial string     `db:"interstitial_mode"`
+		OwnerSince   *time.Time `db:"owner_since"`
+		Preview      string     `db:"link_preview"`
+		Override     string     `db:"preview_override"`
 	}
 
 	query := `
-		SELECT id, short_code, long_url, user_id, created_at, expires_at, 
-		       click_count, last_accessed, is_active
-		FROM url_mappings 
-		WHERE short_code = $1 AND is_active = true
+		SELECT m.id, m.domain, m.short_code, m.long_url, m.user_id, m.created_at, m.expires_at, 
+		       m.click_count, m.last_accessed, m.is_active, m.workspace_id, m.password_hash,
+		       m.activates_at, m.fallback_url, m.max_clicks, m.interstitial_mode,
+		       (SELECT MIN(o.created_at) FROM url_mappings o WHERE o.user_id = m.user_id) AS owner_since,
+		       COALESCE(m.utm_template, '{}'::jsonb)::text AS utm_template,
+		       COALESCE(w.utm_template, '{}'::jsonb)::text AS workspace_utm_template,
+		       m.link_preview::text AS link_preview, m.preview_override::text AS preview_override
+		FROM url_mappings m
+		LEFT JOIN workspaces w ON w.id = m.workspace_id
+		WHERE m.domain = $1 AND m.short_code = $2 AND m.is_active = true
 	`
+	if codePolicy.CaseInsensitive() {
+		query = strings.Replace(query, "m.short_code = $2", "lower(m.short_code) = lower($2)", 1) +
+			` ORDER BY m.short_code = $2 DESC, m.id LIMIT 1`
+	}
 
-	err = s.db.GetContext(ctx, &dbResult, query, shortCode)
+	err := s.db.GetContext(ctx, &dbResult, query, shortDomain, shortCode)
 	if err != nil {
 		return nil, fmt.Errorf("short URL not found")
 	}
@@ -96,6 +171,7 @@ func (s *RedirectStore) ResolveURL(ctx context.Context, shortCode string) (*doma
 	// Convert to domain.URL
 	url := &domain.URL{
 		ID:           dbResult.ID,
+		Domain:       dbResult.Domain,
 		ShortCode:    dbResult.ShortCode,
 		LongURL:      dbResult.LongURL,
 		UserID:       dbResult.UserID,
@@ -104,8 +180,30 @@ func (s *RedirectStore) ResolveURL(ctx context.Context, shortCode string) (*doma
 		ClickCount:   dbResult.ClickCount,
 		LastAccessed: dbResult.LastAccessed,
 		IsActive:     dbResult.IsActive,
+		ActivatesAt:  dbResult.ActivatesAt,
 		Metadata:     make(map[string]string), // Empty metadata for redirect service
+		UTMTemplate: domain.MergeUTMTemplates(
+			domain.ParseUTMTemplate(dbResult.WorkspaceUTM),
+			domain.ParseUTMTemplate(dbResult.UTMTemplate),
+		),
+	}
+	if dbResult.WorkspaceID != nil {
+		url.WorkspaceID = *dbResult.WorkspaceID
 	}
+	if dbResult.PasswordHash != nil {
+		url.PasswordHash = *dbResult.PasswordHash
+	}
+	if dbResult.FallbackURL != nil {
+		url.FallbackURL = *dbResult.FallbackURL
+	}
+	if dbResult.MaxClicks != nil {
+		url.MaxClicks = *dbResult.MaxClicks
+	}
+	url.InterstitialMode = dbResult.Interstitial
+	url.OwnerSince = dbResult.OwnerSince
+	url.Preview = domain.ParseLinkPreview(dbResult.Preview)
+	url.PreviewOverride = domain.ParseLinkPreview(dbResult.Override)
+	url.DomainStatus = s.destinationStatus(ctx, url.LongURL)
 
 	// Check if URL has expired
 	if url.ExpiresAt != nil && time.Now().After(*url.ExpiresAt) {
@@ -114,39 +212,132 @@ func (s *RedirectStore) ResolveURL(ctx context.Context, shortCode string) (*doma
 
 	// 3. Update cache for future requests (write-through)
 	cacheEntry := CacheEntry{
-		ShortCode:  url.ShortCode,
-		LongURL:    url.LongURL,
-		CreatedAt:  url.CreatedAt,
-		ExpiresAt:  url.ExpiresAt,
-		ClickCount: url.ClickCount,
-		IsActive:   url.IsActive,
+		Domain:       url.Domain,
+		ShortCode:    url.ShortCode,
+		LongURL:      url.LongURL,
+		CreatedAt:    url.CreatedAt,
+		ExpiresAt:    url.ExpiresAt,
+		ClickCount:   url.ClickCount,
+		IsActive:     url.IsActive,
+		WorkspaceID:  url.WorkspaceID,
+		UTMTemplate:  url.UTMTemplate,
+		PasswordHash: url.PasswordHash,
+		ActivatesAt:  url.ActivatesAt,
+		FallbackURL:  url.FallbackURL,
+		MaxClicks:    url.MaxClicks,
+
+		InterstitialMode: url.InterstitialMode,
+		DomainStatus:     url.DomainStatus,
+		OwnerSince:       url.OwnerSince,
+
+		Preview:         url.Preview,
+		PreviewOverride: url.PreviewOverride,
+	}
+	if codePolicy.CaseInsensitive() {
+		cacheEntry.CaseUnique = s.caseUnique(ctx, url.Domain, url.ShortCode)
 	}
 
 	if entryJSON, err := json.Marshal(cacheEntry); err == nil {
-		// Cache for 24 hours as per HLD
-		s.redis.Set(ctx, cacheKey, entryJSON, 24*time.Hour)
+		// Cache for 24 hours as per HLD, but never past an activation window boundary
+		if ttl := url.CacheTTL(24 * time.Hour); ttl > 0 {
+			s.redis.Set(ctx, cache.RedirectCacheKey(url.Domain, url.ShortCode), entryJSON, ttl)
+			if canonical := strings.ToLower(url.ShortCode); cacheEntry.CaseUnique && canonical != url.ShortCode {
+				s.redis.Set(ctx, cache.RedirectCacheKey(url.Domain, canonical), entryJSON, ttl)
+			}
+		}
 	}
 
 	return url, nil
 }
 
+// caseUnique reports whether shortCode is the only active code on its domain among its case variants
+func (s *RedirectStore) caseUnique(ctx context.Context, shortDomain, shortCode string) bool {
+	var variants int
+	query := `SELECT COUNT(*) FROM url_mappings WHERE domain = $1 AND lower(short_code) = lower($2) AND is_active = true`
+	if err := s.db.GetContext(ctx, &variants, query, shortDomain, shortCode); err != nil {
+		return false
+	}
+	return variants == 1
+}
+
+// BrandedDomainPolicy reports whether host is a registered branded short domain and returns its code policy
+func (s *RedirectStore) BrandedDomainPolicy(ctx context.Context, host string) (alias.CodePolicy, bool, error) {
+	cacheKey := cache.BrandedDomainCacheKey(host)
+	if cached, err := s.redis.Get(ctx, cacheKey).Result(); err == nil {
+		switch cached {
+		case "0":
+			return "", false, nil
+		case "1":
+			// Entry cached before domains had code policies
+			return alias.CodePolicyMixed, true, nil
+		}
+		if codePolicy, err := alias.ParseCodePolicy(cached); err == nil {
+			return codePolicy, true, nil
+		}
+	}
+
+	var policies []string
+	query := `SELECT code_policy FROM branded_domains WHERE domain = $1`
+	if err := s.db.SelectContext(ctx, &policies, query, host); err != nil {
+		return "", false, fmt.Errorf("failed to look up branded domain: %w", err)
+	}
+	if len(policies) == 0 {
+		s.redis.Set(ctx, cacheKey, "0", brandedDomainCacheTTL)
+		return "", false, nil
+	}
+
+	codePolicy, err := alias.ParseCodePolicy(policies[0])
+	if err != nil {
+		codePolicy = alias.CodePolicyMixed
+	}
+	s.redis.Set(ctx, cacheKey, string(codePolicy), brandedDomainCacheTTL)
+
+	return codePolicy, true, nil
+}
+
+// destinationStatus looks up the review status of a destination's domain (most specific match wins)
+func (s *RedirectStore) destinationStatus(ctx context.Context, longURL string) string {
+	candidates := domain.CandidateDomains(domain.DestinationHost(longURL))
+	if len(candidates) == 0 {
+		return ""
+	}
+
+	var reviews []struct {
+		Domain string `db:"domain"`
+		Status string `db:"status"`
+	}
+	query := `SELECT domain, status FROM domain_reviews WHERE domain = ANY($1)`
+	if err := s.db.SelectContext(ctx, &reviews, query, candidates); err != nil {
+		return ""
+	}
+
+	for _, candidate := range candidates {
+		for _, review := range reviews {
+			if review.Domain == candidate {
+				return review.Status
+			}
+		}
+	}
+	return ""
+}
+
 // IncrementClickCount atomically increments the click count
-func (s *RedirectStore) IncrementClickCount(ctx context.Context, shortCode string) error {
+func (s *RedirectStore) IncrementClickCount(ctx context.Context, shortDomain, shortCode string) error {
 	// 1. Increment in database (persistent)
 	query := `
 		UPDATE url_mappings 
 		SET click_count = click_count + 1, 
 		    last_accessed = NOW() 
-		WHERE short_code = $1
+		WHERE domain = $1 AND short_code = $2
 	`
 
-	_, err := s.db.ExecContext(ctx, query, shortCode)
+	_, err := s.db.ExecContext(ctx, query, shortDomain, shortCode)
 	if err != nil {
 		return fmt.Errorf("failed to increment database count: %w", err)
 	}
 
 	// 2. Increment in cache (for fast access)
-	cacheKey := fmt.Sprintf("url:short:%s", shortCode)
+	cacheKey := cache.RedirectCacheKey(shortDomain, shortCode)
 
 	// Get current cache entry
 	cached, err := s.redis.Get(ctx, cacheKey).Result()
@@ -156,23 +347,60 @@ func (s *RedirectStore) IncrementClickCount(ctx context.Context, shortCode strin
 			// Increment count and update cache
 			entry.ClickCount++
 			if entryJSON, err := json.Marshal(entry); err == nil {
-				s.redis.Set(ctx, cacheKey, entryJSON, 24*time.Hour)
+				// Keep the TTL chosen when the entry was cached (window boundaries)
+				s.redis.Set(ctx, cacheKey, entryJSON, redis.KeepTTL)
 			}
 		}
 	}
 
 	// 3. Track click count in Redis counter (for analytics)
-	counterKey := fmt.Sprintf("clicks:counter:%s", shortCode)
+	counterKey := fmt.Sprintf("clicks:counter:%s", cache.LinkKey(shortDomain, shortCode))
 	s.redis.Incr(ctx, counterKey)
 	s.redis.Expire(ctx, counterKey, 30*24*time.Hour) // 30 days retention
 
 	return nil
 }
 
+// ConsumeClick atomically records a click on a click-limited URL.
+// The conditional update is serialized by the row lock, so concurrent redirects can never
+// push click_count past max_clicks. It reports false once the limit has been reached.
+func (s *RedirectStore) ConsumeClick(ctx context.Context, shortDomain, shortCode string) (int64, bool, error) {
+	query := `
+		UPDATE url_mappings
+		SET click_count = click_count + 1,
+		    last_accessed = NOW()
+		WHERE domain = $1 AND short_code = $2 AND is_active = true
+		  AND (max_clicks IS NULL OR click_count < max_clicks)
+		RETURNING click_count, COALESCE(max_clicks, 0)
+	`
+
+	var clickCount, maxClicks int64
+	err := s.db.QueryRowContext(ctx, query, shortDomain, shortCode).Scan(&clickCount, &maxClicks)
+	if err == sql.ErrNoRows {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to consume click: %w", err)
+	}
+
+	// The last click turns the cached entry stale; drop it so the next lookup sees the limit
+	// (case-insensitive domains also cache codes without case variants under the lower-case key)
+	if maxClicks > 0 && clickCount >= maxClicks {
+		s.redis.Del(ctx, cache.RedirectCacheKey(shortDomain, shortCode), cache.RedirectCacheKey(shortDomain, strings.ToLower(shortCode)))
+	}
+
+	// Track click count in Redis counter (for analytics)
+	counterKey := fmt.Sprintf("clicks:counter:%s", cache.LinkKey(shortDomain, shortCode))
+	s.redis.Incr(ctx, counterKey)
+	s.redis.Expire(ctx, counterKey, 30*24*time.Hour) // 30 days retention
+
+	return clickCount, true, nil
+}
+
 // GetClickCount gets the current click count from cache or database
-func (s *RedirectStore) GetClickCount(ctx context.Context, shortCode string) (int64, error) {
+func (s *RedirectStore) GetClickCount(ctx context.Context, shortDomain, shortCode string) (int64, error) {
 	// Try cache first
-	cacheKey := fmt.Sprintf("url:short:%s", shortCode)
+	cacheKey := cache.RedirectCacheKey(shortDomain, shortCode)
 	cached, err := s.redis.Get(ctx, cacheKey).Result()
 	if err == nil {
 		var entry CacheEntry
@@ -183,8 +411,8 @@ func (s *RedirectStore) GetClickCount(ctx context.Context, shortCode string) (in
 
 	// Fallback to database
 	var clickCount int64
-	query := `SELECT click_count FROM url_mappings WHERE short_code = $1`
-	err = s.db.GetContext(ctx, &clickCount, query, shortCode)
+	query := `SELECT click_count FROM url_mappings WHERE domain = $1 AND short_code = $2`
+	err = s.db.GetContext(ctx, &clickCount, query, shortDomain, shortCode)
 	if err != nil {
 		return 0, fmt.Errorf("short URL not found")
 	}
@@ -192,7 +420,7 @@ func (s *RedirectStore) GetClickCount(ctx context.Context, shortCode string) (in
 	return clickCount, nil
 }
 
-// PrewarmCache preloads popular URLs into cache
+// PrewarmCache preloads popular URLs on the default short domain into cache
 func (s *RedirectStore) PrewarmCache(ctx context.Context, shortCodes []string) error {
 	if len(shortCodes) == 0 {
 		return nil
@@ -200,9 +428,15 @@ func (s *RedirectStore) PrewarmCache(ctx context.Context, shortCodes []string) e
 
 	// Batch fetch from database
 	query := `
-		SELECT short_code, long_url, created_at, expires_at, click_count, is_active
-		FROM url_mappings 
-		WHERE short_code = ANY($1) AND is_active = true
+		SELECT m.short_code, m.long_url, m.created_at, m.expires_at, m.click_count, m.is_active,
+		       COALESCE(m.workspace_id, ''), COALESCE(m.password_hash, ''),
+		       m.activates_at, COALESCE(m.fallback_url, ''), COALESCE(m.max_clicks, 0), m.interstitial_mode,
+		       (SELECT MIN(o.created_at) FROM url_mappings o WHERE o.user_id = m.user_id),
+		       COALESCE(m.utm_template, '{}'::jsonb)::text,
+		       COALESCE(w.utm_template, '{}'::jsonb)::text
+		FROM url_mappings m
+		LEFT JOIN workspaces w ON w.id = m.workspace_id
+		WHERE m.domain = '' AND m.short_code = ANY($1) AND m.is_active = true
 	`
 
 	rows, err := s.db.QueryContext(ctx, query, shortCodes)
@@ -215,12 +449,22 @@ func (s *RedirectStore) PrewarmCache(ctx context.Context, shortCodes []string) e
 	pipe := s.redis.Pipeline()
 	for rows.Next() {
 		var entry CacheEntry
+		var linkUTM, workspaceUTM string
 		err := rows.Scan(&entry.ShortCode, &entry.LongURL, &entry.CreatedAt,
-			&entry.ExpiresAt, &entry.ClickCount, &entry.IsActive)
+			&entry.ExpiresAt, &entry.ClickCount, &entry.IsActive,
+			&entry.WorkspaceID, &entry.PasswordHash, &entry.ActivatesAt, &entry.FallbackURL, &entry.MaxClicks,
+			&entry.InterstitialMode, &entry.OwnerSince,
+			&linkUTM, &workspaceUTM)
 		if err == nil {
-			if entryJSON, err := json.Marshal(entry); err == nil {
-				cacheKey := fmt.Sprintf("url:short:%s", entry.ShortCode)
-				pipe.Set(ctx, cacheKey, entryJSON, 24*time.Hour)
+			entry.DomainStatus = s.destinationStatus(ctx, entry.LongURL)
+			entry.UTMTemplate = domain.MergeUTMTemplates(
+				domain.ParseUTMTemplate(workspaceUTM),
+				domain.ParseUTMTemplate(linkUTM),
+			)
+			ttl := (&domain.URL{ActivatesAt: entry.ActivatesAt, ExpiresAt: entry.ExpiresAt}).CacheTTL(24 * time.Hour)
+			if entryJSON, err := json.Marshal(entry); err == nil && ttl > 0 {
+				cacheKey := cache.RedirectCacheKey("", entry.ShortCode)
+				pipe.Set(ctx, cacheKey, entryJSON, ttl)
 			}
 		}
 	}
@@ -230,11 +474,45 @@ func (s *RedirectStore) PrewarmCache(ctx context.Context, shortCodes []string) e
 }
 
 // InvalidateCache removes a URL from cache (useful for updates/deletions)
-func (s *RedirectStore) InvalidateCache(ctx context.Context, shortCode string) error {
-	cacheKey := fmt.Sprintf("url:short:%s", shortCode)
+func (s *RedirectStore) InvalidateCache(ctx context.Context, shortDomain, shortCode string) error {
+	cacheKey := cache.RedirectCacheKey(shortDomain, shortCode)
 	return s.redis.Del(ctx, cacheKey).Err()
 }
 
+// RecordFailedPasswordAttempt counts a failed password attempt for a client IP within the window
+func (s *RedirectStore) RecordFailedPasswordAttempt(ctx context.Context, clientIP string, window time.Duration) (int64, error) {
+	key := fmt.Sprintf("password:attempts:%s", clientIP)
+
+	pipe := s.redis.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.ExpireNX(ctx, key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("failed to record password attempt: %w", err)
+	}
+
+	return incr.Val(), nil
+}
+
+// GetFailedPasswordAttempts returns the failed attempt count for a client IP and the time until it resets
+func (s *RedirectStore) GetFailedPasswordAttempts(ctx context.Context, clientIP string) (int64, time.Duration, error) {
+	key := fmt.Sprintf("password:attempts:%s", clientIP)
+
+	count, err := s.redis.Get(ctx, key).Int64()
+	if err == redis.Nil {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to read password attempts: %w", err)
+	}
+
+	ttl, err := s.redis.TTL(ctx, key).Result()
+	if err != nil {
+		return count, 0, nil
+	}
+
+	return count, ttl, nil
+}
+
 // GetCacheStats returns cache performance metrics
 func (s *RedirectStore) GetCacheStats(ctx context.Context) (map[string]interface{}, error) {
 	info := s.redis.Info(ctx, "stats").Val()
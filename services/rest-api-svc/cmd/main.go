@@ -52,6 +52,10 @@ type HealthResponse struct {
 //
 // @host		localhost:8082
 // @BasePath	/api/v1
+//
+// @securityDefinitions.apikey	AdminToken
+// @in							header
+// @name						X-Admin-Token
 func main() {
 	logger := logrus.New()
 	logger.SetFormatter(&logrus.JSONFormatter{})
@@ -115,6 +119,9 @@ func main() {
 	// Add observability middleware
 	router.Use(metrics.GinMiddleware("rest-api"))
 
+	// Tag requests with an ID for the audit log
+	router.Use(handler.RequestID())
+
 	// Add distributed tracing middleware
 	if tp != nil {
 		logger.Info("🔍 Registering distributed tracing middleware")
@@ -158,7 +165,7 @@ func main() {
 	router.Use(func(c *gin.Context) {
 		c.Header("Access-Control-Allow-Origin", "*")
 		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		c.Header("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		c.Header("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Admin-Token, X-Request-ID")
 
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
@@ -192,6 +199,7 @@ func main() {
         .method { font-weight: bold; padding: 4px 8px; border-radius: 3px; color: white; margin-right: 10px; }
         .get { background: #27ae60; }
         .post { background: #e74c3c; }
+        .put { background: #8e44ad; }
         .delete { background: #e67e22; }
         a { color: #3498db; text-decoration: none; }
         a:hover { text-decoration: underline; }
@@ -213,14 +221,137 @@ func main() {
         <div class="endpoint">
             <span class="method post">POST</span> <strong>/api/v1/shorten</strong> - Create a short URL
         </div>
+        <div class="endpoint">
+            <span class="method get">GET</span> <strong>/api/v1/aliases/suggestions</strong> - Suggest available custom aliases
+        </div>
+        <div class="endpoint">
+            <span class="method get">GET</span> <strong>/api/v1/urls/search</strong> - Search links with tag, domain and status facets
+        </div>
         <div class="endpoint">
             <span class="method get">GET</span> <strong>/api/v1/urls/{shortCode}</strong> - Get URL information
         </div>
         <div class="endpoint">
-            <span class="method delete">DELETE</span> <strong>/api/v1/urls/{shortCode}</strong> - Delete a URL
+            <span class="method put">PUT</span> <strong>/api/v1/urls/{shortCode}</strong> - Update a URL
+        </div>
+        <div class="endpoint">
+            <span class="method delete">DELETE</span> <strong>/api/v1/urls/{shortCode}</strong> - Move a URL to the trash
+        </div>
+        <div class="endpoint">
+            <span class="method post">POST</span> <strong>/api/v1/urls/{shortCode}/restore</strong> - Restore a URL from the trash
+        </div>
+        <div class="endpoint">
+            <span class="method post">POST</span> <strong>/api/v1/urls/{shortCode}/revive</strong> - Revive a URL archived for inactivity
         </div>
         <div class="endpoint">
-            <span class="method get">GET</span> <strong>/api/v1/users/{userID}/urls</strong> - List user URLs
+            <span class="method get">GET</span> <strong>/api/v1/urls/{shortCode}/revisions</strong> - List a URL's revision history
+        </div>
+        <div class="endpoint">
+            <span class="method post">POST</span> <strong>/api/v1/urls/{shortCode}/revisions/{revisionID}/rollback</strong> - Roll a URL back to before a revision
+        </div>
+        <div class="endpoint">
+            <span class="method get">GET</span> <strong>/api/v1/urls/{shortCode}/health</strong> - Get destination health
+        </div>
+        <div class="endpoint">
+            <span class="method get">GET</span> <strong>/api/v1/urls/{shortCode}/qr</strong> - Get a PNG or SVG QR code
+        </div>
+        <div class="endpoint">
+            <span class="method get">GET</span> <strong>/api/v1/users/{userID}/urls</strong> - List user URLs, filtered by tag or folder
+        </div>
+        <div class="endpoint">
+            <span class="method get">GET</span> <strong>/api/v1/users/{userID}/trash</strong> - List deleted URLs that can still be restored
+        </div>
+        <div class="endpoint">
+            <span class="method post">POST</span> <strong>/api/v1/users/{userID}/tags</strong> - Create a tag
+        </div>
+        <div class="endpoint">
+            <span class="method get">GET</span> <strong>/api/v1/users/{userID}/tags</strong> - List tags
+        </div>
+        <div class="endpoint">
+            <span class="method put">PUT</span> <strong>/api/v1/users/{userID}/tags/{tag}</strong> - Rename a tag
+        </div>
+        <div class="endpoint">
+            <span class="method delete">DELETE</span> <strong>/api/v1/users/{userID}/tags/{tag}</strong> - Delete a tag
+        </div>
+        <div class="endpoint">
+            <span class="method post">POST</span> <strong>/api/v1/users/{userID}/folders</strong> - Create a folder
+        </div>
+        <div class="endpoint">
+            <span class="method get">GET</span> <strong>/api/v1/users/{userID}/folders</strong> - List folders
+        </div>
+        <div class="endpoint">
+            <span class="method put">PUT</span> <strong>/api/v1/users/{userID}/folders/{folderID}</strong> - Rename or move a folder
+        </div>
+        <div class="endpoint">
+            <span class="method delete">DELETE</span> <strong>/api/v1/users/{userID}/folders/{folderID}</strong> - Delete a folder
+        </div>
+        <div class="endpoint">
+            <span class="method put">PUT</span> <strong>/api/v1/workspaces/{workspaceID}</strong> - Create or update a workspace UTM template
+        </div>
+        <div class="endpoint">
+            <span class="method get">GET</span> <strong>/api/v1/workspaces/{workspaceID}</strong> - Get workspace information
+        </div>
+        <div class="endpoint">
+            <span class="method post">POST</span> <strong>/api/v1/workspaces/{workspaceID}/domains</strong> - Attach a branded short domain
+        </div>
+        <div class="endpoint">
+            <span class="method get">GET</span> <strong>/api/v1/workspaces/{workspaceID}/domains</strong> - List branded short domains
+        </div>
+        <div class="endpoint">
+            <span class="method delete">DELETE</span> <strong>/api/v1/workspaces/{workspaceID}/domains/{domain}</strong> - Remove a branded short domain
+        </div>
+        <div class="endpoint">
+            <span class="method put">PUT</span> <strong>/api/v1/workspaces/{workspaceID}/domains/{domain}/code-policy</strong> - Set a branded domain's short code policy
+        </div>
+        <div class="endpoint">
+            <span class="method put">PUT</span> <strong>/api/v1/workspaces/{workspaceID}/inactivity-policy</strong> - Archive links that go unused
+        </div>
+        <div class="endpoint">
+            <span class="method get">GET</span> <strong>/api/v1/workspaces/{workspaceID}/inactivity-report</strong> - List links the inactivity policy would archive
+        </div>
+        <div class="endpoint">
+            <span class="method post">POST</span> <strong>/api/v1/workspaces/{workspaceID}/webhooks</strong> - Subscribe an endpoint to link events
+        </div>
+        <div class="endpoint">
+            <span class="method get">GET</span> <strong>/api/v1/workspaces/{workspaceID}/webhooks</strong> - List a workspace's webhooks
+        </div>
+        <div class="endpoint">
+            <span class="method put">PUT</span> <strong>/api/v1/workspaces/{workspaceID}/webhooks/{webhookID}</strong> - Update, disable or rotate the secret of a webhook
+        </div>
+        <div class="endpoint">
+            <span class="method delete">DELETE</span> <strong>/api/v1/workspaces/{workspaceID}/webhooks/{webhookID}</strong> - Delete a webhook
+        </div>
+        <div class="endpoint">
+            <span class="method get">GET</span> <strong>/api/v1/workspaces/{workspaceID}/webhooks/{webhookID}/deliveries</strong> - List a webhook's delivery log
+        </div>
+        <div class="endpoint">
+            <span class="method post">POST</span> <strong>/api/v1/workspaces/{workspaceID}/webhooks/{webhookID}/deliveries/{deliveryID}/redeliver</strong> - Redeliver a webhook event
+        </div>
+        <div class="endpoint">
+            <span class="method put">PUT</span> <strong>/api/v1/admin/urls/{shortCode}/interstitial</strong> - Set a link's interstitial mode (admin)
+        </div>
+        <div class="endpoint">
+            <span class="method put">PUT</span> <strong>/api/v1/admin/workspaces/{workspaceID}/plan</strong> - Set a workspace's plan (admin)
+        </div>
+        <div class="endpoint">
+            <span class="method put">PUT</span> <strong>/api/v1/admin/domains/{domain}</strong> - Review or trust a destination domain (admin)
+        </div>
+        <div class="endpoint">
+            <span class="method delete">DELETE</span> <strong>/api/v1/admin/domains/{domain}</strong> - Remove a domain review (admin)
+        </div>
+        <div class="endpoint">
+            <span class="method get">GET</span> <strong>/api/v1/admin/domains</strong> - List reviewed domains (admin)
+        </div>
+        <div class="endpoint">
+            <span class="method get">GET</span> <strong>/api/v1/admin/flagged-urls</strong> - List links disabled as unsafe (admin)
+        </div>
+        <div class="endpoint">
+            <span class="method get">GET</span> <strong>/api/v1/admin/audit-events</strong> - List the audit log (admin)
+        </div>
+        <div class="endpoint">
+            <span class="method get">GET</span> <strong>/api/v1/admin/audit-events/export</strong> - Export the audit log as NDJSON (admin)
+        </div>
+        <div class="endpoint">
+            <span class="method get">GET</span> <strong>/api/v1/admin/audit-events/verify</strong> - Verify the audit log's hash chain (admin)
         </div>
         <div class="endpoint">
             <span class="method get">GET</span> <strong>/api/v1/analytics/urls/{shortCode}</strong> - Get URL analytics
@@ -231,6 +362,12 @@ func main() {
         <div class="endpoint">
             <span class="method get">GET</span> <strong>/api/v1/analytics/dashboard</strong> - Get analytics dashboard
         </div>
+        <div class="endpoint">
+            <span class="method get">GET</span> <strong>/api/v1/analytics/campaigns</strong> - Get UTM campaign analytics
+        </div>
+        <div class="endpoint">
+            <span class="method post">POST</span> <strong>/{shortCode}</strong> - Unlock a password-protected short URL
+        </div>
         <div class="endpoint">
             <span class="method get">GET</span> <strong>/health</strong> - Service health check
         </div>
@@ -249,19 +386,68 @@ func main() {
 	{
 		// URL Management endpoints
 		api.POST("/shorten", urlHandler.ShortenURL)
+		api.GET("/aliases/suggestions", urlHandler.SuggestAliases)
+		api.GET("/urls/search", urlHandler.SearchURLs)
 		api.GET("/urls/:shortCode", urlHandler.GetURLInfo)
+		api.PUT("/urls/:shortCode", urlHandler.UpdateURL)
 		api.DELETE("/urls/:shortCode", urlHandler.DeleteURL)
+		api.POST("/urls/:shortCode/restore", urlHandler.RestoreURL)
+		api.POST("/urls/:shortCode/revive", urlHandler.ReviveURL)
+		api.GET("/urls/:shortCode/revisions", urlHandler.ListURLRevisions)
+		api.POST("/urls/:shortCode/revisions/:revisionID/rollback", urlHandler.RollbackURL)
+		api.GET("/urls/:shortCode/health", urlHandler.GetLinkHealth)
+		api.GET("/urls/:shortCode/qr", urlHandler.GetQRCode)
 		api.GET("/users/:userID/urls", urlHandler.GetUserURLs)
+		api.GET("/users/:userID/trash", urlHandler.ListDeletedURLs)
+		api.POST("/users/:userID/tags", urlHandler.CreateTag)
+		api.GET("/users/:userID/tags", urlHandler.ListTags)
+		api.PUT("/users/:userID/tags/:tag", urlHandler.RenameTag)
+		api.DELETE("/users/:userID/tags/:tag", urlHandler.DeleteTag)
+		api.POST("/users/:userID/folders", urlHandler.CreateFolder)
+		api.GET("/users/:userID/folders", urlHandler.ListFolders)
+		api.PUT("/users/:userID/folders/:folderID", urlHandler.UpdateFolder)
+		api.DELETE("/users/:userID/folders/:folderID", urlHandler.DeleteFolder)
+
+		// Workspace endpoints
+		api.PUT("/workspaces/:workspaceID", urlHandler.UpsertWorkspace)
+		api.GET("/workspaces/:workspaceID", urlHandler.GetWorkspace)
+		api.POST("/workspaces/:workspaceID/domains", urlHandler.AddBrandedDomain)
+		api.GET("/workspaces/:workspaceID/domains", urlHandler.ListBrandedDomains)
+		api.DELETE("/workspaces/:workspaceID/domains/:domain", urlHandler.RemoveBrandedDomain)
+		api.PUT("/workspaces/:workspaceID/domains/:domain/code-policy", urlHandler.SetBrandedDomainCodePolicy)
+		api.PUT("/workspaces/:workspaceID/inactivity-policy", urlHandler.SetInactivityPolicy)
+		api.GET("/workspaces/:workspaceID/inactivity-report", urlHandler.GetInactivityReport)
+		api.POST("/workspaces/:workspaceID/webhooks", urlHandler.CreateWebhook)
+		api.GET("/workspaces/:workspaceID/webhooks", urlHandler.ListWebhooks)
+		api.PUT("/workspaces/:workspaceID/webhooks/:webhookID", urlHandler.UpdateWebhook)
+		api.DELETE("/workspaces/:workspaceID/webhooks/:webhookID", urlHandler.DeleteWebhook)
+		api.GET("/workspaces/:workspaceID/webhooks/:webhookID/deliveries", urlHandler.ListWebhookDeliveries)
+		api.POST("/workspaces/:workspaceID/webhooks/:webhookID/deliveries/:deliveryID/redeliver", urlHandler.RedeliverWebhook)
+
+		// Admin endpoints (require X-Admin-Token)
+		admin := api.Group("/admin", handler.AdminAuth(os.Getenv("ADMIN_API_TOKEN")))
+		admin.PUT("/urls/:shortCode/interstitial", urlHandler.SetInterstitialMode)
+		admin.PUT("/workspaces/:workspaceID/plan", urlHandler.SetWorkspacePlan)
+		admin.PUT("/domains/:domain", urlHandler.UpsertDomainReview)
+		admin.DELETE("/domains/:domain", urlHandler.DeleteDomainReview)
+		admin.GET("/domains", urlHandler.ListDomainReviews)
+		admin.GET("/flagged-urls", urlHandler.ListFlaggedURLs)
+		admin.GET("/audit-events", urlHandler.ListAuditEvents)
+		admin.GET("/audit-events/export", urlHandler.ExportAuditEvents)
+		admin.GET("/audit-events/verify", urlHandler.VerifyAuditLog)
 
 		// Analytics endpoints
 		api.GET("/analytics/urls/:shortCode", urlHandler.GetURLStats)
 		api.GET("/analytics/top-urls", urlHandler.GetTopURLs)
 		api.GET("/analytics/dashboard", urlHandler.GetDashboard)
+		api.GET("/analytics/campaigns", urlHandler.GetCampaignStats)
 	}
 
 	// Add redirect route (must be after API routes to avoid conflicts)
 	// This handles GET /:shortCode for actual URL redirection
 	router.GET("/:shortCode", urlHandler.RedirectURL)
+	// POST /:shortCode unlocks password-protected links
+	router.POST("/:shortCode", urlHandler.VerifyLinkPassword)
 
 	// Health check with Swagger annotation
 	//
@@ -281,6 +467,9 @@ func main() {
 		})
 	})
 
+	// Custom aliases may not collide with any of the routes above
+	urlHandler.ReserveRoutes(router.Routes())
+
 	logger.WithFields(logrus.Fields{
 		"port":    port,
 		"swagger": "http://localhost:" + port + "/docs/index.html",
@@ -22,9 +22,14 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/analytics/top-urls": {
+        "/admin/audit-events": {
             "get": {
-                "description": "Retrieve the top performing URLs based on clicks",
+                "security": [
+                    {
+                        "AdminToken": []
+                    }
+                ],
+                "description": "List the append-only audit log of administrative actions (links created, edited, deleted, restored, rolled back or disabled, admin changes), newest first. Every event carries the hash of the one before it. Follow next_cursor and prev_cursor to page",
                 "consumes": [
                     "application/json"
                 ],
@@ -32,54 +37,86 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Analytics"
+                    "Admin"
                 ],
-                "summary": "Get top performing URLs",
+                "summary": "List audit events",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "example": 10,
-                        "description": "Number of URLs to return",
-                        "name": "limit",
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID, admin or system",
+                        "name": "actor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "url.update",
+                        "description": "Action",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "url:abc123",
+                        "description": "Target",
+                        "name": "target",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "request_id",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "example": 1672531200,
-                        "description": "Start time (Unix timestamp)",
-                        "name": "start_time",
+                        "example": 1704067200,
+                        "description": "Events at or after (unix seconds)",
+                        "name": "since",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "example": 1672617600,
-                        "description": "End time (Unix timestamp)",
-                        "name": "end_time",
+                        "example": 1706745600,
+                        "description": "Events before (unix seconds)",
+                        "name": "until",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "example": "clicks",
-                        "description": "Sort by (clicks/unique_clicks/created_at)",
-                        "name": "sort_by",
+                        "description": "next_cursor or prev_cursor of a previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 20,
+                        "description": "Page size",
+                        "name": "page_size",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Top URLs retrieved successfully",
+                        "description": "Audit events",
                         "schema": {
-                            "$ref": "#/definitions/handler.TopURLsResponse"
+                            "$ref": "#/definitions/handler.AuditEventsResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid parameters",
+                        "description": "Invalid cursor",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid admin token",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Failed to list audit events",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -87,71 +124,116 @@ const docTemplate = `{
                 }
             }
         },
-        "/analytics/urls/{shortCode}": {
+        "/admin/audit-events/export": {
             "get": {
-                "description": "Retrieve comprehensive analytics for a specific short URL",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "AdminToken": []
+                    }
                 ],
+                "description": "Stream the audit events matching the filters as newline-delimited JSON, newest first, one AuditEventResponse per line",
                 "produces": [
-                    "application/json"
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "Analytics"
+                    "Admin"
                 ],
-                "summary": "Get URL analytics",
+                "summary": "Export audit events",
                 "parameters": [
                     {
                         "type": "string",
-                        "example": "abc123",
-                        "description": "Short code identifier",
-                        "name": "shortCode",
-                        "in": "path",
-                        "required": true
+                        "example": "user123",
+                        "description": "User ID, admin or system",
+                        "name": "actor",
+                        "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "example": 1672531200,
-                        "description": "Start time (Unix timestamp)",
-                        "name": "start_time",
+                        "type": "string",
+                        "example": "url.update",
+                        "description": "Action",
+                        "name": "action",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "example": 1672617600,
-                        "description": "End time (Unix timestamp)",
-                        "name": "end_time",
+                        "type": "string",
+                        "example": "url:abc123",
+                        "description": "Target",
+                        "name": "target",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "example": "day",
-                        "description": "Time granularity (hour/day/week/month)",
-                        "name": "granularity",
+                        "description": "Request ID",
+                        "name": "request_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 1704067200,
+                        "description": "Events at or after (unix seconds)",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 1706745600,
+                        "description": "Events before (unix seconds)",
+                        "name": "until",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "URL analytics retrieved successfully",
+                        "description": "NDJSON audit events",
                         "schema": {
-                            "$ref": "#/definitions/handler.URLStatsResponse"
+                            "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Invalid parameters",
+                    "401": {
+                        "description": "Invalid admin token",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "URL not found",
+                    "500": {
+                        "description": "Failed to export audit events",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/audit-events/verify": {
+            "get": {
+                "security": [
+                    {
+                        "AdminToken": []
+                    }
+                ],
+                "description": "Recompute the audit log's hash chain from the first event. valid is false and broken_at names the first event that was altered, or follows a removed one, when the log was tampered with. Compare last_hash with a copy kept elsewhere to detect removed trailing events",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Verify the audit log",
+                "responses": {
+                    "200": {
+                        "description": "Verification result",
+                        "schema": {
+                            "$ref": "#/definitions/handler.AuditVerificationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid admin token",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Failed to verify audit log",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -159,9 +241,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/shorten": {
-            "post": {
-                "description": "Create a short URL from a long URL with optional custom alias and expiration",
+        "/admin/domains": {
+            "get": {
+                "security": [
+                    {
+                        "AdminToken": []
+                    }
+                ],
+                "description": "List destination domains on the review list or marked trusted",
                 "consumes": [
                     "application/json"
                 ],
@@ -169,29 +256,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "URL Management"
+                    "Admin"
                 ],
-                "summary": "Create a short URL",
+                "summary": "List reviewed domains",
                 "parameters": [
                     {
-                        "description": "URL shortening request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.ShortenURLRequest"
-                        }
+                        "enum": [
+                            "review",
+                            "trusted"
+                        ],
+                        "type": "string",
+                        "description": "Filter by status",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Successfully created short URL",
+                    "200": {
+                        "description": "Reviewed domains",
                         "schema": {
-                            "$ref": "#/definitions/handler.ShortenURLResponse"
+                            "$ref": "#/definitions/handler.DomainReviewsResponse"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request body",
+                    "401": {
+                        "description": "Invalid admin token",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -205,9 +293,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/urls/{shortCode}": {
-            "get": {
-                "description": "Retrieve detailed information about a short URL",
+        "/admin/domains/{domain}": {
+            "put": {
+                "security": [
+                    {
+                        "AdminToken": []
+                    }
+                ],
+                "description": "Put a domain (and its subdomains) on the review list so links to it show a warning page, or mark it trusted so they never do",
                 "consumes": [
                     "application/json"
                 ],
@@ -215,42 +308,55 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "URL Management"
+                    "Admin"
                 ],
-                "summary": "Get URL information",
+                "summary": "Review a destination domain",
                 "parameters": [
                     {
                         "type": "string",
-                        "example": "abc123",
-                        "description": "Short code identifier",
-                        "name": "shortCode",
+                        "example": "example.com",
+                        "description": "Destination domain",
+                        "name": "domain",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "example": "user123",
-                        "description": "User ID",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
+                        "description": "Admin making the change",
+                        "name": "X-Admin-User",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Domain review",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.DomainReviewRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "URL information retrieved successfully",
+                        "description": "Domain review saved",
                         "schema": {
-                            "$ref": "#/definitions/handler.URLInfoResponse"
+                            "$ref": "#/definitions/handler.DomainReviewResponse"
                         }
                     },
                     "400": {
-                        "description": "Missing user_id parameter",
+                        "description": "Invalid request body",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "URL not found",
+                    "401": {
+                        "description": "Invalid admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -258,7 +364,12 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Delete a short URL belonging to the authenticated user",
+                "security": [
+                    {
+                        "AdminToken": []
+                    }
+                ],
+                "description": "Take a domain off the review list; its links fall back to the default interstitial rules",
                 "consumes": [
                     "application/json"
                 ],
@@ -266,42 +377,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "URL Management"
+                    "Admin"
                 ],
-                "summary": "Delete a short URL",
+                "summary": "Remove a domain review",
                 "parameters": [
                     {
                         "type": "string",
-                        "example": "abc123",
-                        "description": "Short code identifier",
-                        "name": "shortCode",
+                        "example": "example.com",
+                        "description": "Destination domain",
+                        "name": "domain",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "example": "user123",
-                        "description": "User ID",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "URL deleted successfully",
+                        "description": "Domain review removed",
                         "schema": {
                             "$ref": "#/definitions/handler.DeleteResponse"
                         }
                     },
                     "400": {
-                        "description": "Missing user_id parameter",
+                        "description": "Invalid domain",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid admin token",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Failed to delete URL",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -309,9 +418,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{userID}/urls": {
+        "/admin/flagged-urls": {
             "get": {
-                "description": "Retrieve a paginated list of URLs belonging to a specific user",
+                "security": [
+                    {
+                        "AdminToken": []
+                    }
+                ],
+                "description": "List links disabled by the destination safety engine with their reason code (blocklisted_domain, blocklisted_url, safe_browsing_match, idn_homograph, ip_host, shortener_chain)",
                 "consumes": [
                     "application/json"
                 ],
@@ -319,56 +433,52 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Admin"
                 ],
-                "summary": "Get user's URLs",
+                "summary": "List links flagged as unsafe",
                 "parameters": [
                     {
                         "type": "string",
-                        "example": "user123",
-                        "description": "User ID",
-                        "name": "userID",
-                        "in": "path",
-                        "required": true
+                        "description": "next_cursor or prev_cursor of a previous page",
+                        "name": "cursor",
+                        "in": "query"
                     },
                     {
                         "type": "integer",
-                        "example": 1,
-                        "description": "Page number",
+                        "default": 1,
+                        "description": "Page number (deprecated, use cursor)",
                         "name": "page",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "example": 20,
-                        "description": "Page size",
+                        "default": 20,
+                        "description": "Items per page",
                         "name": "page_size",
                         "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "example": "created_at",
-                        "description": "Sort field",
-                        "name": "sort_by",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "example": "desc",
-                        "description": "Sort order",
-                        "name": "sort_order",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User URLs retrieved successfully",
+                        "description": "Flagged links",
                         "schema": {
                             "$ref": "#/definitions/handler.UserURLsResponse"
                         }
                     },
+                    "400": {
+                        "description": "Invalid cursor",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
                     "500": {
-                        "description": "Failed to get user URLs",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -376,9 +486,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/{shortCode}": {
-            "get": {
-                "description": "Resolve a short code and redirect to the original URL with click tracking",
+        "/admin/urls/{shortCode}/interstitial": {
+            "put": {
+                "security": [
+                    {
+                        "AdminToken": []
+                    }
+                ],
+                "description": "Choose whether visitors see a \"you are leaving\" warning page: auto (domain review list and account trust decide), always or never",
                 "consumes": [
                     "application/json"
                 ],
@@ -386,9 +501,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Redirect"
+                    "Admin"
                 ],
-                "summary": "Redirect to original URL",
+                "summary": "Set a link's interstitial mode",
                 "parameters": [
                     {
                         "type": "string",
@@ -397,26 +512,190 @@ const docTemplate = `{
                         "name": "shortCode",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "go.acme.com",
+                        "description": "Branded domain of the link (default domain when empty)",
+                        "name": "domain",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Interstitial mode",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.InterstitialModeRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Redirect information (for API testing)",
+                        "description": "Interstitial mode updated",
                         "schema": {
-                            "$ref": "#/definitions/handler.RedirectResponse"
+                            "$ref": "#/definitions/handler.URLInfoResponse"
                         }
                     },
-                    "302": {
-                        "description": "Redirect to original URL"
+                    "400": {
+                        "description": "Invalid mode or unknown short code",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/workspaces/{workspaceID}/plan": {
+            "put": {
+                "security": [
+                    {
+                        "AdminToken": []
+                    }
+                ],
+                "description": "Move a workspace to another plan. The plan decides the custom alias rules (length and whether - and _ are allowed) for aliases created from now on",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Set a workspace's plan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "marketing",
+                        "description": "Workspace identifier",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Plan",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.WorkspacePlanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Workspace plan updated",
+                        "schema": {
+                            "$ref": "#/definitions/handler.WorkspaceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Unknown plan",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
                     },
                     "404": {
-                        "description": "Short code not found",
+                        "description": "Workspace not found",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
-                    "410": {
-                        "description": "URL has expired",
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/aliases/suggestions": {
+            "get": {
+                "description": "Check whether a custom alias is available and suggest available alternatives: numbered suffixes, dictionary word combinations and slugs of the destination page title. Suggestions follow the alias rules of the workspace plan",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "URL Management"
+                ],
+                "summary": "Suggest custom aliases",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "promo",
+                        "description": "Desired custom alias (alias or long_url is required)",
+                        "name": "alias",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "https://www.acme.com/spring-sale",
+                        "description": "Destination URL whose page title seeds suggestions",
+                        "name": "long_url",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "go.acme.com",
+                        "description": "Branded domain (default domain when empty)",
+                        "name": "domain",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "marketing",
+                        "description": "Workspace whose plan decides the alias rules",
+                        "name": "workspace_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 5,
+                        "description": "Number of suggestions (max 20)",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Alias suggestions",
+                        "schema": {
+                            "$ref": "#/definitions/handler.AliasSuggestionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -429,177 +708,4286 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "handler.BrowserStatsItem": {
-            "type": "object",
-            "properties": {
-                "browser": {
-                    "type": "string",
-                    "example": "Chrome 120"
-                },
-                "clicks": {
-                    "type": "integer",
-                    "example": 80
-                },
-                "percentage": {
-                    "type": "number",
-                    "example": 53.3
+        },
+        "/analytics/campaigns": {
+            "get": {
+                "description": "Retrieve clicks grouped by the UTM source, medium and campaign actually sent to destinations",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Analytics"
+                ],
+                "summary": "Get campaign analytics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "abc123",
+                        "description": "Restrict to one short code",
+                        "name": "short_code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 20,
+                        "description": "Number of campaigns to return",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 1672531200,
+                        "description": "Start time (Unix timestamp)",
+                        "name": "start_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 1672617600,
+                        "description": "End time (Unix timestamp)",
+                        "name": "end_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "example": true,
+                        "description": "Leave out clicks flagged by fraud scoring",
+                        "name": "exclude_suspicious",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Campaign analytics retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CampaignStatsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
                 }
             }
         },
-        "handler.CountryStatsItem": {
+        "/analytics/top-urls": {
+            "get": {
+                "description": "Retrieve the top performing URLs based on clicks",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Analytics"
+                ],
+                "summary": "Get top performing URLs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "example": 10,
+                        "description": "Number of URLs to return",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 1672531200,
+                        "description": "Start time (Unix timestamp)",
+                        "name": "start_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 1672617600,
+                        "description": "End time (Unix timestamp)",
+                        "name": "end_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "clicks",
+                        "description": "Sort by (clicks/unique_clicks/created_at)",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "example": true,
+                        "description": "Leave out clicks flagged by fraud scoring",
+                        "name": "exclude_suspicious",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Top URLs retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TopURLsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/analytics/urls/{shortCode}": {
+            "get": {
+                "description": "Retrieve comprehensive analytics for a specific short URL",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Analytics"
+                ],
+                "summary": "Get URL analytics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "abc123",
+                        "description": "Short code identifier",
+                        "name": "shortCode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 1672531200,
+                        "description": "Start time (Unix timestamp)",
+                        "name": "start_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 1672617600,
+                        "description": "End time (Unix timestamp)",
+                        "name": "end_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "day",
+                        "description": "Time granularity (hour/day/week/month)",
+                        "name": "granularity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "example": true,
+                        "description": "Leave out clicks flagged by fraud scoring",
+                        "name": "exclude_suspicious",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "URL analytics retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.URLStatsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "URL not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/shorten": {
+            "post": {
+                "description": "Create a short URL from a long URL with optional custom alias and expiration",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "URL Management"
+                ],
+                "summary": "Create a short URL",
+                "parameters": [
+                    {
+                        "description": "URL shortening request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ShortenURLRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successfully created short URL",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ShortenURLResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, reserved or invalid custom alias",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Custom alias already exists, with suggestions",
+                        "schema": {
+                            "$ref": "#/definitions/handler.AliasConflictResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Destination URL was flagged as unsafe",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/urls/search": {
+            "get": {
+                "description": "Search a user's links. Every word of q must match the short code, the destination host or path, the page title, a tag or a metadata value; words match as prefixes (\"pric\" finds \"pricing\") and codes, destinations and tags also as substrings. Results are ranked by relevance, with an exact short code first; follow next_cursor and prev_cursor to page. Facets count all matches by tag, short domain and status (active, expired, disabled or archived). Deleted links are not searched",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "URL Management"
+                ],
+                "summary": "Search links",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "q3 pricing",
+                        "description": "Search words (all links when empty)",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "campaign",
+                        "description": "Only links with this tag",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "go.acme.com",
+                        "description": "Only links on this short domain",
+                        "name": "domain",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "active",
+                        "description": "Only active, expired, disabled or archived links",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 1,
+                        "description": "Page number (deprecated, use cursor)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 20,
+                        "description": "Page size (max 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Search results",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SearchURLsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing user_id or invalid search",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to search URLs",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/urls/{shortCode}": {
+            "get": {
+                "description": "Retrieve detailed information about a short URL",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "URL Management"
+                ],
+                "summary": "Get URL information",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "abc123",
+                        "description": "Short code identifier",
+                        "name": "shortCode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "go.acme.com",
+                        "description": "Branded domain of the link (default domain when empty)",
+                        "name": "domain",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "URL information retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.URLInfoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing user_id parameter",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "URL not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update the destination, activation window, metadata, UTM template, password, link preview overrides, tags, folder or inactivity exemption of a short URL",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "URL Management"
+                ],
+                "summary": "Update a short URL",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "abc123",
+                        "description": "Short code identifier",
+                        "name": "shortCode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "go.acme.com",
+                        "description": "Branded domain of the link (default domain when empty)",
+                        "name": "domain",
+                        "in": "query"
+                    },
+                    {
+                        "description": "URL update request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdateURLRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "URL updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.URLInfoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or update rejected",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Move a short URL belonging to the authenticated user to the trash. It stops resolving and can be restored until the trash retention purges it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "URL Management"
+                ],
+                "summary": "Delete a short URL",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "abc123",
+                        "description": "Short code identifier",
+                        "name": "shortCode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "go.acme.com",
+                        "description": "Branded domain of the link (default domain when empty)",
+                        "name": "domain",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "URL deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.DeleteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing user_id parameter",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete URL",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/urls/{shortCode}/health": {
+            "get": {
+                "description": "Retrieve the latest background check of a short URL's destination. Links become broken after several consecutive failed checks; unknown means the link has not been checked yet",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "URL Management"
+                ],
+                "summary": "Get link health",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "abc123",
+                        "description": "Short code identifier",
+                        "name": "shortCode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "go.acme.com",
+                        "description": "Branded domain of the link (default domain when empty)",
+                        "name": "domain",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Link health retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.LinkHealthResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing user_id parameter",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "URL not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/urls/{shortCode}/qr": {
+            "get": {
+                "description": "Render a QR code for a short URL as PNG or SVG. The code encodes the canonical short URL with ?src=qr appended (unless src=false), so scans show up separately in click analytics. A logo URL places an image in the centre and forces error correction level H",
+                "produces": [
+                    "image/png",
+                    "image/svg+xml"
+                ],
+                "tags": [
+                    "URL Management"
+                ],
+                "summary": "Get QR code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "abc123",
+                        "description": "Short code identifier",
+                        "name": "shortCode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "go.acme.com",
+                        "description": "Branded domain of the link (default domain when empty)",
+                        "name": "domain",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "png",
+                            "svg"
+                        ],
+                        "type": "string",
+                        "default": "png",
+                        "description": "Output format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 256,
+                        "description": "Width and height in pixels (64-2048)",
+                        "name": "size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 4,
+                        "description": "Quiet zone in modules (0-16)",
+                        "name": "margin",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "L",
+                            "M",
+                            "Q",
+                            "H"
+                        ],
+                        "type": "string",
+                        "default": "M",
+                        "description": "Error correction level",
+                        "name": "level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "#000000",
+                        "description": "Foreground colour as hex",
+                        "name": "fg",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "#ffffff",
+                        "description": "Background colour as hex, #rrggbbaa for alpha",
+                        "name": "bg",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "https://example.com/logo.png",
+                        "description": "PNG, JPEG or GIF logo (max 1MB) drawn in the centre",
+                        "name": "logo_url",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": true,
+                        "description": "Append ?src=qr to the encoded URL",
+                        "name": "src",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "QR code image",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "304": {
+                        "description": "Not modified",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid QR code options",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "URL not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/urls/{shortCode}/restore": {
+            "post": {
+                "description": "Take a link out of the trash so it resolves again. The destination is checked again like a new link's, and a link on a branded domain needs the domain to still belong to its workspace",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "URL Management"
+                ],
+                "summary": "Restore a deleted link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "abc123",
+                        "description": "Short code identifier",
+                        "name": "shortCode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "go.acme.com",
+                        "description": "Branded domain of the link (default domain when empty)",
+                        "name": "domain",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Restored link",
+                        "schema": {
+                            "$ref": "#/definitions/handler.URLInfoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing user_id parameter",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Link belongs to another user",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Deleted link or its branded domain not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Destination URL was flagged as unsafe",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to restore URL",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/urls/{shortCode}/revisions": {
+            "get": {
+                "description": "List every change made to a link (destination, expiry, metadata, rules, ...), newest first: who made it, when and which fields changed. Follow next_cursor and prev_cursor to page",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "URL Management"
+                ],
+                "summary": "List a link's revisions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "abc123",
+                        "description": "Short code identifier",
+                        "name": "shortCode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "go.acme.com",
+                        "description": "Branded domain of the link (default domain when empty)",
+                        "name": "domain",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 20,
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Revisions",
+                        "schema": {
+                            "$ref": "#/definitions/handler.URLRevisionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing user_id parameter or invalid cursor",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "URL not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to list URL revisions",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/urls/{shortCode}/revisions/{revisionID}/rollback": {
+            "post": {
+                "description": "Restore a link to its state before a revision. The rollback is recorded as a new revision, the destination is checked again like a new link's and the redirect cache is refreshed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "URL Management"
+                ],
+                "summary": "Roll a link back",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "abc123",
+                        "description": "Short code identifier",
+                        "name": "shortCode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 42,
+                        "description": "Revision ID",
+                        "name": "revisionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "go.acme.com",
+                        "description": "Branded domain of the link (default domain when empty)",
+                        "name": "domain",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Rolled back link",
+                        "schema": {
+                            "$ref": "#/definitions/handler.URLInfoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing user_id parameter or invalid revision ID",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "URL or revision not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Destination URL flagged as unsafe",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to roll back URL",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/urls/{shortCode}/revive": {
+            "post": {
+                "description": "Reactivate a link its workspace's inactivity policy archived. The inactivity clock starts over",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "URL Management"
+                ],
+                "summary": "Revive an archived link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "abc123",
+                        "description": "Short code identifier",
+                        "name": "shortCode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "go.acme.com",
+                        "description": "Branded domain of the link (default domain when empty)",
+                        "name": "domain",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Revived link",
+                        "schema": {
+                            "$ref": "#/definitions/handler.URLInfoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing user_id parameter",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Archived link not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to revive URL",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userID}/folders": {
+            "get": {
+                "description": "List a user's folders with their paths and the number of active links directly inside each",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags and Folders"
+                ],
+                "summary": "List folders",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Folders retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.FoldersResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to list folders",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a folder for organizing links, optionally inside another folder. Folder names are unique among siblings regardless of case",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags and Folders"
+                ],
+                "summary": "Create a folder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Folder request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.FolderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Folder created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.FolderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid folder name or nesting too deep",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Parent folder not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Folder already exists",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create folder",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userID}/folders/{folderID}": {
+            "put": {
+                "description": "Set the name and parent of a folder; its subfolders and links move with it. A folder cannot be moved into its own subfolders",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags and Folders"
+                ],
+                "summary": "Rename or move a folder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 4,
+                        "description": "Folder ID",
+                        "name": "folderID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Folder request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.FolderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Folder updated",
+                        "schema": {
+                            "$ref": "#/definitions/handler.FolderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid folder name, cycle or nesting too deep",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Folder not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Folder already exists",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update folder",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a folder and its subfolders. Their links are kept and no longer belong to a folder",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags and Folders"
+                ],
+                "summary": "Delete a folder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 4,
+                        "description": "Folder ID",
+                        "name": "folderID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Folder deleted",
+                        "schema": {
+                            "$ref": "#/definitions/handler.DeleteResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Folder not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete folder",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userID}/tags": {
+            "get": {
+                "description": "List a user's tags with the number of active links carrying each",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags and Folders"
+                ],
+                "summary": "List tags",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tags retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TagsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to list tags",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a tag for organizing links. Tag names are case-insensitive and stored in lower case. Tags are also created when a link is tagged with a new name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags and Folders"
+                ],
+                "summary": "Create a tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tag request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.TagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Tag created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid tag name",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Tag already exists",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create tag",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userID}/tags/{tag}": {
+            "put": {
+                "description": "Rename a tag; links carrying it keep it under the new name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags and Folders"
+                ],
+                "summary": "Rename a tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "spring campaign",
+                        "description": "Tag name",
+                        "name": "tag",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rename request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.RenameTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tag renamed",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid tag name",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A tag with the new name already exists",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to rename tag",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a tag and remove it from all links; the links themselves are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags and Folders"
+                ],
+                "summary": "Delete a tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "spring campaign",
+                        "description": "Tag name",
+                        "name": "tag",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tag deleted",
+                        "schema": {
+                            "$ref": "#/definitions/handler.DeleteResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete tag",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userID}/trash": {
+            "get": {
+                "description": "List the links a user deleted, most recently deleted first. Deleted links stop resolving but can be restored until purge_at, when the retention worker removes them for good (no purge_at when deleted links are kept). Follow next_cursor and prev_cursor to page",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "List deleted links",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 1,
+                        "description": "Page number (deprecated, use cursor)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 20,
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deleted links",
+                        "schema": {
+                            "$ref": "#/definitions/handler.UserURLsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid cursor",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to list deleted URLs",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userID}/urls": {
+            "get": {
+                "description": "Retrieve a page of the URLs belonging to a specific user, optionally filtered by tag or folder. Follow next_cursor and prev_cursor to page; page numbers still work but are deprecated and get slower the deeper the page. total_count counts all matching URLs",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Get user's URLs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "eyJvIjoiY3JlYXRlZF9hdDpkZXNjIiwidiI6IjIwMjQtMDMtMDFUMTA6MDA6MDBaIiwiaSI6NDJ9",
+                        "description": "next_cursor or prev_cursor of a previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 1,
+                        "description": "Page number (deprecated, use cursor)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 20,
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "created_at",
+                        "description": "Sort field (created_at, click_count, last_accessed or alias)",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort order (asc or desc)",
+                        "name": "sort_order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "spring campaign",
+                        "description": "Only links with this tag",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 4,
+                        "description": "Only links in this folder",
+                        "name": "folder_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "example": true,
+                        "description": "Also list links in subfolders of folder_id",
+                        "name": "include_subfolders",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User URLs retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.UserURLsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid sort, tag, folder or cursor",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Folder not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to get user URLs",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}": {
+            "get": {
+                "description": "Retrieve a workspace and its default UTM template",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Get workspace information",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "marketing",
+                        "description": "Workspace identifier",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Workspace retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.WorkspaceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing user_id parameter",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Workspace not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Create or update a workspace and its default UTM template. Supported placeholders are {short_code}, {country} and {referrer_domain}",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Create or update a workspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "marketing",
+                        "description": "Workspace identifier",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Workspace request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.WorkspaceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Workspace saved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.WorkspaceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to save workspace",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/domains": {
+            "get": {
+                "description": "List the branded short domains attached to a workspace",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "List branded domains",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "marketing",
+                        "description": "Workspace identifier",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Branded domains retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.BrandedDomainsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing user_id parameter",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Workspace not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Attach a branded short domain to a workspace the user owns. Links created with this domain get short URLs on it, and the redirect service resolves their codes when requests arrive with it as the Host header (point its DNS at the redirect service). The readable code policy makes codes case-insensitive and avoids look-alike characters",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Add a branded domain",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "marketing",
+                        "description": "Workspace identifier",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Branded domain request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.BrandedDomainRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Branded domain added",
+                        "schema": {
+                            "$ref": "#/definitions/handler.BrandedDomainResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid domain",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Workspace not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Domain already registered",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/domains/{domain}": {
+            "delete": {
+                "description": "Detach a branded short domain from a workspace. Domains that still have active links cannot be removed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Remove a branded domain",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "marketing",
+                        "description": "Workspace identifier",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "go.acme.com",
+                        "description": "Branded domain",
+                        "name": "domain",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Branded domain removed",
+                        "schema": {
+                            "$ref": "#/definitions/handler.DeleteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing user_id or domain still in use",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to remove branded domain",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/domains/{domain}/code-policy": {
+            "put": {
+                "description": "Switch the short code policy of a branded domain. Under the readable policy codes are looked up regardless of case, new codes are stored in lower case and generated codes avoid look-alike characters (0/o, 1/l/i). Existing mixed-case codes keep working under any spelling; codes that only differ in case are listed in case_conflicts, and the oldest of them wins until the others are deleted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Set a branded domain's code policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "marketing",
+                        "description": "Workspace identifier",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "go.acme.com",
+                        "description": "Branded domain",
+                        "name": "domain",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Code policy request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CodePolicyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Code policy updated",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CodePolicyChangeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid code policy",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Domain not found in workspace",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to set code policy",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/inactivity-policy": {
+            "put": {
+                "description": "Archive workspace links that were not clicked for inactivity_days (0 turns the policy off), counted from the last click, creation or revival. Archived links stop resolving until revived; exempt links are never archived. In dry run nothing is archived and the report lists what would be",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Set a workspace's inactivity policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "marketing",
+                        "description": "Workspace identifier",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Inactivity policy request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.InactivityPolicyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Inactivity policy updated",
+                        "schema": {
+                            "$ref": "#/definitions/handler.WorkspaceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inactivity policy",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Workspace not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to set inactivity policy",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/inactivity-report": {
+            "get": {
+                "description": "List the workspace links the inactivity policy would archive now, least recently used first. Pass inactivity_days to preview a policy before setting it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Report inactive links",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "marketing",
+                        "description": "Workspace identifier",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 90,
+                        "description": "Preview a policy (default: the workspace's)",
+                        "name": "inactivity_days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Links listed (default 100, at most 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Inactive links",
+                        "schema": {
+                            "$ref": "#/definitions/handler.InactivityReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "No inactivity policy to report on",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Workspace not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to get inactivity report",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/webhooks": {
+            "get": {
+                "description": "List the webhooks of a workspace, with their state and failure count. Secrets are not included",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List webhooks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "marketing",
+                        "description": "Workspace identifier",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhooks",
+                        "schema": {
+                            "$ref": "#/definitions/handler.WebhooksResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing user_id parameter",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Workspace not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to list webhooks",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Subscribe an endpoint to the link events of a workspace the user owns: url.created, url.updated, url.deleted, url.expired, url.expiring (a link expires within the notice period), url.click_threshold (a link passed a click count) and url.traffic_spike (a link's hourly clicks far above its baseline); all of them when events is empty. Every event is POSTed as JSON with X-Webhook-Event, X-Webhook-Delivery, X-Webhook-Timestamp and X-Webhook-Signature headers; the signature is \"sha256=\" and the hex HMAC-SHA256 of the timestamp, a dot and the body, keyed with the returned secret, which is not shown again. Failed deliveries are retried with exponential backoff, and a webhook that keeps failing is disabled",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Create a webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "marketing",
+                        "description": "Workspace identifier",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Webhook request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Webhook created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.WebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid URL or event, or too many webhooks",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Workspace not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create webhook",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/webhooks/{webhookID}": {
+            "put": {
+                "description": "Change a webhook's endpoint or event filter, turn it on or off, or rotate its secret (the new secret is returned once). Turning a webhook on resets its failure count; its pending deliveries resume",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Update a webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "marketing",
+                        "description": "Workspace identifier",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 12,
+                        "description": "Webhook ID",
+                        "name": "webhookID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Webhook update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdateWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhook updated",
+                        "schema": {
+                            "$ref": "#/definitions/handler.WebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid URL or event",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Workspace or webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update webhook",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a webhook with its pending deliveries and delivery log",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Delete a webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "marketing",
+                        "description": "Workspace identifier",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 12,
+                        "description": "Webhook ID",
+                        "name": "webhookID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhook deleted",
+                        "schema": {
+                            "$ref": "#/definitions/handler.DeleteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing user_id parameter",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Workspace or webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete webhook",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/webhooks/{webhookID}/deliveries": {
+            "get": {
+                "description": "List the delivery log of a webhook, newest first: the payload sent, the attempts made, the receiver's last response and, for pending deliveries, when the next attempt is due. Follow next_cursor and prev_cursor to page",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List webhook deliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "marketing",
+                        "description": "Workspace identifier",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 12,
+                        "description": "Webhook ID",
+                        "name": "webhookID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "failed",
+                        "description": "pending, succeeded or failed",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 20,
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deliveries",
+                        "schema": {
+                            "$ref": "#/definitions/handler.WebhookDeliveriesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing user_id parameter, invalid status or cursor",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Workspace or webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to list webhook deliveries",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/webhooks/{webhookID}/deliveries/{deliveryID}/redeliver": {
+            "post": {
+                "description": "Queue a delivery again, e.g. after fixing the receiver. The new delivery carries the same event ID and payload, so receivers can recognize events they already processed; the dispatcher sends it within seconds",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Redeliver a webhook event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "marketing",
+                        "description": "Workspace identifier",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 12,
+                        "description": "Webhook ID",
+                        "name": "webhookID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 1042,
+                        "description": "Delivery ID",
+                        "name": "deliveryID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "user123",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Delivery queued",
+                        "schema": {
+                            "$ref": "#/definitions/handler.WebhookDeliveryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing user_id parameter",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Workspace, webhook or delivery not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Webhook is disabled",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to redeliver webhook",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/{shortCode}": {
+            "get": {
+                "description": "Resolve a short code and redirect to the original URL with click tracking.\nCodes are looked up on the branded domain named by the Host header, or on the default short domain for any other host.\nFlagged or unverified destinations answer 200 with a warning page (InterstitialResponse for JSON clients) until confirm=1 is sent.\nKnown link preview bots (Slack, Twitter, Facebook, Discord, ...) get a 200 HTML page with the link's Open Graph / Twitter card tags instead of a redirect.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Redirect"
+                ],
+                "summary": "Redirect to original URL",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "abc123",
+                        "description": "Short code identifier",
+                        "name": "shortCode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Set to 1 to continue past the interstitial warning page",
+                        "name": "confirm",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Redirect information (for API testing)",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RedirectResponse"
+                        }
+                    },
+                    "302": {
+                        "description": "Redirect to original URL (or to the fallback URL before activation)"
+                    },
+                    "401": {
+                        "description": "Password required",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Short code not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "410": {
+                        "description": "URL has expired or reached its click limit",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "URL is not active yet (coming soon)",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ComingSoonResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Check the password of a protected short URL, set a short-lived signed access cookie and redirect back to the short URL",
+                "consumes": [
+                    "application/x-www-form-urlencoded",
+                    "application/json"
+                ],
+                "produces": [
+                    "text/html",
+                    "application/json"
+                ],
+                "tags": [
+                    "Redirect"
+                ],
+                "summary": "Unlock a password-protected short URL",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "abc123",
+                        "description": "Short code identifier",
+                        "name": "shortCode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Link password",
+                        "name": "password",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "303": {
+                        "description": "Redirect back to the short URL with the access cookie set"
+                    },
+                    "400": {
+                        "description": "Missing password",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Incorrect password",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many failed attempts",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "handler.AliasConflictResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "Custom alias already exists"
+                },
+                "suggestions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "promo-2",
+                        "go-promo"
+                    ]
+                }
+            }
+        },
+        "handler.AliasSuggestionsResponse": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "type": "string",
+                    "example": "promo"
+                },
+                "available": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "suggestions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "spring-sale",
+                        "promo-2",
+                        "go-promo"
+                    ]
+                }
+            }
+        },
+        "handler.AuditEventResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "url.update"
+                },
+                "actor": {
+                    "description": "user ID, \"admin\" or \"system\"",
+                    "type": "string",
+                    "example": "user123"
+                },
+                "after": {
+                    "description": "state after the action",
+                    "type": "object"
+                },
+                "before": {
+                    "description": "state before the action",
+                    "type": "object"
+                },
+                "created_at": {
+                    "type": "integer",
+                    "example": 1704067200
+                },
+                "hash": {
+                    "type": "string",
+                    "example": "60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1042
+                },
+                "ip": {
+                    "type": "string",
+                    "example": "203.0.113.7"
+                },
+                "prev_hash": {
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                },
+                "request_id": {
+                    "type": "string",
+                    "example": "5f2b9c1e8a7d4e3f9b6a0c1d2e3f4a5b"
+                },
+                "target": {
+                    "type": "string",
+                    "example": "url:abc123"
+                },
+                "user_agent": {
+                    "type": "string",
+                    "example": "Mozilla/5.0"
+                }
+            }
+        },
+        "handler.AuditEventsResponse": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.AuditEventResponse"
+                    }
+                },
+                "has_next": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "prev_cursor": {
+                    "type": "string"
+                },
+                "total_count": {
+                    "type": "integer",
+                    "example": 1042
+                }
+            }
+        },
+        "handler.AuditVerificationResponse": {
+            "type": "object",
+            "properties": {
+                "broken_at": {
+                    "description": "first event whose hash does not match",
+                    "type": "integer",
+                    "example": 0
+                },
+                "checked": {
+                    "type": "integer",
+                    "example": 1042
+                },
+                "last_hash": {
+                    "type": "string",
+                    "example": "60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752"
+                },
+                "valid": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handler.BrandedDomainRequest": {
+            "type": "object",
+            "required": [
+                "domain",
+                "user_id"
+            ],
+            "properties": {
+                "code_policy": {
+                    "description": "mixed (default) or readable",
+                    "type": "string",
+                    "example": "readable"
+                },
+                "domain": {
+                    "type": "string",
+                    "example": "go.acme.com"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "user123"
+                }
+            }
+        },
+        "handler.BrandedDomainResponse": {
+            "type": "object",
+            "properties": {
+                "code_policy": {
+                    "type": "string",
+                    "example": "readable"
+                },
+                "created_at": {
+                    "type": "integer",
+                    "example": 1672531200
+                },
+                "created_by": {
+                    "type": "string",
+                    "example": "user123"
+                },
+                "domain": {
+                    "type": "string",
+                    "example": "go.acme.com"
+                },
+                "short_url": {
+                    "type": "string",
+                    "example": "https://go.acme.com/"
+                },
+                "workspace_id": {
+                    "type": "string",
+                    "example": "marketing"
+                }
+            }
+        },
+        "handler.BrandedDomainsResponse": {
+            "type": "object",
+            "properties": {
+                "domains": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.BrandedDomainResponse"
+                    }
+                }
+            }
+        },
+        "handler.BrowserStatsItem": {
+            "type": "object",
+            "properties": {
+                "browser": {
+                    "type": "string",
+                    "example": "Chrome 120"
+                },
+                "clicks": {
+                    "type": "integer",
+                    "example": 80
+                },
+                "percentage": {
+                    "type": "number",
+                    "example": 53.3
+                }
+            }
+        },
+        "handler.CampaignStatsItem": {
+            "type": "object",
+            "properties": {
+                "clicks": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "unique_clicks": {
+                    "type": "integer",
+                    "example": 87
+                },
+                "utm_campaign": {
+                    "type": "string",
+                    "example": "spring_launch"
+                },
+                "utm_medium": {
+                    "type": "string",
+                    "example": "social"
+                },
+                "utm_source": {
+                    "type": "string",
+                    "example": "twitter.com"
+                }
+            }
+        },
+        "handler.CampaignStatsResponse": {
+            "type": "object",
+            "properties": {
+                "campaigns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.CampaignStatsItem"
+                    }
+                }
+            }
+        },
+        "handler.CodePolicyChangeResponse": {
+            "type": "object",
+            "properties": {
+                "case_conflicts": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Promo",
+                        "PROMO"
+                    ]
+                },
+                "code_policy": {
+                    "type": "string",
+                    "example": "readable"
+                },
+                "created_at": {
+                    "type": "integer",
+                    "example": 1672531200
+                },
+                "created_by": {
+                    "type": "string",
+                    "example": "user123"
+                },
+                "domain": {
+                    "type": "string",
+                    "example": "go.acme.com"
+                },
+                "short_url": {
+                    "type": "string",
+                    "example": "https://go.acme.com/"
+                },
+                "workspace_id": {
+                    "type": "string",
+                    "example": "marketing"
+                }
+            }
+        },
+        "handler.CodePolicyRequest": {
+            "type": "object",
+            "required": [
+                "code_policy",
+                "user_id"
+            ],
+            "properties": {
+                "code_policy": {
+                    "type": "string",
+                    "example": "readable"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "user123"
+                }
+            }
+        },
+        "handler.ComingSoonResponse": {
+            "type": "object",
+            "properties": {
+                "activates_at": {
+                    "type": "integer",
+                    "example": 1704067200
+                },
+                "error": {
+                    "type": "string",
+                    "example": "This short URL is not active yet"
+                }
+            }
+        },
+        "handler.CountryStatsItem": {
+            "type": "object",
+            "properties": {
+                "clicks": {
+                    "type": "integer",
+                    "example": 75
+                },
+                "country": {
+                    "type": "string",
+                    "example": "US"
+                },
+                "percentage": {
+                    "type": "number",
+                    "example": 50
+                }
+            }
+        },
+        "handler.CreateWebhookRequest": {
+            "type": "object",
+            "required": [
+                "url",
+                "user_id"
+            ],
+            "properties": {
+                "events": {
+                    "description": "empty for all events",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "url.created",
+                        "url.deleted"
+                    ]
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://hooks.acme.com/links"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "user123"
+                }
+            }
+        },
+        "handler.DeleteResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "URL deleted successfully"
+                }
+            }
+        },
+        "handler.DeviceStatsItem": {
+            "type": "object",
+            "properties": {
+                "clicks": {
+                    "type": "integer",
+                    "example": 90
+                },
+                "device_type": {
+                    "type": "string",
+                    "example": "Desktop"
+                },
+                "percentage": {
+                    "type": "number",
+                    "example": 60
+                }
+            }
+        },
+        "handler.DomainReviewRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "example": "Reported phishing campaign"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "review",
+                        "trusted"
+                    ],
+                    "example": "review"
+                }
+            }
+        },
+        "handler.DomainReviewResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "integer",
+                    "example": 1672531200
+                },
+                "domain": {
+                    "type": "string",
+                    "example": "example.com"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Reported phishing campaign"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "review"
+                },
+                "updated_at": {
+                    "type": "integer",
+                    "example": 1672617600
+                },
+                "updated_by": {
+                    "type": "string",
+                    "example": "admin@example.com"
+                }
+            }
+        },
+        "handler.DomainReviewsResponse": {
+            "type": "object",
+            "properties": {
+                "reviews": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.DomainReviewResponse"
+                    }
+                }
+            }
+        },
+        "handler.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "Invalid request body"
+                }
+            }
+        },
+        "handler.FacetCountResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "value": {
+                    "type": "string",
+                    "example": "pricing"
+                }
+            }
+        },
+        "handler.FieldChangeResponse": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string",
+                    "example": "long_url"
+                },
+                "new_value": {
+                    "description": "passwords show as \"set\" or \"none\"",
+                    "type": "string",
+                    "example": "https://example.com/summer"
+                },
+                "old_value": {
+                    "description": "empty when unset",
+                    "type": "string",
+                    "example": "https://example.com/spring"
+                }
+            }
+        },
+        "handler.FolderRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Campaigns"
+                },
+                "parent_id": {
+                    "description": "top level when 0",
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "handler.FolderResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "integer",
+                    "example": 1672531200
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "link_count": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Spring"
+                },
+                "parent_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "path": {
+                    "type": "string",
+                    "example": "Campaigns/Spring"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "user123"
+                }
+            }
+        },
+        "handler.FoldersResponse": {
+            "type": "object",
+            "properties": {
+                "folders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.FolderResponse"
+                    }
+                }
+            }
+        },
+        "handler.InactivityPolicyRequest": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "dry_run": {
+                    "description": "only report the links the policy would archive",
+                    "type": "boolean",
+                    "example": false
+                },
+                "inactivity_days": {
+                    "description": "0 turns the policy off",
+                    "type": "integer",
+                    "example": 180
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "user123"
+                }
+            }
+        },
+        "handler.InactivityReportResponse": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "inactivity_days": {
+                    "type": "integer",
+                    "example": 180
+                },
+                "total_count": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "urls": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.URLInfoResponse"
+                    }
+                },
+                "workspace_id": {
+                    "type": "string",
+                    "example": "marketing"
+                }
+            }
+        },
+        "handler.InterstitialModeRequest": {
+            "type": "object",
+            "required": [
+                "mode"
+            ],
+            "properties": {
+                "mode": {
+                    "type": "string",
+                    "enum": [
+                        "auto",
+                        "always",
+                        "never"
+                    ],
+                    "example": "always"
+                }
+            }
+        },
+        "handler.LinkHealthResponse": {
+            "type": "object",
+            "properties": {
+                "checked_at": {
+                    "type": "integer",
+                    "example": 1672531200
+                },
+                "consecutive_failures": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "domain": {
+                    "type": "string",
+                    "example": "go.acme.com"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "dial tcp: i/o timeout"
+                },
+                "last_healthy_at": {
+                    "type": "integer",
+                    "example": 1672531200
+                },
+                "latency_ms": {
+                    "type": "integer",
+                    "example": 182
+                },
+                "redirect_chain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "https://www.example.com/"
+                    ]
+                },
+                "short_code": {
+                    "type": "string",
+                    "example": "abc123"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "healthy",
+                        "failing",
+                        "broken",
+                        "unknown"
+                    ],
+                    "example": "healthy"
+                },
+                "status_code": {
+                    "type": "integer",
+                    "example": 200
+                }
+            }
+        },
+        "handler.LinkPreview": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Our biggest sale of the season"
+                },
+                "image": {
+                    "type": "string",
+                    "example": "https://www.example.com/images/sale.png"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Spring sale - up to 50% off"
+                }
+            }
+        },
+        "handler.RedirectResponse": {
+            "type": "object",
+            "properties": {
+                "click_count": {
+                    "type": "integer",
+                    "example": 43
+                },
+                "long_url": {
+                    "type": "string",
+                    "example": "https://www.google.com"
+                },
+                "session_id": {
+                    "type": "string",
+                    "example": "sess_1234567890abcdef"
+                },
+                "short_code": {
+                    "type": "string",
+                    "example": "abc123"
+                },
+                "timestamp": {
+                    "type": "integer",
+                    "example": 1672531200
+                }
+            }
+        },
+        "handler.ReferrerStatsItem": {
+            "type": "object",
+            "properties": {
+                "clicks": {
+                    "type": "integer",
+                    "example": 45
+                },
+                "percentage": {
+                    "type": "number",
+                    "example": 30
+                },
+                "referrer": {
+                    "type": "string",
+                    "example": "https://google.com"
+                }
+            }
+        },
+        "handler.RenameTagRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "spring 2024"
+                }
+            }
+        },
+        "handler.SearchFacetsResponse": {
+            "type": "object",
+            "properties": {
+                "domains": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.FacetCountResponse"
+                    }
+                },
+                "statuses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.FacetCountResponse"
+                    }
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.FacetCountResponse"
+                    }
+                }
+            }
+        },
+        "handler.SearchHitResponse": {
+            "type": "object",
+            "properties": {
+                "activates_at": {
+                    "type": "integer",
+                    "example": 1704067200
+                },
+                "archived_at": {
+                    "description": "set while archived for inactivity",
+                    "type": "integer",
+                    "example": 1704067200
+                },
+                "click_count": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "created_at": {
+                    "type": "integer",
+                    "example": 1672531200
+                },
+                "deleted_at": {
+                    "description": "set while the link is in the trash",
+                    "type": "integer",
+                    "example": 1704067200
+                },
+                "disabled_at": {
+                    "type": "integer",
+                    "example": 1704067200
+                },
+                "disabled_reason": {
+                    "type": "string",
+                    "example": "blocklisted_domain"
+                },
+                "domain": {
+                    "type": "string",
+                    "example": "go.acme.com"
+                },
+                "expires_at": {
+                    "type": "integer",
+                    "example": 1735689600
+                },
+                "fallback_url": {
+                    "type": "string",
+                    "example": "https://www.google.com/coming-soon"
+                },
+                "folder_id": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "inactivity_exempt": {
+                    "description": "never archived by the workspace's inactivity policy",
+                    "type": "boolean",
+                    "example": false
+                },
+                "interstitial_mode": {
+                    "type": "string",
+                    "example": "auto"
+                },
+                "is_active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "long_url": {
+                    "type": "string",
+                    "example": "https://www.google.com"
+                },
+                "max_clicks": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "campaign": "social",
+                        "source": "twitter"
+                    }
+                },
+                "password_protected": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "preview": {
+                    "$ref": "#/definitions/handler.LinkPreview"
+                },
+                "preview_override": {
+                    "$ref": "#/definitions/handler.LinkPreview"
+                },
+                "purge_at": {
+                    "description": "when the trash retention removes it for good",
+                    "type": "integer",
+                    "example": 1706659200
+                },
+                "rank": {
+                    "type": "number",
+                    "example": 0.61
+                },
+                "short_code": {
+                    "type": "string",
+                    "example": "abc123"
+                },
+                "short_url": {
+                    "type": "string",
+                    "example": "https://short.ly/abc123"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "spring campaign",
+                        "social"
+                    ]
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "user123"
+                },
+                "utm_template": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "utm_campaign": "spring",
+                        "utm_source": "{referrer_domain}"
+                    }
+                },
+                "workspace_id": {
+                    "type": "string",
+                    "example": "marketing"
+                }
+            }
+        },
+        "handler.SearchURLsResponse": {
+            "type": "object",
+            "properties": {
+                "facets": {
+                    "$ref": "#/definitions/handler.SearchFacetsResponse"
+                },
+                "has_next": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "prev_cursor": {
+                    "type": "string"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.SearchHitResponse"
+                    }
+                },
+                "total_count": {
+                    "type": "integer",
+                    "example": 9
+                }
+            }
+        },
+        "handler.ShortenURLRequest": {
+            "type": "object",
+            "required": [
+                "long_url",
+                "user_id"
+            ],
+            "properties": {
+                "activation_time": {
+                    "type": "integer",
+                    "example": 1704067200
+                },
+                "custom_alias": {
+                    "type": "string",
+                    "example": "google"
+                },
+                "domain": {
+                    "description": "branded domain of the workspace; default domain when empty",
+                    "type": "string",
+                    "example": "go.acme.com"
+                },
+                "expiration_time": {
+                    "type": "integer",
+                    "example": 1735689600
+                },
+                "fallback_url": {
+                    "type": "string",
+                    "example": "https://www.google.com/coming-soon"
+                },
+                "folder_id": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "long_url": {
+                    "type": "string",
+                    "example": "https://www.google.com"
+                },
+                "max_clicks": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "campaign": "social",
+                        "source": "twitter"
+                    }
+                },
+                "password": {
+                    "type": "string",
+                    "example": "s3cret-pass"
+                },
+                "preview_override": {
+                    "$ref": "#/definitions/handler.LinkPreview"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "spring campaign",
+                        "social"
+                    ]
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "user123"
+                },
+                "utm_template": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "utm_campaign": "spring",
+                        "utm_source": "{referrer_domain}"
+                    }
+                },
+                "workspace_id": {
+                    "type": "string",
+                    "example": "marketing"
+                }
+            }
+        },
+        "handler.ShortenURLResponse": {
+            "type": "object",
+            "properties": {
+                "activates_at": {
+                    "type": "integer",
+                    "example": 1704067200
+                },
+                "created_at": {
+                    "type": "integer",
+                    "example": 1672531200
+                },
+                "domain": {
+                    "type": "string",
+                    "example": "go.acme.com"
+                },
+                "expires_at": {
+                    "type": "integer",
+                    "example": 1735689600
+                },
+                "long_url": {
+                    "type": "string",
+                    "example": "https://www.google.com"
+                },
+                "max_clicks": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "password_protected": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "short_code": {
+                    "type": "string",
+                    "example": "abc123"
+                },
+                "short_url": {
+                    "type": "string",
+                    "example": "https://short.ly/abc123"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "user123"
+                },
+                "workspace_id": {
+                    "type": "string",
+                    "example": "marketing"
+                }
+            }
+        },
+        "handler.TagRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "spring campaign"
+                }
+            }
+        },
+        "handler.TagResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "integer",
+                    "example": 1672531200
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "link_count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "name": {
+                    "type": "string",
+                    "example": "spring campaign"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "user123"
+                }
+            }
+        },
+        "handler.TagsResponse": {
+            "type": "object",
+            "properties": {
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.TagResponse"
+                    }
+                }
+            }
+        },
+        "handler.TimeSeriesPoint": {
+            "type": "object",
+            "properties": {
+                "clicks": {
+                    "type": "integer",
+                    "example": 25
+                },
+                "timestamp": {
+                    "type": "integer",
+                    "example": 1672531200
+                },
+                "unique_clicks": {
+                    "type": "integer",
+                    "example": 18
+                }
+            }
+        },
+        "handler.TopURLItem": {
             "type": "object",
             "properties": {
-                "clicks": {
+                "created_at": {
                     "type": "integer",
-                    "example": 75
+                    "example": 1672531200
                 },
-                "country": {
+                "last_clicked": {
+                    "type": "integer",
+                    "example": 1672617600
+                },
+                "short_code": {
                     "type": "string",
-                    "example": "US"
+                    "example": "abc123"
                 },
-                "percentage": {
-                    "type": "number",
-                    "example": 50
+                "total_clicks": {
+                    "type": "integer",
+                    "example": 150
+                },
+                "unique_clicks": {
+                    "type": "integer",
+                    "example": 95
                 }
             }
         },
-        "handler.DeleteResponse": {
+        "handler.TopURLsResponse": {
             "type": "object",
             "properties": {
-                "message": {
-                    "type": "string",
-                    "example": "URL deleted successfully"
+                "urls": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.TopURLItem"
+                    }
                 }
             }
         },
-        "handler.DeviceStatsItem": {
+        "handler.URLInfoResponse": {
             "type": "object",
             "properties": {
-                "clicks": {
+                "activates_at": {
                     "type": "integer",
-                    "example": 90
+                    "example": 1704067200
                 },
-                "device_type": {
+                "archived_at": {
+                    "description": "set while archived for inactivity",
+                    "type": "integer",
+                    "example": 1704067200
+                },
+                "click_count": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "created_at": {
+                    "type": "integer",
+                    "example": 1672531200
+                },
+                "deleted_at": {
+                    "description": "set while the link is in the trash",
+                    "type": "integer",
+                    "example": 1704067200
+                },
+                "disabled_at": {
+                    "type": "integer",
+                    "example": 1704067200
+                },
+                "disabled_reason": {
                     "type": "string",
-                    "example": "Desktop"
+                    "example": "blocklisted_domain"
                 },
-                "percentage": {
-                    "type": "number",
-                    "example": 60
+                "domain": {
+                    "type": "string",
+                    "example": "go.acme.com"
+                },
+                "expires_at": {
+                    "type": "integer",
+                    "example": 1735689600
+                },
+                "fallback_url": {
+                    "type": "string",
+                    "example": "https://www.google.com/coming-soon"
+                },
+                "folder_id": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "inactivity_exempt": {
+                    "description": "never archived by the workspace's inactivity policy",
+                    "type": "boolean",
+                    "example": false
+                },
+                "interstitial_mode": {
+                    "type": "string",
+                    "example": "auto"
+                },
+                "is_active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "long_url": {
+                    "type": "string",
+                    "example": "https://www.google.com"
+                },
+                "max_clicks": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "campaign": "social",
+                        "source": "twitter"
+                    }
+                },
+                "password_protected": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "preview": {
+                    "$ref": "#/definitions/handler.LinkPreview"
+                },
+                "preview_override": {
+                    "$ref": "#/definitions/handler.LinkPreview"
+                },
+                "purge_at": {
+                    "description": "when the trash retention removes it for good",
+                    "type": "integer",
+                    "example": 1706659200
+                },
+                "short_code": {
+                    "type": "string",
+                    "example": "abc123"
+                },
+                "short_url": {
+                    "type": "string",
+                    "example": "https://short.ly/abc123"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "spring campaign",
+                        "social"
+                    ]
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "user123"
+                },
+                "utm_template": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "utm_campaign": "spring",
+                        "utm_source": "{referrer_domain}"
+                    }
+                },
+                "workspace_id": {
+                    "type": "string",
+                    "example": "marketing"
                 }
             }
         },
-        "handler.ErrorResponse": {
+        "handler.URLRevisionResponse": {
             "type": "object",
             "properties": {
-                "error": {
+                "changed_by": {
                     "type": "string",
-                    "example": "Invalid request body"
+                    "example": "user123"
+                },
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.FieldChangeResponse"
+                    }
+                },
+                "created_at": {
+                    "type": "integer",
+                    "example": 1704067200
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "revision": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "rollback_of": {
+                    "description": "the revision rolled back",
+                    "type": "integer",
+                    "example": 41
                 }
             }
         },
-        "handler.RedirectResponse": {
+        "handler.URLRevisionsResponse": {
             "type": "object",
             "properties": {
-                "click_count": {
-                    "type": "integer",
-                    "example": 43
+                "has_next": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "long_url": {
-                    "type": "string",
-                    "example": "https://www.google.com"
+                "next_cursor": {
+                    "type": "string"
                 },
-                "session_id": {
-                    "type": "string",
-                    "example": "sess_1234567890abcdef"
+                "page_size": {
+                    "type": "integer",
+                    "example": 20
                 },
-                "short_code": {
-                    "type": "string",
-                    "example": "abc123"
+                "prev_cursor": {
+                    "type": "string"
                 },
-                "timestamp": {
+                "revisions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.URLRevisionResponse"
+                    }
+                },
+                "total_count": {
                     "type": "integer",
-                    "example": 1672531200
+                    "example": 3
                 }
             }
         },
-        "handler.ReferrerStatsItem": {
+        "handler.URLStatsResponse": {
             "type": "object",
             "properties": {
-                "clicks": {
-                    "type": "integer",
-                    "example": 45
+                "browser_stats": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.BrowserStatsItem"
+                    }
                 },
-                "percentage": {
-                    "type": "number",
-                    "example": 30
+                "country_stats": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.CountryStatsItem"
+                    }
                 },
-                "referrer": {
+                "device_stats": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.DeviceStatsItem"
+                    }
+                },
+                "referrer_stats": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.ReferrerStatsItem"
+                    }
+                },
+                "short_code": {
                     "type": "string",
-                    "example": "https://google.com"
+                    "example": "abc123"
+                },
+                "time_series": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.TimeSeriesPoint"
+                    }
+                },
+                "total_clicks": {
+                    "type": "integer",
+                    "example": 150
+                },
+                "unique_clicks": {
+                    "type": "integer",
+                    "example": 95
                 }
             }
         },
-        "handler.ShortenURLRequest": {
+        "handler.UpdateURLRequest": {
             "type": "object",
             "required": [
-                "long_url",
                 "user_id"
             ],
             "properties": {
-                "custom_alias": {
-                    "type": "string",
-                    "example": "google"
+                "activation_time": {
+                    "type": "integer",
+                    "example": 1704067200
+                },
+                "clear_folder": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "clear_max_clicks": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "clear_password": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "clear_tags": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "clear_utm_template": {
+                    "type": "boolean",
+                    "example": false
                 },
                 "expiration_time": {
                     "type": "integer",
                     "example": 1735689600
                 },
-                "long_url": {
+                "fallback_url": {
+                    "type": "string",
+                    "example": "https://www.google.com/coming-soon"
+                },
+                "folder_id": {
+                    "description": "moves the link into the folder",
+                    "type": "integer",
+                    "example": 4
+                },
+                "inactivity_exempt": {
+                    "description": "exempts the link from the workspace's inactivity policy",
+                    "type": "boolean",
+                    "example": true
+                },
+                "long_url": {
+                    "type": "string",
+                    "example": "https://www.google.com/search"
+                },
+                "max_clicks": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "campaign": "social",
+                        "source": "twitter"
+                    }
+                },
+                "password": {
+                    "type": "string",
+                    "example": "n3w-pass"
+                },
+                "preview_override": {
+                    "description": "replaces the overrides; {} clears them",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/handler.LinkPreview"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "replaces the tags",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "spring campaign",
+                        "social"
+                    ]
+                },
+                "user_id": {
                     "type": "string",
-                    "example": "https://www.google.com"
+                    "example": "user123"
                 },
-                "metadata": {
+                "utm_template": {
                     "type": "object",
                     "additionalProperties": {
                         "type": "string"
                     },
                     "example": {
-                        "campaign": "social",
-                        "source": "twitter"
+                        "utm_medium": "email",
+                        "utm_source": "newsletter"
                     }
-                },
-                "user_id": {
-                    "type": "string",
-                    "example": "user123"
                 }
             }
         },
-        "handler.ShortenURLResponse": {
+        "handler.UpdateWebhookRequest": {
             "type": "object",
+            "required": [
+                "user_id"
+            ],
             "properties": {
-                "created_at": {
-                    "type": "integer",
-                    "example": 1672531200
+                "clear_events": {
+                    "description": "subscribe to all events",
+                    "type": "boolean",
+                    "example": false
                 },
-                "expires_at": {
-                    "type": "integer",
-                    "example": 1735689600
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "url.created",
+                        "url.expired"
+                    ]
                 },
-                "long_url": {
-                    "type": "string",
-                    "example": "https://www.google.com"
+                "is_active": {
+                    "description": "true re-enables a disabled webhook and resets its failures",
+                    "type": "boolean",
+                    "example": true
                 },
-                "short_code": {
-                    "type": "string",
-                    "example": "abc123"
+                "rotate_secret": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "short_url": {
+                "url": {
                     "type": "string",
-                    "example": "https://short.ly/abc123"
+                    "example": "https://hooks.acme.com/links"
                 },
                 "user_id": {
                     "type": "string",
@@ -607,180 +4995,293 @@ const docTemplate = `{
                 }
             }
         },
-        "handler.TimeSeriesPoint": {
+        "handler.UserURLsResponse": {
             "type": "object",
             "properties": {
-                "clicks": {
-                    "type": "integer",
-                    "example": 25
+                "has_next": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "timestamp": {
-                    "type": "integer",
-                    "example": 1672531200
+                "next_cursor": {
+                    "type": "string",
+                    "example": "eyJvIjoiY3JlYXRlZF9hdDpkZXNjIiwidiI6IjIwMjQtMDMtMDFUMTA6MDA6MDBaIiwiaSI6NDJ9"
                 },
-                "unique_clicks": {
-                    "type": "integer",
-                    "example": 18
-                }
-            }
-        },
-        "handler.TopURLItem": {
-            "type": "object",
-            "properties": {
-                "created_at": {
+                "page": {
                     "type": "integer",
-                    "example": 1672531200
+                    "example": 1
                 },
-                "last_clicked": {
+                "page_size": {
                     "type": "integer",
-                    "example": 1672617600
+                    "example": 20
                 },
-                "short_code": {
-                    "type": "string",
-                    "example": "abc123"
+                "prev_cursor": {
+                    "type": "string"
                 },
-                "total_clicks": {
+                "total_count": {
                     "type": "integer",
-                    "example": 150
+                    "example": 100
                 },
-                "unique_clicks": {
-                    "type": "integer",
-                    "example": 95
+                "urls": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.URLInfoResponse"
+                    }
                 }
             }
         },
-        "handler.TopURLsResponse": {
+        "handler.WebhookDeliveriesResponse": {
             "type": "object",
             "properties": {
-                "urls": {
+                "deliveries": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/handler.TopURLItem"
+                        "$ref": "#/definitions/handler.WebhookDeliveryResponse"
                     }
+                },
+                "has_next": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "prev_cursor": {
+                    "type": "string"
+                },
+                "total_count": {
+                    "type": "integer",
+                    "example": 42
                 }
             }
         },
-        "handler.URLInfoResponse": {
+        "handler.WebhookDeliveryResponse": {
             "type": "object",
             "properties": {
-                "click_count": {
+                "attempts": {
                     "type": "integer",
-                    "example": 42
+                    "example": 1
                 },
                 "created_at": {
                     "type": "integer",
-                    "example": 1672531200
+                    "example": 1704067200
                 },
-                "expires_at": {
+                "delivered_at": {
                     "type": "integer",
-                    "example": 1735689600
+                    "example": 1704067201
                 },
-                "is_active": {
-                    "type": "boolean",
-                    "example": true
+                "error": {
+                    "type": "string"
                 },
-                "long_url": {
+                "event_id": {
                     "type": "string",
-                    "example": "https://www.google.com"
-                },
-                "metadata": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    },
-                    "example": {
-                        "campaign": "social",
-                        "source": "twitter"
-                    }
+                    "example": "evt_5f2b9c1e8a7d4e3f9b6a0c1d2e3f4a5b"
                 },
-                "short_code": {
+                "event_type": {
                     "type": "string",
-                    "example": "abc123"
+                    "example": "url.created"
                 },
-                "short_url": {
+                "id": {
+                    "type": "integer",
+                    "example": 1042
+                },
+                "last_attempt_at": {
+                    "type": "integer",
+                    "example": 1704067201
+                },
+                "next_attempt_at": {
+                    "description": "pending deliveries only",
+                    "type": "integer",
+                    "example": 0
+                },
+                "payload": {
+                    "description": "the body as sent",
+                    "type": "object"
+                },
+                "response_body": {
+                    "description": "truncated",
                     "type": "string",
-                    "example": "https://short.ly/abc123"
+                    "example": "ok"
                 },
-                "user_id": {
+                "response_status": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "status": {
+                    "description": "pending, succeeded or failed",
                     "type": "string",
-                    "example": "user123"
+                    "example": "succeeded"
+                },
+                "webhook_id": {
+                    "type": "integer",
+                    "example": 12
                 }
             }
         },
-        "handler.URLStatsResponse": {
+        "handler.WebhookResponse": {
             "type": "object",
             "properties": {
-                "browser_stats": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/handler.BrowserStatsItem"
-                    }
+                "consecutive_failures": {
+                    "type": "integer",
+                    "example": 0
                 },
-                "country_stats": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/handler.CountryStatsItem"
-                    }
+                "created_at": {
+                    "type": "integer",
+                    "example": 1704067200
                 },
-                "device_stats": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/handler.DeviceStatsItem"
-                    }
+                "created_by": {
+                    "type": "string",
+                    "example": "user123"
                 },
-                "referrer_stats": {
+                "disabled_at": {
+                    "description": "set when repeated failures turned the webhook off",
+                    "type": "integer",
+                    "example": 0
+                },
+                "events": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/handler.ReferrerStatsItem"
-                    }
+                        "type": "string"
+                    },
+                    "example": [
+                        "url.created",
+                        "url.deleted"
+                    ]
                 },
-                "short_code": {
+                "id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "is_active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "secret": {
+                    "description": "only returned on creation and rotation",
                     "type": "string",
-                    "example": "abc123"
+                    "example": "whsec_5f2b9c1e8a7d4e3f9b6a0c1d2e3f4a5b"
                 },
-                "time_series": {
+                "updated_at": {
+                    "type": "integer",
+                    "example": 1704067200
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://hooks.acme.com/links"
+                },
+                "workspace_id": {
+                    "type": "string",
+                    "example": "marketing"
+                }
+            }
+        },
+        "handler.WebhooksResponse": {
+            "type": "object",
+            "properties": {
+                "webhooks": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/handler.TimeSeriesPoint"
+                        "$ref": "#/definitions/handler.WebhookResponse"
                     }
+                }
+            }
+        },
+        "handler.WorkspacePlanRequest": {
+            "type": "object",
+            "required": [
+                "plan"
+            ],
+            "properties": {
+                "plan": {
+                    "type": "string",
+                    "example": "pro"
+                }
+            }
+        },
+        "handler.WorkspaceRequest": {
+            "type": "object",
+            "required": [
+                "owner_id"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Marketing"
                 },
-                "total_clicks": {
-                    "type": "integer",
-                    "example": 150
+                "owner_id": {
+                    "type": "string",
+                    "example": "user123"
                 },
-                "unique_clicks": {
-                    "type": "integer",
-                    "example": 95
+                "utm_template": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "utm_medium": "social",
+                        "utm_source": "{referrer_domain}"
+                    }
                 }
             }
         },
-        "handler.UserURLsResponse": {
+        "handler.WorkspaceResponse": {
             "type": "object",
             "properties": {
-                "has_next": {
-                    "type": "boolean",
-                    "example": true
-                },
-                "page": {
+                "created_at": {
                     "type": "integer",
-                    "example": 1
+                    "example": 1672531200
                 },
-                "page_size": {
+                "inactivity_days": {
+                    "description": "archive links not clicked for this many days, 0 for never",
                     "type": "integer",
-                    "example": 20
+                    "example": 180
                 },
-                "total_count": {
+                "inactivity_dry_run": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Marketing"
+                },
+                "owner_id": {
+                    "type": "string",
+                    "example": "user123"
+                },
+                "plan": {
+                    "type": "string",
+                    "example": "free"
+                },
+                "updated_at": {
                     "type": "integer",
-                    "example": 100
+                    "example": 1672617600
                 },
-                "urls": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/handler.URLInfoResponse"
+                "utm_template": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "utm_medium": "social",
+                        "utm_source": "{referrer_domain}"
                     }
+                },
+                "workspace_id": {
+                    "type": "string",
+                    "example": "marketing"
                 }
             }
         }
+    },
+    "securityDefinitions": {
+        "AdminToken": {
+            "type": "apiKey",
+            "name": "X-Admin-Token",
+            "in": "header"
+        }
     }
 }`
 
@@ -0,0 +1,351 @@
+package handler
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/go-systems-lab/go-url-shortener/proto/url"
+)
+
+// AdminAuth guards admin endpoints with a shared token sent in the X-Admin-Token header.
+// Admin endpoints are disabled when no token is configured.
+func AdminAuth(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if token == "" {
+			c.AbortWithStatusJSON(http.StatusForbidden, ErrorResponse{Error: "Admin API is disabled"})
+			return
+		}
+		if subtle.ConstantTimeCompare([]byte(c.GetHeader("X-Admin-Token")), []byte(token)) != 1 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid admin token"})
+			return
+		}
+		// Changes made through the admin API are audited as the admin's
+		c.Set(auditActorKey, "admin")
+		c.Next()
+	}
+}
+
+// InterstitialModeRequest represents the admin request for changing a link's interstitial mode
+type InterstitialModeRequest struct {
+	Mode string `json:"mode" binding:"required" example:"always" enums:"auto,always,never"`
+}
+
+// WorkspacePlanRequest represents the admin request for changing a workspace's plan
+type WorkspacePlanRequest struct {
+	Plan string `json:"plan" binding:"required" example:"pro"`
+}
+
+// DomainReviewRequest represents the admin request for reviewing a destination domain
+type DomainReviewRequest struct {
+	Status string `json:"status" binding:"required" example:"review" enums:"review,trusted"`
+	Reason string `json:"reason,omitempty" example:"Reported phishing campaign"`
+}
+
+// DomainReviewResponse represents a reviewed destination domain
+type DomainReviewResponse struct {
+	Domain    string `json:"domain" example:"example.com"`
+	Status    string `json:"status" example:"review"`
+	Reason    string `json:"reason,omitempty" example:"Reported phishing campaign"`
+	UpdatedBy string `json:"updated_by,omitempty" example:"admin@example.com"`
+	CreatedAt int64  `json:"created_at" example:"1672531200"`
+	UpdatedAt int64  `json:"updated_at" example:"1672617600"`
+}
+
+// DomainReviewsResponse represents the domain review list
+type DomainReviewsResponse struct {
+	Reviews []DomainReviewResponse `json:"reviews"`
+}
+
+// toDomainReviewResponse converts an RPC domain review to its REST representation
+func toDomainReviewResponse(review *pb.DomainReview) DomainReviewResponse {
+	return DomainReviewResponse{
+		Domain:    review.Domain,
+		Status:    review.Status,
+		Reason:    review.Reason,
+		UpdatedBy: review.UpdatedBy,
+		CreatedAt: review.CreatedAt,
+		UpdatedAt: review.UpdatedAt,
+	}
+}
+
+// SetInterstitialMode handles PUT /api/v1/admin/urls/:shortCode/interstitial
+//
+//	@Summary		Set a link's interstitial mode
+//	@Description	Choose whether visitors see a "you are leaving" warning page: auto (domain review list and account trust decide), always or never
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Security		AdminToken
+//	@Param			shortCode	path		string					true	"Short code identifier"	example(abc123)
+//	@Param			domain		query		string					false	"Branded domain of the link (default domain when empty)"	example(go.acme.com)
+//	@Param			request		body		InterstitialModeRequest	true	"Interstitial mode"
+//	@Success		200			{object}	URLInfoResponse			"Interstitial mode updated"
+//	@Failure		400			{object}	ErrorResponse			"Invalid mode or unknown short code"
+//	@Failure		401			{object}	ErrorResponse			"Invalid admin token"
+//	@Failure		500			{object}	ErrorResponse			"Internal server error"
+//	@Router			/admin/urls/{shortCode}/interstitial [put]
+func (h *URLHandler) SetInterstitialMode(c *gin.Context) {
+	shortCode := c.Param("shortCode")
+
+	var req InterstitialModeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request body"})
+		return
+	}
+
+	h.log.WithFields(logrus.Fields{
+		"short_code": shortCode,
+		"mode":       req.Mode,
+	}).Info("Processing SetInterstitialMode admin request")
+
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.SetInterstitialMode(ctx, &pb.SetInterstitialModeRequest{
+		ShortCode: shortCode,
+		Mode:      req.Mode,
+		Domain:    c.Query("domain"),
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to set interstitial mode"})
+		return
+	}
+
+	if !rsp.Success {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: rsp.Message})
+		return
+	}
+
+	c.JSON(http.StatusOK, toURLInfoResponse(rsp.UpdatedUrl))
+}
+
+// SetWorkspacePlan handles PUT /api/v1/admin/workspaces/:workspaceID/plan
+//
+//	@Summary		Set a workspace's plan
+//	@Description	Move a workspace to another plan. The plan decides the custom alias rules (length and whether - and _ are allowed) for aliases created from now on
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Security		AdminToken
+//	@Param			workspaceID	path		string					true	"Workspace identifier"	example(marketing)
+//	@Param			request		body		WorkspacePlanRequest	true	"Plan"
+//	@Success		200			{object}	WorkspaceResponse		"Workspace plan updated"
+//	@Failure		400			{object}	ErrorResponse			"Unknown plan"
+//	@Failure		401			{object}	ErrorResponse			"Invalid admin token"
+//	@Failure		404			{object}	ErrorResponse			"Workspace not found"
+//	@Failure		500			{object}	ErrorResponse			"Internal server error"
+//	@Router			/admin/workspaces/{workspaceID}/plan [put]
+func (h *URLHandler) SetWorkspacePlan(c *gin.Context) {
+	workspaceID := c.Param("workspaceID")
+
+	var req WorkspacePlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request body"})
+		return
+	}
+
+	h.log.WithFields(logrus.Fields{
+		"workspace_id": workspaceID,
+		"plan":         req.Plan,
+	}).Info("Processing SetWorkspacePlan admin request")
+
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.SetWorkspacePlan(ctx, &pb.SetWorkspacePlanRequest{
+		WorkspaceId: workspaceID,
+		Plan:        req.Plan,
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		switch {
+		case strings.Contains(err.Error(), "unknown workspace plan"):
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Unknown plan"})
+		case strings.Contains(err.Error(), "workspace not found"):
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: "Workspace not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to set workspace plan"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, toWorkspaceResponse(rsp))
+}
+
+// UpsertDomainReview handles PUT /api/v1/admin/domains/:domain
+//
+//	@Summary		Review a destination domain
+//	@Description	Put a domain (and its subdomains) on the review list so links to it show a warning page, or mark it trusted so they never do
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Security		AdminToken
+//	@Param			domain			path		string					true	"Destination domain"	example(example.com)
+//	@Param			X-Admin-User	header		string					false	"Admin making the change"
+//	@Param			request			body		DomainReviewRequest		true	"Domain review"
+//	@Success		200				{object}	DomainReviewResponse	"Domain review saved"
+//	@Failure		400				{object}	ErrorResponse			"Invalid request body"
+//	@Failure		401				{object}	ErrorResponse			"Invalid admin token"
+//	@Failure		500				{object}	ErrorResponse			"Internal server error"
+//	@Router			/admin/domains/{domain} [put]
+func (h *URLHandler) UpsertDomainReview(c *gin.Context) {
+	domainName := c.Param("domain")
+
+	var req DomainReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request body"})
+		return
+	}
+
+	h.log.WithFields(logrus.Fields{
+		"domain": domainName,
+		"status": req.Status,
+	}).Info("Processing UpsertDomainReview admin request")
+
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.UpsertDomainReview(ctx, &pb.DomainReview{
+		Domain:    domainName,
+		Status:    req.Status,
+		Reason:    req.Reason,
+		UpdatedBy: c.GetHeader("X-Admin-User"),
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to save domain review"})
+		return
+	}
+
+	c.JSON(http.StatusOK, toDomainReviewResponse(rsp))
+}
+
+// DeleteDomainReview handles DELETE /api/v1/admin/domains/:domain
+//
+//	@Summary		Remove a domain review
+//	@Description	Take a domain off the review list; its links fall back to the default interstitial rules
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Security		AdminToken
+//	@Param			domain	path		string			true	"Destination domain"	example(example.com)
+//	@Success		200		{object}	DeleteResponse	"Domain review removed"
+//	@Failure		400		{object}	ErrorResponse	"Invalid domain"
+//	@Failure		401		{object}	ErrorResponse	"Invalid admin token"
+//	@Failure		500		{object}	ErrorResponse	"Internal server error"
+//	@Router			/admin/domains/{domain} [delete]
+func (h *URLHandler) DeleteDomainReview(c *gin.Context) {
+	domainName := c.Param("domain")
+
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.DeleteDomainReview(ctx, &pb.DeleteDomainReviewRequest{Domain: domainName})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to delete domain review"})
+		return
+	}
+
+	if !rsp.Success {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: rsp.Message})
+		return
+	}
+
+	c.JSON(http.StatusOK, DeleteResponse{Message: rsp.Message})
+}
+
+// ListDomainReviews handles GET /api/v1/admin/domains
+//
+//	@Summary		List reviewed domains
+//	@Description	List destination domains on the review list or marked trusted
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Security		AdminToken
+//	@Param			status	query		string					false	"Filter by status"	Enums(review, trusted)
+//	@Success		200		{object}	DomainReviewsResponse	"Reviewed domains"
+//	@Failure		401		{object}	ErrorResponse			"Invalid admin token"
+//	@Failure		500		{object}	ErrorResponse			"Internal server error"
+//	@Router			/admin/domains [get]
+func (h *URLHandler) ListDomainReviews(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.ListDomainReviews(ctx, &pb.ListDomainReviewsRequest{Status: c.Query("status")})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to list domain reviews"})
+		return
+	}
+
+	response := DomainReviewsResponse{Reviews: make([]DomainReviewResponse, len(rsp.Reviews))}
+	for i, review := range rsp.Reviews {
+		response.Reviews[i] = toDomainReviewResponse(review)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ListFlaggedURLs handles GET /api/v1/admin/flagged-urls
+//
+//	@Summary		List links flagged as unsafe
+//	@Description	List links disabled by the destination safety engine with their reason code (blocklisted_domain, blocklisted_url, safe_browsing_match, idn_homograph, ip_host, shortener_chain)
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Security		AdminToken
+//	@Param			cursor		query		string				false	"next_cursor or prev_cursor of a previous page"
+//	@Param			page		query		int					false	"Page number (deprecated, use cursor)"	default(1)
+//	@Param			page_size	query		int					false	"Items per page"	default(20)
+//	@Success		200			{object}	UserURLsResponse	"Flagged links"
+//	@Failure		400			{object}	ErrorResponse		"Invalid cursor"
+//	@Failure		401			{object}	ErrorResponse		"Invalid admin token"
+//	@Failure		500			{object}	ErrorResponse		"Internal server error"
+//	@Router			/admin/flagged-urls [get]
+func (h *URLHandler) ListFlaggedURLs(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.ListFlaggedURLs(ctx, &pb.ListFlaggedURLsRequest{
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+		Cursor:   c.Query("cursor"),
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		if strings.Contains(err.Error(), "invalid cursor") {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid cursor"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to list flagged URLs"})
+		return
+	}
+
+	urls := make([]URLInfoResponse, len(rsp.Urls))
+	for i, url := range rsp.Urls {
+		urls[i] = toURLInfoResponse(url)
+	}
+
+	flagDeprecatedPaging(c)
+	c.JSON(http.StatusOK, UserURLsResponse{
+		URLs:       urls,
+		TotalCount: rsp.TotalCount,
+		Page:       rsp.Page,
+		PageSize:   rsp.PageSize,
+		HasNext:    rsp.HasNext,
+		NextCursor: rsp.NextCursor,
+		PrevCursor: rsp.PrevCursor,
+	})
+}
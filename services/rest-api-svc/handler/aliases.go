@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/go-systems-lab/go-url-shortener/proto/url"
+)
+
+// AliasSuggestionsResponse represents available alternatives to a custom alias
+type AliasSuggestionsResponse struct {
+	Alias       string   `json:"alias" example:"promo"`
+	Available   bool     `json:"available" example:"false"`
+	Suggestions []string `json:"suggestions" example:"spring-sale,promo-2,go-promo"`
+}
+
+// AliasConflictResponse is returned when a custom alias is already taken
+type AliasConflictResponse struct {
+	Error       string   `json:"error" example:"Custom alias already exists"`
+	Suggestions []string `json:"suggestions,omitempty" example:"promo-2,go-promo"`
+}
+
+// SuggestAliases handles GET /api/v1/aliases/suggestions
+//
+//	@Summary		Suggest custom aliases
+//	@Description	Check whether a custom alias is available and suggest available alternatives: numbered suffixes, dictionary word combinations and slugs of the destination page title. Suggestions follow the alias rules of the workspace plan
+//	@Tags			URL Management
+//	@Accept			json
+//	@Produce		json
+//	@Param			alias			query		string						false	"Desired custom alias (alias or long_url is required)"	example(promo)
+//	@Param			long_url		query		string						false	"Destination URL whose page title seeds suggestions"	example(https://www.acme.com/spring-sale)
+//	@Param			domain			query		string						false	"Branded domain (default domain when empty)"			example(go.acme.com)
+//	@Param			workspace_id	query		string						false	"Workspace whose plan decides the alias rules"			example(marketing)
+//	@Param			user_id			query		string						true	"User ID"												example(user123)
+//	@Param			count			query		int							false	"Number of suggestions (max 20)"						default(5)
+//	@Success		200				{object}	AliasSuggestionsResponse	"Alias suggestions"
+//	@Failure		400				{object}	ErrorResponse				"Invalid request"
+//	@Failure		500				{object}	ErrorResponse				"Internal server error"
+//	@Router			/aliases/suggestions [get]
+func (h *URLHandler) SuggestAliases(c *gin.Context) {
+	userID := c.Query("user_id")
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "user_id is required"})
+		return
+	}
+
+	rpcReq := &pb.SuggestAliasesRequest{
+		Alias:       c.Query("alias"),
+		LongUrl:     c.Query("long_url"),
+		Domain:      c.Query("domain"),
+		WorkspaceId: c.Query("workspace_id"),
+		UserId:      userID,
+	}
+	if count := c.Query("count"); count != "" {
+		value, err := strconv.Atoi(count)
+		if err != nil || value < 1 {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "count must be a positive number"})
+			return
+		}
+		rpcReq.Count = int32(value)
+	}
+
+	h.log.WithFields(logrus.Fields{
+		"alias":   rpcReq.Alias,
+		"user_id": userID,
+	}).Info("Processing SuggestAliases REST request")
+
+	suggestions, err := h.suggestAliases(rpcReq)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		switch {
+		case strings.Contains(err.Error(), "need an alias or a destination URL"):
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "alias or long_url is required"})
+		case strings.Contains(err.Error(), "URL validation failed"):
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid long_url"})
+		case strings.Contains(err.Error(), "branded domain"):
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Domain is not a branded domain of the workspace"})
+		case strings.Contains(err.Error(), "workspace not found"), strings.Contains(err.Error(), "unauthorized"):
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Workspace not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to suggest aliases"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, suggestions)
+}
+
+// suggestAliases asks the RPC service for alias suggestions and drops those
+// that collide with the router's own routes
+func (h *URLHandler) suggestAliases(rpcReq *pb.SuggestAliasesRequest) (*AliasSuggestionsResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.SuggestAliases(ctx, rpcReq)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AliasSuggestionsResponse{
+		Alias:       rsp.Alias,
+		Available:   rsp.Available && !h.aliases.Reserved(rsp.Alias),
+		Suggestions: []string{},
+	}
+	for _, suggestion := range rsp.Suggestions {
+		if !h.aliases.Reserved(suggestion) {
+			response.Suggestions = append(response.Suggestions, suggestion)
+		}
+	}
+	return response, nil
+}
+
+// aliasErrorMessage maps custom alias validation errors of the RPC service to a response message
+func aliasErrorMessage(rpcError string) (string, bool) {
+	switch {
+	case strings.Contains(rpcError, "alias is reserved"):
+		return "Custom alias is reserved", true
+	case strings.Contains(rpcError, "alias contains blocked words"):
+		return "Custom alias contains blocked words", true
+	case strings.Contains(rpcError, "alias contains confusable characters"):
+		return "Custom alias contains the digits 0 or 1, which the domain's readable code policy does not allow", true
+	case strings.Contains(rpcError, "invalid custom alias"):
+		return "Custom alias does not match the alias rules of the workspace plan", true
+	}
+	return "", false
+}
@@ -0,0 +1,284 @@
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	pb "github.com/go-systems-lab/go-url-shortener/proto/url"
+	"github.com/go-systems-lab/go-url-shortener/utils/rpc"
+)
+
+// Gin context keys of the audit metadata
+const (
+	requestIDKey   = "request_id"
+	auditActorKey  = "audit_actor"
+	maxRequestID   = 128
+	exportPageSize = 100
+)
+
+// RequestID tags every request with an ID, echoed in the X-Request-ID header
+// and recorded with the audit events it causes. A client-sent X-Request-ID is
+// kept when it is printable and at most 128 characters.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader("X-Request-ID")
+		if !validRequestID(id) {
+			id = newRequestID()
+		}
+		c.Set(requestIDKey, id)
+		c.Header("X-Request-ID", id)
+		c.Next()
+	}
+}
+
+// validRequestID reports whether a client-sent request ID can be kept
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestID {
+		return false
+	}
+	for _, r := range id {
+		if r < 0x21 || r > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// newRequestID generates a random request ID
+func newRequestID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// rpcContext returns the parent context of the RPC calls made for a request.
+// It carries who made the request so the services can audit what it changes.
+func rpcContext(c *gin.Context) context.Context {
+	return rpc.WithCaller(context.Background(), rpc.Caller{
+		Actor:     c.GetString(auditActorKey),
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+		RequestID: c.GetString(requestIDKey),
+	})
+}
+
+// AuditEventResponse is an entry of the audit log
+type AuditEventResponse struct {
+	ID        int64           `json:"id" example:"1042"`
+	CreatedAt int64           `json:"created_at" example:"1704067200"`
+	Actor     string          `json:"actor" example:"user123"` // user ID, "admin" or "system"
+	IP        string          `json:"ip,omitempty" example:"203.0.113.7"`
+	UserAgent string          `json:"user_agent,omitempty" example:"Mozilla/5.0"`
+	RequestID string          `json:"request_id,omitempty" example:"5f2b9c1e8a7d4e3f9b6a0c1d2e3f4a5b"`
+	Action    string          `json:"action" example:"url.update"`
+	Target    string          `json:"target" example:"url:abc123"`
+	Before    json.RawMessage `json:"before,omitempty" swaggertype:"object"` // state before the action
+	After     json.RawMessage `json:"after,omitempty" swaggertype:"object"`  // state after the action
+	PrevHash  string          `json:"prev_hash" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	Hash      string          `json:"hash" example:"60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752"`
+}
+
+// AuditEventsResponse is a page of the audit log, newest first
+type AuditEventsResponse struct {
+	Events     []AuditEventResponse `json:"events"`
+	TotalCount int32                `json:"total_count" example:"1042"`
+	PageSize   int32                `json:"page_size" example:"20"`
+	HasNext    bool                 `json:"has_next" example:"true"`
+	NextCursor string               `json:"next_cursor,omitempty"`
+	PrevCursor string               `json:"prev_cursor,omitempty"`
+}
+
+// AuditVerificationResponse is the result of checking the audit log's hash chain
+type AuditVerificationResponse struct {
+	Checked  int64  `json:"checked" example:"1042"`
+	Valid    bool   `json:"valid" example:"true"`
+	BrokenAt int64  `json:"broken_at,omitempty" example:"0"` // first event whose hash does not match
+	LastHash string `json:"last_hash" example:"60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752"`
+}
+
+// toAuditEventResponse converts an RPC audit event to its REST representation
+func toAuditEventResponse(event *pb.AuditEvent) AuditEventResponse {
+	response := AuditEventResponse{
+		ID:        event.Id,
+		CreatedAt: event.CreatedAt,
+		Actor:     event.Actor,
+		IP:        event.Ip,
+		UserAgent: event.UserAgent,
+		RequestID: event.RequestId,
+		Action:    event.Action,
+		Target:    event.Target,
+		PrevHash:  event.PrevHash,
+		Hash:      event.Hash,
+	}
+	if event.Before != "" {
+		response.Before = json.RawMessage(event.Before)
+	}
+	if event.After != "" {
+		response.After = json.RawMessage(event.After)
+	}
+	return response
+}
+
+// auditEventsRequest reads the audit log filters of a request
+func auditEventsRequest(c *gin.Context) *pb.ListAuditEventsRequest {
+	since, _ := strconv.ParseInt(c.Query("since"), 10, 64)
+	until, _ := strconv.ParseInt(c.Query("until"), 10, 64)
+	pageSize, _ := strconv.ParseInt(c.DefaultQuery("page_size", "20"), 10, 32)
+
+	return &pb.ListAuditEventsRequest{
+		Actor:     c.Query("actor"),
+		Action:    c.Query("action"),
+		Target:    c.Query("target"),
+		RequestId: c.Query("request_id"),
+		Since:     since,
+		Until:     until,
+		PageSize:  int32(pageSize),
+		Cursor:    c.Query("cursor"),
+	}
+}
+
+// ListAuditEvents handles GET /api/v1/admin/audit-events
+//
+//	@Summary		List audit events
+//	@Description	List the append-only audit log of administrative actions (links created, edited, deleted, restored, rolled back or disabled, admin changes), newest first. Every event carries the hash of the one before it. Follow next_cursor and prev_cursor to page
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Security		AdminToken
+//	@Param			actor		query		string				false	"User ID, admin or system"						example(user123)
+//	@Param			action		query		string				false	"Action"										example(url.update)
+//	@Param			target		query		string				false	"Target"										example(url:abc123)
+//	@Param			request_id	query		string				false	"Request ID"
+//	@Param			since		query		int					false	"Events at or after (unix seconds)"				example(1704067200)
+//	@Param			until		query		int					false	"Events before (unix seconds)"					example(1706745600)
+//	@Param			cursor		query		string				false	"next_cursor or prev_cursor of a previous page"
+//	@Param			page_size	query		int					false	"Page size"										example(20)
+//	@Success		200			{object}	AuditEventsResponse	"Audit events"
+//	@Failure		400			{object}	ErrorResponse		"Invalid cursor"
+//	@Failure		401			{object}	ErrorResponse		"Invalid admin token"
+//	@Failure		500			{object}	ErrorResponse		"Failed to list audit events"
+//	@Router			/admin/audit-events [get]
+func (h *URLHandler) ListAuditEvents(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.ListAuditEvents(ctx, auditEventsRequest(c))
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		if strings.Contains(err.Error(), "invalid cursor") {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid cursor"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to list audit events"})
+		return
+	}
+
+	events := make([]AuditEventResponse, len(rsp.Events))
+	for i, event := range rsp.Events {
+		events[i] = toAuditEventResponse(event)
+	}
+
+	c.JSON(http.StatusOK, AuditEventsResponse{
+		Events:     events,
+		TotalCount: rsp.TotalCount,
+		PageSize:   rsp.PageSize,
+		HasNext:    rsp.HasNext,
+		NextCursor: rsp.NextCursor,
+		PrevCursor: rsp.PrevCursor,
+	})
+}
+
+// ExportAuditEvents handles GET /api/v1/admin/audit-events/export
+//
+//	@Summary		Export audit events
+//	@Description	Stream the audit events matching the filters as newline-delimited JSON, newest first, one AuditEventResponse per line
+//	@Tags			Admin
+//	@Produce		application/x-ndjson
+//	@Security		AdminToken
+//	@Param			actor		query		string	false	"User ID, admin or system"				example(user123)
+//	@Param			action		query		string	false	"Action"								example(url.update)
+//	@Param			target		query		string	false	"Target"								example(url:abc123)
+//	@Param			request_id	query		string	false	"Request ID"
+//	@Param			since		query		int		false	"Events at or after (unix seconds)"		example(1704067200)
+//	@Param			until		query		int		false	"Events before (unix seconds)"			example(1706745600)
+//	@Success		200			{string}	string	"NDJSON audit events"
+//	@Failure		401			{object}	ErrorResponse	"Invalid admin token"
+//	@Failure		500			{object}	ErrorResponse	"Failed to export audit events"
+//	@Router			/admin/audit-events/export [get]
+func (h *URLHandler) ExportAuditEvents(c *gin.Context) {
+	req := auditEventsRequest(c)
+	req.PageSize = exportPageSize
+	req.Cursor = ""
+
+	encoder := json.NewEncoder(c.Writer)
+	started := false
+	for {
+		ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+		rsp, err := h.client.ListAuditEvents(ctx, req)
+		cancel()
+		if err != nil {
+			h.log.WithError(err).Error("Failed to call RPC service")
+			if !started {
+				c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to export audit events"})
+			}
+			// Once streaming, a cut-off export is all that can be signalled
+			return
+		}
+
+		if !started {
+			c.Header("Content-Type", "application/x-ndjson")
+			c.Header("Content-Disposition", `attachment; filename="audit-events.ndjson"`)
+			c.Status(http.StatusOK)
+			started = true
+		}
+		for _, event := range rsp.Events {
+			if err := encoder.Encode(toAuditEventResponse(event)); err != nil {
+				return
+			}
+		}
+		c.Writer.Flush()
+
+		if !rsp.HasNext || rsp.NextCursor == "" {
+			return
+		}
+		req.Cursor = rsp.NextCursor
+	}
+}
+
+// VerifyAuditLog handles GET /api/v1/admin/audit-events/verify
+//
+//	@Summary		Verify the audit log
+//	@Description	Recompute the audit log's hash chain from the first event. valid is false and broken_at names the first event that was altered, or follows a removed one, when the log was tampered with. Compare last_hash with a copy kept elsewhere to detect removed trailing events
+//	@Tags			Admin
+//	@Produce		json
+//	@Security		AdminToken
+//	@Success		200	{object}	AuditVerificationResponse	"Verification result"
+//	@Failure		401	{object}	ErrorResponse				"Invalid admin token"
+//	@Failure		500	{object}	ErrorResponse				"Failed to verify audit log"
+//	@Router			/admin/audit-events/verify [get]
+func (h *URLHandler) VerifyAuditLog(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(rpcContext(c), 60*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.VerifyAuditLog(ctx, &pb.VerifyAuditLogRequest{})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to verify audit log"})
+		return
+	}
+
+	c.JSON(http.StatusOK, AuditVerificationResponse{
+		Checked:  rsp.Checked,
+		Valid:    rsp.Valid,
+		BrokenAt: rsp.BrokenAt,
+		LastHash: rsp.LastHash,
+	})
+}
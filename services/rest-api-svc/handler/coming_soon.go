@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"bytes"
+	"html/template"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	redirectpb "github.com/go-systems-lab/go-url-shortener/proto/redirect"
+)
+
+// comingSoonTemplate is the page shown for scheduled links without a fallback URL
+var comingSoonTemplate = template.Must(template.New("coming-soon").Parse(`<!DOCTYPE html>
+<html>
+<head>
+    <title>Coming soon</title>
+    <meta name="viewport" content="width=device-width, initial-scale=1">
+    <style>
+        body { font-family: Arial, sans-serif; max-width: 480px; margin: 80px auto; padding: 20px; text-align: center; }
+        h1 { font-size: 1.6em; }
+        .when { color: #555; }
+    </style>
+</head>
+<body>
+    <h1>⏳ Coming soon</h1>
+    <p>This link is not live yet.</p>
+    {{if .ActivatesAt}}<p class="when">It goes live on <time datetime="{{.ActivatesAtISO}}">{{.ActivatesAt}}</time>.</p>{{end}}
+</body>
+</html>`))
+
+// ComingSoonResponse represents the JSON answer for a scheduled link before activation
+type ComingSoonResponse struct {
+	Error       string `json:"error" example:"This short URL is not active yet"`
+	ActivatesAt int64  `json:"activates_at,omitempty" example:"1704067200"`
+}
+
+// renderComingSoon sends visitors of a not-yet-active link to its fallback URL,
+// or answers 503 with a "coming soon" page and a Retry-After hint
+func (h *URLHandler) renderComingSoon(c *gin.Context, rsp *redirectpb.ResolveResponse) {
+	if rsp.FallbackUrl != "" {
+		c.Redirect(http.StatusFound, rsp.FallbackUrl)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	if rsp.ActivatesAt > 0 {
+		if wait := time.Until(time.Unix(rsp.ActivatesAt, 0)); wait > 0 {
+			c.Header("Retry-After", strconv.FormatInt(int64(wait.Seconds())+1, 10))
+		}
+	}
+
+	if c.GetHeader("Accept") == "application/json" {
+		c.JSON(http.StatusServiceUnavailable, ComingSoonResponse{
+			Error:       "This short URL is not active yet",
+			ActivatesAt: rsp.ActivatesAt,
+		})
+		return
+	}
+
+	data := map[string]string{}
+	if rsp.ActivatesAt > 0 {
+		activatesAt := time.Unix(rsp.ActivatesAt, 0).UTC()
+		data["ActivatesAt"] = activatesAt.Format("January 2, 2006 at 15:04 MST")
+		data["ActivatesAtISO"] = activatesAt.Format(time.RFC3339)
+	}
+
+	var page bytes.Buffer
+	if err := comingSoonTemplate.Execute(&page, data); err != nil {
+		h.log.WithError(err).Error("Failed to render coming soon page")
+		c.JSON(http.StatusServiceUnavailable, ErrorResponse{Error: "This short URL is not active yet"})
+		return
+	}
+
+	c.Data(http.StatusServiceUnavailable, "text/html; charset=utf-8", page.Bytes())
+}
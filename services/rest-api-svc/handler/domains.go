@@ -0,0 +1,266 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/go-systems-lab/go-url-shortener/proto/url"
+)
+
+// BrandedDomainRequest represents the REST API request for attaching a branded domain
+type BrandedDomainRequest struct {
+	Domain     string `json:"domain" binding:"required" example:"go.acme.com"`
+	UserID     string `json:"user_id" binding:"required" example:"user123"`
+	CodePolicy string `json:"code_policy,omitempty" example:"readable"` // mixed (default) or readable
+}
+
+// CodePolicyRequest represents the REST API request for switching a domain's short code policy
+type CodePolicyRequest struct {
+	UserID     string `json:"user_id" binding:"required" example:"user123"`
+	CodePolicy string `json:"code_policy" binding:"required" example:"readable"`
+}
+
+// BrandedDomainResponse represents a branded short domain of a workspace
+type BrandedDomainResponse struct {
+	Domain      string `json:"domain" example:"go.acme.com"`
+	WorkspaceID string `json:"workspace_id" example:"marketing"`
+	CreatedBy   string `json:"created_by" example:"user123"`
+	CreatedAt   int64  `json:"created_at" example:"1672531200"`
+	ShortURL    string `json:"short_url" example:"https://go.acme.com/"`
+	CodePolicy  string `json:"code_policy" example:"readable"`
+}
+
+// CodePolicyChangeResponse represents a domain after switching its code policy
+type CodePolicyChangeResponse struct {
+	BrandedDomainResponse
+	CaseConflicts []string `json:"case_conflicts" example:"Promo,PROMO"`
+}
+
+// BrandedDomainsResponse represents the branded domains of a workspace
+type BrandedDomainsResponse struct {
+	Domains []BrandedDomainResponse `json:"domains"`
+}
+
+// toBrandedDomainResponse converts an RPC branded domain message to its REST representation
+func toBrandedDomainResponse(brandedDomain *pb.BrandedDomain) BrandedDomainResponse {
+	return BrandedDomainResponse{
+		Domain:      brandedDomain.Domain,
+		WorkspaceID: brandedDomain.WorkspaceId,
+		CreatedBy:   brandedDomain.CreatedBy,
+		CreatedAt:   brandedDomain.CreatedAt,
+		ShortURL:    brandedDomain.ShortUrl,
+		CodePolicy:  brandedDomain.CodePolicy,
+	}
+}
+
+// AddBrandedDomain handles POST /api/v1/workspaces/:workspaceID/domains
+//
+//	@Summary		Add a branded domain
+//	@Description	Attach a branded short domain to a workspace the user owns. Links created with this domain get short URLs on it, and the redirect service resolves their codes when requests arrive with it as the Host header (point its DNS at the redirect service). The readable code policy makes codes case-insensitive and avoids look-alike characters
+//	@Tags			Workspaces
+//	@Accept			json
+//	@Produce		json
+//	@Param			workspaceID	path		string					true	"Workspace identifier"	example(marketing)
+//	@Param			request		body		BrandedDomainRequest	true	"Branded domain request"
+//	@Success		201			{object}	BrandedDomainResponse	"Branded domain added"
+//	@Failure		400			{object}	ErrorResponse			"Invalid domain"
+//	@Failure		404			{object}	ErrorResponse			"Workspace not found"
+//	@Failure		409			{object}	ErrorResponse			"Domain already registered"
+//	@Router			/workspaces/{workspaceID}/domains [post]
+func (h *URLHandler) AddBrandedDomain(c *gin.Context) {
+	workspaceID := c.Param("workspaceID")
+
+	var req BrandedDomainRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request body"})
+		return
+	}
+
+	h.log.WithFields(logrus.Fields{
+		"workspace_id": workspaceID,
+		"user_id":      req.UserID,
+		"domain":       req.Domain,
+	}).Info("Processing AddBrandedDomain REST request")
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.AddBrandedDomain(ctx, &pb.BrandedDomainRequest{
+		WorkspaceId: workspaceID,
+		UserId:      req.UserID,
+		Domain:      req.Domain,
+		CodePolicy:  req.CodePolicy,
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		switch {
+		case strings.Contains(err.Error(), "code policy must be"):
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "code_policy must be mixed or readable"})
+		case strings.Contains(err.Error(), "invalid branded domain"):
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid domain"})
+		case strings.Contains(err.Error(), "already registered"):
+			c.JSON(http.StatusConflict, ErrorResponse{Error: "Domain is already registered"})
+		default:
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: "Workspace not found"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, toBrandedDomainResponse(rsp))
+}
+
+// ListBrandedDomains handles GET /api/v1/workspaces/:workspaceID/domains
+//
+//	@Summary		List branded domains
+//	@Description	List the branded short domains attached to a workspace
+//	@Tags			Workspaces
+//	@Accept			json
+//	@Produce		json
+//	@Param			workspaceID	path		string					true	"Workspace identifier"	example(marketing)
+//	@Param			user_id		query		string					true	"User ID"				example(user123)
+//	@Success		200			{object}	BrandedDomainsResponse	"Branded domains retrieved successfully"
+//	@Failure		400			{object}	ErrorResponse			"Missing user_id parameter"
+//	@Failure		404			{object}	ErrorResponse			"Workspace not found"
+//	@Router			/workspaces/{workspaceID}/domains [get]
+func (h *URLHandler) ListBrandedDomains(c *gin.Context) {
+	workspaceID := c.Param("workspaceID")
+	userID := c.Query("user_id")
+
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "user_id is required"})
+		return
+	}
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.ListBrandedDomains(ctx, &pb.ListBrandedDomainsRequest{
+		WorkspaceId: workspaceID,
+		UserId:      userID,
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		c.JSON(http.StatusNotFound, ErrorResponse{Error: "Workspace not found"})
+		return
+	}
+
+	response := BrandedDomainsResponse{Domains: []BrandedDomainResponse{}}
+	for _, brandedDomain := range rsp.Domains {
+		response.Domains = append(response.Domains, toBrandedDomainResponse(brandedDomain))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// SetBrandedDomainCodePolicy handles PUT /api/v1/workspaces/:workspaceID/domains/:domain/code-policy
+//
+//	@Summary		Set a branded domain's code policy
+//	@Description	Switch the short code policy of a branded domain. Under the readable policy codes are looked up regardless of case, new codes are stored in lower case and generated codes avoid look-alike characters (0/o, 1/l/i). Existing mixed-case codes keep working under any spelling; codes that only differ in case are listed in case_conflicts, and the oldest of them wins until the others are deleted
+//	@Tags			Workspaces
+//	@Accept			json
+//	@Produce		json
+//	@Param			workspaceID	path		string						true	"Workspace identifier"	example(marketing)
+//	@Param			domain		path		string						true	"Branded domain"		example(go.acme.com)
+//	@Param			request		body		CodePolicyRequest			true	"Code policy request"
+//	@Success		200			{object}	CodePolicyChangeResponse	"Code policy updated"
+//	@Failure		400			{object}	ErrorResponse				"Invalid code policy"
+//	@Failure		404			{object}	ErrorResponse				"Domain not found in workspace"
+//	@Failure		500			{object}	ErrorResponse				"Failed to set code policy"
+//	@Router			/workspaces/{workspaceID}/domains/{domain}/code-policy [put]
+func (h *URLHandler) SetBrandedDomainCodePolicy(c *gin.Context) {
+	workspaceID := c.Param("workspaceID")
+
+	var req CodePolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request body"})
+		return
+	}
+
+	h.log.WithFields(logrus.Fields{
+		"workspace_id": workspaceID,
+		"domain":       c.Param("domain"),
+		"code_policy":  req.CodePolicy,
+	}).Info("Processing SetBrandedDomainCodePolicy REST request")
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.SetBrandedDomainCodePolicy(ctx, &pb.BrandedDomainRequest{
+		WorkspaceId: workspaceID,
+		UserId:      req.UserID,
+		Domain:      c.Param("domain"),
+		CodePolicy:  req.CodePolicy,
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		switch {
+		case strings.Contains(err.Error(), "code policy must be"):
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "code_policy must be mixed or readable"})
+		case strings.Contains(err.Error(), "not found"), strings.Contains(err.Error(), "unauthorized"),
+			strings.Contains(err.Error(), "invalid branded domain"):
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: "Domain not found in workspace"})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to set code policy"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, CodePolicyChangeResponse{
+		BrandedDomainResponse: toBrandedDomainResponse(rsp.Domain),
+		CaseConflicts:         append([]string{}, rsp.CaseConflicts...),
+	})
+}
+
+// RemoveBrandedDomain handles DELETE /api/v1/workspaces/:workspaceID/domains/:domain
+//
+//	@Summary		Remove a branded domain
+//	@Description	Detach a branded short domain from a workspace. Domains that still have active links cannot be removed
+//	@Tags			Workspaces
+//	@Accept			json
+//	@Produce		json
+//	@Param			workspaceID	path		string			true	"Workspace identifier"	example(marketing)
+//	@Param			domain		path		string			true	"Branded domain"		example(go.acme.com)
+//	@Param			user_id		query		string			true	"User ID"				example(user123)
+//	@Success		200			{object}	DeleteResponse	"Branded domain removed"
+//	@Failure		400			{object}	ErrorResponse	"Missing user_id or domain still in use"
+//	@Failure		500			{object}	ErrorResponse	"Failed to remove branded domain"
+//	@Router			/workspaces/{workspaceID}/domains/{domain} [delete]
+func (h *URLHandler) RemoveBrandedDomain(c *gin.Context) {
+	workspaceID := c.Param("workspaceID")
+	userID := c.Query("user_id")
+
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "user_id is required"})
+		return
+	}
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.RemoveBrandedDomain(ctx, &pb.BrandedDomainRequest{
+		WorkspaceId: workspaceID,
+		UserId:      userID,
+		Domain:      c.Param("domain"),
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to remove branded domain"})
+		return
+	}
+
+	if !rsp.Success {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: rsp.Message})
+		return
+	}
+
+	c.JSON(http.StatusOK, DeleteResponse{Message: rsp.Message})
+}
@@ -0,0 +1,241 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/go-systems-lab/go-url-shortener/proto/url"
+)
+
+// FolderRequest represents the REST API request for creating, renaming or moving a folder
+type FolderRequest struct {
+	Name     string `json:"name" binding:"required" example:"Campaigns"`
+	ParentID int64  `json:"parent_id,omitempty" example:"3"` // top level when 0
+}
+
+// FolderResponse represents a folder of a user
+type FolderResponse struct {
+	ID        int64  `json:"id" example:"4"`
+	UserID    string `json:"user_id" example:"user123"`
+	ParentID  int64  `json:"parent_id,omitempty" example:"3"`
+	Name      string `json:"name" example:"Spring"`
+	Path      string `json:"path" example:"Campaigns/Spring"`
+	CreatedAt int64  `json:"created_at" example:"1672531200"`
+	LinkCount int64  `json:"link_count" example:"8"`
+}
+
+// FoldersResponse represents the folders of a user
+type FoldersResponse struct {
+	Folders []FolderResponse `json:"folders"`
+}
+
+// toFolderResponse converts an RPC folder message to its REST representation
+func toFolderResponse(folder *pb.Folder) FolderResponse {
+	return FolderResponse{
+		ID:        folder.Id,
+		UserID:    folder.UserId,
+		ParentID:  folder.ParentId,
+		Name:      folder.Name,
+		Path:      folder.Path,
+		CreatedAt: folder.CreatedAt,
+		LinkCount: folder.LinkCount,
+	}
+}
+
+// folderError maps folder errors of the RPC service to a REST response
+func folderError(c *gin.Context, err error, fallback string) {
+	switch {
+	case strings.Contains(err.Error(), "invalid folder"):
+		message := "Invalid folder"
+		if _, detail, ok := strings.Cut(err.Error(), "invalid folder: "); ok {
+			message = "Invalid folder: " + detail
+		}
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: message})
+	case strings.Contains(err.Error(), "folder not found"):
+		c.JSON(http.StatusNotFound, ErrorResponse{Error: "Folder not found"})
+	case strings.Contains(err.Error(), "folder already exists"):
+		c.JSON(http.StatusConflict, ErrorResponse{Error: "A folder with this name already exists in the parent folder"})
+	default:
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: fallback})
+	}
+}
+
+// CreateFolder handles POST /api/v1/users/:userID/folders
+//
+//	@Summary		Create a folder
+//	@Description	Create a folder for organizing links, optionally inside another folder. Folder names are unique among siblings regardless of case
+//	@Tags			Tags and Folders
+//	@Accept			json
+//	@Produce		json
+//	@Param			userID	path		string			true	"User ID"	example(user123)
+//	@Param			request	body		FolderRequest	true	"Folder request"
+//	@Success		201		{object}	FolderResponse	"Folder created"
+//	@Failure		400		{object}	ErrorResponse	"Invalid folder name or nesting too deep"
+//	@Failure		404		{object}	ErrorResponse	"Parent folder not found"
+//	@Failure		409		{object}	ErrorResponse	"Folder already exists"
+//	@Failure		500		{object}	ErrorResponse	"Failed to create folder"
+//	@Router			/users/{userID}/folders [post]
+func (h *URLHandler) CreateFolder(c *gin.Context) {
+	userID := c.Param("userID")
+
+	var req FolderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request body"})
+		return
+	}
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.CreateFolder(ctx, &pb.FolderRequest{
+		UserId:   userID,
+		Name:     req.Name,
+		ParentId: req.ParentID,
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		folderError(c, err, "Failed to create folder")
+		return
+	}
+
+	c.JSON(http.StatusCreated, toFolderResponse(rsp))
+}
+
+// ListFolders handles GET /api/v1/users/:userID/folders
+//
+//	@Summary		List folders
+//	@Description	List a user's folders with their paths and the number of active links directly inside each
+//	@Tags			Tags and Folders
+//	@Accept			json
+//	@Produce		json
+//	@Param			userID	path		string			true	"User ID"	example(user123)
+//	@Success		200		{object}	FoldersResponse	"Folders retrieved successfully"
+//	@Failure		500		{object}	ErrorResponse	"Failed to list folders"
+//	@Router			/users/{userID}/folders [get]
+func (h *URLHandler) ListFolders(c *gin.Context) {
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.ListFolders(ctx, &pb.ListFoldersRequest{UserId: c.Param("userID")})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to list folders"})
+		return
+	}
+
+	response := FoldersResponse{Folders: []FolderResponse{}}
+	for _, folder := range rsp.Folders {
+		response.Folders = append(response.Folders, toFolderResponse(folder))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateFolder handles PUT /api/v1/users/:userID/folders/:folderID
+//
+//	@Summary		Rename or move a folder
+//	@Description	Set the name and parent of a folder; its subfolders and links move with it. A folder cannot be moved into its own subfolders
+//	@Tags			Tags and Folders
+//	@Accept			json
+//	@Produce		json
+//	@Param			userID		path		string			true	"User ID"	example(user123)
+//	@Param			folderID	path		int				true	"Folder ID"	example(4)
+//	@Param			request		body		FolderRequest	true	"Folder request"
+//	@Success		200			{object}	FolderResponse	"Folder updated"
+//	@Failure		400			{object}	ErrorResponse	"Invalid folder name, cycle or nesting too deep"
+//	@Failure		404			{object}	ErrorResponse	"Folder not found"
+//	@Failure		409			{object}	ErrorResponse	"Folder already exists"
+//	@Failure		500			{object}	ErrorResponse	"Failed to update folder"
+//	@Router			/users/{userID}/folders/{folderID} [put]
+func (h *URLHandler) UpdateFolder(c *gin.Context) {
+	userID := c.Param("userID")
+	folderID, err := strconv.ParseInt(c.Param("folderID"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusNotFound, ErrorResponse{Error: "Folder not found"})
+		return
+	}
+
+	var req FolderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request body"})
+		return
+	}
+
+	h.log.WithFields(logrus.Fields{
+		"user_id":   userID,
+		"folder_id": folderID,
+		"parent_id": req.ParentID,
+	}).Info("Processing UpdateFolder REST request")
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.UpdateFolder(ctx, &pb.FolderRequest{
+		UserId:   userID,
+		Id:       folderID,
+		Name:     req.Name,
+		ParentId: req.ParentID,
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		folderError(c, err, "Failed to update folder")
+		return
+	}
+
+	c.JSON(http.StatusOK, toFolderResponse(rsp))
+}
+
+// DeleteFolder handles DELETE /api/v1/users/:userID/folders/:folderID
+//
+//	@Summary		Delete a folder
+//	@Description	Delete a folder and its subfolders. Their links are kept and no longer belong to a folder
+//	@Tags			Tags and Folders
+//	@Accept			json
+//	@Produce		json
+//	@Param			userID		path		string			true	"User ID"	example(user123)
+//	@Param			folderID	path		int				true	"Folder ID"	example(4)
+//	@Success		200			{object}	DeleteResponse	"Folder deleted"
+//	@Failure		404			{object}	ErrorResponse	"Folder not found"
+//	@Failure		500			{object}	ErrorResponse	"Failed to delete folder"
+//	@Router			/users/{userID}/folders/{folderID} [delete]
+func (h *URLHandler) DeleteFolder(c *gin.Context) {
+	folderID, err := strconv.ParseInt(c.Param("folderID"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusNotFound, ErrorResponse{Error: "Folder not found"})
+		return
+	}
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.DeleteFolder(ctx, &pb.FolderRequest{
+		UserId: c.Param("userID"),
+		Id:     folderID,
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to delete folder"})
+		return
+	}
+
+	if !rsp.Success {
+		if strings.Contains(rsp.Message, "folder not found") {
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: "Folder not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to delete folder"})
+		return
+	}
+
+	c.JSON(http.StatusOK, DeleteResponse{Message: rsp.Message})
+}
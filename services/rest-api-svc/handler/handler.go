@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -14,6 +15,7 @@ import (
 	analyticspb "github.com/go-systems-lab/go-url-shortener/proto/analytics"
 	redirectpb "github.com/go-systems-lab/go-url-shortener/proto/redirect"
 	pb "github.com/go-systems-lab/go-url-shortener/proto/url"
+	"github.com/go-systems-lab/go-url-shortener/utils/alias"
 )
 
 // URLHandler handles REST API requests for URL shortening
@@ -21,6 +23,7 @@ type URLHandler struct {
 	client          pb.URLShortenerService
 	redirectClient  redirectpb.RedirectService
 	analyticsClient analyticspb.AnalyticsService
+	aliases         *alias.Policy // reserved aliases, including the router's own routes
 	log             *logrus.Logger
 }
 
@@ -33,27 +36,54 @@ func NewURLHandler(service micro.Service) *URLHandler {
 		client:          client,
 		redirectClient:  redirectClient,
 		analyticsClient: analyticsClient,
+		aliases:         alias.NewPolicy(),
 		log:             logrus.New(),
 	}
 }
 
+// ReserveRoutes reserves the first path segment of every registered route so
+// custom aliases can never be shadowed by the router. Call it after all routes
+// are registered.
+func (h *URLHandler) ReserveRoutes(routes gin.RoutesInfo) {
+	paths := make([]string, len(routes))
+	for i, route := range routes {
+		paths[i] = route.Path
+	}
+	h.aliases.Reserve(alias.RouteWords(paths)...)
+}
+
 // ShortenURLRequest represents the REST API request for URL shortening
 type ShortenURLRequest struct {
-	LongURL        string            `json:"long_url" binding:"required" example:"https://www.google.com"`
-	CustomAlias    string            `json:"custom_alias,omitempty" example:"google"`
-	ExpirationTime *int64            `json:"expiration_time,omitempty" example:"1735689600"`
-	UserID         string            `json:"user_id" binding:"required" example:"user123"`
-	Metadata       map[string]string `json:"metadata,omitempty" example:"campaign:social,source:twitter"`
+	LongURL         string            `json:"long_url" binding:"required" example:"https://www.google.com"`
+	CustomAlias     string            `json:"custom_alias,omitempty" example:"google"`
+	ExpirationTime  *int64            `json:"expiration_time,omitempty" example:"1735689600"`
+	UserID          string            `json:"user_id" binding:"required" example:"user123"`
+	Metadata        map[string]string `json:"metadata,omitempty" example:"campaign:social,source:twitter"`
+	WorkspaceID     string            `json:"workspace_id,omitempty" example:"marketing"`
+	UTMTemplate     map[string]string `json:"utm_template,omitempty" example:"utm_source:{referrer_domain},utm_campaign:spring"`
+	Password        string            `json:"password,omitempty" example:"s3cret-pass"`
+	ActivationTime  *int64            `json:"activation_time,omitempty" example:"1704067200"`
+	FallbackURL     string            `json:"fallback_url,omitempty" example:"https://www.google.com/coming-soon"`
+	MaxClicks       int64             `json:"max_clicks,omitempty" example:"1"`
+	PreviewOverride *LinkPreview      `json:"preview_override,omitempty"`
+	Domain          string            `json:"domain,omitempty" example:"go.acme.com"` // branded domain of the workspace; default domain when empty
+	Tags            []string          `json:"tags,omitempty" example:"spring campaign,social"`
+	FolderID        int64             `json:"folder_id,omitempty" example:"4"`
 }
 
 // ShortenURLResponse represents the REST API response for URL shortening
 type ShortenURLResponse struct {
-	ShortCode string `json:"short_code" example:"abc123"`
-	ShortURL  string `json:"short_url" example:"https://short.ly/abc123"`
-	LongURL   string `json:"long_url" example:"https://www.google.com"`
-	CreatedAt int64  `json:"created_at" example:"1672531200"`
-	ExpiresAt *int64 `json:"expires_at,omitempty" example:"1735689600"`
-	UserID    string `json:"user_id" example:"user123"`
+	ShortCode         string `json:"short_code" example:"abc123"`
+	ShortURL          string `json:"short_url" example:"https://short.ly/abc123"`
+	Domain            string `json:"domain,omitempty" example:"go.acme.com"`
+	LongURL           string `json:"long_url" example:"https://www.google.com"`
+	CreatedAt         int64  `json:"created_at" example:"1672531200"`
+	ExpiresAt         *int64 `json:"expires_at,omitempty" example:"1735689600"`
+	UserID            string `json:"user_id" example:"user123"`
+	WorkspaceID       string `json:"workspace_id,omitempty" example:"marketing"`
+	PasswordProtected bool   `json:"password_protected" example:"false"`
+	ActivatesAt       *int64 `json:"activates_at,omitempty" example:"1704067200"`
+	MaxClicks         int64  `json:"max_clicks,omitempty" example:"1"`
 }
 
 // ErrorResponse represents an error response
@@ -70,7 +100,9 @@ type ErrorResponse struct {
 //	@Produce		json
 //	@Param			request	body		ShortenURLRequest	true	"URL shortening request"
 //	@Success		201		{object}	ShortenURLResponse	"Successfully created short URL"
-//	@Failure		400		{object}	ErrorResponse		"Invalid request body"
+//	@Failure		400		{object}	ErrorResponse		"Invalid request body, reserved or invalid custom alias"
+//	@Failure		409		{object}	AliasConflictResponse	"Custom alias already exists, with suggestions"
+//	@Failure		422		{object}	ErrorResponse		"Destination URL was flagged as unsafe"
 //	@Failure		500		{object}	ErrorResponse		"Internal server error"
 //	@Router			/shorten [post]
 func (h *URLHandler) ShortenURL(c *gin.Context) {
@@ -86,41 +118,101 @@ func (h *URLHandler) ShortenURL(c *gin.Context) {
 		"user_id":  req.UserID,
 	}).Info("Processing ShortenURL REST request")
 
+	if req.CustomAlias != "" && h.aliases.Reserved(req.CustomAlias) {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Custom alias is reserved"})
+		return
+	}
+
 	// Convert REST request to RPC request
 	rpcReq := &pb.ShortenRequest{
 		LongUrl:     req.LongURL,
 		CustomAlias: req.CustomAlias,
 		UserId:      req.UserID,
 		Metadata:    req.Metadata,
+		WorkspaceId: req.WorkspaceID,
+		UtmTemplate: req.UTMTemplate,
+		Password:    req.Password,
+		FallbackUrl: req.FallbackURL,
+		MaxClicks:   req.MaxClicks,
+		Domain:      req.Domain,
+		Tags:        req.Tags,
+		FolderId:    req.FolderID,
 	}
 
+	if req.PreviewOverride != nil {
+		rpcReq.PreviewOverride = req.PreviewOverride.toProto()
+	}
 	if req.ExpirationTime != nil {
 		rpcReq.ExpirationTime = *req.ExpirationTime
 	}
+	if req.ActivationTime != nil {
+		rpcReq.ActivationTime = *req.ActivationTime
+	}
 
 	// Call RPC service
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
 	defer cancel()
 
 	rsp, err := h.client.ShortenURL(ctx, rpcReq)
 	if err != nil {
 		h.log.WithError(err).Error("Failed to call RPC service")
+		if strings.Contains(err.Error(), "flagged as unsafe") {
+			c.JSON(http.StatusUnprocessableEntity, ErrorResponse{Error: "Destination URL was flagged as unsafe"})
+			return
+		}
+		if strings.Contains(err.Error(), "branded domain") {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Domain is not a branded domain of the workspace"})
+			return
+		}
+		if strings.Contains(err.Error(), "invalid tag") {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: invalidTagMessage})
+			return
+		}
+		if strings.Contains(err.Error(), "folder not found") {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Folder not found"})
+			return
+		}
+		if message, ok := aliasErrorMessage(err.Error()); ok {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: message})
+			return
+		}
+		if strings.Contains(err.Error(), "custom alias already exists") {
+			response := AliasConflictResponse{Error: "Custom alias already exists"}
+			if suggestions, err := h.suggestAliases(&pb.SuggestAliasesRequest{
+				Alias:       req.CustomAlias,
+				LongUrl:     req.LongURL,
+				Domain:      req.Domain,
+				WorkspaceId: req.WorkspaceID,
+				UserId:      req.UserID,
+			}); err == nil {
+				response.Suggestions = suggestions.Suggestions
+			}
+			c.JSON(http.StatusConflict, response)
+			return
+		}
 		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to shorten URL"})
 		return
 	}
 
 	// Convert RPC response to REST response
 	response := ShortenURLResponse{
-		ShortCode: rsp.ShortCode,
-		ShortURL:  rsp.ShortUrl,
-		LongURL:   rsp.LongUrl,
-		CreatedAt: rsp.CreatedAt,
-		UserID:    rsp.UserId,
+		ShortCode:         rsp.ShortCode,
+		ShortURL:          rsp.ShortUrl,
+		Domain:            rsp.Domain,
+		LongURL:           rsp.LongUrl,
+		CreatedAt:         rsp.CreatedAt,
+		UserID:            rsp.UserId,
+		WorkspaceID:       rsp.WorkspaceId,
+		PasswordProtected: rsp.PasswordProtected,
+		MaxClicks:         rsp.MaxClicks,
 	}
 
 	if rsp.ExpiresAt > 0 {
 		response.ExpiresAt = &rsp.ExpiresAt
 	}
+	if rsp.ActivatesAt > 0 {
+		response.ActivatesAt = &rsp.ActivatesAt
+	}
 
 	h.log.WithFields(logrus.Fields{
 		"short_code": response.ShortCode,
@@ -132,15 +224,79 @@ func (h *URLHandler) ShortenURL(c *gin.Context) {
 
 // URLInfoResponse represents URL information response
 type URLInfoResponse struct {
-	ShortCode  string            `json:"short_code" example:"abc123"`
-	ShortURL   string            `json:"short_url" example:"https://short.ly/abc123"`
-	LongURL    string            `json:"long_url" example:"https://www.google.com"`
-	UserID     string            `json:"user_id" example:"user123"`
-	CreatedAt  int64             `json:"created_at" example:"1672531200"`
-	ExpiresAt  *int64            `json:"expires_at,omitempty" example:"1735689600"`
-	ClickCount int64             `json:"click_count" example:"42"`
-	IsActive   bool              `json:"is_active" example:"true"`
-	Metadata   map[string]string `json:"metadata,omitempty" example:"campaign:social,source:twitter"`
+	ShortCode         string            `json:"short_code" example:"abc123"`
+	ShortURL          string            `json:"short_url" example:"https://short.ly/abc123"`
+	Domain            string            `json:"domain,omitempty" example:"go.acme.com"`
+	LongURL           string            `json:"long_url" example:"https://www.google.com"`
+	UserID            string            `json:"user_id" example:"user123"`
+	CreatedAt         int64             `json:"created_at" example:"1672531200"`
+	ExpiresAt         *int64            `json:"expires_at,omitempty" example:"1735689600"`
+	ClickCount        int64             `json:"click_count" example:"42"`
+	IsActive          bool              `json:"is_active" example:"true"`
+	Metadata          map[string]string `json:"metadata,omitempty" example:"campaign:social,source:twitter"`
+	WorkspaceID       string            `json:"workspace_id,omitempty" example:"marketing"`
+	UTMTemplate       map[string]string `json:"utm_template,omitempty" example:"utm_source:{referrer_domain},utm_campaign:spring"`
+	PasswordProtected bool              `json:"password_protected" example:"false"`
+	ActivatesAt       *int64            `json:"activates_at,omitempty" example:"1704067200"`
+	FallbackURL       string            `json:"fallback_url,omitempty" example:"https://www.google.com/coming-soon"`
+	MaxClicks         int64             `json:"max_clicks,omitempty" example:"1"`
+	InterstitialMode  string            `json:"interstitial_mode,omitempty" example:"auto"`
+	DisabledReason    string            `json:"disabled_reason,omitempty" example:"blocklisted_domain"`
+	DisabledAt        *int64            `json:"disabled_at,omitempty" example:"1704067200"`
+	Preview           *LinkPreview      `json:"preview,omitempty"`
+	PreviewOverride   *LinkPreview      `json:"preview_override,omitempty"`
+	Tags              []string          `json:"tags,omitempty" example:"spring campaign,social"`
+	FolderID          int64             `json:"folder_id,omitempty" example:"4"`
+	DeletedAt         *int64            `json:"deleted_at,omitempty" example:"1704067200"`   // set while the link is in the trash
+	PurgeAt           *int64            `json:"purge_at,omitempty" example:"1706659200"`     // when the trash retention removes it for good
+	InactivityExempt  bool              `json:"inactivity_exempt,omitempty" example:"false"` // never archived by the workspace's inactivity policy
+	ArchivedAt        *int64            `json:"archived_at,omitempty" example:"1704067200"`  // set while archived for inactivity
+}
+
+// toURLInfoResponse converts an RPC URL info message to its REST representation
+func toURLInfoResponse(url *pb.URLInfo) URLInfoResponse {
+	response := URLInfoResponse{
+		ShortCode:         url.ShortCode,
+		ShortURL:          url.ShortUrl,
+		Domain:            url.Domain,
+		LongURL:           url.LongUrl,
+		UserID:            url.UserId,
+		CreatedAt:         url.CreatedAt,
+		ClickCount:        url.ClickCount,
+		IsActive:          url.IsActive,
+		Metadata:          url.Metadata,
+		WorkspaceID:       url.WorkspaceId,
+		UTMTemplate:       url.UtmTemplate,
+		PasswordProtected: url.PasswordProtected,
+		FallbackURL:       url.FallbackUrl,
+		MaxClicks:         url.MaxClicks,
+		InterstitialMode:  url.InterstitialMode,
+		Preview:           toLinkPreview(url.Preview),
+		PreviewOverride:   toLinkPreview(url.PreviewOverride),
+		Tags:              url.Tags,
+		FolderID:          url.FolderId,
+	}
+	if url.ExpiresAt > 0 {
+		response.ExpiresAt = &url.ExpiresAt
+	}
+	if url.ActivatesAt > 0 {
+		response.ActivatesAt = &url.ActivatesAt
+	}
+	if url.DisabledAt > 0 {
+		response.DisabledReason = url.DisabledReason
+		response.DisabledAt = &url.DisabledAt
+	}
+	if url.DeletedAt > 0 {
+		response.DeletedAt = &url.DeletedAt
+	}
+	if url.PurgeAt > 0 {
+		response.PurgeAt = &url.PurgeAt
+	}
+	response.InactivityExempt = url.InactivityExempt
+	if url.ArchivedAt > 0 {
+		response.ArchivedAt = &url.ArchivedAt
+	}
+	return response
 }
 
 // GetURLInfo handles GET /api/v1/urls/:shortCode
@@ -152,6 +308,7 @@ type URLInfoResponse struct {
 //	@Produce		json
 //	@Param			shortCode	path		string				true	"Short code identifier"	example(abc123)
 //	@Param			user_id		query		string				true	"User ID"				example(user123)
+//	@Param			domain		query		string				false	"Branded domain of the link (default domain when empty)"	example(go.acme.com)
 //	@Success		200			{object}	URLInfoResponse		"URL information retrieved successfully"
 //	@Failure		400			{object}	ErrorResponse		"Missing user_id parameter"
 //	@Failure		404			{object}	ErrorResponse		"URL not found"
@@ -171,12 +328,13 @@ func (h *URLHandler) GetURLInfo(c *gin.Context) {
 	}).Info("Processing GetURLInfo REST request")
 
 	// Call RPC service
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
 	defer cancel()
 
 	rsp, err := h.client.GetURLInfo(ctx, &pb.GetURLRequest{
 		ShortCode: shortCode,
 		UserId:    userID,
+		Domain:    c.Query("domain"),
 	})
 	if err != nil {
 		h.log.WithError(err).Error("Failed to call RPC service")
@@ -185,22 +343,7 @@ func (h *URLHandler) GetURLInfo(c *gin.Context) {
 	}
 
 	// Convert RPC response to REST response
-	response := URLInfoResponse{
-		ShortCode:  rsp.ShortCode,
-		ShortURL:   rsp.ShortUrl,
-		LongURL:    rsp.LongUrl,
-		UserID:     rsp.UserId,
-		CreatedAt:  rsp.CreatedAt,
-		ClickCount: rsp.ClickCount,
-		IsActive:   rsp.IsActive,
-		Metadata:   rsp.Metadata,
-	}
-
-	if rsp.ExpiresAt > 0 {
-		response.ExpiresAt = &rsp.ExpiresAt
-	}
-
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, toURLInfoResponse(rsp))
 }
 
 // UserURLsResponse represents the response for user URLs list
@@ -210,22 +353,39 @@ type UserURLsResponse struct {
 	Page       int32             `json:"page" example:"1"`
 	PageSize   int32             `json:"page_size" example:"20"`
 	HasNext    bool              `json:"has_next" example:"true"`
+	NextCursor string            `json:"next_cursor,omitempty" example:"eyJvIjoiY3JlYXRlZF9hdDpkZXNjIiwidiI6IjIwMjQtMDMtMDFUMTA6MDA6MDBaIiwiaSI6NDJ9"`
+	PrevCursor string            `json:"prev_cursor,omitempty"`
+}
+
+// flagDeprecatedPaging marks responses to page-number requests as using a
+// deprecated parameter; clients should follow next_cursor and prev_cursor
+func flagDeprecatedPaging(c *gin.Context) {
+	if c.Query("page") != "" && c.Query("cursor") == "" {
+		c.Header("Deprecation", "true")
+		c.Header("Warning", `299 - "page is deprecated, use cursor"`)
+	}
 }
 
 // GetUserURLs handles GET /api/v1/users/:userID/urls
 //
 //	@Summary		Get user's URLs
-//	@Description	Retrieve a paginated list of URLs belonging to a specific user
+//	@Description	Retrieve a page of the URLs belonging to a specific user, optionally filtered by tag or folder. Follow next_cursor and prev_cursor to page; page numbers still work but are deprecated and get slower the deeper the page. total_count counts all matching URLs
 //	@Tags			User Management
 //	@Accept			json
 //	@Produce		json
-//	@Param			userID		path		string				true	"User ID"			example(user123)
-//	@Param			page		query		int					false	"Page number"		example(1)
-//	@Param			page_size	query		int					false	"Page size"			example(20)
-//	@Param			sort_by		query		string				false	"Sort field"		example(created_at)
-//	@Param			sort_order	query		string				false	"Sort order"		example(desc)
-//	@Success		200			{object}	UserURLsResponse	"User URLs retrieved successfully"
-//	@Failure		500			{object}	ErrorResponse		"Failed to get user URLs"
+//	@Param			userID				path		string				true	"User ID"											example(user123)
+//	@Param			cursor				query		string				false	"next_cursor or prev_cursor of a previous page"		example(eyJvIjoiY3JlYXRlZF9hdDpkZXNjIiwidiI6IjIwMjQtMDMtMDFUMTA6MDA6MDBaIiwiaSI6NDJ9)
+//	@Param			page				query		int					false	"Page number (deprecated, use cursor)"				example(1)
+//	@Param			page_size			query		int					false	"Page size"											example(20)
+//	@Param			sort_by				query		string				false	"Sort field (created_at, click_count, last_accessed or alias)"	example(created_at)
+//	@Param			sort_order			query		string				false	"Sort order (asc or desc)"							example(desc)
+//	@Param			tag					query		string				false	"Only links with this tag"							example(spring campaign)
+//	@Param			folder_id			query		int					false	"Only links in this folder"							example(4)
+//	@Param			include_subfolders	query		bool				false	"Also list links in subfolders of folder_id"		example(true)
+//	@Success		200					{object}	UserURLsResponse	"User URLs retrieved successfully"
+//	@Failure		400					{object}	ErrorResponse		"Invalid sort, tag, folder or cursor"
+//	@Failure		404					{object}	ErrorResponse		"Folder not found"
+//	@Failure		500					{object}	ErrorResponse		"Failed to get user URLs"
 //	@Router			/users/{userID}/urls [get]
 func (h *URLHandler) GetUserURLs(c *gin.Context) {
 	userID := c.Param("userID")
@@ -235,6 +395,12 @@ func (h *URLHandler) GetUserURLs(c *gin.Context) {
 	pageSize, _ := strconv.ParseInt(c.DefaultQuery("page_size", "20"), 10, 32)
 	sortBy := c.DefaultQuery("sort_by", "created_at")
 	sortOrder := c.DefaultQuery("sort_order", "desc")
+	folderID, err := strconv.ParseInt(c.DefaultQuery("folder_id", "0"), 10, 64)
+	if err != nil || folderID < 0 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "folder_id must be a folder ID"})
+		return
+	}
+	includeSubfolders, _ := strconv.ParseBool(c.DefaultQuery("include_subfolders", "false"))
 
 	h.log.WithFields(logrus.Fields{
 		"user_id":   userID,
@@ -243,39 +409,41 @@ func (h *URLHandler) GetUserURLs(c *gin.Context) {
 	}).Info("Processing GetUserURLs REST request")
 
 	// Call RPC service
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
 	defer cancel()
 
 	rsp, err := h.client.GetUserURLs(ctx, &pb.GetUserURLsRequest{
-		UserId:    userID,
-		Page:      int32(page),
-		PageSize:  int32(pageSize),
-		SortBy:    sortBy,
-		SortOrder: sortOrder,
+		UserId:            userID,
+		Page:              int32(page),
+		PageSize:          int32(pageSize),
+		Cursor:            c.Query("cursor"),
+		SortBy:            sortBy,
+		SortOrder:         sortOrder,
+		Tag:               c.Query("tag"),
+		FolderId:          folderID,
+		IncludeSubfolders: includeSubfolders,
 	})
 	if err != nil {
 		h.log.WithError(err).Error("Failed to call RPC service")
-		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to get user URLs"})
+		switch {
+		case strings.Contains(err.Error(), "sort_by must be"):
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "sort_by must be created_at, click_count, last_accessed or alias and sort_order asc or desc"})
+		case strings.Contains(err.Error(), "invalid tag"):
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: invalidTagMessage})
+		case strings.Contains(err.Error(), "invalid cursor"):
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid cursor: pass next_cursor or prev_cursor unchanged with the same sort"})
+		case strings.Contains(err.Error(), "folder not found"):
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: "Folder not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to get user URLs"})
+		}
 		return
 	}
 
 	// Convert RPC response to REST response
 	urls := make([]URLInfoResponse, len(rsp.Urls))
 	for i, url := range rsp.Urls {
-		urlData := URLInfoResponse{
-			ShortCode:  url.ShortCode,
-			ShortURL:   url.ShortUrl,
-			LongURL:    url.LongUrl,
-			UserID:     url.UserId,
-			CreatedAt:  url.CreatedAt,
-			ClickCount: url.ClickCount,
-			IsActive:   url.IsActive,
-			Metadata:   url.Metadata,
-		}
-		if url.ExpiresAt > 0 {
-			urlData.ExpiresAt = &url.ExpiresAt
-		}
-		urls[i] = urlData
+		urls[i] = toURLInfoResponse(url)
 	}
 
 	response := UserURLsResponse{
@@ -284,11 +452,118 @@ func (h *URLHandler) GetUserURLs(c *gin.Context) {
 		Page:       rsp.Page,
 		PageSize:   rsp.PageSize,
 		HasNext:    rsp.HasNext,
+		NextCursor: rsp.NextCursor,
+		PrevCursor: rsp.PrevCursor,
 	}
 
+	flagDeprecatedPaging(c)
 	c.JSON(http.StatusOK, response)
 }
 
+// UpdateURLRequest represents the REST API request for updating a URL
+type UpdateURLRequest struct {
+	UserID           string            `json:"user_id" binding:"required" example:"user123"`
+	LongURL          string            `json:"long_url,omitempty" example:"https://www.google.com/search"`
+	ExpirationTime   *int64            `json:"expiration_time,omitempty" example:"1735689600"`
+	Metadata         map[string]string `json:"metadata,omitempty" example:"campaign:social,source:twitter"`
+	UTMTemplate      map[string]string `json:"utm_template,omitempty" example:"utm_source:newsletter,utm_medium:email"`
+	ClearUTMTemplate bool              `json:"clear_utm_template,omitempty" example:"false"`
+	Password         string            `json:"password,omitempty" example:"n3w-pass"`
+	ClearPassword    bool              `json:"clear_password,omitempty" example:"false"`
+	ActivationTime   *int64            `json:"activation_time,omitempty" example:"1704067200"`
+	FallbackURL      string            `json:"fallback_url,omitempty" example:"https://www.google.com/coming-soon"`
+	MaxClicks        int64             `json:"max_clicks,omitempty" example:"10"`
+	ClearMaxClicks   bool              `json:"clear_max_clicks,omitempty" example:"false"`
+	PreviewOverride  *LinkPreview      `json:"preview_override,omitempty"`                      // replaces the overrides; {} clears them
+	Tags             []string          `json:"tags,omitempty" example:"spring campaign,social"` // replaces the tags
+	ClearTags        bool              `json:"clear_tags,omitempty" example:"false"`
+	FolderID         int64             `json:"folder_id,omitempty" example:"4"` // moves the link into the folder
+	ClearFolder      bool              `json:"clear_folder,omitempty" example:"false"`
+	InactivityExempt *bool             `json:"inactivity_exempt,omitempty" example:"true"` // exempts the link from the workspace's inactivity policy
+}
+
+// UpdateURL handles PUT /api/v1/urls/:shortCode
+//
+//	@Summary		Update a short URL
+//	@Description	Update the destination, activation window, metadata, UTM template, password, link preview overrides, tags, folder or inactivity exemption of a short URL
+//	@Tags			URL Management
+//	@Accept			json
+//	@Produce		json
+//	@Param			shortCode	path		string				true	"Short code identifier"	example(abc123)
+//	@Param			domain		query		string				false	"Branded domain of the link (default domain when empty)"	example(go.acme.com)
+//	@Param			request		body		UpdateURLRequest	true	"URL update request"
+//	@Success		200			{object}	URLInfoResponse		"URL updated successfully"
+//	@Failure		400			{object}	ErrorResponse		"Invalid request body or update rejected"
+//	@Failure		500			{object}	ErrorResponse		"Internal server error"
+//	@Router			/urls/{shortCode} [put]
+func (h *URLHandler) UpdateURL(c *gin.Context) {
+	shortCode := c.Param("shortCode")
+
+	var req UpdateURLRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.WithError(err).Error("Invalid request body")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request body"})
+		return
+	}
+
+	h.log.WithFields(logrus.Fields{
+		"short_code": shortCode,
+		"user_id":    req.UserID,
+	}).Info("Processing UpdateURL REST request")
+
+	// Convert REST request to RPC request
+	rpcReq := &pb.UpdateURLRequest{
+		Domain:           c.Query("domain"),
+		ShortCode:        shortCode,
+		UserId:           req.UserID,
+		NewLongUrl:       req.LongURL,
+		Metadata:         req.Metadata,
+		UtmTemplate:      req.UTMTemplate,
+		ClearUtmTemplate: req.ClearUTMTemplate,
+		NewPassword:      req.Password,
+		ClearPassword:    req.ClearPassword,
+		NewFallbackUrl:   req.FallbackURL,
+		NewMaxClicks:     req.MaxClicks,
+		ClearMaxClicks:   req.ClearMaxClicks,
+		Tags:             req.Tags,
+		ClearTags:        req.ClearTags,
+		NewFolderId:      req.FolderID,
+		ClearFolder:      req.ClearFolder,
+	}
+
+	if req.PreviewOverride != nil {
+		rpcReq.PreviewOverride = req.PreviewOverride.toProto()
+	}
+	if req.ExpirationTime != nil {
+		rpcReq.NewExpirationTime = *req.ExpirationTime
+	}
+	if req.ActivationTime != nil {
+		rpcReq.NewActivationTime = *req.ActivationTime
+	}
+	if req.InactivityExempt != nil {
+		rpcReq.InactivityExempt = *req.InactivityExempt
+		rpcReq.ClearInactivityExempt = !*req.InactivityExempt
+	}
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.UpdateURL(ctx, rpcReq)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to update URL"})
+		return
+	}
+
+	if !rsp.Success {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: rsp.Message})
+		return
+	}
+
+	c.JSON(http.StatusOK, toURLInfoResponse(rsp.UpdatedUrl))
+}
+
 // DeleteResponse represents delete operation response
 type DeleteResponse struct {
 	Message string `json:"message" example:"URL deleted successfully"`
@@ -297,12 +572,13 @@ type DeleteResponse struct {
 // DeleteURL handles DELETE /api/v1/urls/:shortCode
 //
 //	@Summary		Delete a short URL
-//	@Description	Delete a short URL belonging to the authenticated user
+//	@Description	Move a short URL belonging to the authenticated user to the trash. It stops resolving and can be restored until the trash retention purges it
 //	@Tags			URL Management
 //	@Accept			json
 //	@Produce		json
 //	@Param			shortCode	path		string			true	"Short code identifier"	example(abc123)
 //	@Param			user_id		query		string			true	"User ID"				example(user123)
+//	@Param			domain		query		string			false	"Branded domain of the link (default domain when empty)"	example(go.acme.com)
 //	@Success		200			{object}	DeleteResponse	"URL deleted successfully"
 //	@Failure		400			{object}	ErrorResponse	"Missing user_id parameter"
 //	@Failure		500			{object}	ErrorResponse	"Failed to delete URL"
@@ -322,12 +598,13 @@ func (h *URLHandler) DeleteURL(c *gin.Context) {
 	}).Info("Processing DeleteURL REST request")
 
 	// Call RPC service
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
 	defer cancel()
 
 	rsp, err := h.client.DeleteURL(ctx, &pb.DeleteURLRequest{
 		ShortCode: shortCode,
 		UserId:    userID,
+		Domain:    c.Query("domain"),
 	})
 	if err != nil {
 		h.log.WithError(err).Error("Failed to call RPC service")
@@ -355,16 +632,22 @@ type RedirectResponse struct {
 // RedirectURL handles GET /:shortCode for URL redirection
 //
 //	@Summary		Redirect to original URL
-//	@Description	Resolve a short code and redirect to the original URL with click tracking
+//	@Description	Resolve a short code and redirect to the original URL with click tracking.
+//	@Description	Codes are looked up on the branded domain named by the Host header, or on the default short domain for any other host.
+//	@Description	Flagged or unverified destinations answer 200 with a warning page (InterstitialResponse for JSON clients) until confirm=1 is sent.
+//	@Description	Known link preview bots (Slack, Twitter, Facebook, Discord, ...) get a 200 HTML page with the link's Open Graph / Twitter card tags instead of a redirect.
 //	@Tags			Redirect
 //	@Accept			json
 //	@Produce		json
 //	@Param			shortCode	path	string	true	"Short code identifier"	example(abc123)
-//	@Success		302			"Redirect to original URL"
+//	@Param			confirm		query	string	false	"Set to 1 to continue past the interstitial warning page"
+//	@Success		302			"Redirect to original URL (or to the fallback URL before activation)"
 //	@Success		200			{object}	RedirectResponse	"Redirect information (for API testing)"
+//	@Failure		401			{object}	ErrorResponse		"Password required"
 //	@Failure		404			{object}	ErrorResponse		"Short code not found"
-//	@Failure		410			{object}	ErrorResponse		"URL has expired"
+//	@Failure		410			{object}	ErrorResponse		"URL has expired or reached its click limit"
 //	@Failure		500			{object}	ErrorResponse		"Internal server error"
+//	@Failure		503			{object}	ComingSoonResponse	"URL is not active yet (coming soon)"
 //	@Router			/{shortCode} [get]
 func (h *URLHandler) RedirectURL(c *gin.Context) {
 	shortCode := c.Param("shortCode")
@@ -373,6 +656,9 @@ func (h *URLHandler) RedirectURL(c *gin.Context) {
 	userAgent := c.GetHeader("User-Agent")
 	ipAddress := c.ClientIP()
 	referrer := c.GetHeader("Referer")
+	acceptLanguage := c.GetHeader("Accept-Language")
+	accept := c.GetHeader("Accept")
+	source := c.Query("src")
 
 	h.log.WithFields(logrus.Fields{
 		"short_code": shortCode,
@@ -385,11 +671,20 @@ func (h *URLHandler) RedirectURL(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	// Access cookie issued after a correct password (ignored for unprotected links)
+	accessToken := accessCookie(c, shortCode)
+
 	rsp, err := h.redirectClient.ResolveURL(ctx, &redirectpb.ResolveRequest{
-		ShortCode: shortCode,
-		ClientIp:  ipAddress,
-		UserAgent: userAgent,
-		Referrer:  referrer,
+		ShortCode:   shortCode,
+		ClientIp:    ipAddress,
+		UserAgent:   userAgent,
+		Referrer:    referrer,
+		AccessToken: accessToken,
+		Confirmed:   c.Query("confirm") == "1",
+		Host:        c.Request.Host,
+
+		AcceptLanguage: acceptLanguage,
+		Accept:         accept,
 	})
 	if err != nil {
 		h.log.WithError(err).Error("Failed to resolve URL via redirect service")
@@ -411,17 +706,59 @@ func (h *URLHandler) RedirectURL(c *gin.Context) {
 		return
 	}
 
-	// Track the click asynchronously via redirect service
+	// Scheduled links are dark until their activation time
+	if rsp.NotYetActive {
+		h.log.WithField("short_code", shortCode).Info("Redirect requested before activation time")
+		h.renderComingSoon(c, rsp)
+		return
+	}
+
+	// Password-protected links never reveal the destination before authentication
+	if rsp.RequiresPassword {
+		h.renderPasswordRequired(c, shortCode, "")
+		return
+	}
+
+	// Flagged or unverified destinations get a warning page until the visitor confirms
+	if rsp.Interstitial {
+		h.log.WithFields(logrus.Fields{
+			"short_code":       shortCode,
+			"destination_host": rsp.DestinationHost,
+		}).Info("Showing interstitial warning page")
+		h.renderInterstitial(c, shortCode, rsp)
+		return
+	}
+
+	// Link preview bots get a page with the link's unfurl metadata
+	if rsp.Unfurl {
+		h.log.WithFields(logrus.Fields{
+			"short_code": shortCode,
+			"user_agent": userAgent,
+		}).Info("Serving link preview page to unfurl bot")
+		h.renderUnfurl(c, shortCode, rsp)
+		return
+	}
+
+	// Track the click asynchronously via redirect service, under the stored spelling of the code
+	trackedCode := shortCode
+	if rsp.ShortCode != "" {
+		trackedCode = rsp.ShortCode
+	}
 	go func() {
 		trackCtx, trackCancel := context.WithTimeout(context.Background(), 3*time.Second)
 		defer trackCancel()
 
 		_, trackErr := h.redirectClient.TrackClick(trackCtx, &redirectpb.ClickRequest{
-			ShortCode: shortCode,
+			Domain:    rsp.Domain,
+			ShortCode: trackedCode,
 			LongUrl:   rsp.LongUrl,
 			ClientIp:  ipAddress,
 			UserAgent: userAgent,
 			Referrer:  referrer,
+			Source:    source,
+
+			AcceptLanguage: acceptLanguage,
+			Accept:         accept,
 		})
 		if trackErr != nil {
 			h.log.WithError(trackErr).Warn("Failed to track click asynchronously")
@@ -547,6 +884,7 @@ type DashboardResponse struct {
 //	@Param			start_time	query		int64				false	"Start time (Unix timestamp)"	example(1672531200)
 //	@Param			end_time	query		int64				false	"End time (Unix timestamp)"	example(1672617600)
 //	@Param			granularity	query		string				false	"Time granularity (hour/day/week/month)"	example(day)
+//	@Param			exclude_suspicious	query		bool				false	"Leave out clicks flagged by fraud scoring"	example(true)
 //	@Success		200			{object}	URLStatsResponse	"URL analytics retrieved successfully"
 //	@Failure		400			{object}	ErrorResponse		"Invalid parameters"
 //	@Failure		404			{object}	ErrorResponse		"URL not found"
@@ -584,6 +922,15 @@ func (h *URLHandler) GetURLStats(c *gin.Context) {
 		granularity = "day"
 	}
 
+	excludeSuspicious := false
+	if excludeStr := c.Query("exclude_suspicious"); excludeStr != "" {
+		excludeSuspicious, err = strconv.ParseBool(excludeStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid exclude_suspicious format"})
+			return
+		}
+	}
+
 	h.log.WithFields(logrus.Fields{
 		"short_code":  shortCode,
 		"start_time":  startTime,
@@ -596,10 +943,11 @@ func (h *URLHandler) GetURLStats(c *gin.Context) {
 	defer cancel()
 
 	rsp, err := h.analyticsClient.GetURLStats(ctx, &analyticspb.StatsRequest{
-		ShortCode:   shortCode,
-		StartTime:   startTime,
-		EndTime:     endTime,
-		Granularity: granularity,
+		ShortCode:         shortCode,
+		StartTime:         startTime,
+		EndTime:           endTime,
+		Granularity:       granularity,
+		ExcludeSuspicious: excludeSuspicious,
 	})
 	if err != nil {
 		h.log.WithError(err).Error("Failed to get URL analytics")
@@ -644,6 +992,7 @@ func (h *URLHandler) GetURLStats(c *gin.Context) {
 //	@Param			start_time	query		int64				false	"Start time (Unix timestamp)"	example(1672531200)
 //	@Param			end_time	query		int64				false	"End time (Unix timestamp)"	example(1672617600)
 //	@Param			sort_by		query		string				false	"Sort by (clicks/unique_clicks/created_at)"	example(clicks)
+//	@Param			exclude_suspicious	query		bool				false	"Leave out clicks flagged by fraud scoring"	example(true)
 //	@Success		200			{object}	TopURLsResponse		"Top URLs retrieved successfully"
 //	@Failure		400			{object}	ErrorResponse		"Invalid parameters"
 //	@Failure		500			{object}	ErrorResponse		"Internal server error"
@@ -688,6 +1037,15 @@ func (h *URLHandler) GetTopURLs(c *gin.Context) {
 		sortBy = "clicks"
 	}
 
+	excludeSuspicious := false
+	if excludeStr := c.Query("exclude_suspicious"); excludeStr != "" {
+		excludeSuspicious, err = strconv.ParseBool(excludeStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid exclude_suspicious format"})
+			return
+		}
+	}
+
 	h.log.WithFields(logrus.Fields{
 		"limit":      limit,
 		"start_time": startTime,
@@ -696,14 +1054,15 @@ func (h *URLHandler) GetTopURLs(c *gin.Context) {
 	}).Info("Processing GetTopURLs analytics request")
 
 	// Call analytics service
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
 	defer cancel()
 
 	rsp, err := h.analyticsClient.GetTopURLs(ctx, &analyticspb.TopURLsRequest{
-		Limit:     limit,
-		StartTime: startTime,
-		EndTime:   endTime,
-		SortBy:    sortBy,
+		Limit:             limit,
+		StartTime:         startTime,
+		EndTime:           endTime,
+		SortBy:            sortBy,
+		ExcludeSuspicious: excludeSuspicious,
 	})
 	if err != nil {
 		h.log.WithError(err).Error("Failed to get top URLs analytics")
@@ -727,6 +1086,115 @@ func (h *URLHandler) GetTopURLs(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// CampaignStatsResponse represents clicks grouped by UTM campaign
+type CampaignStatsResponse struct {
+	Campaigns []CampaignStatsItem `json:"campaigns"`
+}
+
+// CampaignStatsItem represents clicks for one UTM source/medium/campaign combination
+type CampaignStatsItem struct {
+	UTMSource    string `json:"utm_source" example:"twitter.com"`
+	UTMMedium    string `json:"utm_medium" example:"social"`
+	UTMCampaign  string `json:"utm_campaign" example:"spring_launch"`
+	Clicks       int64  `json:"clicks" example:"120"`
+	UniqueClicks int64  `json:"unique_clicks" example:"87"`
+}
+
+// GetCampaignStats handles GET /api/v1/analytics/campaigns
+//
+//	@Summary		Get campaign analytics
+//	@Description	Retrieve clicks grouped by the UTM source, medium and campaign actually sent to destinations
+//	@Tags			Analytics
+//	@Accept			json
+//	@Produce		json
+//	@Param			short_code	query		string					false	"Restrict to one short code"	example(abc123)
+//	@Param			limit		query		int32					false	"Number of campaigns to return"	example(20)
+//	@Param			start_time	query		int64					false	"Start time (Unix timestamp)"	example(1672531200)
+//	@Param			end_time	query		int64					false	"End time (Unix timestamp)"	example(1672617600)
+//	@Param			exclude_suspicious	query		bool				false	"Leave out clicks flagged by fraud scoring"	example(true)
+//	@Success		200			{object}	CampaignStatsResponse	"Campaign analytics retrieved successfully"
+//	@Failure		400			{object}	ErrorResponse			"Invalid parameters"
+//	@Failure		500			{object}	ErrorResponse			"Internal server error"
+//	@Router			/analytics/campaigns [get]
+func (h *URLHandler) GetCampaignStats(c *gin.Context) {
+	// Parse query parameters
+	var limit int32 = 20 // Default limit
+	var startTime, endTime int64
+	var err error
+
+	if limitStr := c.Query("limit"); limitStr != "" {
+		limitParsed, err := strconv.ParseInt(limitStr, 10, 32)
+		if err != nil || limitParsed <= 0 {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid limit format"})
+			return
+		}
+		limit = int32(limitParsed)
+	}
+
+	if startTimeStr := c.Query("start_time"); startTimeStr != "" {
+		startTime, err = strconv.ParseInt(startTimeStr, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid start_time format"})
+			return
+		}
+	}
+
+	if endTimeStr := c.Query("end_time"); endTimeStr != "" {
+		endTime, err = strconv.ParseInt(endTimeStr, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid end_time format"})
+			return
+		}
+	}
+
+	shortCode := c.Query("short_code")
+
+	excludeSuspicious := false
+	if excludeStr := c.Query("exclude_suspicious"); excludeStr != "" {
+		excludeSuspicious, err = strconv.ParseBool(excludeStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid exclude_suspicious format"})
+			return
+		}
+	}
+
+	h.log.WithFields(logrus.Fields{
+		"short_code": shortCode,
+		"limit":      limit,
+	}).Info("Processing GetCampaignStats analytics request")
+
+	// Call analytics service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.analyticsClient.GetCampaignStats(ctx, &analyticspb.CampaignStatsRequest{
+		ShortCode:         shortCode,
+		StartTime:         startTime,
+		EndTime:           endTime,
+		Limit:             limit,
+		ExcludeSuspicious: excludeSuspicious,
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to get campaign analytics")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to retrieve campaign analytics"})
+		return
+	}
+
+	// Convert to REST response
+	response := CampaignStatsResponse{Campaigns: []CampaignStatsItem{}}
+	for _, campaign := range rsp.Campaigns {
+		response.Campaigns = append(response.Campaigns, CampaignStatsItem{
+			UTMSource:    campaign.UtmSource,
+			UTMMedium:    campaign.UtmMedium,
+			UTMCampaign:  campaign.UtmCampaign,
+			Clicks:       campaign.Clicks,
+			UniqueClicks: campaign.UniqueClicks,
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
 // GetDashboard returns comprehensive analytics dashboard
 func (h *URLHandler) GetDashboard(c *gin.Context) {
 	h.log.Info("GetDashboard called")
@@ -738,6 +1206,15 @@ func (h *URLHandler) GetDashboard(c *gin.Context) {
 	// Create request
 	req := &analyticspb.DashboardRequest{}
 
+	if excludeStr := c.Query("exclude_suspicious"); excludeStr != "" {
+		excludeSuspicious, err := strconv.ParseBool(excludeStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid exclude_suspicious format"})
+			return
+		}
+		req.ExcludeSuspicious = excludeSuspicious
+	}
+
 	// Parse time parameters if provided
 	if startTimeParam != "" {
 		if startTime, err := time.Parse(time.RFC3339, startTimeParam); err == nil {
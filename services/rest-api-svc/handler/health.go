@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/go-systems-lab/go-url-shortener/proto/url"
+)
+
+// LinkHealthResponse represents the latest background check of a link destination
+type LinkHealthResponse struct {
+	Domain              string   `json:"domain,omitempty" example:"go.acme.com"`
+	ShortCode           string   `json:"short_code" example:"abc123"`
+	Status              string   `json:"status" example:"healthy" enums:"healthy,failing,broken,unknown"`
+	StatusCode          int32    `json:"status_code,omitempty" example:"200"`
+	LatencyMs           int64    `json:"latency_ms,omitempty" example:"182"`
+	RedirectChain       []string `json:"redirect_chain,omitempty" example:"https://www.example.com/"`
+	Error               string   `json:"error,omitempty" example:"dial tcp: i/o timeout"`
+	ConsecutiveFailures int32    `json:"consecutive_failures" example:"0"`
+	CheckedAt           *int64   `json:"checked_at,omitempty" example:"1672531200"`
+	LastHealthyAt       *int64   `json:"last_healthy_at,omitempty" example:"1672531200"`
+}
+
+// toLinkHealthResponse converts an RPC link health message to its REST representation
+func toLinkHealthResponse(health *pb.LinkHealthInfo) LinkHealthResponse {
+	response := LinkHealthResponse{
+		Domain:              health.Domain,
+		ShortCode:           health.ShortCode,
+		Status:              health.Status,
+		StatusCode:          health.StatusCode,
+		LatencyMs:           health.LatencyMs,
+		RedirectChain:       health.RedirectChain,
+		Error:               health.Error,
+		ConsecutiveFailures: health.ConsecutiveFailures,
+	}
+	if health.CheckedAt > 0 {
+		response.CheckedAt = &health.CheckedAt
+	}
+	if health.LastHealthyAt > 0 {
+		response.LastHealthyAt = &health.LastHealthyAt
+	}
+	return response
+}
+
+// GetLinkHealth handles GET /api/v1/urls/:shortCode/health
+//
+//	@Summary		Get link health
+//	@Description	Retrieve the latest background check of a short URL's destination. Links become broken after several consecutive failed checks; unknown means the link has not been checked yet
+//	@Tags			URL Management
+//	@Accept			json
+//	@Produce		json
+//	@Param			shortCode	path		string				true	"Short code identifier"	example(abc123)
+//	@Param			user_id		query		string				true	"User ID"				example(user123)
+//	@Param			domain		query		string				false	"Branded domain of the link (default domain when empty)"	example(go.acme.com)
+//	@Success		200			{object}	LinkHealthResponse	"Link health retrieved successfully"
+//	@Failure		400			{object}	ErrorResponse		"Missing user_id parameter"
+//	@Failure		404			{object}	ErrorResponse		"URL not found"
+//	@Router			/urls/{shortCode}/health [get]
+func (h *URLHandler) GetLinkHealth(c *gin.Context) {
+	shortCode := c.Param("shortCode")
+	userID := c.Query("user_id")
+
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "user_id is required"})
+		return
+	}
+
+	h.log.WithFields(logrus.Fields{
+		"short_code": shortCode,
+		"user_id":    userID,
+	}).Info("Processing GetLinkHealth REST request")
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.GetLinkHealth(ctx, &pb.GetLinkHealthRequest{
+		ShortCode: shortCode,
+		UserId:    userID,
+		Domain:    c.Query("domain"),
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		c.JSON(http.StatusNotFound, ErrorResponse{Error: "URL not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, toLinkHealthResponse(rsp))
+}
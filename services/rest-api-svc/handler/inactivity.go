@@ -0,0 +1,201 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/go-systems-lab/go-url-shortener/proto/url"
+)
+
+// InactivityPolicyRequest represents the REST API request for setting a workspace's inactivity policy
+type InactivityPolicyRequest struct {
+	UserID         string `json:"user_id" binding:"required" example:"user123"`
+	InactivityDays int32  `json:"inactivity_days" example:"180"` // 0 turns the policy off
+	DryRun         bool   `json:"dry_run" example:"false"`       // only report the links the policy would archive
+}
+
+// InactivityReportResponse lists the links an inactivity policy would archive now
+type InactivityReportResponse struct {
+	WorkspaceID    string            `json:"workspace_id" example:"marketing"`
+	InactivityDays int32             `json:"inactivity_days" example:"180"`
+	DryRun         bool              `json:"dry_run" example:"true"`
+	TotalCount     int32             `json:"total_count" example:"42"`
+	URLs           []URLInfoResponse `json:"urls"`
+}
+
+// SetInactivityPolicy handles PUT /api/v1/workspaces/:workspaceID/inactivity-policy
+//
+//	@Summary		Set a workspace's inactivity policy
+//	@Description	Archive workspace links that were not clicked for inactivity_days (0 turns the policy off), counted from the last click, creation or revival. Archived links stop resolving until revived; exempt links are never archived. In dry run nothing is archived and the report lists what would be
+//	@Tags			Workspaces
+//	@Accept			json
+//	@Produce		json
+//	@Param			workspaceID	path		string					true	"Workspace identifier"	example(marketing)
+//	@Param			request		body		InactivityPolicyRequest	true	"Inactivity policy request"
+//	@Success		200			{object}	WorkspaceResponse		"Inactivity policy updated"
+//	@Failure		400			{object}	ErrorResponse			"Invalid inactivity policy"
+//	@Failure		404			{object}	ErrorResponse			"Workspace not found"
+//	@Failure		500			{object}	ErrorResponse			"Failed to set inactivity policy"
+//	@Router			/workspaces/{workspaceID}/inactivity-policy [put]
+func (h *URLHandler) SetInactivityPolicy(c *gin.Context) {
+	workspaceID := c.Param("workspaceID")
+
+	var req InactivityPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request body"})
+		return
+	}
+
+	h.log.WithFields(logrus.Fields{
+		"workspace_id":    workspaceID,
+		"inactivity_days": req.InactivityDays,
+		"dry_run":         req.DryRun,
+	}).Info("Processing SetInactivityPolicy REST request")
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.SetInactivityPolicy(ctx, &pb.SetInactivityPolicyRequest{
+		WorkspaceId:    workspaceID,
+		UserId:         req.UserID,
+		InactivityDays: req.InactivityDays,
+		DryRun:         req.DryRun,
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		switch {
+		case strings.Contains(err.Error(), "invalid inactivity policy"):
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "inactivity_days must be between 0 and 3650"})
+		case strings.Contains(err.Error(), "workspace not found"), strings.Contains(err.Error(), "unauthorized"):
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: "Workspace not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to set inactivity policy"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, toWorkspaceResponse(rsp))
+}
+
+// GetInactivityReport handles GET /api/v1/workspaces/:workspaceID/inactivity-report
+//
+//	@Summary		Report inactive links
+//	@Description	List the workspace links the inactivity policy would archive now, least recently used first. Pass inactivity_days to preview a policy before setting it
+//	@Tags			Workspaces
+//	@Accept			json
+//	@Produce		json
+//	@Param			workspaceID		path		string						true	"Workspace identifier"								example(marketing)
+//	@Param			user_id			query		string						true	"User ID"											example(user123)
+//	@Param			inactivity_days	query		int							false	"Preview a policy (default: the workspace's)"		example(90)
+//	@Param			limit			query		int							false	"Links listed (default 100, at most 1000)"			example(100)
+//	@Success		200				{object}	InactivityReportResponse	"Inactive links"
+//	@Failure		400				{object}	ErrorResponse				"No inactivity policy to report on"
+//	@Failure		404				{object}	ErrorResponse				"Workspace not found"
+//	@Failure		500				{object}	ErrorResponse				"Failed to get inactivity report"
+//	@Router			/workspaces/{workspaceID}/inactivity-report [get]
+func (h *URLHandler) GetInactivityReport(c *gin.Context) {
+	workspaceID := c.Param("workspaceID")
+	userID := c.Query("user_id")
+
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "user_id is required"})
+		return
+	}
+
+	days, _ := strconv.ParseInt(c.DefaultQuery("inactivity_days", "0"), 10, 32)
+	limit, _ := strconv.ParseInt(c.DefaultQuery("limit", "0"), 10, 32)
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.GetInactivityReport(ctx, &pb.InactivityReportRequest{
+		WorkspaceId:    workspaceID,
+		UserId:         userID,
+		InactivityDays: int32(days),
+		Limit:          int32(limit),
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		switch {
+		case strings.Contains(err.Error(), "invalid inactivity policy"):
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "The workspace has no inactivity policy; pass inactivity_days between 1 and 3650 to preview one"})
+		case strings.Contains(err.Error(), "workspace not found"), strings.Contains(err.Error(), "unauthorized"):
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: "Workspace not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to get inactivity report"})
+		}
+		return
+	}
+
+	urls := make([]URLInfoResponse, len(rsp.Urls))
+	for i, url := range rsp.Urls {
+		urls[i] = toURLInfoResponse(url)
+	}
+
+	c.JSON(http.StatusOK, InactivityReportResponse{
+		WorkspaceID:    rsp.WorkspaceId,
+		InactivityDays: rsp.InactivityDays,
+		DryRun:         rsp.DryRun,
+		TotalCount:     rsp.TotalCount,
+		URLs:           urls,
+	})
+}
+
+// ReviveURL handles POST /api/v1/urls/:shortCode/revive
+//
+//	@Summary		Revive an archived link
+//	@Description	Reactivate a link its workspace's inactivity policy archived. The inactivity clock starts over
+//	@Tags			URL Management
+//	@Accept			json
+//	@Produce		json
+//	@Param			shortCode	path		string			true	"Short code identifier"	example(abc123)
+//	@Param			user_id		query		string			true	"User ID"				example(user123)
+//	@Param			domain		query		string			false	"Branded domain of the link (default domain when empty)"	example(go.acme.com)
+//	@Success		200			{object}	URLInfoResponse	"Revived link"
+//	@Failure		400			{object}	ErrorResponse	"Missing user_id parameter"
+//	@Failure		404			{object}	ErrorResponse	"Archived link not found"
+//	@Failure		500			{object}	ErrorResponse	"Failed to revive URL"
+//	@Router			/urls/{shortCode}/revive [post]
+func (h *URLHandler) ReviveURL(c *gin.Context) {
+	shortCode := c.Param("shortCode")
+	userID := c.Query("user_id")
+
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "user_id is required"})
+		return
+	}
+
+	h.log.WithFields(logrus.Fields{
+		"short_code": shortCode,
+		"user_id":    userID,
+	}).Info("Processing ReviveURL REST request")
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.ReviveURL(ctx, &pb.RestoreURLRequest{
+		ShortCode: shortCode,
+		UserId:    userID,
+		Domain:    c.Query("domain"),
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		if strings.Contains(err.Error(), "URL not found") {
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: "Archived URL not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to revive URL"})
+		return
+	}
+
+	c.JSON(http.StatusOK, toURLInfoResponse(rsp))
+}
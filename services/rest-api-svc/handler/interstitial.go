@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"bytes"
+	"html/template"
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+
+	redirectpb "github.com/go-systems-lab/go-url-shortener/proto/redirect"
+)
+
+// interstitialTemplate is the warning page shown before leaving to an untrusted or flagged destination
+var interstitialTemplate = template.Must(template.New("interstitial").Parse(`<!DOCTYPE html>
+<html>
+<head>
+    <title>You are leaving this site</title>
+    <meta name="viewport" content="width=device-width, initial-scale=1">
+    <meta name="robots" content="noindex">
+    <style>
+        body { font-family: Arial, sans-serif; max-width: 520px; margin: 80px auto; padding: 20px; }
+        h1 { font-size: 1.4em; }
+        .destination { word-break: break-all; background: #f4f4f4; padding: 10px; border-radius: 4px; }
+        .continue { display: inline-block; margin-top: 16px; padding: 8px 16px; background: #c0392b; color: #fff; text-decoration: none; border-radius: 4px; }
+    </style>
+</head>
+<body>
+    <h1>⚠️ You are leaving to {{.Host}}</h1>
+    <p>This link points to a site we have not verified. Only continue if you trust it.</p>
+    <p class="destination">{{.Destination}}</p>
+    <a class="continue" href="{{.ContinueURL}}" rel="nofollow">Continue to {{.Host}}</a>
+</body>
+</html>`))
+
+// InterstitialResponse represents the JSON answer for a link that shows a warning page
+type InterstitialResponse struct {
+	Interstitial    bool   `json:"interstitial" example:"true"`
+	ShortCode       string `json:"short_code" example:"abc123"`
+	Destination     string `json:"destination" example:"https://unverified.example.com/offer"`
+	DestinationHost string `json:"destination_host" example:"unverified.example.com"`
+	ContinueURL     string `json:"continue_url" example:"/abc123?confirm=1"`
+}
+
+// renderInterstitial shows the "you are leaving" page; continuing re-requests the short URL with confirm=1
+func (h *URLHandler) renderInterstitial(c *gin.Context, shortCode string, rsp *redirectpb.ResolveResponse) {
+	response := InterstitialResponse{
+		Interstitial:    true,
+		ShortCode:       shortCode,
+		Destination:     rsp.LongUrl,
+		DestinationHost: rsp.DestinationHost,
+		ContinueURL:     shortLinkPath(c, shortCode, url.Values{"confirm": {"1"}}),
+	}
+
+	c.Header("Cache-Control", "no-store")
+	if c.GetHeader("Accept") == "application/json" {
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	var page bytes.Buffer
+	if err := interstitialTemplate.Execute(&page, map[string]string{
+		"Host":        response.DestinationHost,
+		"Destination": response.Destination,
+		"ContinueURL": response.ContinueURL,
+	}); err != nil {
+		h.log.WithError(err).Error("Failed to render interstitial page")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Internal server error"})
+		return
+	}
+
+	c.Data(http.StatusOK, "text/html; charset=utf-8", page.Bytes())
+}
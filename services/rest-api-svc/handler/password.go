@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	redirectpb "github.com/go-systems-lab/go-url-shortener/proto/redirect"
+)
+
+// passwordFormTemplate is the page shown to visitors of a password-protected link
+var passwordFormTemplate = template.Must(template.New("password").Parse(`<!DOCTYPE html>
+<html>
+<head>
+    <title>Password required</title>
+    <meta name="viewport" content="width=device-width, initial-scale=1">
+    <style>
+        body { font-family: Arial, sans-serif; max-width: 360px; margin: 80px auto; padding: 20px; }
+        h1 { font-size: 1.4em; }
+        input[type=password] { width: 100%; padding: 8px; margin: 10px 0; box-sizing: border-box; }
+        button { padding: 8px 16px; }
+        .error { color: #c0392b; }
+    </style>
+</head>
+<body>
+    <h1>🔒 This link is password protected</h1>
+    {{if .Error}}<p class="error">{{.Error}}</p>{{end}}
+    <form method="POST" action="{{.Action}}">
+        <input type="password" name="password" placeholder="Password" autofocus required>
+        <button type="submit">Continue</button>
+    </form>
+</body>
+</html>`))
+
+// PasswordRequest represents the password submitted for a protected link
+type PasswordRequest struct {
+	Password string `json:"password" form:"password" binding:"required" example:"s3cret-pass"`
+}
+
+// accessCookieName returns the cookie holding the access token for a short code
+func accessCookieName(shortCode string) string {
+	return "sl_access_" + shortCode
+}
+
+// accessCookie returns the access token sent for a short code. Cookies are named
+// after the stored spelling of the code, so on case-insensitive domains another
+// spelling finds them too; an exact match wins.
+func accessCookie(c *gin.Context, shortCode string) string {
+	name := accessCookieName(shortCode)
+	if token, err := c.Cookie(name); err == nil {
+		return token
+	}
+	for _, cookie := range c.Request.Cookies() {
+		if strings.EqualFold(cookie.Name, name) {
+			return cookie.Value
+		}
+	}
+	return ""
+}
+
+// renderPasswordRequired answers with the password form, or a JSON error for API clients
+func (h *URLHandler) renderPasswordRequired(c *gin.Context, shortCode, message string) {
+	h.renderPasswordForm(c, http.StatusUnauthorized, shortCode, message)
+}
+
+func (h *URLHandler) renderPasswordForm(c *gin.Context, status int, shortCode, message string) {
+	if c.GetHeader("Accept") == "application/json" {
+		if message == "" {
+			message = "This short URL is password protected"
+		}
+		c.JSON(status, ErrorResponse{Error: message})
+		return
+	}
+
+	var page bytes.Buffer
+	if err := passwordFormTemplate.Execute(&page, map[string]string{
+		"Action": shortLinkPath(c, shortCode, nil),
+		"Error":  message,
+	}); err != nil {
+		h.log.WithError(err).Error("Failed to render password form")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Internal server error"})
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.Data(status, "text/html; charset=utf-8", page.Bytes())
+}
+
+// VerifyLinkPassword handles POST /:shortCode for password-protected links
+//
+//	@Summary		Unlock a password-protected short URL
+//	@Description	Check the password of a protected short URL, set a short-lived signed access cookie and redirect back to the short URL
+//	@Tags			Redirect
+//	@Accept			x-www-form-urlencoded,json
+//	@Produce		html,json
+//	@Param			shortCode	path		string			true	"Short code identifier"	example(abc123)
+//	@Param			password	formData	string			true	"Link password"
+//	@Success		303			"Redirect back to the short URL with the access cookie set"
+//	@Failure		400			{object}	ErrorResponse	"Missing password"
+//	@Failure		401			{object}	ErrorResponse	"Incorrect password"
+//	@Failure		429			{object}	ErrorResponse	"Too many failed attempts"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Router			/{shortCode} [post]
+func (h *URLHandler) VerifyLinkPassword(c *gin.Context) {
+	shortCode := c.Param("shortCode")
+
+	var req PasswordRequest
+	if err := c.ShouldBind(&req); err != nil {
+		h.renderPasswordForm(c, http.StatusBadRequest, shortCode, "Please enter the password")
+		return
+	}
+
+	ipAddress := c.ClientIP()
+
+	h.log.WithFields(logrus.Fields{
+		"short_code": shortCode,
+		"ip_address": ipAddress,
+	}).Info("Processing link password request")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	rsp, err := h.redirectClient.VerifyPassword(ctx, &redirectpb.VerifyPasswordRequest{
+		ShortCode: shortCode,
+		Password:  req.Password,
+		ClientIp:  ipAddress,
+		Host:      c.Request.Host,
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to verify password via redirect service")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to verify password"})
+		return
+	}
+
+	if !rsp.Success {
+		if rsp.RetryAfter > 0 {
+			c.Header("Retry-After", strconv.FormatInt(rsp.RetryAfter, 10))
+			h.renderPasswordForm(c, http.StatusTooManyRequests, shortCode, rsp.Error)
+			return
+		}
+		if strings.Contains(rsp.Error, "not found") {
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: "Short URL not found"})
+			return
+		}
+		h.renderPasswordForm(c, http.StatusUnauthorized, shortCode, rsp.Error)
+		return
+	}
+
+	// Signed, short-lived cookie named after the stored code. Cookie paths match
+	// case-sensitively, so it is sent on every path to cover all spellings of the code.
+	storedCode := shortCode
+	if rsp.ShortCode != "" {
+		storedCode = rsp.ShortCode
+	}
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     accessCookieName(storedCode),
+		Value:    rsp.AccessToken,
+		Path:     "/",
+		Expires:  time.Unix(rsp.ExpiresAt, 0),
+		MaxAge:   int(time.Until(time.Unix(rsp.ExpiresAt, 0)).Seconds()),
+		Secure:   c.Request.TLS != nil,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	c.Redirect(http.StatusSeeOther, shortLinkPath(c, shortCode, nil))
+}
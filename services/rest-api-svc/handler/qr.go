@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/go-systems-lab/go-url-shortener/proto/url"
+)
+
+// qrCacheMaxAge lets browsers and proxies reuse a rendered QR code; the
+// symbol only depends on the short code and the options
+const qrCacheMaxAge = 24 * time.Hour
+
+// GetQRCode handles GET /api/v1/urls/:shortCode/qr
+//
+//	@Summary		Get QR code
+//	@Description	Render a QR code for a short URL as PNG or SVG. The code encodes the canonical short URL with ?src=qr appended (unless src=false), so scans show up separately in click analytics. A logo URL places an image in the centre and forces error correction level H
+//	@Tags			URL Management
+//	@Produce		png
+//	@Produce		image/svg+xml
+//	@Param			shortCode	path		string			true	"Short code identifier"								example(abc123)
+//	@Param			user_id		query		string			true	"User ID"											example(user123)
+//	@Param			domain		query		string			false	"Branded domain of the link (default domain when empty)"	example(go.acme.com)
+//	@Param			format		query		string			false	"Output format"										Enums(png, svg)	default(png)
+//	@Param			size		query		int				false	"Width and height in pixels (64-2048)"				default(256)
+//	@Param			margin		query		int				false	"Quiet zone in modules (0-16)"						default(4)
+//	@Param			level		query		string			false	"Error correction level"							Enums(L, M, Q, H)	default(M)
+//	@Param			fg			query		string			false	"Foreground colour as hex"							example(#000000)
+//	@Param			bg			query		string			false	"Background colour as hex, #rrggbbaa for alpha"	example(#ffffff)
+//	@Param			logo_url	query		string			false	"PNG, JPEG or GIF logo (max 1MB) drawn in the centre"	example(https://example.com/logo.png)
+//	@Param			src			query		bool			false	"Append ?src=qr to the encoded URL"					default(true)
+//	@Success		200			{file}		binary			"QR code image"
+//	@Success		304			{string}	string			"Not modified"
+//	@Failure		400			{object}	ErrorResponse	"Invalid QR code options"
+//	@Failure		404			{object}	ErrorResponse	"URL not found"
+//	@Router			/urls/{shortCode}/qr [get]
+func (h *URLHandler) GetQRCode(c *gin.Context) {
+	shortCode := c.Param("shortCode")
+	userID := c.Query("user_id")
+
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "user_id is required"})
+		return
+	}
+
+	rpcReq := &pb.GetQRCodeRequest{
+		Domain:       c.Query("domain"),
+		ShortCode:    shortCode,
+		UserId:       userID,
+		Format:       c.Query("format"),
+		Level:        c.Query("level"),
+		Foreground:   c.Query("fg"),
+		Background:   c.Query("bg"),
+		LogoUrl:      c.Query("logo_url"),
+		SourceMarker: true,
+	}
+	if size := c.Query("size"); size != "" {
+		value, err := strconv.Atoi(size)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "size must be a number of pixels"})
+			return
+		}
+		rpcReq.Size = int32(value)
+	}
+	if margin := c.Query("margin"); margin != "" {
+		value, err := strconv.Atoi(margin)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "margin must be a number of modules"})
+			return
+		}
+		marginModules := int32(value)
+		rpcReq.Margin = &marginModules
+	}
+	if src := c.Query("src"); src != "" {
+		value, err := strconv.ParseBool(src)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "src must be true or false"})
+			return
+		}
+		rpcReq.SourceMarker = value
+	}
+
+	h.log.WithFields(logrus.Fields{
+		"short_code": shortCode,
+		"user_id":    userID,
+		"format":     rpcReq.Format,
+	}).Info("Processing GetQRCode REST request")
+
+	// Call RPC service; allow time for a logo download on a cache miss
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.GetQRCode(ctx, rpcReq)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		if strings.Contains(err.Error(), "invalid QR code options") {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: qrErrorMessage(err.Error())})
+			return
+		}
+		c.JSON(http.StatusNotFound, ErrorResponse{Error: "URL not found"})
+		return
+	}
+
+	etag := `"` + rsp.Etag + `"`
+	c.Header("ETag", etag)
+	c.Header("Cache-Control", "private, max-age="+strconv.Itoa(int(qrCacheMaxAge.Seconds())))
+	c.Header("X-QR-Content", rsp.Content)
+	if c.GetHeader("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.Data(http.StatusOK, rsp.ContentType, rsp.Data)
+}
+
+// shortLinkPath returns the path of a short link with query, keeping the click
+// source marker (e.g. src=qr) of the current request so pages in front of the
+// redirect do not lose it
+func shortLinkPath(c *gin.Context, shortCode string, query url.Values) string {
+	if src := c.Query("src"); src != "" {
+		if query == nil {
+			query = url.Values{}
+		}
+		query.Set("src", src)
+	}
+	if len(query) == 0 {
+		return "/" + shortCode
+	}
+	return "/" + shortCode + "?" + query.Encode()
+}
+
+// qrErrorMessage extracts the validation message from an RPC error
+func qrErrorMessage(rpcError string) string {
+	const marker = "invalid QR code options"
+	message := rpcError[strings.Index(rpcError, marker):]
+	if end := strings.IndexAny(message, `"}`); end > 0 {
+		message = message[:end]
+	}
+	return message
+}
@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/go-systems-lab/go-url-shortener/proto/url"
+)
+
+// FieldChangeResponse is a field a revision changed
+type FieldChangeResponse struct {
+	Field    string `json:"field" example:"long_url"`
+	OldValue string `json:"old_value" example:"https://example.com/spring"` // empty when unset
+	NewValue string `json:"new_value" example:"https://example.com/summer"` // passwords show as "set" or "none"
+}
+
+// URLRevisionResponse is a recorded change of a link
+type URLRevisionResponse struct {
+	ID         int64                 `json:"id" example:"42"`
+	Revision   int32                 `json:"revision" example:"3"`
+	ChangedBy  string                `json:"changed_by" example:"user123"`
+	CreatedAt  int64                 `json:"created_at" example:"1704067200"`
+	Changes    []FieldChangeResponse `json:"changes"`
+	RollbackOf int64                 `json:"rollback_of,omitempty" example:"41"` // the revision rolled back
+}
+
+// URLRevisionsResponse is a page of a link's revisions, newest first
+type URLRevisionsResponse struct {
+	Revisions  []URLRevisionResponse `json:"revisions"`
+	TotalCount int32                 `json:"total_count" example:"3"`
+	PageSize   int32                 `json:"page_size" example:"20"`
+	HasNext    bool                  `json:"has_next" example:"false"`
+	NextCursor string                `json:"next_cursor,omitempty"`
+	PrevCursor string                `json:"prev_cursor,omitempty"`
+}
+
+// ListURLRevisions handles GET /api/v1/urls/:shortCode/revisions
+//
+//	@Summary		List a link's revisions
+//	@Description	List every change made to a link (destination, expiry, metadata, rules, ...), newest first: who made it, when and which fields changed. Follow next_cursor and prev_cursor to page
+//	@Tags			URL Management
+//	@Accept			json
+//	@Produce		json
+//	@Param			shortCode	path		string					true	"Short code identifier"									example(abc123)
+//	@Param			user_id		query		string					true	"User ID"												example(user123)
+//	@Param			domain		query		string					false	"Branded domain of the link (default domain when empty)"	example(go.acme.com)
+//	@Param			cursor		query		string					false	"next_cursor or prev_cursor of a previous page"
+//	@Param			page_size	query		int						false	"Page size"												example(20)
+//	@Success		200			{object}	URLRevisionsResponse	"Revisions"
+//	@Failure		400			{object}	ErrorResponse			"Missing user_id parameter or invalid cursor"
+//	@Failure		403			{object}	ErrorResponse			"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse			"URL not found"
+//	@Failure		500			{object}	ErrorResponse			"Failed to list URL revisions"
+//	@Router			/urls/{shortCode}/revisions [get]
+func (h *URLHandler) ListURLRevisions(c *gin.Context) {
+	shortCode := c.Param("shortCode")
+	userID := c.Query("user_id")
+
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "user_id is required"})
+		return
+	}
+
+	pageSize, _ := strconv.ParseInt(c.DefaultQuery("page_size", "20"), 10, 32)
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.ListURLRevisions(ctx, &pb.ListURLRevisionsRequest{
+		ShortCode: shortCode,
+		UserId:    userID,
+		Domain:    c.Query("domain"),
+		PageSize:  int32(pageSize),
+		Cursor:    c.Query("cursor"),
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		switch {
+		case strings.Contains(err.Error(), "invalid cursor"):
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid cursor"})
+		case strings.Contains(err.Error(), "URL not found"):
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: "URL not found"})
+		case strings.Contains(err.Error(), "unauthorized"):
+			c.JSON(http.StatusForbidden, ErrorResponse{Error: "Unauthorized"})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to list URL revisions"})
+		}
+		return
+	}
+
+	revisions := make([]URLRevisionResponse, len(rsp.Revisions))
+	for i, revision := range rsp.Revisions {
+		changes := make([]FieldChangeResponse, len(revision.Changes))
+		for j, change := range revision.Changes {
+			changes[j] = FieldChangeResponse{Field: change.Field, OldValue: change.OldValue, NewValue: change.NewValue}
+		}
+		revisions[i] = URLRevisionResponse{
+			ID:         revision.Id,
+			Revision:   revision.Revision,
+			ChangedBy:  revision.ChangedBy,
+			CreatedAt:  revision.CreatedAt,
+			Changes:    changes,
+			RollbackOf: revision.RollbackOf,
+		}
+	}
+
+	c.JSON(http.StatusOK, URLRevisionsResponse{
+		Revisions:  revisions,
+		TotalCount: rsp.TotalCount,
+		PageSize:   rsp.PageSize,
+		HasNext:    rsp.HasNext,
+		NextCursor: rsp.NextCursor,
+		PrevCursor: rsp.PrevCursor,
+	})
+}
+
+// RollbackURL handles POST /api/v1/urls/:shortCode/revisions/:revisionID/rollback
+//
+//	@Summary		Roll a link back
+//	@Description	Restore a link to its state before a revision. The rollback is recorded as a new revision, the destination is checked again like a new link's and the redirect cache is refreshed
+//	@Tags			URL Management
+//	@Accept			json
+//	@Produce		json
+//	@Param			shortCode	path		string			true	"Short code identifier"									example(abc123)
+//	@Param			revisionID	path		int				true	"Revision ID"											example(42)
+//	@Param			user_id		query		string			true	"User ID"												example(user123)
+//	@Param			domain		query		string			false	"Branded domain of the link (default domain when empty)"	example(go.acme.com)
+//	@Success		200			{object}	URLInfoResponse	"Rolled back link"
+//	@Failure		400			{object}	ErrorResponse	"Missing user_id parameter or invalid revision ID"
+//	@Failure		403			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse	"URL or revision not found"
+//	@Failure		422			{object}	ErrorResponse	"Destination URL flagged as unsafe"
+//	@Failure		500			{object}	ErrorResponse	"Failed to roll back URL"
+//	@Router			/urls/{shortCode}/revisions/{revisionID}/rollback [post]
+func (h *URLHandler) RollbackURL(c *gin.Context) {
+	shortCode := c.Param("shortCode")
+	userID := c.Query("user_id")
+
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "user_id is required"})
+		return
+	}
+
+	revisionID, err := strconv.ParseInt(c.Param("revisionID"), 10, 64)
+	if err != nil || revisionID <= 0 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid revision ID"})
+		return
+	}
+
+	h.log.WithFields(logrus.Fields{
+		"short_code":  shortCode,
+		"user_id":     userID,
+		"revision_id": revisionID,
+	}).Info("Processing RollbackURL REST request")
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.RollbackURL(ctx, &pb.RollbackURLRequest{
+		ShortCode:  shortCode,
+		UserId:     userID,
+		Domain:     c.Query("domain"),
+		RevisionId: revisionID,
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		switch {
+		case strings.Contains(err.Error(), "URL not found"):
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: "URL not found"})
+		case strings.Contains(err.Error(), "revision not found"):
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: "Revision not found"})
+		case strings.Contains(err.Error(), "unauthorized"):
+			c.JSON(http.StatusForbidden, ErrorResponse{Error: "Unauthorized"})
+		case strings.Contains(err.Error(), "flagged as unsafe"):
+			c.JSON(http.StatusUnprocessableEntity, ErrorResponse{Error: "Destination URL was flagged as unsafe"})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to roll back URL"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, toURLInfoResponse(rsp))
+}
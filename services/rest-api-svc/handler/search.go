@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/go-systems-lab/go-url-shortener/proto/url"
+)
+
+// SearchHitResponse represents a link matching a search
+type SearchHitResponse struct {
+	URLInfoResponse
+	Rank float64 `json:"rank" example:"0.61"`
+}
+
+// FacetCountResponse represents the number of matching links with a facet value
+type FacetCountResponse struct {
+	Value string `json:"value" example:"pricing"`
+	Count int64  `json:"count" example:"4"`
+}
+
+// SearchFacetsResponse counts all links matching a search by tag, short domain and status
+type SearchFacetsResponse struct {
+	Tags     []FacetCountResponse `json:"tags"`
+	Domains  []FacetCountResponse `json:"domains"`
+	Statuses []FacetCountResponse `json:"statuses"`
+}
+
+// SearchURLsResponse represents ranked search results with facet counts
+type SearchURLsResponse struct {
+	Results    []SearchHitResponse  `json:"results"`
+	TotalCount int64                `json:"total_count" example:"9"`
+	Page       int32                `json:"page" example:"1"`
+	PageSize   int32                `json:"page_size" example:"20"`
+	HasNext    bool                 `json:"has_next" example:"false"`
+	NextCursor string               `json:"next_cursor,omitempty"`
+	PrevCursor string               `json:"prev_cursor,omitempty"`
+	Facets     SearchFacetsResponse `json:"facets"`
+}
+
+// toFacetCountResponses converts RPC facet counts to their REST representation
+func toFacetCountResponses(facets []*pb.FacetCount) []FacetCountResponse {
+	counts := make([]FacetCountResponse, len(facets))
+	for i, facet := range facets {
+		counts[i] = FacetCountResponse{Value: facet.Value, Count: facet.Count}
+	}
+	return counts
+}
+
+// SearchURLs handles GET /api/v1/urls/search
+//
+//	@Summary		Search links
+//	@Description	Search a user's links. Every word of q must match the short code, the destination host or path, the page title, a tag or a metadata value; words match as prefixes ("pric" finds "pricing") and codes, destinations and tags also as substrings. Results are ranked by relevance, with an exact short code first; follow next_cursor and prev_cursor to page. Facets count all matches by tag, short domain and status (active, expired, disabled or archived). Deleted links are not searched
+//	@Tags			URL Management
+//	@Accept			json
+//	@Produce		json
+//	@Param			user_id		query		string				true	"User ID"											example(user123)
+//	@Param			q			query		string				false	"Search words (all links when empty)"				example(q3 pricing)
+//	@Param			tag			query		string				false	"Only links with this tag"							example(campaign)
+//	@Param			domain		query		string				false	"Only links on this short domain"					example(go.acme.com)
+//	@Param			status		query		string				false	"Only active, expired, disabled or archived links"			example(active)
+//	@Param			cursor		query		string				false	"next_cursor or prev_cursor of a previous page"
+//	@Param			page		query		int					false	"Page number (deprecated, use cursor)"				example(1)
+//	@Param			page_size	query		int					false	"Page size (max 100)"								example(20)
+//	@Success		200			{object}	SearchURLsResponse	"Search results"
+//	@Failure		400			{object}	ErrorResponse		"Missing user_id or invalid search"
+//	@Failure		500			{object}	ErrorResponse		"Failed to search URLs"
+//	@Router			/urls/search [get]
+func (h *URLHandler) SearchURLs(c *gin.Context) {
+	userID := c.Query("user_id")
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "user_id is required"})
+		return
+	}
+
+	page, _ := strconv.ParseInt(c.DefaultQuery("page", "1"), 10, 32)
+	pageSize, _ := strconv.ParseInt(c.DefaultQuery("page_size", "20"), 10, 32)
+
+	h.log.WithFields(logrus.Fields{
+		"user_id": userID,
+		"query":   c.Query("q"),
+		"page":    page,
+	}).Info("Processing SearchURLs REST request")
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.SearchURLs(ctx, &pb.SearchURLsRequest{
+		UserId:   userID,
+		Query:    c.Query("q"),
+		Tag:      c.Query("tag"),
+		Domain:   c.Query("domain"),
+		Status:   c.Query("status"),
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+		Cursor:   c.Query("cursor"),
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		switch {
+		case strings.Contains(err.Error(), "invalid search: "):
+			_, detail, _ := strings.Cut(err.Error(), "invalid search: ")
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid search: " + detail})
+		case strings.Contains(err.Error(), "invalid tag"):
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: invalidTagMessage})
+		case strings.Contains(err.Error(), "invalid cursor"):
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid cursor"})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to search URLs"})
+		}
+		return
+	}
+
+	response := SearchURLsResponse{
+		Results:    make([]SearchHitResponse, len(rsp.Hits)),
+		TotalCount: rsp.TotalCount,
+		Page:       rsp.Page,
+		PageSize:   rsp.PageSize,
+		HasNext:    rsp.HasNext,
+		NextCursor: rsp.NextCursor,
+		PrevCursor: rsp.PrevCursor,
+		Facets: SearchFacetsResponse{
+			Tags:     toFacetCountResponses(rsp.TagFacets),
+			Domains:  toFacetCountResponses(rsp.DomainFacets),
+			Statuses: toFacetCountResponses(rsp.StatusFacets),
+		},
+	}
+	for i, hit := range rsp.Hits {
+		response.Results[i] = SearchHitResponse{URLInfoResponse: toURLInfoResponse(hit.Url), Rank: hit.Rank}
+	}
+
+	flagDeprecatedPaging(c)
+	c.JSON(http.StatusOK, response)
+}
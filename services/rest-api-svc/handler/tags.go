@@ -0,0 +1,227 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/go-systems-lab/go-url-shortener/proto/url"
+)
+
+// TagRequest represents the REST API request for creating a tag
+type TagRequest struct {
+	Name string `json:"name" binding:"required" example:"spring campaign"`
+}
+
+// RenameTagRequest represents the REST API request for renaming a tag
+type RenameTagRequest struct {
+	Name string `json:"name" binding:"required" example:"spring 2024"`
+}
+
+// TagResponse represents a tag of a user
+type TagResponse struct {
+	ID        int64  `json:"id" example:"7"`
+	UserID    string `json:"user_id" example:"user123"`
+	Name      string `json:"name" example:"spring campaign"`
+	CreatedAt int64  `json:"created_at" example:"1672531200"`
+	LinkCount int64  `json:"link_count" example:"12"`
+}
+
+// TagsResponse represents the tags of a user
+type TagsResponse struct {
+	Tags []TagResponse `json:"tags"`
+}
+
+// toTagResponse converts an RPC tag message to its REST representation
+func toTagResponse(tag *pb.Tag) TagResponse {
+	return TagResponse{
+		ID:        tag.Id,
+		UserID:    tag.UserId,
+		Name:      tag.Name,
+		CreatedAt: tag.CreatedAt,
+		LinkCount: tag.LinkCount,
+	}
+}
+
+// invalidTagMessage is returned when a tag name breaks the tag rules
+const invalidTagMessage = "Tags must be 1 to 50 characters of letters, digits, spaces, -, _ and :"
+
+// CreateTag handles POST /api/v1/users/:userID/tags
+//
+//	@Summary		Create a tag
+//	@Description	Create a tag for organizing links. Tag names are case-insensitive and stored in lower case. Tags are also created when a link is tagged with a new name
+//	@Tags			Tags and Folders
+//	@Accept			json
+//	@Produce		json
+//	@Param			userID	path		string			true	"User ID"	example(user123)
+//	@Param			request	body		TagRequest		true	"Tag request"
+//	@Success		201		{object}	TagResponse		"Tag created"
+//	@Failure		400		{object}	ErrorResponse	"Invalid tag name"
+//	@Failure		409		{object}	ErrorResponse	"Tag already exists"
+//	@Failure		500		{object}	ErrorResponse	"Failed to create tag"
+//	@Router			/users/{userID}/tags [post]
+func (h *URLHandler) CreateTag(c *gin.Context) {
+	userID := c.Param("userID")
+
+	var req TagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request body"})
+		return
+	}
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.CreateTag(ctx, &pb.TagRequest{
+		UserId: userID,
+		Name:   req.Name,
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		switch {
+		case strings.Contains(err.Error(), "invalid tag"):
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: invalidTagMessage})
+		case strings.Contains(err.Error(), "tag already exists"):
+			c.JSON(http.StatusConflict, ErrorResponse{Error: "Tag already exists"})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to create tag"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, toTagResponse(rsp))
+}
+
+// ListTags handles GET /api/v1/users/:userID/tags
+//
+//	@Summary		List tags
+//	@Description	List a user's tags with the number of active links carrying each
+//	@Tags			Tags and Folders
+//	@Accept			json
+//	@Produce		json
+//	@Param			userID	path		string			true	"User ID"	example(user123)
+//	@Success		200		{object}	TagsResponse	"Tags retrieved successfully"
+//	@Failure		500		{object}	ErrorResponse	"Failed to list tags"
+//	@Router			/users/{userID}/tags [get]
+func (h *URLHandler) ListTags(c *gin.Context) {
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.ListTags(ctx, &pb.ListTagsRequest{UserId: c.Param("userID")})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to list tags"})
+		return
+	}
+
+	response := TagsResponse{Tags: []TagResponse{}}
+	for _, tag := range rsp.Tags {
+		response.Tags = append(response.Tags, toTagResponse(tag))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// RenameTag handles PUT /api/v1/users/:userID/tags/:tag
+//
+//	@Summary		Rename a tag
+//	@Description	Rename a tag; links carrying it keep it under the new name
+//	@Tags			Tags and Folders
+//	@Accept			json
+//	@Produce		json
+//	@Param			userID	path		string				true	"User ID"	example(user123)
+//	@Param			tag		path		string				true	"Tag name"	example(spring campaign)
+//	@Param			request	body		RenameTagRequest	true	"Rename request"
+//	@Success		200		{object}	TagResponse			"Tag renamed"
+//	@Failure		400		{object}	ErrorResponse		"Invalid tag name"
+//	@Failure		404		{object}	ErrorResponse		"Tag not found"
+//	@Failure		409		{object}	ErrorResponse		"A tag with the new name already exists"
+//	@Failure		500		{object}	ErrorResponse		"Failed to rename tag"
+//	@Router			/users/{userID}/tags/{tag} [put]
+func (h *URLHandler) RenameTag(c *gin.Context) {
+	userID := c.Param("userID")
+
+	var req RenameTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request body"})
+		return
+	}
+
+	h.log.WithFields(logrus.Fields{
+		"user_id":  userID,
+		"tag":      c.Param("tag"),
+		"new_name": req.Name,
+	}).Info("Processing RenameTag REST request")
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.RenameTag(ctx, &pb.TagRequest{
+		UserId:  userID,
+		Name:    c.Param("tag"),
+		NewName: req.Name,
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		switch {
+		case strings.Contains(err.Error(), "invalid tag"):
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: invalidTagMessage})
+		case strings.Contains(err.Error(), "tag not found"):
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: "Tag not found"})
+		case strings.Contains(err.Error(), "tag already exists"):
+			c.JSON(http.StatusConflict, ErrorResponse{Error: "Tag already exists"})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to rename tag"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, toTagResponse(rsp))
+}
+
+// DeleteTag handles DELETE /api/v1/users/:userID/tags/:tag
+//
+//	@Summary		Delete a tag
+//	@Description	Delete a tag and remove it from all links; the links themselves are kept
+//	@Tags			Tags and Folders
+//	@Accept			json
+//	@Produce		json
+//	@Param			userID	path		string			true	"User ID"	example(user123)
+//	@Param			tag		path		string			true	"Tag name"	example(spring campaign)
+//	@Success		200		{object}	DeleteResponse	"Tag deleted"
+//	@Failure		404		{object}	ErrorResponse	"Tag not found"
+//	@Failure		500		{object}	ErrorResponse	"Failed to delete tag"
+//	@Router			/users/{userID}/tags/{tag} [delete]
+func (h *URLHandler) DeleteTag(c *gin.Context) {
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.DeleteTag(ctx, &pb.TagRequest{
+		UserId: c.Param("userID"),
+		Name:   c.Param("tag"),
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to delete tag"})
+		return
+	}
+
+	if !rsp.Success {
+		if strings.Contains(rsp.Message, "tag not found") || strings.Contains(rsp.Message, "invalid tag") {
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: "Tag not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to delete tag"})
+		return
+	}
+
+	c.JSON(http.StatusOK, DeleteResponse{Message: rsp.Message})
+}
@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/go-systems-lab/go-url-shortener/proto/url"
+)
+
+// ListDeletedURLs handles GET /api/v1/users/:userID/trash
+//
+//	@Summary		List deleted links
+//	@Description	List the links a user deleted, most recently deleted first. Deleted links stop resolving but can be restored until purge_at, when the retention worker removes them for good (no purge_at when deleted links are kept). Follow next_cursor and prev_cursor to page
+//	@Tags			User Management
+//	@Accept			json
+//	@Produce		json
+//	@Param			userID		path		string				true	"User ID"											example(user123)
+//	@Param			cursor		query		string				false	"next_cursor or prev_cursor of a previous page"
+//	@Param			page		query		int					false	"Page number (deprecated, use cursor)"				example(1)
+//	@Param			page_size	query		int					false	"Page size"											example(20)
+//	@Success		200			{object}	UserURLsResponse	"Deleted links"
+//	@Failure		400			{object}	ErrorResponse		"Invalid cursor"
+//	@Failure		500			{object}	ErrorResponse		"Failed to list deleted URLs"
+//	@Router			/users/{userID}/trash [get]
+func (h *URLHandler) ListDeletedURLs(c *gin.Context) {
+	userID := c.Param("userID")
+
+	page, _ := strconv.ParseInt(c.DefaultQuery("page", "1"), 10, 32)
+	pageSize, _ := strconv.ParseInt(c.DefaultQuery("page_size", "20"), 10, 32)
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.ListDeletedURLs(ctx, &pb.ListDeletedURLsRequest{
+		UserId:   userID,
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+		Cursor:   c.Query("cursor"),
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		if strings.Contains(err.Error(), "invalid cursor") {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid cursor"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to list deleted URLs"})
+		return
+	}
+
+	urls := make([]URLInfoResponse, len(rsp.Urls))
+	for i, url := range rsp.Urls {
+		urls[i] = toURLInfoResponse(url)
+	}
+
+	flagDeprecatedPaging(c)
+	c.JSON(http.StatusOK, UserURLsResponse{
+		URLs:       urls,
+		TotalCount: rsp.TotalCount,
+		Page:       rsp.Page,
+		PageSize:   rsp.PageSize,
+		HasNext:    rsp.HasNext,
+		NextCursor: rsp.NextCursor,
+		PrevCursor: rsp.PrevCursor,
+	})
+}
+
+// RestoreURL handles POST /api/v1/urls/:shortCode/restore
+//
+//	@Summary		Restore a deleted link
+//	@Description	Take a link out of the trash so it resolves again. The destination is checked again like a new link's, and a link on a branded domain needs the domain to still belong to its workspace
+//	@Tags			URL Management
+//	@Accept			json
+//	@Produce		json
+//	@Param			shortCode	path		string			true	"Short code identifier"	example(abc123)
+//	@Param			user_id		query		string			true	"User ID"				example(user123)
+//	@Param			domain		query		string			false	"Branded domain of the link (default domain when empty)"	example(go.acme.com)
+//	@Success		200			{object}	URLInfoResponse	"Restored link"
+//	@Failure		400			{object}	ErrorResponse	"Missing user_id parameter"
+//	@Failure		403			{object}	ErrorResponse	"Link belongs to another user"
+//	@Failure		404			{object}	ErrorResponse	"Deleted link or its branded domain not found"
+//	@Failure		422			{object}	ErrorResponse	"Destination URL was flagged as unsafe"
+//	@Failure		500			{object}	ErrorResponse	"Failed to restore URL"
+//	@Router			/urls/{shortCode}/restore [post]
+func (h *URLHandler) RestoreURL(c *gin.Context) {
+	shortCode := c.Param("shortCode")
+	userID := c.Query("user_id")
+
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "user_id is required"})
+		return
+	}
+
+	h.log.WithFields(logrus.Fields{
+		"short_code": shortCode,
+		"user_id":    userID,
+	}).Info("Processing RestoreURL REST request")
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.RestoreURL(ctx, &pb.RestoreURLRequest{
+		ShortCode: shortCode,
+		UserId:    userID,
+		Domain:    c.Query("domain"),
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		switch {
+		case strings.Contains(err.Error(), "URL not found"):
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: "Deleted URL not found"})
+		case strings.Contains(err.Error(), "unauthorized"):
+			c.JSON(http.StatusForbidden, ErrorResponse{Error: "URL belongs to another user"})
+		case strings.Contains(err.Error(), "branded domain not found"):
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: "The link's branded domain is no longer attached to its workspace"})
+		case strings.Contains(err.Error(), "flagged as unsafe"):
+			c.JSON(http.StatusUnprocessableEntity, ErrorResponse{Error: "Destination URL was flagged as unsafe"})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to restore URL"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, toURLInfoResponse(rsp))
+}
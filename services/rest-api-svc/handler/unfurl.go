@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"bytes"
+	"html/template"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	redirectpb "github.com/go-systems-lab/go-url-shortener/proto/redirect"
+	pb "github.com/go-systems-lab/go-url-shortener/proto/url"
+)
+
+// LinkPreview represents the Open Graph / Twitter card metadata shown when a link is unfurled
+type LinkPreview struct {
+	Title       string `json:"title,omitempty" example:"Spring sale - up to 50% off"`
+	Description string `json:"description,omitempty" example:"Our biggest sale of the season"`
+	Image       string `json:"image,omitempty" example:"https://www.example.com/images/sale.png"`
+}
+
+// toLinkPreview converts an RPC link preview to its REST representation
+func toLinkPreview(linkPreview *pb.LinkPreview) *LinkPreview {
+	if linkPreview == nil {
+		return nil
+	}
+	return &LinkPreview{
+		Title:       linkPreview.Title,
+		Description: linkPreview.Description,
+		Image:       linkPreview.Image,
+	}
+}
+
+// toProto converts a REST link preview to its RPC representation
+func (p *LinkPreview) toProto() *pb.LinkPreview {
+	return &pb.LinkPreview{
+		Title:       p.Title,
+		Description: p.Description,
+		Image:       p.Image,
+	}
+}
+
+// unfurlTemplate is the lightweight page served to link preview bots; it carries
+// the preview metadata and never redirects, so bots do not count as clicks
+var unfurlTemplate = template.Must(template.New("unfurl").Parse(`<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="utf-8">
+    <title>{{.Title}}</title>
+    <meta name="robots" content="noindex">
+    <link rel="canonical" href="{{.ShortURL}}">
+    <meta property="og:type" content="website">
+    <meta property="og:url" content="{{.ShortURL}}">
+    {{- if .Title}}
+    <meta property="og:title" content="{{.Title}}">
+    <meta name="twitter:title" content="{{.Title}}">
+    {{- end}}
+    {{- if .Description}}
+    <meta name="description" content="{{.Description}}">
+    <meta property="og:description" content="{{.Description}}">
+    <meta name="twitter:description" content="{{.Description}}">
+    {{- end}}
+    {{- if .Image}}
+    <meta property="og:image" content="{{.Image}}">
+    <meta name="twitter:image" content="{{.Image}}">
+    <meta name="twitter:card" content="summary_large_image">
+    {{- else}}
+    <meta name="twitter:card" content="summary">
+    {{- end}}
+</head>
+<body>
+    <h1>{{.Title}}</h1>
+    <p>{{.Description}}</p>
+    <a href="{{.Destination}}" rel="nofollow">{{.Destination}}</a>
+</body>
+</html>`))
+
+// renderUnfurl serves the link preview page to an unfurl bot
+func (h *URLHandler) renderUnfurl(c *gin.Context, shortCode string, rsp *redirectpb.ResolveResponse) {
+	var page bytes.Buffer
+	if err := unfurlTemplate.Execute(&page, map[string]string{
+		"ShortURL":    requestShortURL(c, shortCode),
+		"Destination": rsp.LongUrl,
+		"Title":       rsp.PreviewTitle,
+		"Description": rsp.PreviewDescription,
+		"Image":       rsp.PreviewImage,
+	}); err != nil {
+		h.log.WithError(err).Error("Failed to render link preview page")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Internal server error"})
+		return
+	}
+
+	// Previews change when the owner edits them; let bots re-fetch within the hour
+	c.Header("Cache-Control", "public, max-age=3600")
+	c.Data(http.StatusOK, "text/html; charset=utf-8", page.Bytes())
+}
+
+// requestShortURL rebuilds the public short URL from the incoming request,
+// honouring X-Forwarded-Proto behind a TLS-terminating proxy
+func requestShortURL(c *gin.Context, shortCode string) string {
+	scheme := "http"
+	if c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + c.Request.Host + "/" + shortCode
+}
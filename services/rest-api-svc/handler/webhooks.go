@@ -0,0 +1,488 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/go-systems-lab/go-url-shortener/proto/url"
+)
+
+// CreateWebhookRequest represents the REST API request for subscribing an endpoint to link events
+type CreateWebhookRequest struct {
+	UserID string   `json:"user_id" binding:"required" example:"user123"`
+	URL    string   `json:"url" binding:"required" example:"https://hooks.acme.com/links"`
+	Events []string `json:"events,omitempty" example:"url.created,url.deleted"` // empty for all events
+}
+
+// UpdateWebhookRequest represents the REST API request for changing a webhook; omitted fields are kept
+type UpdateWebhookRequest struct {
+	UserID       string   `json:"user_id" binding:"required" example:"user123"`
+	URL          string   `json:"url,omitempty" example:"https://hooks.acme.com/links"`
+	Events       []string `json:"events,omitempty" example:"url.created,url.expired"`
+	ClearEvents  bool     `json:"clear_events,omitempty" example:"false"` // subscribe to all events
+	IsActive     *bool    `json:"is_active,omitempty" example:"true"`     // true re-enables a disabled webhook and resets its failures
+	RotateSecret bool     `json:"rotate_secret,omitempty" example:"false"`
+}
+
+// WebhookResponse represents a webhook of a workspace
+type WebhookResponse struct {
+	ID                  int64    `json:"id" example:"12"`
+	WorkspaceID         string   `json:"workspace_id" example:"marketing"`
+	URL                 string   `json:"url" example:"https://hooks.acme.com/links"`
+	Secret              string   `json:"secret,omitempty" example:"whsec_5f2b9c1e8a7d4e3f9b6a0c1d2e3f4a5b"` // only returned on creation and rotation
+	Events              []string `json:"events" example:"url.created,url.deleted"`
+	IsActive            bool     `json:"is_active" example:"true"`
+	ConsecutiveFailures int32    `json:"consecutive_failures" example:"0"`
+	DisabledAt          int64    `json:"disabled_at,omitempty" example:"0"` // set when repeated failures turned the webhook off
+	CreatedBy           string   `json:"created_by" example:"user123"`
+	CreatedAt           int64    `json:"created_at" example:"1704067200"`
+	UpdatedAt           int64    `json:"updated_at" example:"1704067200"`
+}
+
+// WebhooksResponse represents the webhooks of a workspace
+type WebhooksResponse struct {
+	Webhooks []WebhookResponse `json:"webhooks"`
+}
+
+// WebhookDeliveryResponse is an entry of a webhook's delivery log
+type WebhookDeliveryResponse struct {
+	ID             int64           `json:"id" example:"1042"`
+	WebhookID      int64           `json:"webhook_id" example:"12"`
+	EventID        string          `json:"event_id" example:"evt_5f2b9c1e8a7d4e3f9b6a0c1d2e3f4a5b"`
+	EventType      string          `json:"event_type" example:"url.created"`
+	Payload        json.RawMessage `json:"payload" swaggertype:"object"` // the body as sent
+	Status         string          `json:"status" example:"succeeded"`   // pending, succeeded or failed
+	Attempts       int32           `json:"attempts" example:"1"`
+	NextAttemptAt  int64           `json:"next_attempt_at,omitempty" example:"0"` // pending deliveries only
+	LastAttemptAt  int64           `json:"last_attempt_at,omitempty" example:"1704067201"`
+	ResponseStatus int32           `json:"response_status,omitempty" example:"200"`
+	ResponseBody   string          `json:"response_body,omitempty" example:"ok"` // truncated
+	Error          string          `json:"error,omitempty"`
+	CreatedAt      int64           `json:"created_at" example:"1704067200"`
+	DeliveredAt    int64           `json:"delivered_at,omitempty" example:"1704067201"`
+}
+
+// WebhookDeliveriesResponse is a page of a webhook's delivery log, newest first
+type WebhookDeliveriesResponse struct {
+	Deliveries []WebhookDeliveryResponse `json:"deliveries"`
+	TotalCount int32                     `json:"total_count" example:"42"`
+	PageSize   int32                     `json:"page_size" example:"20"`
+	HasNext    bool                      `json:"has_next" example:"true"`
+	NextCursor string                    `json:"next_cursor,omitempty"`
+	PrevCursor string                    `json:"prev_cursor,omitempty"`
+}
+
+// toWebhookResponse converts an RPC webhook to its REST representation
+func toWebhookResponse(webhook *pb.Webhook) WebhookResponse {
+	events := webhook.Events
+	if events == nil {
+		events = []string{}
+	}
+	return WebhookResponse{
+		ID:                  webhook.Id,
+		WorkspaceID:         webhook.WorkspaceId,
+		URL:                 webhook.Url,
+		Secret:              webhook.Secret,
+		Events:              events,
+		IsActive:            webhook.IsActive,
+		ConsecutiveFailures: webhook.ConsecutiveFailures,
+		DisabledAt:          webhook.DisabledAt,
+		CreatedBy:           webhook.CreatedBy,
+		CreatedAt:           webhook.CreatedAt,
+		UpdatedAt:           webhook.UpdatedAt,
+	}
+}
+
+// toWebhookDeliveryResponse converts an RPC webhook delivery to its REST representation
+func toWebhookDeliveryResponse(delivery *pb.WebhookDelivery) WebhookDeliveryResponse {
+	return WebhookDeliveryResponse{
+		ID:             delivery.Id,
+		WebhookID:      delivery.WebhookId,
+		EventID:        delivery.EventId,
+		EventType:      delivery.EventType,
+		Payload:        json.RawMessage(delivery.Payload),
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastAttemptAt:  delivery.LastAttemptAt,
+		ResponseStatus: delivery.ResponseStatus,
+		ResponseBody:   delivery.ResponseBody,
+		Error:          delivery.Error,
+		CreatedAt:      delivery.CreatedAt,
+		DeliveredAt:    delivery.DeliveredAt,
+	}
+}
+
+// webhookError maps a webhook RPC error to its HTTP response
+func webhookError(c *gin.Context, err error, fallback string) {
+	switch {
+	case strings.Contains(err.Error(), "invalid webhook"):
+		message := "Invalid webhook"
+		if _, detail, ok := strings.Cut(err.Error(), "invalid webhook: "); ok {
+			message = "Invalid webhook: " + detail
+		}
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: message})
+	case strings.Contains(err.Error(), "invalid cursor"):
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid cursor"})
+	case strings.Contains(err.Error(), "webhook delivery not found"):
+		c.JSON(http.StatusNotFound, ErrorResponse{Error: "Delivery not found"})
+	case strings.Contains(err.Error(), "webhook not found"):
+		c.JSON(http.StatusNotFound, ErrorResponse{Error: "Webhook not found"})
+	case strings.Contains(err.Error(), "webhook is disabled"):
+		c.JSON(http.StatusConflict, ErrorResponse{Error: "Webhook is disabled, enable it first"})
+	case strings.Contains(err.Error(), "workspace not found"), strings.Contains(err.Error(), "unauthorized"):
+		c.JSON(http.StatusNotFound, ErrorResponse{Error: "Workspace not found"})
+	default:
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: fallback})
+	}
+}
+
+// webhookIDParam reads the webhook ID path parameter, answering 404 when it is not a number
+func webhookIDParam(c *gin.Context) (int64, bool) {
+	webhookID, err := strconv.ParseInt(c.Param("webhookID"), 10, 64)
+	if err != nil || webhookID <= 0 {
+		c.JSON(http.StatusNotFound, ErrorResponse{Error: "Webhook not found"})
+		return 0, false
+	}
+	return webhookID, true
+}
+
+// CreateWebhook handles POST /api/v1/workspaces/:workspaceID/webhooks
+//
+//	@Summary		Create a webhook
+//	@Description	Subscribe an endpoint to the link events of a workspace the user owns: url.created, url.updated, url.deleted, url.expired, url.expiring (a link expires within the notice period), url.click_threshold (a link passed a click count) and url.traffic_spike (a link's hourly clicks far above its baseline); all of them when events is empty. Every event is POSTed as JSON with X-Webhook-Event, X-Webhook-Delivery, X-Webhook-Timestamp and X-Webhook-Signature headers; the signature is "sha256=" and the hex HMAC-SHA256 of the timestamp, a dot and the body, keyed with the returned secret, which is not shown again. Failed deliveries are retried with exponential backoff, and a webhook that keeps failing is disabled
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Param			workspaceID	path		string					true	"Workspace identifier"	example(marketing)
+//	@Param			request		body		CreateWebhookRequest	true	"Webhook request"
+//	@Success		201			{object}	WebhookResponse			"Webhook created"
+//	@Failure		400			{object}	ErrorResponse			"Invalid URL or event, or too many webhooks"
+//	@Failure		404			{object}	ErrorResponse			"Workspace not found"
+//	@Failure		500			{object}	ErrorResponse			"Failed to create webhook"
+//	@Router			/workspaces/{workspaceID}/webhooks [post]
+func (h *URLHandler) CreateWebhook(c *gin.Context) {
+	workspaceID := c.Param("workspaceID")
+
+	var req CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request body"})
+		return
+	}
+
+	h.log.WithFields(logrus.Fields{
+		"workspace_id": workspaceID,
+		"user_id":      req.UserID,
+		"events":       req.Events,
+	}).Info("Processing CreateWebhook REST request")
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.CreateWebhook(ctx, &pb.WebhookRequest{
+		WorkspaceId: workspaceID,
+		UserId:      req.UserID,
+		Url:         req.URL,
+		Events:      req.Events,
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		webhookError(c, err, "Failed to create webhook")
+		return
+	}
+
+	c.JSON(http.StatusCreated, toWebhookResponse(rsp))
+}
+
+// ListWebhooks handles GET /api/v1/workspaces/:workspaceID/webhooks
+//
+//	@Summary		List webhooks
+//	@Description	List the webhooks of a workspace, with their state and failure count. Secrets are not included
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Param			workspaceID	path		string				true	"Workspace identifier"	example(marketing)
+//	@Param			user_id		query		string				true	"User ID"				example(user123)
+//	@Success		200			{object}	WebhooksResponse	"Webhooks"
+//	@Failure		400			{object}	ErrorResponse		"Missing user_id parameter"
+//	@Failure		404			{object}	ErrorResponse		"Workspace not found"
+//	@Failure		500			{object}	ErrorResponse		"Failed to list webhooks"
+//	@Router			/workspaces/{workspaceID}/webhooks [get]
+func (h *URLHandler) ListWebhooks(c *gin.Context) {
+	workspaceID := c.Param("workspaceID")
+	userID := c.Query("user_id")
+
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "user_id is required"})
+		return
+	}
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.ListWebhooks(ctx, &pb.ListWebhooksRequest{
+		WorkspaceId: workspaceID,
+		UserId:      userID,
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		webhookError(c, err, "Failed to list webhooks")
+		return
+	}
+
+	response := WebhooksResponse{Webhooks: []WebhookResponse{}}
+	for _, webhook := range rsp.Webhooks {
+		response.Webhooks = append(response.Webhooks, toWebhookResponse(webhook))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateWebhook handles PUT /api/v1/workspaces/:workspaceID/webhooks/:webhookID
+//
+//	@Summary		Update a webhook
+//	@Description	Change a webhook's endpoint or event filter, turn it on or off, or rotate its secret (the new secret is returned once). Turning a webhook on resets its failure count; its pending deliveries resume
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Param			workspaceID	path		string					true	"Workspace identifier"	example(marketing)
+//	@Param			webhookID	path		int						true	"Webhook ID"			example(12)
+//	@Param			request		body		UpdateWebhookRequest	true	"Webhook update"
+//	@Success		200			{object}	WebhookResponse			"Webhook updated"
+//	@Failure		400			{object}	ErrorResponse			"Invalid URL or event"
+//	@Failure		404			{object}	ErrorResponse			"Workspace or webhook not found"
+//	@Failure		500			{object}	ErrorResponse			"Failed to update webhook"
+//	@Router			/workspaces/{workspaceID}/webhooks/{webhookID} [put]
+func (h *URLHandler) UpdateWebhook(c *gin.Context) {
+	workspaceID := c.Param("workspaceID")
+	webhookID, ok := webhookIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request body"})
+		return
+	}
+
+	h.log.WithFields(logrus.Fields{
+		"workspace_id": workspaceID,
+		"webhook_id":   webhookID,
+		"user_id":      req.UserID,
+	}).Info("Processing UpdateWebhook REST request")
+
+	rpcReq := &pb.WebhookRequest{
+		WorkspaceId:  workspaceID,
+		UserId:       req.UserID,
+		Id:           webhookID,
+		Url:          req.URL,
+		Events:       req.Events,
+		ClearEvents:  req.ClearEvents,
+		RotateSecret: req.RotateSecret,
+	}
+	if req.IsActive != nil {
+		rpcReq.Enable = *req.IsActive
+		rpcReq.Disable = !*req.IsActive
+	}
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.UpdateWebhook(ctx, rpcReq)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		webhookError(c, err, "Failed to update webhook")
+		return
+	}
+
+	c.JSON(http.StatusOK, toWebhookResponse(rsp))
+}
+
+// DeleteWebhook handles DELETE /api/v1/workspaces/:workspaceID/webhooks/:webhookID
+//
+//	@Summary		Delete a webhook
+//	@Description	Remove a webhook with its pending deliveries and delivery log
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Param			workspaceID	path		string			true	"Workspace identifier"	example(marketing)
+//	@Param			webhookID	path		int				true	"Webhook ID"			example(12)
+//	@Param			user_id		query		string			true	"User ID"				example(user123)
+//	@Success		200			{object}	DeleteResponse	"Webhook deleted"
+//	@Failure		400			{object}	ErrorResponse	"Missing user_id parameter"
+//	@Failure		404			{object}	ErrorResponse	"Workspace or webhook not found"
+//	@Failure		500			{object}	ErrorResponse	"Failed to delete webhook"
+//	@Router			/workspaces/{workspaceID}/webhooks/{webhookID} [delete]
+func (h *URLHandler) DeleteWebhook(c *gin.Context) {
+	workspaceID := c.Param("workspaceID")
+	userID := c.Query("user_id")
+
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "user_id is required"})
+		return
+	}
+	webhookID, ok := webhookIDParam(c)
+	if !ok {
+		return
+	}
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.DeleteWebhook(ctx, &pb.WebhookRequest{
+		WorkspaceId: workspaceID,
+		UserId:      userID,
+		Id:          webhookID,
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to delete webhook"})
+		return
+	}
+
+	if !rsp.Success {
+		webhookError(c, errors.New(rsp.Message), "Failed to delete webhook")
+		return
+	}
+
+	c.JSON(http.StatusOK, DeleteResponse{Message: rsp.Message})
+}
+
+// ListWebhookDeliveries handles GET /api/v1/workspaces/:workspaceID/webhooks/:webhookID/deliveries
+//
+//	@Summary		List webhook deliveries
+//	@Description	List the delivery log of a webhook, newest first: the payload sent, the attempts made, the receiver's last response and, for pending deliveries, when the next attempt is due. Follow next_cursor and prev_cursor to page
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Param			workspaceID	path		string						true	"Workspace identifier"							example(marketing)
+//	@Param			webhookID	path		int							true	"Webhook ID"									example(12)
+//	@Param			user_id		query		string						true	"User ID"										example(user123)
+//	@Param			status		query		string						false	"pending, succeeded or failed"					example(failed)
+//	@Param			cursor		query		string						false	"next_cursor or prev_cursor of a previous page"
+//	@Param			page_size	query		int							false	"Page size"										example(20)
+//	@Success		200			{object}	WebhookDeliveriesResponse	"Deliveries"
+//	@Failure		400			{object}	ErrorResponse				"Missing user_id parameter, invalid status or cursor"
+//	@Failure		404			{object}	ErrorResponse				"Workspace or webhook not found"
+//	@Failure		500			{object}	ErrorResponse				"Failed to list webhook deliveries"
+//	@Router			/workspaces/{workspaceID}/webhooks/{webhookID}/deliveries [get]
+func (h *URLHandler) ListWebhookDeliveries(c *gin.Context) {
+	workspaceID := c.Param("workspaceID")
+	userID := c.Query("user_id")
+
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "user_id is required"})
+		return
+	}
+	webhookID, ok := webhookIDParam(c)
+	if !ok {
+		return
+	}
+
+	pageSize, _ := strconv.ParseInt(c.DefaultQuery("page_size", "20"), 10, 32)
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.ListWebhookDeliveries(ctx, &pb.ListWebhookDeliveriesRequest{
+		WorkspaceId: workspaceID,
+		UserId:      userID,
+		WebhookId:   webhookID,
+		Status:      c.Query("status"),
+		PageSize:    int32(pageSize),
+		Cursor:      c.Query("cursor"),
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		webhookError(c, err, "Failed to list webhook deliveries")
+		return
+	}
+
+	deliveries := make([]WebhookDeliveryResponse, len(rsp.Deliveries))
+	for i, delivery := range rsp.Deliveries {
+		deliveries[i] = toWebhookDeliveryResponse(delivery)
+	}
+
+	c.JSON(http.StatusOK, WebhookDeliveriesResponse{
+		Deliveries: deliveries,
+		TotalCount: rsp.TotalCount,
+		PageSize:   rsp.PageSize,
+		HasNext:    rsp.HasNext,
+		NextCursor: rsp.NextCursor,
+		PrevCursor: rsp.PrevCursor,
+	})
+}
+
+// RedeliverWebhook handles POST /api/v1/workspaces/:workspaceID/webhooks/:webhookID/deliveries/:deliveryID/redeliver
+//
+//	@Summary		Redeliver a webhook event
+//	@Description	Queue a delivery again, e.g. after fixing the receiver. The new delivery carries the same event ID and payload, so receivers can recognize events they already processed; the dispatcher sends it within seconds
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Param			workspaceID	path		string					true	"Workspace identifier"	example(marketing)
+//	@Param			webhookID	path		int						true	"Webhook ID"			example(12)
+//	@Param			deliveryID	path		int						true	"Delivery ID"			example(1042)
+//	@Param			user_id		query		string					true	"User ID"				example(user123)
+//	@Success		202			{object}	WebhookDeliveryResponse	"Delivery queued"
+//	@Failure		400			{object}	ErrorResponse			"Missing user_id parameter"
+//	@Failure		404			{object}	ErrorResponse			"Workspace, webhook or delivery not found"
+//	@Failure		409			{object}	ErrorResponse			"Webhook is disabled"
+//	@Failure		500			{object}	ErrorResponse			"Failed to redeliver webhook"
+//	@Router			/workspaces/{workspaceID}/webhooks/{webhookID}/deliveries/{deliveryID}/redeliver [post]
+func (h *URLHandler) RedeliverWebhook(c *gin.Context) {
+	workspaceID := c.Param("workspaceID")
+	userID := c.Query("user_id")
+
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "user_id is required"})
+		return
+	}
+	webhookID, ok := webhookIDParam(c)
+	if !ok {
+		return
+	}
+	deliveryID, err := strconv.ParseInt(c.Param("deliveryID"), 10, 64)
+	if err != nil || deliveryID <= 0 {
+		c.JSON(http.StatusNotFound, ErrorResponse{Error: "Delivery not found"})
+		return
+	}
+
+	h.log.WithFields(logrus.Fields{
+		"workspace_id": workspaceID,
+		"webhook_id":   webhookID,
+		"delivery_id":  deliveryID,
+	}).Info("Processing RedeliverWebhook REST request")
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.RedeliverWebhook(ctx, &pb.RedeliverWebhookRequest{
+		WorkspaceId: workspaceID,
+		UserId:      userID,
+		WebhookId:   webhookID,
+		DeliveryId:  deliveryID,
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		webhookError(c, err, "Failed to redeliver webhook")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, toWebhookDeliveryResponse(rsp))
+}
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/go-systems-lab/go-url-shortener/proto/url"
+)
+
+// WorkspaceRequest represents the REST API request for creating or updating a workspace
+type WorkspaceRequest struct {
+	Name        string            `json:"name" example:"Marketing"`
+	OwnerID     string            `json:"owner_id" binding:"required" example:"user123"`
+	UTMTemplate map[string]string `json:"utm_template,omitempty" example:"utm_source:{referrer_domain},utm_medium:social"`
+}
+
+// WorkspaceResponse represents workspace information response
+type WorkspaceResponse struct {
+	WorkspaceID string            `json:"workspace_id" example:"marketing"`
+	Name        string            `json:"name" example:"Marketing"`
+	OwnerID     string            `json:"owner_id" example:"user123"`
+	UTMTemplate map[string]string `json:"utm_template,omitempty" example:"utm_source:{referrer_domain},utm_medium:social"`
+	Plan        string            `json:"plan" example:"free"`
+	CreatedAt   int64             `json:"created_at" example:"1672531200"`
+	UpdatedAt   int64             `json:"updated_at" example:"1672617600"`
+
+	InactivityDays   int32 `json:"inactivity_days" example:"180"` // archive links not clicked for this many days, 0 for never
+	InactivityDryRun bool  `json:"inactivity_dry_run" example:"false"`
+}
+
+// toWorkspaceResponse converts an RPC workspace message to its REST representation
+func toWorkspaceResponse(ws *pb.WorkspaceInfo) WorkspaceResponse {
+	return WorkspaceResponse{
+		WorkspaceID: ws.WorkspaceId,
+		Name:        ws.Name,
+		OwnerID:     ws.OwnerId,
+		UTMTemplate: ws.UtmTemplate,
+		Plan:        ws.Plan,
+		CreatedAt:   ws.CreatedAt,
+		UpdatedAt:   ws.UpdatedAt,
+
+		InactivityDays:   ws.InactivityDays,
+		InactivityDryRun: ws.InactivityDryRun,
+	}
+}
+
+// UpsertWorkspace handles PUT /api/v1/workspaces/:workspaceID
+//
+//	@Summary		Create or update a workspace
+//	@Description	Create or update a workspace and its default UTM template. Supported placeholders are {short_code}, {country} and {referrer_domain}
+//	@Tags			Workspaces
+//	@Accept			json
+//	@Produce		json
+//	@Param			workspaceID	path		string				true	"Workspace identifier"	example(marketing)
+//	@Param			request		body		WorkspaceRequest	true	"Workspace request"
+//	@Success		200			{object}	WorkspaceResponse	"Workspace saved successfully"
+//	@Failure		400			{object}	ErrorResponse		"Invalid request body"
+//	@Failure		500			{object}	ErrorResponse		"Failed to save workspace"
+//	@Router			/workspaces/{workspaceID} [put]
+func (h *URLHandler) UpsertWorkspace(c *gin.Context) {
+	workspaceID := c.Param("workspaceID")
+
+	var req WorkspaceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.WithError(err).Error("Invalid request body")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request body"})
+		return
+	}
+
+	h.log.WithFields(logrus.Fields{
+		"workspace_id": workspaceID,
+		"owner_id":     req.OwnerID,
+	}).Info("Processing UpsertWorkspace REST request")
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.UpsertWorkspace(ctx, &pb.UpsertWorkspaceRequest{
+		WorkspaceId: workspaceID,
+		Name:        req.Name,
+		OwnerId:     req.OwnerID,
+		UtmTemplate: req.UTMTemplate,
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to save workspace"})
+		return
+	}
+
+	c.JSON(http.StatusOK, toWorkspaceResponse(rsp))
+}
+
+// GetWorkspace handles GET /api/v1/workspaces/:workspaceID
+//
+//	@Summary		Get workspace information
+//	@Description	Retrieve a workspace and its default UTM template
+//	@Tags			Workspaces
+//	@Accept			json
+//	@Produce		json
+//	@Param			workspaceID	path		string				true	"Workspace identifier"	example(marketing)
+//	@Param			user_id		query		string				true	"User ID"				example(user123)
+//	@Success		200			{object}	WorkspaceResponse	"Workspace retrieved successfully"
+//	@Failure		400			{object}	ErrorResponse		"Missing user_id parameter"
+//	@Failure		404			{object}	ErrorResponse		"Workspace not found"
+//	@Router			/workspaces/{workspaceID} [get]
+func (h *URLHandler) GetWorkspace(c *gin.Context) {
+	workspaceID := c.Param("workspaceID")
+	userID := c.Query("user_id")
+
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "user_id is required"})
+		return
+	}
+
+	// Call RPC service
+	ctx, cancel := context.WithTimeout(rpcContext(c), 10*time.Second)
+	defer cancel()
+
+	rsp, err := h.client.GetWorkspace(ctx, &pb.GetWorkspaceRequest{
+		WorkspaceId: workspaceID,
+		UserId:      userID,
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to call RPC service")
+		c.JSON(http.StatusNotFound, ErrorResponse{Error: "Workspace not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, toWorkspaceResponse(rsp))
+}
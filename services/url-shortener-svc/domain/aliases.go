@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-systems-lab/go-url-shortener/utils/alias"
+)
+
+// Alias suggestion settings
+const (
+	DefaultAliasSuggestions = 5
+	MaxAliasSuggestions     = 20
+	suggestionTitleTimeout  = 3 * time.Second // the user is waiting, unlike background preview scrapes
+)
+
+// SuggestAliasesRequest asks for alternatives to a custom alias
+type SuggestAliasesRequest struct {
+	Alias       string
+	LongURL     string // optional; its page title seeds slug suggestions
+	Domain      string
+	WorkspaceID string
+	UserID      string
+	Count       int
+}
+
+// AliasSuggestions are available alternatives to a custom alias
+type AliasSuggestions struct {
+	Alias       string   `json:"alias"`
+	Available   bool     `json:"available"` // the requested alias itself can be used
+	Suggestions []string `json:"suggestions"`
+}
+
+// validateAlias checks a custom alias against the alias rules of a plan and the
+// reserved, denied and offensive word lists. Format violations are reported as
+// ErrInvalidShortCode; reserved and offensive aliases keep the alias error.
+func (s *URLService) validateAlias(customAlias, plan string) error {
+	err := s.aliases.Check(customAlias, plan)
+	if errors.Is(err, alias.ErrInvalidFormat) {
+		return ErrInvalidShortCode
+	}
+	return err
+}
+
+// SetWorkspacePlan moves a workspace to another plan (admin operation). Existing
+// aliases stay valid; the new rules apply to aliases created from now on.
+func (s *URLService) SetWorkspacePlan(workspaceID, plan string) (*Workspace, error) {
+	if !s.aliases.HasPlan(plan) {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownPlan, plan)
+	}
+	if _, err := s.getOwnedWorkspace(workspaceID, ""); err != nil {
+		return nil, err
+	}
+
+	dbWorkspace, err := s.db.SetWorkspacePlan(workspaceID, plan)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set workspace plan: %w", err)
+	}
+	return dbToDomainWorkspace(dbWorkspace), nil
+}
+
+// SuggestAliases returns available alternatives to a custom alias: numbered
+// suffixes, dictionary word combinations and slugs of the destination's page
+// title. Every candidate passes the plan's alias rules and word lists, and
+// availability is checked in one query.
+func (s *URLService) SuggestAliases(ctx context.Context, req *SuggestAliasesRequest) (*AliasSuggestions, error) {
+	if req.Alias == "" && req.LongURL == "" {
+		return nil, ErrInvalidSuggestionRequest
+	}
+	count := req.Count
+	if count <= 0 {
+		count = DefaultAliasSuggestions
+	}
+	if count > MaxAliasSuggestions {
+		count = MaxAliasSuggestions
+	}
+
+	plan := alias.DefaultPlan
+	if req.WorkspaceID != "" {
+		workspace, err := s.getOwnedWorkspace(req.WorkspaceID, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+		plan = workspace.Plan
+	}
+	shortDomain, codePolicy, err := s.linkDomain(req.Domain, req.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var title string
+	if req.LongURL != "" {
+		if err := s.validateURL(req.LongURL); err != nil {
+			return nil, fmt.Errorf("URL validation failed: %w", err)
+		}
+		title = s.pageTitle(ctx, req.LongURL)
+	}
+
+	var candidates []string
+	for _, candidate := range alias.Candidates(req.Alias, title, s.aliases.RulesFor(plan)) {
+		if s.aliases.Check(candidate, plan) == nil && codePolicy.Check(candidate) == nil {
+			candidates = append(candidates, candidate)
+		}
+	}
+	desired := codePolicy.Canonical(req.Alias)
+	desiredValid := req.Alias != "" && s.validateAlias(req.Alias, plan) == nil && codePolicy.Check(req.Alias) == nil
+	lookup := candidates
+	if desiredValid {
+		lookup = append([]string{desired}, candidates...)
+	}
+
+	taken, err := s.db.GetTakenShortCodes(shortDomain, lookup, codePolicy.CaseInsensitive())
+	if err != nil {
+		return nil, fmt.Errorf("failed to check alias availability: %w", err)
+	}
+
+	result := &AliasSuggestions{
+		Alias:       req.Alias,
+		Available:   desiredValid && !taken[desired],
+		Suggestions: []string{},
+	}
+	for _, candidate := range candidates {
+		if len(result.Suggestions) == count {
+			break
+		}
+		if !taken[candidate] {
+			result.Suggestions = append(result.Suggestions, candidate)
+		}
+	}
+	return result, nil
+}
+
+// pageTitle fetches the title of a destination page for slug suggestions; it
+// returns "" when previews are disabled or the page cannot be fetched
+func (s *URLService) pageTitle(ctx context.Context, longURL string) string {
+	if s.previews == nil {
+		return ""
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, suggestionTitleTimeout)
+	defer cancel()
+
+	metadata, err := s.previews.Fetch(ctx, longURL)
+	if err != nil {
+		return ""
+	}
+	return metadata.Title
+}
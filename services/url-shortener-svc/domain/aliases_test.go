@@ -0,0 +1,19 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-systems-lab/go-url-shortener/utils/alias"
+)
+
+func TestValidateAlias(t *testing.T) {
+	service := NewURLService(nil, nil, nil, nil, nil, nil)
+
+	assert.NoError(t, service.validateAlias("promo24", alias.DefaultPlan))
+	assert.Equal(t, ErrInvalidShortCode, service.validateAlias("spring-sale", alias.DefaultPlan))
+	assert.NoError(t, service.validateAlias("spring-sale", "pro"))
+	assert.ErrorIs(t, service.validateAlias("swagger", "pro"), alias.ErrReserved)
+	assert.ErrorIs(t, service.validateAlias("sh1t-happens", "pro"), alias.ErrOffensive)
+}
@@ -0,0 +1,249 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-systems-lab/go-url-shortener/utils/database"
+)
+
+// Audit log settings (business rule: every administrative action is logged, append-only)
+const (
+	SystemActor          = "system" // background workers
+	AdminActor           = "admin"  // admin API, authenticated by the shared admin token
+	auditVerifyBatchSize = 1000
+)
+
+// Audited actions
+const (
+	AuditURLCreate          = "url.create"
+	AuditURLUpdate          = "url.update"
+	AuditURLDelete          = "url.delete"
+	AuditURLRestore         = "url.restore"
+	AuditURLRevive          = "url.revive"
+	AuditURLRollback        = "url.rollback"
+	AuditURLDisable         = "url.disable"
+	AuditURLInterstitial    = "url.interstitial"
+	AuditWorkspacePlan      = "workspace.plan"
+	AuditDomainReviewUpsert = "domain_review.upsert"
+	AuditDomainReviewDelete = "domain_review.delete"
+)
+
+// AuditActor is who performed an action and where the request came from
+type AuditActor struct {
+	Actor     string `json:"actor"` // user ID, AdminActor or SystemActor
+	IP        string `json:"ip,omitempty"`
+	UserAgent string `json:"user_agent,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// AuditEvent is an entry of the audit log. Before and After are JSON
+// documents, empty when the target did not exist before or after the action.
+type AuditEvent struct {
+	ID        int64     `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	AuditActor
+	Action   string `json:"action"`
+	Target   string `json:"target"`
+	Before   string `json:"before,omitempty"`
+	After    string `json:"after,omitempty"`
+	PrevHash string `json:"prev_hash"`
+	Hash     string `json:"hash"`
+}
+
+// ListAuditEventsRequest filters the audit log; empty fields match all
+type ListAuditEventsRequest struct {
+	Actor     string     `json:"actor,omitempty"`
+	Action    string     `json:"action,omitempty"`
+	Target    string     `json:"target,omitempty"`
+	RequestID string     `json:"request_id,omitempty"`
+	Since     *time.Time `json:"since,omitempty"`
+	Until     *time.Time `json:"until,omitempty"`
+	PageSize  int32      `json:"page_size"`
+	Cursor    string     `json:"cursor,omitempty"`
+}
+
+// ListAuditEventsResponse is a page of audit events, newest first
+type ListAuditEventsResponse struct {
+	Events     []AuditEvent `json:"events"`
+	TotalCount int32        `json:"total_count"`
+	PageSize   int32        `json:"page_size"`
+	HasNext    bool         `json:"has_next"`
+	NextCursor string       `json:"next_cursor,omitempty"`
+	PrevCursor string       `json:"prev_cursor,omitempty"`
+}
+
+// AuditVerification is the result of checking the audit log's hash chain
+type AuditVerification struct {
+	Checked  int64  `json:"checked"`
+	Valid    bool   `json:"valid"`
+	BrokenAt int64  `json:"broken_at,omitempty"` // first event whose hash does not match
+	LastHash string `json:"last_hash"`           // head of the chain, to compare with a copy kept elsewhere
+}
+
+// AuditURLTarget names a link in the audit log
+func AuditURLTarget(shortDomain, shortCode string) string {
+	if shortDomain == "" {
+		return "url:" + shortCode
+	}
+	return "url:" + shortDomain + "/" + shortCode
+}
+
+// RecordAudit appends an action to the audit log. before and after are
+// encoded as JSON; nil leaves them empty.
+func (s *URLService) RecordAudit(actor AuditActor, action, target string, before, after interface{}) error {
+	event := &database.AuditEvent{
+		Actor:     actor.Actor,
+		IP:        actor.IP,
+		UserAgent: actor.UserAgent,
+		RequestID: actor.RequestID,
+		Action:    action,
+		Target:    target,
+		Before:    auditJSON(before),
+		After:     auditJSON(after),
+	}
+	if event.Actor == "" {
+		event.Actor = SystemActor
+	}
+
+	if err := s.db.AppendAuditEvent(event); err != nil {
+		return fmt.Errorf("failed to record audit event: %w", err)
+	}
+	return nil
+}
+
+// AuditSnapshot loads a link as it is in the database, bypassing the cache
+// that only holds part of it; nil when there is no active link
+func (s *URLService) AuditSnapshot(shortDomain, shortCode string) *URL {
+	dbURL, err := s.db.GetURLByShortCode(shortDomain, shortCode)
+	if err != nil {
+		return nil
+	}
+	return s.dbToDomainURL(dbURL)
+}
+
+// ListAuditEvents lists the audit log, newest first (admin operation)
+func (s *URLService) ListAuditEvents(req *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	pages, err := newPager("audit", 0, req.PageSize, req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := database.AuditFilter{
+		Actor:     req.Actor,
+		Action:    req.Action,
+		Target:    req.Target,
+		RequestID: req.RequestID,
+	}
+	if req.Since != nil {
+		filter.Since = *req.Since
+	}
+	if req.Until != nil {
+		filter.Until = *req.Until
+	}
+
+	dbEvents, err := s.db.ListAuditEvents(filter, pages.cursor, pages.limit(), pages.offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve audit events: %w", err)
+	}
+	total, err := s.db.CountAuditEvents(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count audit events: %w", err)
+	}
+
+	dbEvents, cursors := paginate(pages, dbEvents, func(event *database.AuditEvent, backward bool) database.Cursor {
+		return database.AuditEventCursor(event, backward)
+	})
+	events := make([]AuditEvent, len(dbEvents))
+	for i := range dbEvents {
+		events[i] = dbToDomainAuditEvent(&dbEvents[i])
+	}
+
+	return &ListAuditEventsResponse{
+		Events:     events,
+		TotalCount: int32(total),
+		PageSize:   int32(pages.size),
+		HasNext:    cursors.hasNext,
+		NextCursor: cursors.next,
+		PrevCursor: cursors.prev,
+	}, nil
+}
+
+// VerifyAuditLog walks the audit log from the first event and checks that
+// every event hashes to its stored hash and points at the one before it
+func (s *URLService) VerifyAuditLog(ctx context.Context) (*AuditVerification, error) {
+	result := &AuditVerification{Valid: true, LastHash: database.GenesisAuditHash}
+
+	var afterID int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		events, err := s.db.GetAuditEventsAfter(afterID, auditVerifyBatchSize)
+		if err != nil {
+			return result, fmt.Errorf("failed to load audit events: %w", err)
+		}
+		if len(events) == 0 {
+			return result, nil
+		}
+
+		checked, brokenAt := verifyAuditChain(result.LastHash, events)
+		result.Checked += int64(checked)
+		if brokenAt != 0 {
+			result.Valid = false
+			result.BrokenAt = brokenAt
+			return result, nil
+		}
+		last := events[len(events)-1]
+		result.LastHash = last.Hash
+		afterID = last.ID
+	}
+}
+
+// verifyAuditChain checks events in chain order against the hash before
+// them. It returns how many events it checked and the ID of the first broken
+// one, 0 when all hold.
+func verifyAuditChain(prevHash string, events []database.AuditEvent) (int, int64) {
+	for i := range events {
+		event := &events[i]
+		if event.PrevHash != prevHash || database.AuditEventHash(prevHash, event) != event.Hash {
+			return i + 1, event.ID
+		}
+		prevHash = event.Hash
+	}
+	return len(events), 0
+}
+
+// auditJSON encodes a before or after state of the audit log
+func auditJSON(state interface{}) string {
+	if state == nil {
+		return ""
+	}
+	data, err := json.Marshal(state)
+	if err != nil || string(data) == "null" {
+		return ""
+	}
+	return string(data)
+}
+
+func dbToDomainAuditEvent(dbEvent *database.AuditEvent) AuditEvent {
+	return AuditEvent{
+		ID:        dbEvent.ID,
+		CreatedAt: dbEvent.CreatedAt,
+		AuditActor: AuditActor{
+			Actor:     dbEvent.Actor,
+			IP:        dbEvent.IP,
+			UserAgent: dbEvent.UserAgent,
+			RequestID: dbEvent.RequestID,
+		},
+		Action:   dbEvent.Action,
+		Target:   dbEvent.Target,
+		Before:   dbEvent.Before,
+		After:    dbEvent.After,
+		PrevHash: dbEvent.PrevHash,
+		Hash:     dbEvent.Hash,
+	}
+}
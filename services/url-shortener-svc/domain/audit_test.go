@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-systems-lab/go-url-shortener/utils/database"
+)
+
+func TestVerifyAuditChain(t *testing.T) {
+	chain := func() []database.AuditEvent {
+		events := make([]database.AuditEvent, 3)
+		prevHash := database.GenesisAuditHash
+		for i := range events {
+			events[i] = database.AuditEvent{
+				ID:        int64(i + 1),
+				CreatedAt: time.Date(2026, time.January, 1, 0, 0, i, 0, time.UTC),
+				Actor:     "user123",
+				Action:    AuditURLUpdate,
+				Target:    AuditURLTarget("", "abc123"),
+				PrevHash:  prevHash,
+			}
+			events[i].Hash = database.AuditEventHash(prevHash, &events[i])
+			prevHash = events[i].Hash
+		}
+		return events
+	}
+
+	checked, brokenAt := verifyAuditChain(database.GenesisAuditHash, chain())
+	assert.Equal(t, 3, checked)
+	assert.Zero(t, brokenAt)
+
+	// An edited event no longer matches its hash
+	events := chain()
+	events[1].Actor = "someone-else"
+	checked, brokenAt = verifyAuditChain(database.GenesisAuditHash, events)
+	assert.Equal(t, 2, checked)
+	assert.Equal(t, int64(2), brokenAt)
+
+	// A removed event breaks the link of the next one
+	events = chain()
+	events = append(events[:1], events[2:]...)
+	_, brokenAt = verifyAuditChain(database.GenesisAuditHash, events)
+	assert.Equal(t, int64(3), brokenAt)
+}
+
+func TestAuditURLTarget(t *testing.T) {
+	assert.Equal(t, "url:abc123", AuditURLTarget("", "abc123"))
+	assert.Equal(t, "url:go.acme.com/abc123", AuditURLTarget("go.acme.com", "abc123"))
+}
+
+func TestAuditJSON(t *testing.T) {
+	var url *URL
+	assert.Empty(t, auditJSON(nil))
+	assert.Empty(t, auditJSON(url))
+	assert.Equal(t, `{"mode":"always"}`, auditJSON(map[string]string{"mode": "always"}))
+}
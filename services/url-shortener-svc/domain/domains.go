@@ -0,0 +1,279 @@
+package domain
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/net/idna"
+
+	"github.com/go-systems-lab/go-url-shortener/utils/alias"
+	"github.com/go-systems-lab/go-url-shortener/utils/cache"
+	"github.com/go-systems-lab/go-url-shortener/utils/database"
+)
+
+// DefaultShortURLBase is where links without a branded domain live; see ConfigureShortURLBase
+var DefaultShortURLBase = "https://short.ly"
+
+// DefaultCodePolicy is the short code policy of the default domain; see ConfigureCodePolicy
+var DefaultCodePolicy = alias.CodePolicyMixed
+
+// Branded domain rules
+const (
+	maxBrandedDomainLength = 253
+	maxBrandedDomains      = 20 // per workspace
+)
+
+// BrandedDomain is a short domain attached to a workspace
+type BrandedDomain struct {
+	Domain      string           `json:"domain"`
+	WorkspaceID string           `json:"workspace_id"`
+	CreatedBy   string           `json:"created_by"`
+	CodePolicy  alias.CodePolicy `json:"code_policy"`
+	CreatedAt   time.Time        `json:"created_at"`
+	ShortURL    string           `json:"short_url"` // base of the short URLs on this domain
+}
+
+// CodePolicyChange is the result of switching a branded domain's code policy
+type CodePolicyChange struct {
+	Domain *BrandedDomain `json:"domain"`
+	// CaseConflicts are existing codes that differ only in case. Under the
+	// readable policy each still resolves when typed exactly; other spellings
+	// resolve to the oldest of them.
+	CaseConflicts []string `json:"case_conflicts"`
+}
+
+// ConfigureShortURLBase sets the base URL of the default short domain, e.g. https://sho.rt
+func ConfigureShortURLBase(base string) error {
+	parsed, err := url.Parse(strings.TrimSuffix(base, "/"))
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" || parsed.Path != "" {
+		return fmt.Errorf("short URL base must be an http(s) origin such as https://short.ly, got %q", base)
+	}
+	DefaultShortURLBase = parsed.Scheme + "://" + parsed.Host
+	return nil
+}
+
+// ConfigureCodePolicy sets the short code policy of the default domain
+func ConfigureCodePolicy(name string) error {
+	codePolicy, err := alias.ParseCodePolicy(name)
+	if err != nil {
+		return err
+	}
+	DefaultCodePolicy = codePolicy
+	return nil
+}
+
+// ShortURL returns the canonical short URL of a code on a short domain ("" for the default domain)
+func ShortURL(shortDomain, shortCode string) string {
+	if shortDomain == "" {
+		return DefaultShortURLBase + "/" + shortCode
+	}
+	return "https://" + shortDomain + "/" + shortCode
+}
+
+// ShortURL returns the canonical short URL of the link
+func (u *URL) ShortURL() string {
+	return ShortURL(u.Domain, u.ShortCode)
+}
+
+// IsDefaultShortHost reports whether host (without port) is the default short domain
+func IsDefaultShortHost(host string) bool {
+	defaultURL, err := url.Parse(DefaultShortURLBase)
+	return err == nil && strings.EqualFold(defaultURL.Hostname(), host)
+}
+
+// defaultShortHost returns the host name of the default short domain
+func defaultShortHost() string {
+	defaultURL, err := url.Parse(DefaultShortURLBase)
+	if err != nil {
+		return ""
+	}
+	return defaultURL.Hostname()
+}
+
+// NormalizeShortDomain validates a branded short domain and returns its lower-case ASCII form
+func NormalizeShortDomain(name string) (string, error) {
+	name = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+	asciiName, err := idna.Lookup.ToASCII(name)
+	if err != nil || asciiName == "" || len(asciiName) > maxBrandedDomainLength || !strings.Contains(asciiName, ".") {
+		return "", ErrInvalidBrandedDomain
+	}
+	if net.ParseIP(asciiName) != nil {
+		return "", fmt.Errorf("%w: IP addresses cannot be used", ErrInvalidBrandedDomain)
+	}
+	if IsDefaultShortHost(asciiName) {
+		return "", fmt.Errorf("%w: %s is the default short domain", ErrInvalidBrandedDomain, asciiName)
+	}
+	return asciiName, nil
+}
+
+// AddBrandedDomain attaches a short domain to a workspace the user owns. The
+// domain's DNS must point at the redirect service for its links to resolve.
+func (s *URLService) AddBrandedDomain(workspaceID, userID, name, codePolicyName string) (*BrandedDomain, error) {
+	if _, err := s.getOwnedWorkspace(workspaceID, userID); err != nil {
+		return nil, err
+	}
+	shortDomain, err := NormalizeShortDomain(name)
+	if err != nil {
+		return nil, err
+	}
+	codePolicy, err := alias.ParseCodePolicy(codePolicyName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBrandedDomain, err)
+	}
+
+	existing, err := s.db.ListBrandedDomains(workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list branded domains: %w", err)
+	}
+	if len(existing) >= maxBrandedDomains {
+		return nil, fmt.Errorf("%w: a workspace can have at most %d domains", ErrInvalidBrandedDomain, maxBrandedDomains)
+	}
+
+	dbDomain := &database.BrandedDomain{Domain: shortDomain, WorkspaceID: workspaceID, CreatedBy: userID, CodePolicy: string(codePolicy)}
+	created, err := s.db.CreateBrandedDomain(dbDomain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save branded domain: %w", err)
+	}
+	if !created {
+		registered, err := s.db.GetBrandedDomain(shortDomain)
+		if err != nil || registered.WorkspaceID != workspaceID {
+			return nil, ErrBrandedDomainTaken
+		}
+		dbDomain = registered
+	}
+	s.cache.Delete(cache.BrandedDomainCacheKey(shortDomain))
+
+	return dbToDomainBrandedDomain(dbDomain), nil
+}
+
+// ListBrandedDomains lists the short domains of a workspace the user owns
+func (s *URLService) ListBrandedDomains(workspaceID, userID string) ([]BrandedDomain, error) {
+	if _, err := s.getOwnedWorkspace(workspaceID, userID); err != nil {
+		return nil, err
+	}
+
+	dbDomains, err := s.db.ListBrandedDomains(workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list branded domains: %w", err)
+	}
+
+	domains := make([]BrandedDomain, len(dbDomains))
+	for i := range dbDomains {
+		domains[i] = *dbToDomainBrandedDomain(&dbDomains[i])
+	}
+	return domains, nil
+}
+
+// SetBrandedDomainCodePolicy switches the short code policy of a workspace's
+// domain. Existing codes are not rewritten, so printed links and analytics keep
+// working; codes that only differ in case are reported so the owner can retire
+// all but one of them.
+func (s *URLService) SetBrandedDomainCodePolicy(workspaceID, userID, name, codePolicyName string) (*CodePolicyChange, error) {
+	if _, err := s.getOwnedWorkspace(workspaceID, userID); err != nil {
+		return nil, err
+	}
+	shortDomain, err := NormalizeShortDomain(name)
+	if err != nil {
+		return nil, err
+	}
+	codePolicy, err := alias.ParseCodePolicy(codePolicyName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBrandedDomain, err)
+	}
+
+	registered, err := s.db.GetBrandedDomain(shortDomain)
+	if err != nil || registered.WorkspaceID != workspaceID {
+		return nil, ErrBrandedDomainNotFound
+	}
+
+	if err := s.db.SetBrandedDomainCodePolicy(shortDomain, workspaceID, string(codePolicy)); err != nil {
+		return nil, fmt.Errorf("failed to set code policy: %w", err)
+	}
+	registered.CodePolicy = string(codePolicy)
+	s.cache.Delete(cache.BrandedDomainCacheKey(shortDomain))
+
+	change := &CodePolicyChange{Domain: dbToDomainBrandedDomain(registered), CaseConflicts: []string{}}
+	if codePolicy.CaseInsensitive() {
+		conflicts, err := s.db.GetCaseConflicts(shortDomain)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find case conflicts: %w", err)
+		}
+		change.CaseConflicts = append(change.CaseConflicts, conflicts...)
+	}
+	return change, nil
+}
+
+// RemoveBrandedDomain detaches a short domain from a workspace the user owns.
+// Domains that still have active links cannot be removed.
+func (s *URLService) RemoveBrandedDomain(workspaceID, userID, name string) error {
+	if _, err := s.getOwnedWorkspace(workspaceID, userID); err != nil {
+		return err
+	}
+	shortDomain, err := NormalizeShortDomain(name)
+	if err != nil {
+		return err
+	}
+
+	registered, err := s.db.GetBrandedDomain(shortDomain)
+	if err != nil || registered.WorkspaceID != workspaceID {
+		return ErrBrandedDomainNotFound
+	}
+
+	active, err := s.db.CountActiveURLsOnDomain(shortDomain)
+	if err != nil {
+		return fmt.Errorf("failed to count links on domain: %w", err)
+	}
+	if active > 0 {
+		return fmt.Errorf("%w: %d active links", ErrBrandedDomainInUse, active)
+	}
+
+	if err := s.db.DeleteBrandedDomain(shortDomain, workspaceID); err != nil {
+		return fmt.Errorf("failed to delete branded domain: %w", err)
+	}
+	s.cache.Delete(cache.BrandedDomainCacheKey(shortDomain))
+	return nil
+}
+
+// linkDomain resolves the short domain a new link is created on and its code
+// policy: "" for the default domain, otherwise a branded domain of the link's workspace
+func (s *URLService) linkDomain(name, workspaceID string) (string, alias.CodePolicy, error) {
+	if name == "" {
+		return "", DefaultCodePolicy, nil
+	}
+	shortDomain, err := NormalizeShortDomain(name)
+	if err != nil {
+		return "", "", err
+	}
+	if workspaceID == "" {
+		return "", "", fmt.Errorf("%w: links on a branded domain need the domain's workspace", ErrBrandedDomainNotFound)
+	}
+
+	registered, err := s.db.GetBrandedDomain(shortDomain)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && registered.WorkspaceID != workspaceID) {
+		return "", "", ErrBrandedDomainNotFound
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("failed to look up branded domain: %w", err)
+	}
+	return shortDomain, dbToDomainBrandedDomain(registered).CodePolicy, nil
+}
+
+func dbToDomainBrandedDomain(dbDomain *database.BrandedDomain) *BrandedDomain {
+	codePolicy, err := alias.ParseCodePolicy(dbDomain.CodePolicy)
+	if err != nil {
+		codePolicy = alias.CodePolicyMixed
+	}
+	return &BrandedDomain{
+		Domain:      dbDomain.Domain,
+		WorkspaceID: dbDomain.WorkspaceID,
+		CreatedBy:   dbDomain.CreatedBy,
+		CodePolicy:  codePolicy,
+		CreatedAt:   dbDomain.CreatedAt,
+		ShortURL:    ShortURL(dbDomain.Domain, ""),
+	}
+}
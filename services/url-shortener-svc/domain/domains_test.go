@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShortURL(t *testing.T) {
+	assert.Equal(t, "https://short.ly/abc123", ShortURL("", "abc123"))
+	assert.Equal(t, "https://go.acme.com/abc123", ShortURL("go.acme.com", "abc123"))
+	assert.Equal(t, "https://go.acme.com/abc123", (&URL{Domain: "go.acme.com", ShortCode: "abc123"}).ShortURL())
+}
+
+func TestConfigureShortURLBase(t *testing.T) {
+	defer func(base string) { DefaultShortURLBase = base }(DefaultShortURLBase)
+
+	require.NoError(t, ConfigureShortURLBase("https://sho.rt/"))
+	assert.Equal(t, "https://sho.rt/abc123", ShortURL("", "abc123"))
+
+	for _, base := range []string{"sho.rt", "ftp://sho.rt", "https://sho.rt/links"} {
+		assert.Error(t, ConfigureShortURLBase(base), base)
+	}
+}
+
+func TestNormalizeShortDomain(t *testing.T) {
+	name, err := NormalizeShortDomain(" Go.ACME.com. ")
+	require.NoError(t, err)
+	assert.Equal(t, "go.acme.com", name)
+
+	name, err = NormalizeShortDomain("bücher.de")
+	require.NoError(t, err)
+	assert.Equal(t, "xn--bcher-kva.de", name)
+
+	for _, name := range []string{"", "localhost", "10.0.0.1", "short.ly", "bad_domain!.com"} {
+		_, err := NormalizeShortDomain(name)
+		assert.ErrorIs(t, err, ErrInvalidBrandedDomain, name)
+	}
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-systems-lab/go-url-shortener/utils/database"
+)
+
+// Expiry sweeper settings (business rule: expired links are marked and leave the cache)
+const (
+	expirySweepBatchSize = 500
+	DefaultExpiryNotice  = 3 * 24 * time.Hour
+)
+
+// ExpiryNotice is a link the expiry sweeper reports as expired or expiring soon
+type ExpiryNotice struct {
+	Domain      string    `json:"domain"`
+	ShortCode   string    `json:"short_code"`
+	UserID      string    `json:"user_id"`
+	WorkspaceID string    `json:"workspace_id,omitempty"`
+	LongURL     string    `json:"long_url"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// ExpirySweepResult summarizes a run of the expiry sweeper
+type ExpirySweepResult struct {
+	Expired  []ExpiryNotice `json:"expired"`  // links marked expired in this sweep
+	Expiring []ExpiryNotice `json:"expiring"` // links that got their "expiring soon" notice
+}
+
+// SweepExpiry marks the links whose expiration passed and evicts them from the
+// cache, then picks the links expiring within noticeAhead that were not
+// notified yet (0 skips notices). Each link is reported once; the caller sends
+// the events. Links marked before a failure are returned with the error.
+func (s *URLService) SweepExpiry(ctx context.Context, noticeAhead time.Duration) (*ExpirySweepResult, error) {
+	result := &ExpirySweepResult{}
+
+	err := sweepExpiryBatches(ctx, s.db.MarkExpiredURLs, func(link *database.ExpiringLink) {
+		s.invalidateURLCache(link.Domain, link.ShortCode)
+		result.Expired = append(result.Expired, toExpiryNotice(link))
+	})
+	if err != nil {
+		return result, fmt.Errorf("failed to mark expired URLs: %w", err)
+	}
+
+	if noticeAhead <= 0 {
+		return result, nil
+	}
+
+	expiresBefore := time.Now().Add(noticeAhead)
+	markExpiring := func(limit int) ([]database.ExpiringLink, error) {
+		return s.db.MarkExpiringURLs(expiresBefore, limit)
+	}
+	err = sweepExpiryBatches(ctx, markExpiring, func(link *database.ExpiringLink) {
+		result.Expiring = append(result.Expiring, toExpiryNotice(link))
+	})
+	if err != nil {
+		return result, fmt.Errorf("failed to mark expiring URLs: %w", err)
+	}
+	return result, nil
+}
+
+// sweepExpiryBatches runs mark until it returns a partial batch
+func sweepExpiryBatches(ctx context.Context, mark func(limit int) ([]database.ExpiringLink, error), each func(*database.ExpiringLink)) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		links, err := mark(expirySweepBatchSize)
+		if err != nil {
+			return err
+		}
+		for i := range links {
+			each(&links[i])
+		}
+
+		if len(links) < expirySweepBatchSize {
+			return nil
+		}
+	}
+}
+
+// toExpiryNotice converts a swept link to its notice
+func toExpiryNotice(link *database.ExpiringLink) ExpiryNotice {
+	return ExpiryNotice{
+		Domain:      link.Domain,
+		ShortCode:   link.ShortCode,
+		UserID:      link.UserID,
+		WorkspaceID: link.WorkspaceID,
+		LongURL:     link.LongURL,
+		ExpiresAt:   link.ExpiresAt,
+	}
+}
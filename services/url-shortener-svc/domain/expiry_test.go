@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-systems-lab/go-url-shortener/utils/database"
+)
+
+func TestSweepExpiryBatches(t *testing.T) {
+	// Two full batches and a partial one
+	remaining := 2*expirySweepBatchSize + 3
+	calls := 0
+	mark := func(limit int) ([]database.ExpiringLink, error) {
+		calls++
+		n := min(limit, remaining)
+		remaining -= n
+		return make([]database.ExpiringLink, n), nil
+	}
+
+	swept := 0
+	err := sweepExpiryBatches(context.Background(), mark, func(*database.ExpiringLink) { swept++ })
+	assert.NoError(t, err)
+	assert.Equal(t, 2*expirySweepBatchSize+3, swept)
+	assert.Equal(t, 3, calls)
+
+	// A failure stops the sweep after the links already marked
+	failure := errors.New("connection reset")
+	calls = 0
+	mark = func(limit int) ([]database.ExpiringLink, error) {
+		calls++
+		if calls > 1 {
+			return nil, failure
+		}
+		return make([]database.ExpiringLink, limit), nil
+	}
+	swept = 0
+	err = sweepExpiryBatches(context.Background(), mark, func(*database.ExpiringLink) { swept++ })
+	assert.ErrorIs(t, err, failure)
+	assert.Equal(t, expirySweepBatchSize, swept)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = sweepExpiryBatches(ctx, mark, func(*database.ExpiringLink) {})
+	assert.ErrorIs(t, err, context.Canceled)
+}
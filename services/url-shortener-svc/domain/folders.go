@@ -0,0 +1,270 @@
+package domain
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-systems-lab/go-url-shortener/utils/database"
+)
+
+// Folder rules
+const (
+	maxFolderNameLength = 100
+	maxFolderDepth      = 8   // top-level folders have depth 1
+	maxFolders          = 500 // per user
+)
+
+// Folder groups a user's links; folders nest and a link is in at most one folder
+type Folder struct {
+	ID        int64     `json:"id"`
+	UserID    string    `json:"user_id"`
+	ParentID  int64     `json:"parent_id,omitempty"` // 0 for top-level folders
+	Name      string    `json:"name"`
+	Path      string    `json:"path"` // names from the top-level folder down, joined with "/"
+	CreatedAt time.Time `json:"created_at"`
+	LinkCount int64     `json:"link_count"` // active links directly in the folder
+}
+
+// folderTree indexes a user's folders by ID
+type folderTree map[int64]database.Folder
+
+func newFolderTree(folders []database.Folder) folderTree {
+	tree := make(folderTree, len(folders))
+	for _, folder := range folders {
+		tree[folder.ID] = folder
+	}
+	return tree
+}
+
+// ancestors returns the folder and its parents, the folder first
+func (t folderTree) ancestors(id int64) []database.Folder {
+	var chain []database.Folder
+	for folder, ok := t[id]; ok && len(chain) <= len(t); folder, ok = t[folder.ParentID.Int64] {
+		chain = append(chain, folder)
+		if !folder.ParentID.Valid {
+			break
+		}
+	}
+	return chain
+}
+
+// path joins the names of a folder and its parents from the top down
+func (t folderTree) path(id int64) string {
+	chain := t.ancestors(id)
+	names := make([]string, len(chain))
+	for i, folder := range chain {
+		names[len(chain)-1-i] = folder.Name
+	}
+	return strings.Join(names, "/")
+}
+
+// subtree returns the IDs of a folder and all folders nested in it
+func (t folderTree) subtree(id int64) []int64 {
+	children := make(map[int64][]int64)
+	for _, folder := range t {
+		if folder.ParentID.Valid {
+			children[folder.ParentID.Int64] = append(children[folder.ParentID.Int64], folder.ID)
+		}
+	}
+
+	ids := []int64{id}
+	for i := 0; i < len(ids); i++ {
+		ids = append(ids, children[ids[i]]...)
+	}
+	return ids
+}
+
+// height returns the number of levels in a folder's subtree, 1 for a folder without subfolders
+func (t folderTree) height(id int64) int {
+	height := 1
+	base := len(t.ancestors(id))
+	for _, nested := range t.subtree(id) {
+		if levels := len(t.ancestors(nested)) - base + 1; levels > height {
+			height = levels
+		}
+	}
+	return height
+}
+
+// checkPlacement validates putting folder id (0 for a new folder) under parentID
+// (0 for the top level): the parent must exist, may not be the folder or one of
+// its subfolders, and the result may not nest deeper than maxFolderDepth
+func (t folderTree) checkPlacement(id, parentID int64) error {
+	height := 1
+	if id != 0 {
+		height = t.height(id)
+	}
+	if parentID == 0 {
+		if height > maxFolderDepth {
+			return fmt.Errorf("%w: folders nest at most %d levels deep", ErrInvalidFolder, maxFolderDepth)
+		}
+		return nil
+	}
+
+	if _, ok := t[parentID]; !ok {
+		return ErrFolderNotFound
+	}
+	if id != 0 {
+		for _, nested := range t.subtree(id) {
+			if nested == parentID {
+				return fmt.Errorf("%w: a folder cannot be moved into itself or its subfolders", ErrInvalidFolder)
+			}
+		}
+	}
+	if len(t.ancestors(parentID))+height > maxFolderDepth {
+		return fmt.Errorf("%w: folders nest at most %d levels deep", ErrInvalidFolder, maxFolderDepth)
+	}
+	return nil
+}
+
+// normalizeFolderName trims a folder name and checks its length; "/" separates folder paths
+func normalizeFolderName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > maxFolderNameLength || strings.Contains(name, "/") {
+		return "", fmt.Errorf("%w: names must be 1 to %d characters without /", ErrInvalidFolder, maxFolderNameLength)
+	}
+	return name, nil
+}
+
+// CreateFolder adds a folder for a user, at the top level when parentID is 0
+func (s *URLService) CreateFolder(userID, name string, parentID int64) (*Folder, error) {
+	folderName, err := normalizeFolderName(name)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := s.folderTree(userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(tree) >= maxFolders {
+		return nil, fmt.Errorf("%w: a user can have at most %d folders", ErrInvalidFolder, maxFolders)
+	}
+	if err := tree.checkPlacement(0, parentID); err != nil {
+		return nil, err
+	}
+
+	dbFolder := &database.Folder{UserID: userID, Name: folderName, ParentID: nullFolderID(parentID)}
+	created, err := s.db.CreateFolder(dbFolder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save folder: %w", err)
+	}
+	if !created {
+		return nil, ErrFolderExists
+	}
+
+	tree[dbFolder.ID] = *dbFolder
+	return tree.toDomain(dbFolder.ID), nil
+}
+
+// ListFolders lists all folders of a user with their paths and link counts
+func (s *URLService) ListFolders(userID string) ([]Folder, error) {
+	dbFolders, err := s.db.ListFolders(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list folders: %w", err)
+	}
+
+	tree := newFolderTree(dbFolders)
+	folders := make([]Folder, len(dbFolders))
+	for i, dbFolder := range dbFolders {
+		folders[i] = *tree.toDomain(dbFolder.ID)
+	}
+	return folders, nil
+}
+
+// UpdateFolder renames a user's folder and moves it under parentID (0 for the top level)
+func (s *URLService) UpdateFolder(userID string, folderID int64, name string, parentID int64) (*Folder, error) {
+	folderName, err := normalizeFolderName(name)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := s.folderTree(userID)
+	if err != nil {
+		return nil, err
+	}
+	dbFolder, ok := tree[folderID]
+	if !ok {
+		return nil, ErrFolderNotFound
+	}
+	if err := tree.checkPlacement(folderID, parentID); err != nil {
+		return nil, err
+	}
+
+	dbFolder.Name = folderName
+	dbFolder.ParentID = nullFolderID(parentID)
+	updated, err := s.db.UpdateFolder(&dbFolder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update folder: %w", err)
+	}
+	if !updated {
+		return nil, ErrFolderExists
+	}
+
+	tree[folderID] = dbFolder
+	return tree.toDomain(folderID), nil
+}
+
+// DeleteFolder deletes a user's folder and its subfolders. Their links are not
+// deleted; they are no longer in a folder.
+func (s *URLService) DeleteFolder(userID string, folderID int64) error {
+	tree, err := s.folderTree(userID)
+	if err != nil {
+		return err
+	}
+	if _, ok := tree[folderID]; !ok {
+		return ErrFolderNotFound
+	}
+
+	links, err := s.db.GetFolderLinks(tree.subtree(folderID))
+	if err != nil {
+		return fmt.Errorf("failed to list folder links: %w", err)
+	}
+	if err := s.db.DeleteFolder(folderID, userID); err != nil {
+		return fmt.Errorf("failed to delete folder: %w", err)
+	}
+	for _, link := range links {
+		s.invalidateURLCache(link.Domain, link.ShortCode)
+	}
+	return nil
+}
+
+// checkFolderOwner verifies that a folder belongs to the user
+func (s *URLService) checkFolderOwner(userID string, folderID int64) error {
+	tree, err := s.folderTree(userID)
+	if err != nil {
+		return err
+	}
+	if _, ok := tree[folderID]; !ok {
+		return ErrFolderNotFound
+	}
+	return nil
+}
+
+// folderTree loads all folders of a user
+func (s *URLService) folderTree(userID string) (folderTree, error) {
+	dbFolders, err := s.db.ListFolders(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load folders: %w", err)
+	}
+	return newFolderTree(dbFolders), nil
+}
+
+// toDomain converts a folder of the tree, resolving its path
+func (t folderTree) toDomain(id int64) *Folder {
+	dbFolder := t[id]
+	return &Folder{
+		ID:        dbFolder.ID,
+		UserID:    dbFolder.UserID,
+		ParentID:  dbFolder.ParentID.Int64,
+		Name:      dbFolder.Name,
+		Path:      t.path(id),
+		CreatedAt: dbFolder.CreatedAt,
+		LinkCount: dbFolder.LinkCount,
+	}
+}
+
+// nullFolderID converts a folder ID (0 for none) into a nullable column value
+func nullFolderID(id int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: id, Valid: id != 0}
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-systems-lab/go-url-shortener/utils/database"
+)
+
+// chainTree returns folders 1..n, each nested in the previous one
+func chainTree(n int64) folderTree {
+	folders := make([]database.Folder, 0, n)
+	for id := int64(1); id <= n; id++ {
+		folders = append(folders, database.Folder{
+			ID:       id,
+			Name:     string(rune('a' + id - 1)),
+			ParentID: sql.NullInt64{Int64: id - 1, Valid: id > 1},
+		})
+	}
+	return newFolderTree(folders)
+}
+
+func TestFolderTreePaths(t *testing.T) {
+	tree := chainTree(3)
+
+	assert.Equal(t, "a/b/c", tree.path(3))
+	assert.ElementsMatch(t, []int64{2, 3}, tree.subtree(2))
+	assert.Equal(t, 3, tree.height(1))
+	assert.Equal(t, 1, tree.height(3))
+}
+
+func TestFolderTreeCheckPlacement(t *testing.T) {
+	tree := chainTree(3)
+
+	assert.NoError(t, tree.checkPlacement(0, 0))
+	assert.NoError(t, tree.checkPlacement(0, 3))
+	assert.NoError(t, tree.checkPlacement(3, 1))
+	assert.NoError(t, tree.checkPlacement(2, 0))
+	assert.ErrorIs(t, tree.checkPlacement(0, 42), ErrFolderNotFound)
+	assert.ErrorIs(t, tree.checkPlacement(1, 3), ErrInvalidFolder, "a folder cannot move into its subfolders")
+	assert.ErrorIs(t, tree.checkPlacement(2, 2), ErrInvalidFolder, "a folder cannot be its own parent")
+
+	deep := chainTree(maxFolderDepth)
+	assert.ErrorIs(t, deep.checkPlacement(0, maxFolderDepth), ErrInvalidFolder)
+	assert.NoError(t, deep.checkPlacement(0, maxFolderDepth-1))
+}
@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-systems-lab/go-url-shortener/utils/cache"
+	"github.com/go-systems-lab/go-url-shortener/utils/database"
+	"github.com/go-systems-lab/go-url-shortener/utils/linkhealth"
+)
+
+// Link health settings (business rule: flag links after N consecutive failed checks)
+const (
+	DefaultHealthFailureThreshold = 3
+	healthSweepBatchSize          = 200
+	linkHealthUnknown             = "unknown" // never checked
+)
+
+// LinkHealth is the latest background check result for a link
+type LinkHealth struct {
+	Domain              string     `json:"domain,omitempty"`
+	ShortCode           string     `json:"short_code"`
+	Status              string     `json:"status"` // healthy, failing, broken or unknown
+	StatusCode          int        `json:"status_code,omitempty"`
+	LatencyMs           int64      `json:"latency_ms,omitempty"`
+	RedirectChain       []string   `json:"redirect_chain,omitempty"`
+	Error               string     `json:"error,omitempty"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	CheckedAt           *time.Time `json:"checked_at,omitempty"`
+	LastHealthyAt       *time.Time `json:"last_healthy_at,omitempty"`
+}
+
+// HealthSweepResult summarizes one pass of the link health monitor
+type HealthSweepResult struct {
+	Checked int `json:"checked"`
+	Failing int `json:"failing"`
+	Broken  int `json:"broken"` // links that crossed the failure threshold in this sweep
+	Errors  int `json:"errors"`
+}
+
+// GetLinkHealth returns the latest health check result for a link the user owns
+func (s *URLService) GetLinkHealth(shortDomain, shortCode, userID string) (*LinkHealth, error) {
+	if _, err := s.GetURL(shortDomain, shortCode, userID); err != nil {
+		return nil, err
+	}
+
+	dbHealth, err := s.db.GetLinkHealth(shortDomain, shortCode)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &LinkHealth{Domain: shortDomain, ShortCode: shortCode, Status: linkHealthUnknown}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve link health: %w", err)
+	}
+
+	return dbToDomainLinkHealth(dbHealth), nil
+}
+
+// SweepLinkHealth probes the destinations of all active, unexpired links and
+// records the results. Links reach the broken status after failureThreshold
+// consecutive failed checks and return to healthy on the next good one.
+func (s *URLService) SweepLinkHealth(ctx context.Context, prober *linkhealth.Prober, failureThreshold int) (*HealthSweepResult, error) {
+	if failureThreshold <= 0 {
+		failureThreshold = DefaultHealthFailureThreshold
+	}
+
+	result := &HealthSweepResult{}
+	var mu sync.Mutex
+
+	var afterID int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		dbURLs, err := s.db.GetActiveURLsAfter(afterID, healthSweepBatchSize)
+		if err != nil {
+			return result, fmt.Errorf("failed to load URLs for health sweep: %w", err)
+		}
+		if len(dbURLs) == 0 {
+			return result, nil
+		}
+
+		targets := make([]linkhealth.Target, 0, len(dbURLs))
+		links := make(map[string]database.LinkRef, len(dbURLs))
+		for _, dbURL := range dbURLs {
+			afterID = dbURL.ID
+			if dbURL.ExpiresAt.Valid && time.Now().After(dbURL.ExpiresAt.Time) {
+				continue
+			}
+			id := cache.LinkKey(dbURL.Domain, dbURL.ShortCode)
+			links[id] = database.LinkRef{Domain: dbURL.Domain, ShortCode: dbURL.ShortCode}
+			targets = append(targets, linkhealth.Target{ID: id, URL: dbURL.LongURL})
+		}
+
+		prober.CheckAll(ctx, targets, func(target linkhealth.Target, check linkhealth.Result) {
+			link := links[target.ID]
+			health := &database.LinkHealth{
+				Domain:     link.Domain,
+				ShortCode:  link.ShortCode,
+				StatusCode: check.StatusCode,
+				LatencyMs:  check.Latency.Milliseconds(),
+				CheckedAt:  check.CheckedAt,
+				Error:      sql.NullString{String: check.Error, Valid: check.Error != ""},
+			}
+			if len(check.RedirectChain) > 0 {
+				chain, _ := json.Marshal(check.RedirectChain)
+				health.RedirectChain = string(chain)
+			}
+
+			err := s.db.RecordLinkHealth(health, check.Healthy(), failureThreshold)
+
+			mu.Lock()
+			defer mu.Unlock()
+			result.Checked++
+			switch {
+			case err != nil:
+				result.Errors++
+			case health.Status == database.LinkHealthFailing:
+				result.Failing++
+			case health.Status == database.LinkHealthBroken && health.ConsecutiveFailures == failureThreshold:
+				result.Broken++
+			}
+		})
+	}
+}
+
+// dbToDomainLinkHealth converts a stored check result to the domain model
+func dbToDomainLinkHealth(dbHealth *database.LinkHealth) *LinkHealth {
+	health := &LinkHealth{
+		Domain:              dbHealth.Domain,
+		ShortCode:           dbHealth.ShortCode,
+		Status:              dbHealth.Status,
+		StatusCode:          dbHealth.StatusCode,
+		LatencyMs:           dbHealth.LatencyMs,
+		Error:               dbHealth.Error.String,
+		ConsecutiveFailures: dbHealth.ConsecutiveFailures,
+		CheckedAt:           &dbHealth.CheckedAt,
+	}
+	if dbHealth.RedirectChain != "" {
+		json.Unmarshal([]byte(dbHealth.RedirectChain), &health.RedirectChain)
+	}
+	if dbHealth.LastHealthyAt.Valid {
+		health.LastHealthyAt = &dbHealth.LastHealthyAt.Time
+	}
+	return health
+}
@@ -0,0 +1,163 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-systems-lab/go-url-shortener/utils/database"
+)
+
+// Inactivity policy limits (business rule: unused workspace links are archived, never deleted)
+const (
+	MaxInactivityDays            = 3650
+	defaultInactivityReportLimit = 100
+	maxInactivityReportLimit     = 1000
+	inactivitySweepBatchSize     = 500
+)
+
+// InactivityReportRequest asks which links of a workspace an inactivity policy
+// would archive. InactivityDays previews another policy; 0 uses the workspace's.
+type InactivityReportRequest struct {
+	WorkspaceID    string `json:"workspace_id"`
+	UserID         string `json:"user_id"`
+	InactivityDays int    `json:"inactivity_days,omitempty"`
+	Limit          int    `json:"limit,omitempty"`
+}
+
+// InactivityReport lists the links an inactivity policy would archive now,
+// least recently used first
+type InactivityReport struct {
+	WorkspaceID    string `json:"workspace_id"`
+	InactivityDays int    `json:"inactivity_days"`
+	DryRun         bool   `json:"dry_run"` // the workspace's policy only reports
+	TotalCount     int64  `json:"total_count"`
+	URLs           []URL  `json:"urls"` // up to the requested limit
+}
+
+// ArchivedLink is a link the inactivity sweeper archived
+type ArchivedLink struct {
+	Domain      string `json:"domain"`
+	ShortCode   string `json:"short_code"`
+	WorkspaceID string `json:"workspace_id"`
+}
+
+// InactivitySweepResult summarizes a run of the inactivity sweeper
+type InactivitySweepResult struct {
+	Archived []ArchivedLink   `json:"archived"`
+	DryRun   map[string]int64 `json:"dry_run"` // links that would be archived, by workspace in dry run
+}
+
+// SetInactivityPolicy makes a workspace archive links that were not clicked
+// for days (0 turns the policy off). In dry run nothing is archived and the
+// sweeper only reports the links.
+func (s *URLService) SetInactivityPolicy(workspaceID, userID string, days int, dryRun bool) (*Workspace, error) {
+	if days < 0 || days > MaxInactivityDays {
+		return nil, fmt.Errorf("%w: inactivity days must be between 0 and %d", ErrInvalidInactivityPolicy, MaxInactivityDays)
+	}
+	if _, err := s.getOwnedWorkspace(workspaceID, userID); err != nil {
+		return nil, err
+	}
+
+	dbWorkspace, err := s.db.SetInactivityPolicy(workspaceID, days, dryRun)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set inactivity policy: %w", err)
+	}
+	return dbToDomainWorkspace(dbWorkspace), nil
+}
+
+// GetInactivityReport reports which links of a workspace its inactivity
+// policy, or the previewed one, would archive now
+func (s *URLService) GetInactivityReport(req *InactivityReportRequest) (*InactivityReport, error) {
+	dbWorkspace, err := s.getOwnedWorkspace(req.WorkspaceID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	days := req.InactivityDays
+	if days == 0 {
+		days = dbWorkspace.InactivityDays
+	}
+	if days <= 0 || days > MaxInactivityDays {
+		return nil, fmt.Errorf("%w: the workspace has no inactivity policy, preview one with 1 to %d days", ErrInvalidInactivityPolicy, MaxInactivityDays)
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultInactivityReportLimit
+	}
+	if limit > maxInactivityReportLimit {
+		limit = maxInactivityReportLimit
+	}
+
+	dbURLs, err := s.db.ListInactiveURLs(req.WorkspaceID, days, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list inactive URLs: %w", err)
+	}
+	total, err := s.db.CountInactiveURLs(req.WorkspaceID, days)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count inactive URLs: %w", err)
+	}
+
+	urls := make([]URL, len(dbURLs))
+	for i := range dbURLs {
+		urls[i] = *s.dbToDomainURL(&dbURLs[i])
+	}
+
+	return &InactivityReport{
+		WorkspaceID:    req.WorkspaceID,
+		InactivityDays: days,
+		DryRun:         dbWorkspace.InactivityDryRun,
+		TotalCount:     total,
+		URLs:           urls,
+	}, nil
+}
+
+// ReviveURL reactivates a link archived for inactivity; its inactivity clock
+// starts over
+func (s *URLService) ReviveURL(shortDomain, shortCode, userID string) (*URL, error) {
+	if err := s.db.ReviveURL(shortDomain, shortCode, userID); err != nil {
+		return nil, ErrURLNotFound
+	}
+	s.invalidateURLCache(shortDomain, shortCode)
+
+	return s.GetURL(shortDomain, shortCode, userID)
+}
+
+// SweepInactivity archives the links that their workspace's inactivity policy
+// applies to, in batches, and evicts them from the cache. Workspaces in dry
+// run only get the number of links that would be archived.
+func (s *URLService) SweepInactivity(ctx context.Context) (*InactivitySweepResult, error) {
+	result := &InactivitySweepResult{DryRun: make(map[string]int64)}
+
+	counts, err := s.db.CountInactiveURLsInDryRun()
+	if err != nil {
+		return result, fmt.Errorf("failed to count inactive URLs in dry run: %w", err)
+	}
+	for _, count := range counts {
+		result.DryRun[count.WorkspaceID] = count.Count
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		archived, err := s.db.ArchiveInactiveURLs(inactivitySweepBatchSize)
+		if err != nil {
+			return result, fmt.Errorf("failed to archive inactive URLs: %w", err)
+		}
+		for _, link := range archived {
+			s.invalidateURLCache(link.Domain, link.ShortCode)
+			result.Archived = append(result.Archived, toArchivedLink(link))
+		}
+
+		if len(archived) < inactivitySweepBatchSize {
+			return result, nil
+		}
+	}
+}
+
+// toArchivedLink converts an archived database link
+func toArchivedLink(link database.ArchivedLink) ArchivedLink {
+	return ArchivedLink{Domain: link.Domain, ShortCode: link.ShortCode, WorkspaceID: link.WorkspaceID}
+}
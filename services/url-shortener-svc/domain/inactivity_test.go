@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetInactivityPolicyValidation(t *testing.T) {
+	service := &URLService{}
+
+	_, err := service.SetInactivityPolicy("marketing", "user123", -1, false)
+	assert.ErrorIs(t, err, ErrInvalidInactivityPolicy)
+	_, err = service.SetInactivityPolicy("marketing", "user123", MaxInactivityDays+1, true)
+	assert.ErrorIs(t, err, ErrInvalidInactivityPolicy)
+}
+
+func TestSearchStatusArchived(t *testing.T) {
+	filter, err := urlSearchFilter(&SearchURLsRequest{UserID: "user123", Status: "Archived"})
+	assert.NoError(t, err)
+	assert.Equal(t, SearchStatusArchived, filter.Status)
+}
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Interstitial modes (per link, admin controlled)
+const (
+	InterstitialAuto   = "auto"   // decided by the domain review list and account trust
+	InterstitialAlways = "always" // always warn before leaving
+	InterstitialNever  = "never"  // never warn
+)
+
+// Domain review statuses (per domain, admin controlled)
+const (
+	DomainStatusReview  = "review"  // warn before leaving to this domain
+	DomainStatusTrusted = "trusted" // never warn for this domain in auto mode
+)
+
+// Untrusted account rule (business rule): links created recently by accounts
+// whose first link is recent get a warning page in auto mode
+const (
+	newLinkWindow    = 24 * time.Hour
+	newAccountWindow = 7 * 24 * time.Hour
+)
+
+// ValidateInterstitialMode checks an interstitial mode value
+func ValidateInterstitialMode(mode string) error {
+	switch mode {
+	case InterstitialAuto, InterstitialAlways, InterstitialNever:
+		return nil
+	}
+	return ErrInvalidInterstitialMode
+}
+
+// ValidateDomainStatus checks a domain review status value
+func ValidateDomainStatus(status string) error {
+	switch status {
+	case DomainStatusReview, DomainStatusTrusted:
+		return nil
+	}
+	return ErrInvalidDomainReview
+}
+
+// NeedsInterstitial decides whether visitors see a warning page before being redirected
+func (u *URL) NeedsInterstitial(now time.Time) bool {
+	switch u.InterstitialMode {
+	case InterstitialAlways:
+		return true
+	case InterstitialNever:
+		return false
+	}
+
+	switch u.DomainStatus {
+	case DomainStatusReview:
+		return true
+	case DomainStatusTrusted:
+		return false
+	}
+
+	// Newly created link from an untrusted (new) account
+	if u.OwnerSince == nil {
+		return false
+	}
+	return now.Sub(u.CreatedAt) < newLinkWindow && now.Sub(*u.OwnerSince) < newAccountWindow
+}
+
+// NormalizeDomain lowercases a domain and strips scheme, port, path and a leading "www."
+func NormalizeDomain(domain string) string {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	if strings.Contains(domain, "://") {
+		domain = DestinationHost(domain)
+	}
+	if i := strings.IndexAny(domain, ":/"); i >= 0 {
+		domain = domain[:i]
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(domain, "www."), ".")
+}
+
+// DestinationHost returns the normalized host of a destination URL
+func DestinationHost(longURL string) string {
+	parsedURL, err := url.Parse(longURL)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(strings.ToLower(parsedURL.Hostname()), "www.")
+}
+
+// CandidateDomains lists a host and its parent domains, most specific first,
+// so a review of "example.com" also covers "cdn.example.com"
+func CandidateDomains(host string) []string {
+	var candidates []string
+	for host != "" && strings.Contains(host, ".") {
+		candidates = append(candidates, host)
+		_, host, _ = strings.Cut(host, ".")
+	}
+	return candidates
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestURLNeedsInterstitial(t *testing.T) {
+	now := time.Now()
+	newAccount := now.Add(-48 * time.Hour)
+	oldAccount := now.Add(-30 * 24 * time.Hour)
+
+	// Mode overrides
+	assert.True(t, (&URL{InterstitialMode: InterstitialAlways, DomainStatus: DomainStatusTrusted}).NeedsInterstitial(now))
+	assert.False(t, (&URL{InterstitialMode: InterstitialNever, DomainStatus: DomainStatusReview}).NeedsInterstitial(now))
+
+	// Domain review list
+	assert.True(t, (&URL{InterstitialMode: InterstitialAuto, DomainStatus: DomainStatusReview, CreatedAt: oldAccount, OwnerSince: &oldAccount}).NeedsInterstitial(now))
+	assert.False(t, (&URL{InterstitialMode: InterstitialAuto, DomainStatus: DomainStatusTrusted, CreatedAt: now, OwnerSince: &newAccount}).NeedsInterstitial(now))
+
+	// New link from a new account
+	assert.True(t, (&URL{InterstitialMode: InterstitialAuto, CreatedAt: now.Add(-time.Hour), OwnerSince: &newAccount}).NeedsInterstitial(now))
+	assert.False(t, (&URL{InterstitialMode: InterstitialAuto, CreatedAt: now.Add(-time.Hour), OwnerSince: &oldAccount}).NeedsInterstitial(now))
+	assert.False(t, (&URL{InterstitialMode: InterstitialAuto, CreatedAt: now.Add(-25 * time.Hour), OwnerSince: &newAccount}).NeedsInterstitial(now))
+	assert.False(t, (&URL{InterstitialMode: InterstitialAuto, CreatedAt: now}).NeedsInterstitial(now))
+}
+
+func TestNormalizeDomain(t *testing.T) {
+	assert.Equal(t, "example.com", NormalizeDomain("Example.COM"))
+	assert.Equal(t, "example.com", NormalizeDomain("https://www.example.com/path?q=1"))
+	assert.Equal(t, "cdn.example.com", NormalizeDomain("cdn.example.com:8443/x"))
+	assert.Equal(t, "example.com", NormalizeDomain(" example.com. "))
+}
+
+func TestCandidateDomains(t *testing.T) {
+	assert.Equal(t, []string{"a.cdn.example.com", "cdn.example.com", "example.com"}, CandidateDomains("a.cdn.example.com"))
+	assert.Equal(t, []string{"example.com"}, CandidateDomains("example.com"))
+	assert.Empty(t, CandidateDomains("localhost"))
+	assert.Empty(t, CandidateDomains(""))
+}
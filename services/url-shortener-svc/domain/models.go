@@ -8,6 +8,7 @@ import (
 // URL represents the core URL entity from HLD design
 type URL struct {
 	ID           int64             `json:"id" db:"id"`
+	Domain       string            `json:"domain,omitempty" db:"domain"` // branded short domain, empty for the default domain
 	ShortCode    string            `json:"short_code" db:"short_code"`
 	LongURL      string            `json:"long_url" db:"long_url"`
 	UserID       string            `json:"user_id" db:"user_id"`
@@ -17,42 +18,129 @@ type URL struct {
 	LastAccessed *time.Time        `json:"last_accessed" db:"last_accessed"`
 	IsActive     bool              `json:"is_active" db:"is_active"`
 	Metadata     map[string]string `json:"metadata" db:"metadata"`
+	WorkspaceID  string            `json:"workspace_id,omitempty" db:"workspace_id"`
+	UTMTemplate  UTMTemplate       `json:"utm_template,omitempty" db:"utm_template"`
+	PasswordHash string            `json:"-" db:"password_hash"`
+	ActivatesAt  *time.Time        `json:"activates_at,omitempty" db:"activates_at"`
+	FallbackURL  string            `json:"fallback_url,omitempty" db:"fallback_url"`
+	MaxClicks    int64             `json:"max_clicks,omitempty" db:"max_clicks"` // 0 for unlimited
+
+	InterstitialMode string     `json:"interstitial_mode" db:"interstitial_mode"`
+	DomainStatus     string     `json:"-" db:"-"` // review status of the destination domain (resolved at redirect time)
+	OwnerSince       *time.Time `json:"-" db:"-"` // when the owning account created its first link
+
+	DisabledReason string     `json:"disabled_reason,omitempty" db:"disabled_reason"` // safety reason code when flagged
+	DisabledAt     *time.Time `json:"disabled_at,omitempty" db:"disabled_at"`
+
+	Preview         LinkPreview `json:"preview,omitempty" db:"link_preview"`              // scraped from the destination
+	PreviewOverride LinkPreview `json:"preview_override,omitempty" db:"preview_override"` // set by the owner
+
+	Tags     []string `json:"tags,omitempty" db:"tags"`
+	FolderID int64    `json:"folder_id,omitempty" db:"folder_id"` // 0 when the link is not in a folder
+
+	DeletedAt *time.Time `json:"deleted_at,omitempty" db:"deleted_at"` // set while the link is in the trash
+	PurgeAt   *time.Time `json:"purge_at,omitempty" db:"-"`            // when the trash retention purges it
+
+	InactivityExempt bool       `json:"inactivity_exempt,omitempty" db:"inactivity_exempt"` // never archived for inactivity
+	ArchivedAt       *time.Time `json:"archived_at,omitempty" db:"archived_at"`             // set while archived for inactivity
+}
+
+// Workspace groups links that share defaults such as a UTM template
+type Workspace struct {
+	ID          string      `json:"id" db:"id"`
+	Name        string      `json:"name" db:"name"`
+	OwnerID     string      `json:"owner_id" db:"owner_id"`
+	UTMTemplate UTMTemplate `json:"utm_template" db:"utm_template"`
+	Plan        string      `json:"plan" db:"plan"` // decides the custom alias rules
+	CreatedAt   time.Time   `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at" db:"updated_at"`
+
+	InactivityDays   int  `json:"inactivity_days" db:"inactivity_days"` // archive links not clicked for this many days, 0 for never
+	InactivityDryRun bool `json:"inactivity_dry_run" db:"inactivity_dry_run"`
+}
+
+// DomainReview is an admin decision about warning visitors before they leave to a domain
+type DomainReview struct {
+	Domain    string    `json:"domain" db:"domain"`
+	Status    string    `json:"status" db:"status"`
+	Reason    string    `json:"reason" db:"reason"`
+	UpdatedBy string    `json:"updated_by" db:"updated_by"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
 }
 
 // CreateURLRequest represents the business logic request for creating a short URL
 type CreateURLRequest struct {
-	LongURL        string            `json:"long_url"`
-	CustomAlias    string            `json:"custom_alias,omitempty"`
-	ExpirationTime *time.Time        `json:"expiration_time,omitempty"`
-	UserID         string            `json:"user_id"`
-	Metadata       map[string]string `json:"metadata,omitempty"`
+	LongURL         string            `json:"long_url"`
+	CustomAlias     string            `json:"custom_alias,omitempty"`
+	ExpirationTime  *time.Time        `json:"expiration_time,omitempty"`
+	UserID          string            `json:"user_id"`
+	Metadata        map[string]string `json:"metadata,omitempty"`
+	WorkspaceID     string            `json:"workspace_id,omitempty"`
+	UTMTemplate     UTMTemplate       `json:"utm_template,omitempty"`
+	Password        string            `json:"password,omitempty"`
+	ActivationTime  *time.Time        `json:"activation_time,omitempty"`
+	FallbackURL     string            `json:"fallback_url,omitempty"`
+	MaxClicks       int64             `json:"max_clicks,omitempty"`
+	PreviewOverride LinkPreview       `json:"preview_override,omitempty"`
+	Domain          string            `json:"domain,omitempty"`    // branded short domain of the workspace, empty for the default domain
+	Tags            []string          `json:"tags,omitempty"`      // created for the user when missing
+	FolderID        int64             `json:"folder_id,omitempty"` // one of the user's folders, 0 for none
 }
 
 // UpdateURLRequest represents the business logic request for updating a URL
 type UpdateURLRequest struct {
+	Domain            string            `json:"domain,omitempty"`
 	ShortCode         string            `json:"short_code"`
 	UserID            string            `json:"user_id"`
 	NewLongURL        string            `json:"new_long_url,omitempty"`
 	NewExpirationTime *time.Time        `json:"new_expiration_time,omitempty"`
 	Metadata          map[string]string `json:"metadata,omitempty"`
+	UTMTemplate       UTMTemplate       `json:"utm_template,omitempty"`
+	NewPassword       string            `json:"new_password,omitempty"`
+	ClearPassword     bool              `json:"clear_password,omitempty"`
+	NewActivationTime *time.Time        `json:"new_activation_time,omitempty"`
+	NewFallbackURL    string            `json:"new_fallback_url,omitempty"`
+	NewMaxClicks      int64             `json:"new_max_clicks,omitempty"`
+	ClearMaxClicks    bool              `json:"clear_max_clicks,omitempty"`
+	PreviewOverride   *LinkPreview      `json:"preview_override,omitempty"` // nil leaves the overrides unchanged
+	Tags              []string          `json:"tags,omitempty"`             // replaces the tags; nil leaves them unchanged
+	ClearTags         bool              `json:"clear_tags,omitempty"`
+	NewFolderID       int64             `json:"new_folder_id,omitempty"`     // moves the link into one of the user's folders
+	ClearFolder       bool              `json:"clear_folder,omitempty"`      // takes the link out of its folder
+	InactivityExempt  *bool             `json:"inactivity_exempt,omitempty"` // nil leaves the exemption unchanged
+}
+
+// UpsertWorkspaceRequest represents the business logic request for saving a workspace
+type UpsertWorkspaceRequest struct {
+	ID          string      `json:"id"`
+	Name        string      `json:"name"`
+	OwnerID     string      `json:"owner_id"`
+	UTMTemplate UTMTemplate `json:"utm_template"`
 }
 
 // GetUserURLsRequest represents pagination and filtering for user URLs
 type GetUserURLsRequest struct {
-	UserID    string `json:"user_id"`
-	Page      int32  `json:"page"`
-	PageSize  int32  `json:"page_size"`
-	SortBy    string `json:"sort_by"`
-	SortOrder string `json:"sort_order"`
+	UserID            string `json:"user_id"`
+	Page              int32  `json:"page"` // deprecated: use Cursor
+	PageSize          int32  `json:"page_size"`
+	Cursor            string `json:"cursor,omitempty"` // next_cursor or prev_cursor of a previous page
+	SortBy            string `json:"sort_by"`          // created_at (default), click_count, last_accessed or alias
+	SortOrder         string `json:"sort_order"`       // desc (default) or asc
+	Tag               string `json:"tag,omitempty"`
+	FolderID          int64  `json:"folder_id,omitempty"`
+	IncludeSubfolders bool   `json:"include_subfolders,omitempty"`
 }
 
 // GetUserURLsResponse represents paginated user URLs response
 type GetUserURLsResponse struct {
-	URLs       []URL `json:"urls"`
-	TotalCount int32 `json:"total_count"`
-	Page       int32 `json:"page"`
-	PageSize   int32 `json:"page_size"`
-	HasNext    bool  `json:"has_next"`
+	URLs       []URL  `json:"urls"`
+	TotalCount int32  `json:"total_count"`
+	Page       int32  `json:"page"` // 0 when paging with cursors
+	PageSize   int32  `json:"page_size"`
+	HasNext    bool   `json:"has_next"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
 }
 
 // Domain-specific errors (from HLD design)
@@ -60,9 +148,51 @@ var (
 	ErrInvalidURL       = errors.New("invalid URL format")
 	ErrURLNotFound      = errors.New("URL not found")
 	ErrURLExpired       = errors.New("URL has expired")
+	ErrURLNotYetActive  = errors.New("URL is not active yet")
+	ErrURLExhausted     = errors.New("URL has reached its click limit")
 	ErrUnauthorized     = errors.New("unauthorized access to URL")
 	ErrCustomAliasUsed  = errors.New("custom alias already exists")
 	ErrInvalidShortCode = errors.New("invalid short code format")
+
+	ErrInvalidUTMTemplate = errors.New("invalid UTM template")
+	ErrWorkspaceNotFound  = errors.New("workspace not found")
+	ErrInvalidPassword    = errors.New("password must be between 4 and 72 characters")
+	ErrInvalidActivation  = errors.New("activation time must be before expiration time")
+	ErrInvalidMaxClicks   = errors.New("max clicks must be positive")
+
+	ErrInvalidInterstitialMode = errors.New("interstitial mode must be auto, always or never")
+	ErrInvalidDomainReview     = errors.New("domain review needs a domain and a status of review or trusted")
+	ErrUnsafeURL               = errors.New("destination URL was flagged as unsafe")
+	ErrInvalidLinkPreview      = errors.New("invalid link preview")
+	ErrInvalidQRCode           = errors.New("invalid QR code options")
+
+	ErrInvalidBrandedDomain  = errors.New("invalid branded domain")
+	ErrBrandedDomainTaken    = errors.New("branded domain is already registered")
+	ErrBrandedDomainNotFound = errors.New("branded domain not found in workspace")
+	ErrBrandedDomainInUse    = errors.New("branded domain still has active links")
+
+	ErrUnknownPlan              = errors.New("unknown workspace plan")
+	ErrInvalidSuggestionRequest = errors.New("alias suggestions need an alias or a destination URL")
+
+	ErrInvalidSort    = errors.New("sort_by must be created_at, click_count, last_accessed or alias and sort_order asc or desc")
+	ErrInvalidTag     = errors.New("invalid tag")
+	ErrTagNotFound    = errors.New("tag not found")
+	ErrTagExists      = errors.New("tag already exists")
+	ErrInvalidFolder  = errors.New("invalid folder")
+	ErrFolderNotFound = errors.New("folder not found")
+	ErrFolderExists   = errors.New("folder already exists")
+
+	ErrInvalidSearch = errors.New("invalid search")
+	ErrInvalidCursor = errors.New("invalid cursor")
+
+	ErrInvalidInactivityPolicy = errors.New("invalid inactivity policy")
+
+	ErrRevisionNotFound = errors.New("revision not found")
+
+	ErrInvalidWebhook          = errors.New("invalid webhook")
+	ErrWebhookNotFound         = errors.New("webhook not found")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+	ErrWebhookDisabled         = errors.New("webhook is disabled")
 )
 
 // IsExpired checks if the URL has expired (business rule from HLD)
@@ -73,11 +203,48 @@ func (u *URL) IsExpired() bool {
 	return time.Now().After(*u.ExpiresAt)
 }
 
+// IsNotYetActive checks if the URL is scheduled to go live in the future (business rule)
+func (u *URL) IsNotYetActive() bool {
+	if u.ActivatesAt == nil {
+		return false
+	}
+	return time.Now().Before(*u.ActivatesAt)
+}
+
+// IsClickLimited checks if the URL stops working after a number of clicks (business rule)
+func (u *URL) IsClickLimited() bool {
+	return u.MaxClicks > 0
+}
+
+// IsExhausted checks if a click-limited URL has used up all of its clicks
+func (u *URL) IsExhausted() bool {
+	return u.IsClickLimited() && u.ClickCount >= u.MaxClicks
+}
+
+// CacheTTL caps maxTTL so a cached entry never outlives the next window boundary
+// (activation or expiration), after which the redirect outcome changes
+func (u *URL) CacheTTL(maxTTL time.Duration) time.Duration {
+	ttl := maxTTL
+	now := time.Now()
+	if u.ActivatesAt != nil && u.ActivatesAt.After(now) && u.ActivatesAt.Sub(now) < ttl {
+		ttl = u.ActivatesAt.Sub(now)
+	}
+	if u.ExpiresAt != nil && u.ExpiresAt.Sub(now) < ttl {
+		ttl = u.ExpiresAt.Sub(now)
+	}
+	return ttl
+}
+
 // CanAccess checks if a user can access this URL (authorization business rule)
 func (u *URL) CanAccess(userID string) bool {
 	return u.UserID == userID
 }
 
+// RequiresPassword checks if the URL is password protected (business rule)
+func (u *URL) RequiresPassword() bool {
+	return u.PasswordHash != ""
+}
+
 // IsValidForRedirect checks if URL is valid for redirect (business rule from HLD)
 func (u *URL) IsValidForRedirect() error {
 	if !u.IsActive {
@@ -86,5 +253,19 @@ func (u *URL) IsValidForRedirect() error {
 	if u.IsExpired() {
 		return ErrURLExpired
 	}
+	if u.IsExhausted() {
+		return ErrURLExhausted
+	}
+	if u.IsNotYetActive() {
+		return ErrURLNotYetActive
+	}
+	return nil
+}
+
+// validateActivationWindow checks that a scheduled link goes live before it expires (business rule)
+func validateActivationWindow(activatesAt, expiresAt *time.Time) error {
+	if activatesAt != nil && expiresAt != nil && !activatesAt.Before(*expiresAt) {
+		return ErrInvalidActivation
+	}
 	return nil
 }
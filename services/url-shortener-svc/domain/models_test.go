@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidForRedirectActivationWindow(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	assert.NoError(t, (&URL{IsActive: true}).IsValidForRedirect())
+	assert.NoError(t, (&URL{IsActive: true, ActivatesAt: &past}).IsValidForRedirect())
+	assert.Equal(t, ErrURLNotYetActive, (&URL{IsActive: true, ActivatesAt: &future}).IsValidForRedirect())
+	assert.Equal(t, ErrURLExpired, (&URL{IsActive: true, ExpiresAt: &past}).IsValidForRedirect())
+}
+
+func TestURLCacheTTL(t *testing.T) {
+	maxTTL := 24 * time.Hour
+	activatesAt := time.Now().Add(time.Hour)
+	expiresAt := time.Now().Add(2 * time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	assert.Equal(t, maxTTL, (&URL{}).CacheTTL(maxTTL))
+	assert.Equal(t, maxTTL, (&URL{ActivatesAt: &past}).CacheTTL(maxTTL))
+	assert.InDelta(t, time.Hour, (&URL{ActivatesAt: &activatesAt, ExpiresAt: &expiresAt}).CacheTTL(maxTTL), float64(time.Second))
+	assert.InDelta(t, 2*time.Hour, (&URL{ExpiresAt: &expiresAt}).CacheTTL(maxTTL), float64(time.Second))
+	assert.LessOrEqual(t, (&URL{ExpiresAt: &past}).CacheTTL(maxTTL), time.Duration(0))
+}
+
+func TestIsValidForRedirectClickLimit(t *testing.T) {
+	assert.NoError(t, (&URL{IsActive: true, ClickCount: 100}).IsValidForRedirect())
+	assert.NoError(t, (&URL{IsActive: true, MaxClicks: 1}).IsValidForRedirect())
+	assert.Equal(t, ErrURLExhausted, (&URL{IsActive: true, MaxClicks: 1, ClickCount: 1}).IsValidForRedirect())
+	assert.True(t, (&URL{MaxClicks: 3, ClickCount: 5}).IsExhausted())
+}
@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/go-systems-lab/go-url-shortener/utils/database"
+)
+
+// Page sizes of list RPCs
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// pageCursor is the decoded form of the opaque cursors handed to clients: a
+// database cursor and the ordering it belongs to, so that a cursor is not
+// replayed against a list sorted differently
+type pageCursor struct {
+	Order    string `json:"o"`
+	Value    string `json:"v"`
+	ID       int64  `json:"i"`
+	Backward bool   `json:"b,omitempty"`
+}
+
+// encodeCursor turns a database cursor into an opaque page cursor
+func encodeCursor(order string, cursor database.Cursor) string {
+	data, _ := json.Marshal(pageCursor{Order: order, Value: cursor.Value, ID: cursor.ID, Backward: cursor.Backward})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor reads a page cursor made for the ordering back
+func decodeCursor(token, order string) (*database.Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var cursor pageCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.Order != order || cursor.ID <= 0 {
+		return nil, ErrInvalidCursor
+	}
+	return &database.Cursor{Value: cursor.Value, ID: cursor.ID, Backward: cursor.Backward}, nil
+}
+
+// pager pages a list with cursors or, for clients that have not moved to
+// cursors yet, with page numbers (deprecated: OFFSET gets slower the deeper
+// the page)
+type pager struct {
+	order  string
+	size   int
+	cursor *database.Cursor
+	offset int
+}
+
+// newPager validates the paging of a list request; a cursor wins over a page number
+func newPager(order string, page, pageSize int32, token string) (*pager, error) {
+	if pageSize <= 0 || pageSize > maxPageSize {
+		pageSize = defaultPageSize
+	}
+	p := &pager{order: order, size: int(pageSize)}
+	if token != "" {
+		cursor, err := decodeCursor(token, order)
+		if err != nil {
+			return nil, err
+		}
+		p.cursor = cursor
+	} else if page > 1 {
+		p.offset = int(page-1) * p.size
+	}
+	return p, nil
+}
+
+// limit is the number of rows to read: one more than a page tells whether
+// another page follows
+func (p *pager) limit() int {
+	return p.size + 1
+}
+
+// page returns the page number of a page number request, 0 for cursor requests
+func (p *pager) page() int32 {
+	if p.cursor != nil {
+		return 0
+	}
+	return int32(p.offset/p.size) + 1
+}
+
+// pageCursors holds the cursors of the pages around a page; empty when there is none
+type pageCursors struct {
+	next    string
+	prev    string
+	hasNext bool
+}
+
+// paginate trims the extra row off the rows read for a page and returns the
+// cursors of the neighbouring pages
+func paginate[T any](p *pager, rows []T, cursorOf func(row *T, backward bool) database.Cursor) ([]T, pageCursors) {
+	backward := p.cursor != nil && p.cursor.Backward
+	more := len(rows) > p.size
+	if more {
+		if backward {
+			rows = rows[len(rows)-p.size:]
+		} else {
+			rows = rows[:p.size]
+		}
+	}
+
+	hasNext, hasPrev := more, p.cursor != nil || p.offset > 0
+	if backward {
+		hasNext, hasPrev = true, more
+	}
+
+	cursors := pageCursors{hasNext: hasNext}
+	if len(rows) > 0 {
+		if hasNext {
+			cursors.next = encodeCursor(p.order, cursorOf(&rows[len(rows)-1], false))
+		}
+		if hasPrev {
+			cursors.prev = encodeCursor(p.order, cursorOf(&rows[0], true))
+		}
+	}
+	return rows, cursors
+}
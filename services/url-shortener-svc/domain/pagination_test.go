@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-systems-lab/go-url-shortener/utils/database"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	cursor := database.Cursor{Value: "2024-05-01T10:00:00Z", ID: 42, Backward: true}
+	token := encodeCursor("created_at:desc", cursor)
+
+	decoded, err := decodeCursor(token, "created_at:desc")
+	require.NoError(t, err)
+	assert.Equal(t, cursor, *decoded)
+
+	_, err = decodeCursor(token, "created_at:asc")
+	assert.ErrorIs(t, err, ErrInvalidCursor, "a cursor only pages the ordering it came from")
+	_, err = decodeCursor("not a cursor", "created_at:desc")
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+	_, err = decodeCursor(encodeCursor("search", database.Cursor{Value: "1"}), "search")
+	assert.ErrorIs(t, err, ErrInvalidCursor, "cursors need a row id")
+}
+
+func TestNewPager(t *testing.T) {
+	p, err := newPager("search", 3, 10, "")
+	require.NoError(t, err)
+	assert.Equal(t, 20, p.offset)
+	assert.Equal(t, 11, p.limit())
+	assert.Equal(t, int32(3), p.page())
+
+	p, err = newPager("search", 0, 500, "")
+	require.NoError(t, err)
+	assert.Equal(t, defaultPageSize, p.size)
+	assert.Equal(t, int32(1), p.page())
+
+	token := encodeCursor("search", database.Cursor{Value: "0.5", ID: 7})
+	p, err = newPager("search", 3, 10, token)
+	require.NoError(t, err)
+	assert.Equal(t, 0, p.offset, "a cursor wins over a page number")
+	assert.Equal(t, int32(0), p.page())
+
+	_, err = newPager("search", 1, 10, "bogus")
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestPaginate(t *testing.T) {
+	cursorOf := func(id *int64, backward bool) database.Cursor {
+		return database.Cursor{Value: "v", ID: *id, Backward: backward}
+	}
+	decode := func(token string) database.Cursor {
+		cursor, err := decodeCursor(token, "test")
+		require.NoError(t, err)
+		return *cursor
+	}
+
+	// First page: the extra row means another page follows, nothing before it
+	p := &pager{order: "test", size: 2}
+	rows, cursors := paginate(p, []int64{1, 2, 3}, cursorOf)
+	assert.Equal(t, []int64{1, 2}, rows)
+	assert.True(t, cursors.hasNext)
+	assert.Equal(t, database.Cursor{Value: "v", ID: 2}, decode(cursors.next))
+	assert.Empty(t, cursors.prev)
+
+	// Last page after a cursor
+	p = &pager{order: "test", size: 2, cursor: &database.Cursor{ID: 2}}
+	rows, cursors = paginate(p, []int64{3}, cursorOf)
+	assert.Equal(t, []int64{3}, rows)
+	assert.False(t, cursors.hasNext)
+	assert.Empty(t, cursors.next)
+	assert.Equal(t, database.Cursor{Value: "v", ID: 3, Backward: true}, decode(cursors.prev))
+
+	// Backward page: the extra row is the earliest one
+	p = &pager{order: "test", size: 2, cursor: &database.Cursor{ID: 5, Backward: true}}
+	rows, cursors = paginate(p, []int64{2, 3, 4}, cursorOf)
+	assert.Equal(t, []int64{3, 4}, rows)
+	assert.True(t, cursors.hasNext)
+	assert.Equal(t, int64(4), decode(cursors.next).ID)
+	assert.Equal(t, int64(3), decode(cursors.prev).ID)
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Link password constraints (bcrypt only considers the first 72 bytes)
+const (
+	minLinkPasswordLength = 4
+	maxLinkPasswordLength = 72
+)
+
+// HashLinkPassword validates and hashes a link password for storage
+func HashLinkPassword(password string) (string, error) {
+	if len(password) < minLinkPasswordLength || len(password) > maxLinkPasswordLength {
+		return "", ErrInvalidPassword
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// CheckLinkPassword reports whether password matches the stored hash
+func CheckLinkPassword(hash, password string) bool {
+	if hash == "" {
+		return false
+	}
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
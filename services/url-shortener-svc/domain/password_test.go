@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashLinkPassword(t *testing.T) {
+	hash, err := HashLinkPassword("s3cret-pass")
+	assert.NoError(t, err)
+	assert.NotEqual(t, "s3cret-pass", hash)
+
+	assert.True(t, CheckLinkPassword(hash, "s3cret-pass"))
+	assert.False(t, CheckLinkPassword(hash, "wrong-pass"))
+	assert.False(t, CheckLinkPassword("", "s3cret-pass"))
+
+	_, err = HashLinkPassword("abc")
+	assert.Equal(t, ErrInvalidPassword, err)
+	_, err = HashLinkPassword(strings.Repeat("a", 73))
+	assert.Equal(t, ErrInvalidPassword, err)
+}
+
+func TestURLRequiresPassword(t *testing.T) {
+	assert.False(t, (&URL{}).RequiresPassword())
+	assert.True(t, (&URL{PasswordHash: "$2a$10$hash"}).RequiresPassword())
+}
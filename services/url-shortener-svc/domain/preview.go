@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/go-systems-lab/go-url-shortener/utils/preview"
+)
+
+// previewFetchTimeout bounds the background scrape after a link is created or updated
+const previewFetchTimeout = 15 * time.Second
+
+// LinkPreview is the Open Graph / Twitter card information shown when a link is unfurled
+type LinkPreview struct {
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Image       string `json:"image,omitempty"`
+}
+
+// ParseLinkPreview decodes a preview stored as JSONB; invalid input yields an empty preview
+func ParseLinkPreview(raw string) LinkPreview {
+	var linkPreview LinkPreview
+	if raw != "" && raw != "{}" {
+		json.Unmarshal([]byte(raw), &linkPreview)
+	}
+	return linkPreview
+}
+
+// String encodes the preview for JSONB storage
+func (p LinkPreview) String() string {
+	if p.IsEmpty() {
+		return "{}"
+	}
+	data, _ := json.Marshal(p)
+	return string(data)
+}
+
+// IsEmpty reports whether the preview has no fields set
+func (p LinkPreview) IsEmpty() bool {
+	return p.Title == "" && p.Description == "" && p.Image == ""
+}
+
+// Validate checks owner-supplied preview overrides
+func (p LinkPreview) Validate() error {
+	if len([]rune(p.Title)) > preview.MaxTitleLength || len([]rune(p.Description)) > preview.MaxDescriptionLen {
+		return fmt.Errorf("%w: title or description is too long", ErrInvalidLinkPreview)
+	}
+	if p.Image != "" {
+		imageURL, err := url.Parse(p.Image)
+		if err != nil || (imageURL.Scheme != "http" && imageURL.Scheme != "https") || imageURL.Host == "" || len(p.Image) > preview.MaxImageURLLength {
+			return fmt.Errorf("%w: image must be an absolute http(s) URL", ErrInvalidLinkPreview)
+		}
+	}
+	return nil
+}
+
+// Override returns the preview with every non-empty field of override applied
+func (p LinkPreview) Override(override LinkPreview) LinkPreview {
+	if override.Title != "" {
+		p.Title = override.Title
+	}
+	if override.Description != "" {
+		p.Description = override.Description
+	}
+	if override.Image != "" {
+		p.Image = override.Image
+	}
+	return p
+}
+
+// EffectivePreview is what unfurl bots see: the scraped preview with the owner's overrides applied
+func (u *URL) EffectivePreview() LinkPreview {
+	return u.Preview.Override(u.PreviewOverride)
+}
+
+// refreshPreview scrapes the destination's metadata in the background and stores it on the link
+func (s *URLService) refreshPreview(shortDomain, shortCode, longURL string) {
+	if s.previews == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), previewFetchTimeout)
+		defer cancel()
+
+		metadata, err := s.previews.Fetch(ctx, longURL)
+		if err != nil {
+			fmt.Printf("Warning: Failed to fetch link preview for %s: %v\n", shortCode, err)
+			return
+		}
+
+		linkPreview := LinkPreview{
+			Title:       metadata.Title,
+			Description: metadata.Description,
+			Image:       metadata.Image,
+		}
+		if err := s.db.SetLinkPreview(shortDomain, shortCode, longURL, linkPreview.String()); err != nil {
+			fmt.Printf("Warning: Failed to store link preview for %s: %v\n", shortCode, err)
+			return
+		}
+		s.invalidateURLCache(shortDomain, shortCode)
+	}()
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinkPreviewRoundTrip(t *testing.T) {
+	assert.Equal(t, "{}", LinkPreview{}.String())
+	assert.True(t, ParseLinkPreview("{}").IsEmpty())
+	assert.True(t, ParseLinkPreview("not json").IsEmpty())
+
+	linkPreview := LinkPreview{Title: "Spring sale", Image: "https://cdn.example.com/sale.png"}
+	assert.Equal(t, linkPreview, ParseLinkPreview(linkPreview.String()))
+}
+
+func TestLinkPreviewValidate(t *testing.T) {
+	assert.NoError(t, LinkPreview{}.Validate())
+	assert.NoError(t, LinkPreview{Title: "Sale", Image: "https://cdn.example.com/sale.png"}.Validate())
+
+	invalid := []LinkPreview{
+		{Image: "/relative.png"},
+		{Image: "javascript:alert(1)"},
+		{Image: "ftp://example.com/a.png"},
+		{Title: strings.Repeat("a", 301)},
+		{Description: strings.Repeat("a", 1001)},
+	}
+	for _, linkPreview := range invalid {
+		assert.ErrorIs(t, linkPreview.Validate(), ErrInvalidLinkPreview, linkPreview)
+	}
+}
+
+func TestURLEffectivePreview(t *testing.T) {
+	url := &URL{
+		Preview:         LinkPreview{Title: "Scraped", Description: "Scraped description", Image: "https://example.com/a.png"},
+		PreviewOverride: LinkPreview{Title: "Owner title"},
+	}
+	assert.Equal(t, LinkPreview{
+		Title:       "Owner title",
+		Description: "Scraped description",
+		Image:       "https://example.com/a.png",
+	}, url.EffectivePreview())
+
+	assert.True(t, (&URL{}).EffectivePreview().IsEmpty())
+}
@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"net/url"
+	"time"
+
+	"github.com/go-systems-lab/go-url-shortener/utils/cache"
+	"github.com/go-systems-lab/go-url-shortener/utils/qr"
+)
+
+// QR code settings
+const (
+	QRSourceParam = "src"
+	QRSourceValue = "qr" // marks clicks that came from scanning a QR code
+	qrCacheTTL    = 24 * time.Hour
+	qrLogoTimeout = 5 * time.Second
+)
+
+// QRCodeRequest describes how to render the QR code of a link
+type QRCodeRequest struct {
+	Domain       string // short domain of the link; "" for the default
+	ShortCode    string
+	UserID       string
+	Format       string // png or svg
+	Size         int    // pixels; 0 for the default
+	Margin       *int   // quiet zone in modules; nil for the default
+	Level        string // L, M, Q or H
+	Foreground   string // hex colour
+	Background   string // hex colour
+	LogoURL      string // optional image placed in the centre
+	SourceMarker bool   // append ?src=qr so scans can be told apart from other clicks
+}
+
+// QRCode is a rendered QR code
+type QRCode struct {
+	ContentType string
+	Data        []byte
+	Content     string // the URL encoded in the symbol
+	ETag        string
+}
+
+// GetQRCode renders the QR code of a link the user owns. Rendered codes are
+// cached by content and options, so repeated requests skip the logo download.
+func (s *URLService) GetQRCode(ctx context.Context, req *QRCodeRequest) (*QRCode, error) {
+	if _, err := s.GetURL(req.Domain, req.ShortCode, req.UserID); err != nil {
+		return nil, err
+	}
+
+	opts, err := qrOptions(req)
+	if err != nil {
+		return nil, err
+	}
+	if req.LogoURL != "" {
+		if s.logos == nil {
+			return nil, fmt.Errorf("%w: logos are not enabled", ErrInvalidQRCode)
+		}
+		if err := s.validateURL(req.LogoURL); err != nil {
+			return nil, fmt.Errorf("%w: invalid logo URL", ErrInvalidQRCode)
+		}
+		// Placeholder so Normalize applies the logo rules before the download
+		opts.Logo = image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	}
+	if err := opts.Normalize(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidQRCode, err)
+	}
+
+	content := ShortURL(req.Domain, req.ShortCode)
+	if req.SourceMarker {
+		content += "?" + url.Values{QRSourceParam: {QRSourceValue}}.Encode()
+	}
+
+	etag := qrETag(content, opts, req.LogoURL)
+	code := &QRCode{ContentType: opts.ContentType(), Content: content, ETag: etag}
+
+	cacheKey := cache.CacheKey("qr", etag)
+	if cached, found := s.cache.Get(cacheKey); found {
+		code.Data = []byte(cached)
+		return code, nil
+	}
+
+	if req.LogoURL != "" {
+		logoCtx, cancel := context.WithTimeout(ctx, qrLogoTimeout)
+		defer cancel()
+		logo, err := s.logos.Fetch(logoCtx, req.LogoURL)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidQRCode, err)
+		}
+		opts.Logo = logo
+	}
+
+	code.Data, err = qr.Render(content, opts)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidQRCode, err)
+	}
+
+	if err := s.cache.Set(cacheKey, string(code.Data), qrCacheTTL); err != nil {
+		fmt.Printf("Warning: Failed to cache QR code for %s: %v\n", req.ShortCode, err)
+	}
+
+	return code, nil
+}
+
+// qrOptions converts a request into rendering options
+func qrOptions(req *QRCodeRequest) (qr.Options, error) {
+	opts := qr.Options{
+		Format: req.Format,
+		Size:   req.Size,
+		Margin: req.Margin,
+		Level:  qr.Level(req.Level),
+	}
+
+	var err error
+	if req.Foreground != "" {
+		if opts.Foreground, err = qr.ParseColor(req.Foreground); err != nil {
+			return opts, fmt.Errorf("%w: %v", ErrInvalidQRCode, err)
+		}
+	}
+	if req.Background != "" {
+		if opts.Background, err = qr.ParseColor(req.Background); err != nil {
+			return opts, fmt.Errorf("%w: %v", ErrInvalidQRCode, err)
+		}
+	}
+	return opts, nil
+}
+
+// qrETag identifies a rendering of content with normalized options
+func qrETag(content string, opts qr.Options, logoURL string) string {
+	key := fmt.Sprintf("%s|%s|%d|%d|%s|%s|%s|%s",
+		content, opts.Format, opts.Size, *opts.Margin, opts.Level,
+		qr.HexColor(opts.Foreground), qr.HexColor(opts.Background), logoURL)
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:16])
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQROptions(t *testing.T) {
+	opts, err := qrOptions(&QRCodeRequest{Format: "svg", Foreground: "#102030", Background: "fff"})
+	require.NoError(t, err)
+	require.NoError(t, opts.Normalize())
+	assert.Equal(t, "image/svg+xml", opts.ContentType())
+
+	_, err = qrOptions(&QRCodeRequest{Foreground: "blue"})
+	assert.ErrorIs(t, err, ErrInvalidQRCode)
+}
+
+func TestQRETag(t *testing.T) {
+	normalized := func(req *QRCodeRequest) string {
+		opts, err := qrOptions(req)
+		require.NoError(t, err)
+		require.NoError(t, opts.Normalize())
+		return qrETag("https://short.ly/abc123?src=qr", opts, req.LogoURL)
+	}
+
+	// Explicit defaults and omitted options render the same image
+	margin := 4
+	assert.Equal(t, normalized(&QRCodeRequest{}), normalized(&QRCodeRequest{Format: "PNG", Size: 256, Margin: &margin, Level: "m", Foreground: "#000"}))
+	assert.NotEqual(t, normalized(&QRCodeRequest{}), normalized(&QRCodeRequest{Format: "svg"}))
+	assert.NotEqual(t, normalized(&QRCodeRequest{}), normalized(&QRCodeRequest{LogoURL: "https://example.com/logo.png"}))
+}
@@ -0,0 +1,338 @@
+package domain
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-systems-lab/go-url-shortener/utils/database"
+)
+
+// FieldChange is a field a revision changed, with its values rendered as text
+// (empty when unset). Passwords only show as "set" or "none".
+type FieldChange struct {
+	Field    string `json:"field"`
+	OldValue string `json:"old_value"`
+	NewValue string `json:"new_value"`
+}
+
+// URLRevision is an immutable record of a change made to a link
+type URLRevision struct {
+	ID         int64         `json:"id"`
+	Revision   int           `json:"revision"` // 1, 2, ... per link
+	ChangedBy  string        `json:"changed_by"`
+	CreatedAt  time.Time     `json:"created_at"`
+	Changes    []FieldChange `json:"changes"`
+	RollbackOf int64         `json:"rollback_of,omitempty"` // the revision rolled back, 0 for edits
+}
+
+// GetURLRevisionsRequest represents the business logic request for a link's history
+type GetURLRevisionsRequest struct {
+	Domain    string `json:"domain,omitempty"`
+	ShortCode string `json:"short_code"`
+	UserID    string `json:"user_id"`
+	PageSize  int32  `json:"page_size"`
+	Cursor    string `json:"cursor,omitempty"`
+}
+
+// GetURLRevisionsResponse is a page of a link's revisions, newest first
+type GetURLRevisionsResponse struct {
+	Revisions  []URLRevision `json:"revisions"`
+	TotalCount int32         `json:"total_count"`
+	PageSize   int32         `json:"page_size"`
+	HasNext    bool          `json:"has_next"`
+	NextCursor string        `json:"next_cursor,omitempty"`
+	PrevCursor string        `json:"prev_cursor,omitempty"`
+}
+
+// revisionState is the part of a link UpdateURL can change, as recorded
+// before and after each revision
+type revisionState struct {
+	LongURL          string            `json:"long_url"`
+	ExpiresAt        *time.Time        `json:"expires_at,omitempty"`
+	ActivatesAt      *time.Time        `json:"activates_at,omitempty"`
+	FallbackURL      string            `json:"fallback_url,omitempty"`
+	MaxClicks        int64             `json:"max_clicks,omitempty"`
+	PasswordHash     string            `json:"password_hash,omitempty"`
+	Metadata         map[string]string `json:"metadata,omitempty"`
+	UTMTemplate      UTMTemplate       `json:"utm_template,omitempty"`
+	PreviewOverride  LinkPreview       `json:"preview_override"`
+	FolderID         int64             `json:"folder_id,omitempty"`
+	Tags             []string          `json:"tags,omitempty"`
+	InactivityExempt bool              `json:"inactivity_exempt,omitempty"`
+}
+
+// revisionStateOf captures the state of a link; it shares nothing with the row
+func revisionStateOf(dbURL *database.URLMapping) revisionState {
+	var metadata map[string]string
+	if dbURL.Metadata != "" && dbURL.Metadata != "{}" {
+		json.Unmarshal([]byte(dbURL.Metadata), &metadata)
+	}
+
+	return revisionState{
+		LongURL:          dbURL.LongURL,
+		ExpiresAt:        copyTime(dbURL.ExpiresAt),
+		ActivatesAt:      copyTime(dbURL.ActivatesAt),
+		FallbackURL:      dbURL.FallbackURL.String,
+		MaxClicks:        dbURL.MaxClicks.Int64,
+		PasswordHash:     dbURL.PasswordHash.String,
+		Metadata:         metadata,
+		UTMTemplate:      ParseUTMTemplate(dbURL.UTMTemplate),
+		PreviewOverride:  ParseLinkPreview(dbURL.PreviewOverride),
+		FolderID:         dbURL.FolderID.Int64,
+		Tags:             parseTags(dbURL.Tags),
+		InactivityExempt: dbURL.InactivityExempt,
+	}
+}
+
+// applyTo writes the state back onto a link
+func (st revisionState) applyTo(dbURL *database.URLMapping) {
+	metadata := "{}"
+	if len(st.Metadata) > 0 {
+		encoded, _ := json.Marshal(st.Metadata)
+		metadata = string(encoded)
+	}
+
+	dbURL.LongURL = st.LongURL
+	dbURL.ExpiresAt = nullTimeOf(st.ExpiresAt)
+	dbURL.ActivatesAt = nullTimeOf(st.ActivatesAt)
+	dbURL.FallbackURL = sql.NullString{String: st.FallbackURL, Valid: st.FallbackURL != ""}
+	dbURL.MaxClicks = sql.NullInt64{Int64: st.MaxClicks, Valid: st.MaxClicks > 0}
+	dbURL.PasswordHash = sql.NullString{String: st.PasswordHash, Valid: st.PasswordHash != ""}
+	dbURL.Metadata = metadata
+	dbURL.UTMTemplate = st.UTMTemplate.String()
+	dbURL.PreviewOverride = st.PreviewOverride.String()
+	dbURL.FolderID = nullFolderID(st.FolderID)
+	dbURL.Tags = tagsJSON(st.Tags)
+	dbURL.InactivityExempt = st.InactivityExempt
+}
+
+// fields renders the state field by field, in the order changes are listed
+func (st revisionState) fields() []FieldChange {
+	metadata := ""
+	if len(st.Metadata) > 0 {
+		encoded, _ := json.Marshal(st.Metadata)
+		metadata = string(encoded)
+	}
+	utm := ""
+	if len(st.UTMTemplate) > 0 {
+		utm = st.UTMTemplate.String()
+	}
+	preview := ""
+	if !st.PreviewOverride.IsEmpty() {
+		preview = st.PreviewOverride.String()
+	}
+	password := "none"
+	if st.PasswordHash != "" {
+		password = "set"
+	}
+	maxClicks, folderID := "", ""
+	if st.MaxClicks > 0 {
+		maxClicks = strconv.FormatInt(st.MaxClicks, 10)
+	}
+	if st.FolderID != 0 {
+		folderID = strconv.FormatInt(st.FolderID, 10)
+	}
+
+	return []FieldChange{
+		{Field: "long_url", NewValue: st.LongURL},
+		{Field: "expires_at", NewValue: formatRevisionTime(st.ExpiresAt)},
+		{Field: "activates_at", NewValue: formatRevisionTime(st.ActivatesAt)},
+		{Field: "fallback_url", NewValue: st.FallbackURL},
+		{Field: "max_clicks", NewValue: maxClicks},
+		{Field: "password", NewValue: password},
+		{Field: "metadata", NewValue: metadata},
+		{Field: "utm_template", NewValue: utm},
+		{Field: "preview_override", NewValue: preview},
+		{Field: "folder_id", NewValue: folderID},
+		{Field: "tags", NewValue: strings.Join(st.Tags, ",")},
+		{Field: "inactivity_exempt", NewValue: strconv.FormatBool(st.InactivityExempt)},
+	}
+}
+
+// diffRevisionStates lists the fields that differ between two states. A new
+// password counts as a change although both render as "set".
+func diffRevisionStates(before, after revisionState) []FieldChange {
+	oldFields, newFields := before.fields(), after.fields()
+
+	var changes []FieldChange
+	for i := range newFields {
+		changed := oldFields[i].NewValue != newFields[i].NewValue
+		if newFields[i].Field == "password" {
+			changed = before.PasswordHash != after.PasswordHash
+		}
+		if changed {
+			changes = append(changes, FieldChange{
+				Field:    newFields[i].Field,
+				OldValue: oldFields[i].NewValue,
+				NewValue: newFields[i].NewValue,
+			})
+		}
+	}
+	return changes
+}
+
+// saveURLChange saves a changed link. When a tracked field changed, the
+// revision recording it is saved in the same transaction.
+func (s *URLService) saveURLChange(dbURL *database.URLMapping, before revisionState, changedBy string, rollbackOf int64) error {
+	after := revisionStateOf(dbURL)
+	changes := diffRevisionStates(before, after)
+	if len(changes) == 0 {
+		return s.db.UpdateURL(dbURL)
+	}
+
+	changesJSON, _ := json.Marshal(changes)
+	beforeJSON, _ := json.Marshal(before)
+	afterJSON, _ := json.Marshal(after)
+	return s.db.UpdateURLWithRevision(dbURL, &database.URLRevision{
+		ChangedBy:   changedBy,
+		Changes:     string(changesJSON),
+		StateBefore: string(beforeJSON),
+		StateAfter:  string(afterJSON),
+		RollbackOf:  sql.NullInt64{Int64: rollbackOf, Valid: rollbackOf != 0},
+	})
+}
+
+// ListURLRevisions lists the revision history of a link, newest first
+func (s *URLService) ListURLRevisions(req *GetURLRevisionsRequest) (*GetURLRevisionsResponse, error) {
+	dbURL, err := s.getOwnedURL(req.Domain, req.ShortCode, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	pages, err := newPager("revisions", 0, req.PageSize, req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	dbRevisions, err := s.db.ListURLRevisions(dbURL.ID, pages.cursor, pages.limit(), pages.offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve URL revisions: %w", err)
+	}
+	total, err := s.db.CountURLRevisions(dbURL.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count URL revisions: %w", err)
+	}
+
+	dbRevisions, cursors := paginate(pages, dbRevisions, func(revision *database.URLRevision, backward bool) database.Cursor {
+		return database.URLRevisionCursor(revision, backward)
+	})
+	revisions := make([]URLRevision, len(dbRevisions))
+	for i := range dbRevisions {
+		revisions[i] = dbToDomainRevision(&dbRevisions[i])
+	}
+
+	return &GetURLRevisionsResponse{
+		Revisions:  revisions,
+		TotalCount: int32(total),
+		PageSize:   int32(pages.size),
+		HasNext:    cursors.hasNext,
+		NextCursor: cursors.next,
+		PrevCursor: cursors.prev,
+	}, nil
+}
+
+// RollbackURL restores a link to its state before a revision, recording the
+// rollback as a new revision. The destination is checked again like a new
+// link's, and a folder the owner no longer has is dropped.
+func (s *URLService) RollbackURL(shortDomain, shortCode, userID string, revisionID int64) (*URL, error) {
+	dbURL, err := s.getOwnedURL(shortDomain, shortCode, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	dbRevision, err := s.db.GetURLRevision(dbURL.ID, revisionID)
+	if err != nil {
+		return nil, ErrRevisionNotFound
+	}
+	var target revisionState
+	if err := json.Unmarshal([]byte(dbRevision.StateBefore), &target); err != nil {
+		return nil, fmt.Errorf("failed to read revision %d: %w", revisionID, err)
+	}
+
+	before := revisionStateOf(dbURL)
+	if target.LongURL != before.LongURL {
+		if err := s.checkDestination(target.LongURL); err != nil {
+			return nil, err
+		}
+	}
+	if target.FallbackURL != "" && target.FallbackURL != before.FallbackURL {
+		if err := s.checkDestination(target.FallbackURL); err != nil {
+			return nil, err
+		}
+	}
+	if target.FolderID != 0 && s.checkFolderOwner(dbURL.UserID, target.FolderID) != nil {
+		target.FolderID = 0
+	}
+
+	target.applyTo(dbURL)
+	if err := s.saveURLChange(dbURL, before, userID, dbRevision.ID); err != nil {
+		return nil, fmt.Errorf("failed to roll back URL: %w", err)
+	}
+	if strings.Join(target.Tags, ",") != strings.Join(before.Tags, ",") {
+		if err := s.db.SetURLTags(dbURL.ID, dbURL.UserID, target.Tags); err != nil {
+			return nil, fmt.Errorf("failed to restore tags: %w", err)
+		}
+	}
+
+	s.invalidateURLCache(dbURL.Domain, dbURL.ShortCode)
+	if target.LongURL != before.LongURL {
+		s.refreshPreview(dbURL.Domain, dbURL.ShortCode, dbURL.LongURL)
+	}
+
+	return s.dbToDomainURL(dbURL), nil
+}
+
+// getOwnedURL loads an active link of the user
+func (s *URLService) getOwnedURL(shortDomain, shortCode, userID string) (*database.URLMapping, error) {
+	dbURL, err := s.db.GetURLByShortCode(shortDomain, shortCode)
+	if err != nil {
+		return nil, ErrURLNotFound
+	}
+	if dbURL.UserID != userID {
+		return nil, ErrUnauthorized
+	}
+	return dbURL, nil
+}
+
+func dbToDomainRevision(dbRevision *database.URLRevision) URLRevision {
+	var changes []FieldChange
+	json.Unmarshal([]byte(dbRevision.Changes), &changes)
+
+	return URLRevision{
+		ID:         dbRevision.ID,
+		Revision:   dbRevision.Revision,
+		ChangedBy:  dbRevision.ChangedBy,
+		CreatedAt:  dbRevision.CreatedAt,
+		Changes:    changes,
+		RollbackOf: dbRevision.RollbackOf.Int64,
+	}
+}
+
+// copyTime returns a copy of a nullable timestamp, nil when unset
+func copyTime(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	value := t.Time
+	return &value
+}
+
+// nullTimeOf converts a timestamp (nil for none) into a nullable column value
+func nullTimeOf(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+// formatRevisionTime renders a timestamp of a revision, empty when unset
+func formatRevisionTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
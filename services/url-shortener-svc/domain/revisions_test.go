@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-systems-lab/go-url-shortener/utils/database"
+)
+
+func TestDiffRevisionStates(t *testing.T) {
+	expiry := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	before := revisionState{LongURL: "https://example.com/a", PasswordHash: "hash-1", Tags: []string{"promo"}}
+
+	after := before
+	after.LongURL = "https://example.com/b"
+	after.ExpiresAt = &expiry
+	after.PasswordHash = "hash-2"
+	after.Tags = nil
+
+	assert.Equal(t, []FieldChange{
+		{Field: "long_url", OldValue: "https://example.com/a", NewValue: "https://example.com/b"},
+		{Field: "expires_at", OldValue: "", NewValue: "2026-03-01T12:00:00Z"},
+		{Field: "password", OldValue: "set", NewValue: "set"},
+		{Field: "tags", OldValue: "promo", NewValue: ""},
+	}, diffRevisionStates(before, after))
+
+	assert.Empty(t, diffRevisionStates(before, before))
+}
+
+func TestRevisionStateRoundTrip(t *testing.T) {
+	dbURL := &database.URLMapping{
+		LongURL:         "https://example.com",
+		ExpiresAt:       sql.NullTime{Time: time.Now(), Valid: true},
+		Metadata:        `{"campaign":"spring"}`,
+		UTMTemplate:     `{"utm_source":"newsletter"}`,
+		PreviewOverride: `{"title":"Spring sale"}`,
+		MaxClicks:       sql.NullInt64{Int64: 100, Valid: true},
+		Tags:            `["promo"]`,
+	}
+	state := revisionStateOf(dbURL)
+
+	// The state must not share the row's timestamps
+	dbURL.ExpiresAt.Time = dbURL.ExpiresAt.Time.Add(time.Hour)
+	assert.NotEqual(t, dbURL.ExpiresAt.Time, *state.ExpiresAt)
+
+	restored := &database.URLMapping{}
+	state.applyTo(restored)
+	assert.Empty(t, diffRevisionStates(state, revisionStateOf(restored)))
+	assert.False(t, restored.PasswordHash.Valid)
+	assert.False(t, restored.FolderID.Valid)
+}
@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-systems-lab/go-url-shortener/utils/database"
+	"github.com/go-systems-lab/go-url-shortener/utils/safety"
+)
+
+// Safety scan settings (business rule: check at shorten/update time and rescan periodically)
+const (
+	safetyCheckTimeout  = 5 * time.Second
+	safetyScanBatchSize = 500
+)
+
+// SafetyScanResult summarizes a periodic rescan of active links
+type SafetyScanResult struct {
+	Scanned  int `json:"scanned"`
+	Disabled int `json:"disabled"`
+	Errors   int `json:"errors"`
+}
+
+// GetFlaggedURLsRequest represents pagination for the flagged link list
+type GetFlaggedURLsRequest struct {
+	Page     int32  `json:"page"` // deprecated: use Cursor
+	PageSize int32  `json:"page_size"`
+	Cursor   string `json:"cursor,omitempty"`
+}
+
+// checkDestination rejects destinations flagged by the safety engine and hosts
+// that do not resolve. Other checker failures fail open; the periodic rescan
+// catches those links later.
+func (s *URLService) checkDestination(longURL string) error {
+	if s.safety == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), safetyCheckTimeout)
+	defer cancel()
+
+	verdict, err := s.safety.Check(ctx, longURL)
+	if verdict != nil {
+		return fmt.Errorf("%w (%s)", ErrUnsafeURL, verdict.Reason)
+	}
+	if errors.Is(err, safety.ErrUnresolvableHost) {
+		return fmt.Errorf("%w: destination host does not resolve", ErrInvalidURL)
+	}
+	return nil
+}
+
+// RescanURLs re-checks the destinations of all active links and disables the
+// ones the safety engine now flags, recording the reason code for admins
+func (s *URLService) RescanURLs(ctx context.Context) (*SafetyScanResult, error) {
+	result := &SafetyScanResult{}
+	if s.safety == nil {
+		return result, nil
+	}
+
+	var afterID int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		dbURLs, err := s.db.GetActiveURLsAfter(afterID, safetyScanBatchSize)
+		if err != nil {
+			return result, fmt.Errorf("failed to load URLs for safety scan: %w", err)
+		}
+		if len(dbURLs) == 0 {
+			return result, nil
+		}
+
+		for _, dbURL := range dbURLs {
+			afterID = dbURL.ID
+			result.Scanned++
+
+			verdict, _ := s.safety.Check(ctx, dbURL.LongURL)
+			if verdict == nil && dbURL.FallbackURL.Valid {
+				verdict, _ = s.safety.Check(ctx, dbURL.FallbackURL.String)
+			}
+			if verdict == nil {
+				continue
+			}
+
+			if err := s.db.DisableURL(dbURL.Domain, dbURL.ShortCode, string(verdict.Reason)); err != nil {
+				result.Errors++
+				continue
+			}
+			s.invalidateURLCache(dbURL.Domain, dbURL.ShortCode)
+			s.RecordAudit(AuditActor{Actor: SystemActor}, AuditURLDisable, AuditURLTarget(dbURL.Domain, dbURL.ShortCode),
+				nil, map[string]string{"disabled_reason": string(verdict.Reason)})
+			result.Disabled++
+		}
+	}
+}
+
+// GetFlaggedURLs lists links disabled by the safety engine, most recent first (admin operation)
+func (s *URLService) GetFlaggedURLs(req *GetFlaggedURLsRequest) (*GetUserURLsResponse, error) {
+	pages, err := newPager("flagged", req.Page, req.PageSize, req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	dbURLs, err := s.db.GetDisabledURLs(pages.cursor, pages.limit(), pages.offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve flagged URLs: %w", err)
+	}
+	total, err := s.db.CountDisabledURLs()
+	if err != nil {
+		return nil, fmt.Errorf("failed to count flagged URLs: %w", err)
+	}
+
+	dbURLs, cursors := paginate(pages, dbURLs, func(url *database.URLMapping, backward bool) database.Cursor {
+		return database.DisabledURLCursor(url, backward)
+	})
+	urls := make([]URL, len(dbURLs))
+	for i := range dbURLs {
+		urls[i] = *s.dbToDomainURL(&dbURLs[i])
+	}
+
+	return &GetUserURLsResponse{
+		URLs:       urls,
+		TotalCount: int32(total),
+		Page:       pages.page(),
+		PageSize:   int32(pages.size),
+		HasNext:    cursors.hasNext,
+		NextCursor: cursors.next,
+		PrevCursor: cursors.prev,
+	}, nil
+}
@@ -0,0 +1,186 @@
+package domain
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/go-systems-lab/go-url-shortener/utils/database"
+)
+
+// Search limits
+const (
+	maxSearchQueryLength = 200
+	maxSearchTerms       = 8
+	searchFacetTags      = 20 // most used tags counted in the tag facet
+)
+
+// Link statuses searches can filter by
+const (
+	SearchStatusActive   = "active"
+	SearchStatusExpired  = "expired" // past expires_at or out of clicks
+	SearchStatusDisabled = "disabled"
+	SearchStatusArchived = "archived" // archived by the workspace's inactivity policy
+)
+
+// SearchURLsRequest searches a user's links. Every word of the query must match
+// the short code, destination host or path, title, a tag or a metadata value.
+type SearchURLsRequest struct {
+	UserID   string `json:"user_id"`
+	Query    string `json:"query"`
+	Tag      string `json:"tag,omitempty"`
+	Domain   string `json:"domain,omitempty"` // short domain, the default domain's host included
+	Status   string `json:"status,omitempty"` // active, expired, disabled or archived
+	Page     int32  `json:"page"`             // deprecated: use Cursor
+	PageSize int32  `json:"page_size"`
+	Cursor   string `json:"cursor,omitempty"` // next_cursor or prev_cursor of a previous page
+}
+
+// SearchHit is a link matching a search
+type SearchHit struct {
+	URL  URL     `json:"url"`
+	Rank float64 `json:"rank"`
+}
+
+// FacetCount is the number of matching links with a facet value
+type FacetCount struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+// SearchFacets counts all links matching a search, not just the current page
+type SearchFacets struct {
+	Tags     []FacetCount `json:"tags"`
+	Domains  []FacetCount `json:"domains"` // the default domain by its host
+	Statuses []FacetCount `json:"statuses"`
+}
+
+// SearchURLsResponse is a page of search results, most relevant first
+type SearchURLsResponse struct {
+	Hits       []SearchHit  `json:"hits"`
+	TotalCount int64        `json:"total_count"`
+	Page       int32        `json:"page"`
+	PageSize   int32        `json:"page_size"`
+	HasNext    bool         `json:"has_next"`
+	NextCursor string       `json:"next_cursor,omitempty"`
+	PrevCursor string       `json:"prev_cursor,omitempty"`
+	Facets     SearchFacets `json:"facets"`
+}
+
+// SearchTerms splits a search query into distinct lower-case words of letters
+// and digits, so "Q3 pricing-page" searches for "q3", "pricing" and "page"
+func SearchTerms(query string) []string {
+	words := strings.FieldsFunc(strings.ToLower(query), func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+	})
+
+	seen := make(map[string]struct{}, len(words))
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		if _, ok := seen[word]; ok {
+			continue
+		}
+		seen[word] = struct{}{}
+		terms = append(terms, word)
+	}
+	return terms
+}
+
+// SearchURLs searches a user's links, deleted ones excepted, and counts the
+// matches by tag, short domain and status
+func (s *URLService) SearchURLs(req *SearchURLsRequest) (*SearchURLsResponse, error) {
+	filter, err := urlSearchFilter(req)
+	if err != nil {
+		return nil, err
+	}
+	pages, err := newPager("search", req.Page, req.PageSize, req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	hits, err := s.db.SearchURLs(filter, pages.cursor, pages.limit(), pages.offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search URLs: %w", err)
+	}
+	hits, cursors := paginate(pages, hits, database.SearchCursor)
+
+	dbFacets, err := s.db.GetSearchFacets(filter, searchFacetTags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count search facets: %w", err)
+	}
+
+	response := &SearchURLsResponse{
+		Hits:       make([]SearchHit, len(hits)),
+		Page:       pages.page(),
+		PageSize:   int32(pages.size),
+		HasNext:    cursors.hasNext,
+		NextCursor: cursors.next,
+		PrevCursor: cursors.prev,
+		Facets: SearchFacets{
+			Tags:     dbToDomainFacets(dbFacets.Tags),
+			Domains:  dbToDomainFacets(dbFacets.Domains),
+			Statuses: dbToDomainFacets(dbFacets.Statuses),
+		},
+	}
+	for i := range hits {
+		response.Hits[i] = SearchHit{URL: *s.dbToDomainURL(&hits[i].URLMapping), Rank: hits[i].Rank}
+	}
+	for i, facet := range response.Facets.Domains {
+		if facet.Value == "" {
+			response.Facets.Domains[i].Value = defaultShortHost()
+		}
+	}
+	for _, facet := range response.Facets.Statuses {
+		response.TotalCount += facet.Count
+	}
+	return response, nil
+}
+
+// urlSearchFilter validates a search and turns it into a database filter
+func urlSearchFilter(req *SearchURLsRequest) (database.URLSearchFilter, error) {
+	query := strings.TrimSpace(req.Query)
+	filter := database.URLSearchFilter{
+		UserID: req.UserID,
+		Query:  query,
+		Terms:  SearchTerms(query),
+	}
+	if len(query) > maxSearchQueryLength || len(filter.Terms) > maxSearchTerms {
+		return filter, fmt.Errorf("%w: queries are limited to %d characters and %d words", ErrInvalidSearch, maxSearchQueryLength, maxSearchTerms)
+	}
+
+	switch status := strings.ToLower(req.Status); status {
+	case "", SearchStatusActive, SearchStatusExpired, SearchStatusDisabled, SearchStatusArchived:
+		filter.Status = status
+	default:
+		return filter, fmt.Errorf("%w: status must be %s, %s, %s or %s", ErrInvalidSearch, SearchStatusActive, SearchStatusExpired, SearchStatusDisabled, SearchStatusArchived)
+	}
+
+	if req.Tag != "" {
+		tag, err := NormalizeTag(req.Tag)
+		if err != nil {
+			return filter, err
+		}
+		filter.Tag = tag
+	}
+	if req.Domain != "" {
+		if IsDefaultShortHost(req.Domain) {
+			filter.Domain = sql.NullString{Valid: true}
+		} else {
+			shortDomain, err := NormalizeShortDomain(req.Domain)
+			if err != nil {
+				return filter, fmt.Errorf("%w: unknown domain %q", ErrInvalidSearch, req.Domain)
+			}
+			filter.Domain = sql.NullString{String: shortDomain, Valid: true}
+		}
+	}
+	return filter, nil
+}
+
+func dbToDomainFacets(dbFacets []database.FacetCount) []FacetCount {
+	facets := make([]FacetCount, len(dbFacets))
+	for i, facet := range dbFacets {
+		facets[i] = FacetCount{Value: facet.Value, Count: facet.Count}
+	}
+	return facets
+}
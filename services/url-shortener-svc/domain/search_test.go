@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchTerms(t *testing.T) {
+	assert.Equal(t, []string{"q3", "pricing", "page"}, SearchTerms("Q3 pricing-page  PRICING"))
+	assert.Equal(t, []string{"acme", "com", "spring"}, SearchTerms("acme.com/spring?"))
+	assert.Equal(t, []string{"über"}, SearchTerms("Über"))
+	assert.Empty(t, SearchTerms(" -- "))
+}
+
+func TestURLSearchFilter(t *testing.T) {
+	filter, err := urlSearchFilter(&SearchURLsRequest{UserID: "user123", Query: " Q3 pricing ", Status: "Expired", Tag: "Spring Sale"})
+	require.NoError(t, err)
+	assert.Equal(t, "Q3 pricing", filter.Query)
+	assert.Equal(t, []string{"q3", "pricing"}, filter.Terms)
+	assert.Equal(t, SearchStatusExpired, filter.Status)
+	assert.Equal(t, "spring sale", filter.Tag)
+	assert.False(t, filter.Domain.Valid, "no domain filter searches every domain")
+
+	filter, err = urlSearchFilter(&SearchURLsRequest{UserID: "user123", Domain: "short.ly"})
+	require.NoError(t, err)
+	assert.True(t, filter.Domain.Valid)
+	assert.Equal(t, "", filter.Domain.String, "the default domain is stored as an empty domain")
+
+	filter, err = urlSearchFilter(&SearchURLsRequest{UserID: "user123", Domain: "Go.ACME.com"})
+	require.NoError(t, err)
+	assert.Equal(t, "go.acme.com", filter.Domain.String)
+
+	_, err = urlSearchFilter(&SearchURLsRequest{UserID: "user123", Status: "deleted"})
+	assert.ErrorIs(t, err, ErrInvalidSearch)
+	_, err = urlSearchFilter(&SearchURLsRequest{UserID: "user123", Query: "a b c d e f g h i"})
+	assert.ErrorIs(t, err, ErrInvalidSearch, "too many words")
+	_, err = urlSearchFilter(&SearchURLsRequest{UserID: "user123", Query: strings.Repeat("a", maxSearchQueryLength+1)})
+	assert.ErrorIs(t, err, ErrInvalidSearch, "query too long")
+}
@@ -5,24 +5,41 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
-	"regexp"
+	"strings"
 	"time"
 
+	"github.com/go-systems-lab/go-url-shortener/utils/alias"
 	"github.com/go-systems-lab/go-url-shortener/utils/cache"
 	"github.com/go-systems-lab/go-url-shortener/utils/database"
+	"github.com/go-systems-lab/go-url-shortener/utils/preview"
+	"github.com/go-systems-lab/go-url-shortener/utils/qr"
+	"github.com/go-systems-lab/go-url-shortener/utils/safety"
 )
 
 // URLService implements the core business logic from HLD design
 type URLService struct {
-	db    *database.PostgreSQL
-	cache *cache.Redis
+	db       *database.PostgreSQL
+	cache    *cache.Redis
+	safety   safety.Checker
+	previews *preview.Fetcher
+	logos    *qr.LogoFetcher
+	aliases  *alias.Policy
 }
 
-// NewURLService creates a new URL service instance
-func NewURLService(db *database.PostgreSQL, redisCache *cache.Redis) *URLService {
+// NewURLService creates a new URL service instance; a nil checker disables destination
+// safety checks, a nil fetcher disables link preview scraping, a nil logo fetcher
+// disables QR code logos and a nil alias policy falls back to the built-in one
+func NewURLService(db *database.PostgreSQL, redisCache *cache.Redis, checker safety.Checker, previews *preview.Fetcher, logos *qr.LogoFetcher, aliases *alias.Policy) *URLService {
+	if aliases == nil {
+		aliases = alias.NewPolicy()
+	}
 	return &URLService{
-		db:    db,
-		cache: redisCache,
+		db:       db,
+		cache:    redisCache,
+		safety:   checker,
+		previews: previews,
+		logos:    logos,
+		aliases:  aliases,
 	}
 }
 
@@ -32,23 +49,82 @@ func (s *URLService) ShortenURL(req *CreateURLRequest) (*URL, error) {
 	if err := s.validateURL(req.LongURL); err != nil {
 		return nil, fmt.Errorf("URL validation failed: %w", err)
 	}
+	if err := s.checkDestination(req.LongURL); err != nil {
+		return nil, err
+	}
+
+	// Validate UTM template and workspace membership
+	if err := req.UTMTemplate.Validate(); err != nil {
+		return nil, err
+	}
+	plan := alias.DefaultPlan
+	if req.WorkspaceID != "" {
+		workspace, err := s.getOwnedWorkspace(req.WorkspaceID, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+		plan = workspace.Plan
+	}
+
+	// Validate the activation window and the pre-activation fallback
+	if err := validateActivationWindow(req.ActivationTime, req.ExpirationTime); err != nil {
+		return nil, err
+	}
+	if req.FallbackURL != "" {
+		if err := s.validateURL(req.FallbackURL); err != nil {
+			return nil, fmt.Errorf("invalid fallback URL: %w", err)
+		}
+		if err := s.checkDestination(req.FallbackURL); err != nil {
+			return nil, err
+		}
+	}
+	if req.MaxClicks < 0 {
+		return nil, ErrInvalidMaxClicks
+	}
+	if err := req.PreviewOverride.Validate(); err != nil {
+		return nil, err
+	}
 
-	// Generate or validate custom short code
+	// Validate tags and the folder
+	tags, err := NormalizeTags(req.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if req.FolderID != 0 {
+		if err := s.checkFolderOwner(req.UserID, req.FolderID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Resolve the short domain the link lives on
+	shortDomain, codePolicy, err := s.linkDomain(req.Domain, req.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate or validate custom short code (codes are unique per domain,
+	// regardless of case on domains with a case-insensitive code policy)
 	var shortCode string
 	if req.CustomAlias != "" {
-		if err := s.validateShortCode(req.CustomAlias); err != nil {
+		if err := s.validateAlias(req.CustomAlias, plan); err != nil {
+			return nil, fmt.Errorf("invalid custom alias: %w", err)
+		}
+		if err := codePolicy.Check(req.CustomAlias); err != nil {
 			return nil, fmt.Errorf("invalid custom alias: %w", err)
 		}
-		// Check if custom alias is available
-		existing, _ := s.db.GetURLByShortCode(req.CustomAlias)
-		if existing != nil {
+		shortCode = codePolicy.Canonical(req.CustomAlias)
+
+		// Check if custom alias is available (codes of inactive links stay taken)
+		taken, err := s.db.GetTakenShortCodes(shortDomain, []string{shortCode}, codePolicy.CaseInsensitive())
+		if err != nil {
+			return nil, fmt.Errorf("failed to check alias availability: %w", err)
+		}
+		if taken[shortCode] {
 			return nil, ErrCustomAliasUsed
 		}
-		shortCode = req.CustomAlias
 	} else {
 		// Generate unique short code using algorithm from HLD
-		var err error
-		shortCode, err = s.generateShortCode()
+		shortCode, err = s.generateShortCode(shortDomain, codePolicy)
 		if err != nil {
 			return nil, fmt.Errorf("failed to generate short code: %w", err)
 		}
@@ -66,11 +142,29 @@ func (s *URLService) ShortenURL(req *CreateURLRequest) (*URL, error) {
 
 	// Create URL mapping in database (from HLD design)
 	dbURL := &database.URLMapping{
-		ShortCode: shortCode,
-		LongURL:   req.LongURL,
-		UserID:    req.UserID,
-		IsActive:  true,
-		Metadata:  metadataJSON,
+		Domain:          shortDomain,
+		ShortCode:       shortCode,
+		LongURL:         req.LongURL,
+		UserID:          req.UserID,
+		IsActive:        true,
+		Metadata:        metadataJSON,
+		UTMTemplate:     req.UTMTemplate.String(),
+		PreviewOverride: req.PreviewOverride.String(),
+		FolderID:        nullFolderID(req.FolderID),
+	}
+
+	if req.Password != "" {
+		hash, err := HashLinkPassword(req.Password)
+		if err != nil {
+			return nil, err
+		}
+		dbURL.PasswordHash.Valid = true
+		dbURL.PasswordHash.String = hash
+	}
+
+	if req.WorkspaceID != "" {
+		dbURL.WorkspaceID.Valid = true
+		dbURL.WorkspaceID.String = req.WorkspaceID
 	}
 
 	// Set expiration if provided
@@ -79,46 +173,50 @@ func (s *URLService) ShortenURL(req *CreateURLRequest) (*URL, error) {
 		dbURL.ExpiresAt.Time = *req.ExpirationTime
 	}
 
-	// Save to database
-	if err := s.db.CreateURL(dbURL); err != nil {
-		return nil, fmt.Errorf("failed to save URL: %w", err)
+	// Set scheduled activation if provided
+	if req.ActivationTime != nil {
+		dbURL.ActivatesAt.Valid = true
+		dbURL.ActivatesAt.Time = *req.ActivationTime
 	}
-
-	// Cache the URL mapping for fast lookups (from HLD caching strategy)
-	cacheKey := cache.URLCacheKey(shortCode)
-	urlData := map[string]interface{}{
-		"long_url":   req.LongURL,
-		"user_id":    req.UserID,
-		"created_at": dbURL.CreatedAt.Unix(),
-		"is_active":  true,
+	if req.FallbackURL != "" {
+		dbURL.FallbackURL.Valid = true
+		dbURL.FallbackURL.String = req.FallbackURL
 	}
 
-	if req.ExpirationTime != nil {
-		urlData["expires_at"] = req.ExpirationTime.Unix()
+	// Set click limit if provided
+	if req.MaxClicks > 0 {
+		dbURL.MaxClicks.Valid = true
+		dbURL.MaxClicks.Int64 = req.MaxClicks
 	}
 
-	// Cache with appropriate TTL (from HLD design)
-	ttl := time.Hour * 24 // Default 24 hours
-	if req.ExpirationTime != nil && req.ExpirationTime.Before(time.Now().Add(ttl)) {
-		ttl = time.Until(*req.ExpirationTime)
+	// Save to database
+	if err := s.db.CreateURL(dbURL); err != nil {
+		return nil, fmt.Errorf("failed to save URL: %w", err)
 	}
-
-	if err := s.cache.SetJSON(cacheKey, urlData, ttl); err != nil {
-		// Log warning but don't fail the request
-		fmt.Printf("Warning: Failed to cache URL mapping: %v\n", err)
+	if len(tags) > 0 {
+		if err := s.db.SetURLTags(dbURL.ID, req.UserID, tags); err != nil {
+			return nil, fmt.Errorf("failed to save tags: %w", err)
+		}
+		dbURL.Tags = tagsJSON(tags)
 	}
 
-	// Convert back to domain model
-	return s.dbToDomainURL(dbURL), nil
+	// Convert back to domain model and cache it for fast lookups (from HLD caching strategy)
+	url := s.dbToDomainURL(dbURL)
+	s.cacheURL(url)
+
+	// Scrape the destination's unfurl metadata in the background
+	s.refreshPreview(url.Domain, url.ShortCode, url.LongURL)
+
+	return url, nil
 }
 
 // GetURL retrieves URL information with caching (from HLD design)
-func (s *URLService) GetURL(shortCode, userID string) (*URL, error) {
+func (s *URLService) GetURL(shortDomain, shortCode, userID string) (*URL, error) {
 	// Try cache first (from HLD caching strategy)
-	cacheKey := cache.URLCacheKey(shortCode)
+	cacheKey := cache.URLCacheKey(shortDomain, shortCode)
 	var cachedData map[string]interface{}
 	if found, err := s.cache.GetJSON(cacheKey, &cachedData); err == nil && found {
-		url := s.cacheToURL(shortCode, cachedData)
+		url := s.cacheToURL(shortDomain, shortCode, cachedData)
 		if url != nil {
 			// Check authorization
 			if userID != "" && !url.CanAccess(userID) {
@@ -129,7 +227,7 @@ func (s *URLService) GetURL(shortCode, userID string) (*URL, error) {
 	}
 
 	// Fallback to database
-	dbURL, err := s.db.GetURLByShortCode(shortCode)
+	dbURL, err := s.db.GetURLByShortCode(shortDomain, shortCode)
 	if err != nil {
 		return nil, ErrURLNotFound
 	}
@@ -147,30 +245,35 @@ func (s *URLService) GetURL(shortCode, userID string) (*URL, error) {
 	return url, nil
 }
 
-// GetUserURLs retrieves URLs for a user with pagination (from HLD design)
+// GetUserURLs retrieves a page of a user's URLs, after or before a cursor
+// or, for older clients, by page number (from HLD design)
 func (s *URLService) GetUserURLs(req *GetUserURLsRequest) (*GetUserURLsResponse, error) {
-	// Validate pagination parameters
-	if req.PageSize <= 0 || req.PageSize > 100 {
-		req.PageSize = 20 // Default page size
+	filter, err := s.urlListFilter(req)
+	if err != nil {
+		return nil, err
 	}
-	if req.Page <= 0 {
-		req.Page = 1
+	order := filter.SortBy + ":desc"
+	if filter.Ascending {
+		order = filter.SortBy + ":asc"
+	}
+	pages, err := newPager(order, req.Page, req.PageSize, req.Cursor)
+	if err != nil {
+		return nil, err
 	}
 
-	offset := (req.Page - 1) * req.PageSize
-
-	// Get URLs from database with pagination
-	dbURLs, err := s.db.GetURLsByUserID(req.UserID, int(req.PageSize+1), int(offset))
+	dbURLs, err := s.db.ListURLs(filter, pages.cursor, pages.limit(), pages.offset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve user URLs: %w", err)
 	}
-
-	// Check if there are more pages
-	hasNext := len(dbURLs) > int(req.PageSize)
-	if hasNext {
-		dbURLs = dbURLs[:req.PageSize] // Remove the extra item
+	total, err := s.db.CountURLs(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count user URLs: %w", err)
 	}
 
+	dbURLs, cursors := paginate(pages, dbURLs, func(url *database.URLMapping, backward bool) database.Cursor {
+		return database.URLCursor(url, filter, backward)
+	})
+
 	// Convert to domain models
 	urls := make([]URL, len(dbURLs))
 	for i, dbURL := range dbURLs {
@@ -179,79 +282,399 @@ func (s *URLService) GetUserURLs(req *GetUserURLsRequest) (*GetUserURLsResponse,
 
 	return &GetUserURLsResponse{
 		URLs:       urls,
-		TotalCount: int32(len(urls)), // TODO: Get actual total count in production
-		Page:       req.Page,
-		PageSize:   req.PageSize,
-		HasNext:    hasNext,
+		TotalCount: int32(total),
+		Page:       pages.page(),
+		PageSize:   int32(pages.size),
+		HasNext:    cursors.hasNext,
+		NextCursor: cursors.next,
+		PrevCursor: cursors.prev,
 	}, nil
 }
 
+// urlListFilter validates the sorting and filters of a link listing
+func (s *URLService) urlListFilter(req *GetUserURLsRequest) (database.URLListFilter, error) {
+	filter := database.URLListFilter{
+		UserID:            req.UserID,
+		SortBy:            req.SortBy,
+		FolderID:          req.FolderID,
+		IncludeSubfolders: req.IncludeSubfolders,
+	}
+
+	switch req.SortBy {
+	case "":
+		filter.SortBy = "created_at"
+	case "created_at", "click_count", "last_accessed", "alias":
+	default:
+		return filter, ErrInvalidSort
+	}
+	switch strings.ToLower(req.SortOrder) {
+	case "", "desc":
+	case "asc":
+		filter.Ascending = true
+	default:
+		return filter, ErrInvalidSort
+	}
+
+	if req.Tag != "" {
+		tag, err := NormalizeTag(req.Tag)
+		if err != nil {
+			return filter, err
+		}
+		filter.Tag = tag
+	}
+	if req.FolderID != 0 {
+		if err := s.checkFolderOwner(req.UserID, req.FolderID); err != nil {
+			return filter, err
+		}
+	}
+	return filter, nil
+}
+
 // UpdateURL updates an existing URL (from HLD design)
 func (s *URLService) UpdateURL(req *UpdateURLRequest) (*URL, error) {
-	// Get existing URL and check authorization
-	existingURL, err := s.GetURL(req.ShortCode, req.UserID)
+	// Load the authoritative record (the cache only holds a subset of fields)
+	dbURL, err := s.db.GetURLByShortCode(req.Domain, req.ShortCode)
 	if err != nil {
-		return nil, err
+		return nil, ErrURLNotFound
+	}
+	if req.UserID != "" && dbURL.UserID != req.UserID {
+		return nil, ErrUnauthorized
 	}
+	before := revisionStateOf(dbURL)
 
 	// Update fields if provided
 	updated := false
+	longURLChanged := false
 	if req.NewLongURL != "" {
 		if err := s.validateURL(req.NewLongURL); err != nil {
 			return nil, fmt.Errorf("invalid new URL: %w", err)
 		}
-		existingURL.LongURL = req.NewLongURL
+		if err := s.checkDestination(req.NewLongURL); err != nil {
+			return nil, err
+		}
+		longURLChanged = dbURL.LongURL != req.NewLongURL
+		dbURL.LongURL = req.NewLongURL
 		updated = true
 	}
 
 	if req.NewExpirationTime != nil {
-		existingURL.ExpiresAt = req.NewExpirationTime
+		dbURL.ExpiresAt.Valid = true
+		dbURL.ExpiresAt.Time = *req.NewExpirationTime
+		updated = true
+	}
+
+	if req.NewActivationTime != nil {
+		dbURL.ActivatesAt.Valid = true
+		dbURL.ActivatesAt.Time = *req.NewActivationTime
+		updated = true
+	}
+
+	if req.NewFallbackURL != "" {
+		if err := s.validateURL(req.NewFallbackURL); err != nil {
+			return nil, fmt.Errorf("invalid fallback URL: %w", err)
+		}
+		if err := s.checkDestination(req.NewFallbackURL); err != nil {
+			return nil, err
+		}
+		dbURL.FallbackURL.Valid = true
+		dbURL.FallbackURL.String = req.NewFallbackURL
 		updated = true
 	}
 
 	if req.Metadata != nil {
-		existingURL.Metadata = req.Metadata
+		metadataBytes, err := json.Marshal(req.Metadata)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize metadata: %w", err)
+		}
+		dbURL.Metadata = string(metadataBytes)
+		updated = true
+	}
+
+	if req.UTMTemplate != nil {
+		if err := req.UTMTemplate.Validate(); err != nil {
+			return nil, err
+		}
+		dbURL.UTMTemplate = req.UTMTemplate.String()
+		updated = true
+	}
+
+	if req.ClearPassword {
+		dbURL.PasswordHash.Valid = false
+		dbURL.PasswordHash.String = ""
+		updated = true
+	} else if req.NewPassword != "" {
+		hash, err := HashLinkPassword(req.NewPassword)
+		if err != nil {
+			return nil, err
+		}
+		dbURL.PasswordHash.Valid = true
+		dbURL.PasswordHash.String = hash
+		updated = true
+	}
+
+	if req.ClearMaxClicks {
+		dbURL.MaxClicks.Valid = false
+		dbURL.MaxClicks.Int64 = 0
+		updated = true
+	} else if req.NewMaxClicks != 0 {
+		if req.NewMaxClicks < 0 {
+			return nil, ErrInvalidMaxClicks
+		}
+		dbURL.MaxClicks.Valid = true
+		dbURL.MaxClicks.Int64 = req.NewMaxClicks
+		updated = true
+	}
+
+	if req.PreviewOverride != nil {
+		if err := req.PreviewOverride.Validate(); err != nil {
+			return nil, err
+		}
+		dbURL.PreviewOverride = req.PreviewOverride.String()
+		updated = true
+	}
+
+	if req.ClearFolder {
+		dbURL.FolderID = nullFolderID(0)
+		updated = true
+	} else if req.NewFolderID != 0 {
+		if err := s.checkFolderOwner(dbURL.UserID, req.NewFolderID); err != nil {
+			return nil, err
+		}
+		dbURL.FolderID = nullFolderID(req.NewFolderID)
+		updated = true
+	}
+
+	var tags []string
+	tagsChanged := req.ClearTags || req.Tags != nil
+	if !req.ClearTags && req.Tags != nil {
+		if tags, err = NormalizeTags(req.Tags); err != nil {
+			return nil, err
+		}
+	}
+	if tagsChanged {
+		dbURL.Tags = tagsJSON(tags)
+		updated = true
+	}
+
+	if req.InactivityExempt != nil {
+		dbURL.InactivityExempt = *req.InactivityExempt
 		updated = true
 	}
 
 	if !updated {
-		return existingURL, nil
+		return s.dbToDomainURL(dbURL), nil
 	}
 
-	// TODO: Implement database update operation
-	// For now, return the updated model
+	updatedURL := s.dbToDomainURL(dbURL)
+	if err := validateActivationWindow(updatedURL.ActivatesAt, updatedURL.ExpiresAt); err != nil {
+		return nil, err
+	}
+
+	if err := s.saveURLChange(dbURL, before, req.UserID, 0); err != nil {
+		return nil, fmt.Errorf("failed to update URL: %w", err)
+	}
+	if tagsChanged {
+		if err := s.db.SetURLTags(dbURL.ID, dbURL.UserID, tags); err != nil {
+			return nil, fmt.Errorf("failed to update tags: %w", err)
+		}
+	}
 
 	// Invalidate cache
-	cacheKey := cache.URLCacheKey(req.ShortCode)
-	s.cache.Delete(cacheKey)
+	s.invalidateURLCache(req.Domain, req.ShortCode)
 
-	return existingURL, nil
+	// A new destination needs a fresh preview
+	if longURLChanged {
+		s.refreshPreview(updatedURL.Domain, updatedURL.ShortCode, updatedURL.LongURL)
+	}
+
+	return updatedURL, nil
 }
 
-// DeleteURL soft deletes a URL (from HLD design)
-func (s *URLService) DeleteURL(shortCode, userID string) error {
+// DeleteURL soft deletes a URL (from HLD design): it moves to the trash, where
+// RestoreURL brings it back until the retention worker purges it
+func (s *URLService) DeleteURL(shortDomain, shortCode, userID string) error {
 	// Check if URL exists and user has permission
-	_, err := s.GetURL(shortCode, userID)
+	_, err := s.GetURL(shortDomain, shortCode, userID)
 	if err != nil {
 		return err
 	}
 
 	// Soft delete in database
-	if err := s.db.DeleteURL(shortCode, userID); err != nil {
+	if err := s.db.DeleteURL(shortDomain, shortCode, userID); err != nil {
 		return fmt.Errorf("failed to delete URL: %w", err)
 	}
 
 	// Remove from cache
-	cacheKey := cache.URLCacheKey(shortCode)
-	s.cache.Delete(cacheKey)
+	s.invalidateURLCache(shortDomain, shortCode)
+
+	return nil
+}
+
+// UpsertWorkspace creates or updates a workspace and its default UTM template
+func (s *URLService) UpsertWorkspace(req *UpsertWorkspaceRequest) (*Workspace, error) {
+	if req.ID == "" || req.OwnerID == "" {
+		return nil, fmt.Errorf("workspace ID and owner are required")
+	}
+	if err := req.UTMTemplate.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Only the owner may modify an existing workspace
+	if existing, err := s.db.GetWorkspace(req.ID); err == nil && existing.OwnerID != req.OwnerID {
+		return nil, ErrUnauthorized
+	}
+
+	name := req.Name
+	if name == "" {
+		name = req.ID
+	}
+
+	dbWorkspace := &database.Workspace{
+		ID:          req.ID,
+		Name:        name,
+		OwnerID:     req.OwnerID,
+		UTMTemplate: req.UTMTemplate.String(),
+	}
+	if err := s.db.UpsertWorkspace(dbWorkspace); err != nil {
+		return nil, fmt.Errorf("failed to save workspace: %w", err)
+	}
+
+	// Redirect cache entries embed the effective template, so drop them
+	if links, err := s.db.GetShortCodesByWorkspace(req.ID); err == nil {
+		for _, link := range links {
+			s.cache.Delete(cache.RedirectCacheKey(link.Domain, link.ShortCode))
+		}
+	}
+
+	return dbToDomainWorkspace(dbWorkspace), nil
+}
+
+// SetInterstitialMode changes whether a link shows a warning page before redirecting (admin operation)
+func (s *URLService) SetInterstitialMode(shortDomain, shortCode, mode string) (*URL, error) {
+	if err := ValidateInterstitialMode(mode); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.SetInterstitialMode(shortDomain, shortCode, mode); err != nil {
+		return nil, ErrURLNotFound
+	}
+
+	s.invalidateURLCache(shortDomain, shortCode)
+
+	dbURL, err := s.db.GetURLByShortCode(shortDomain, shortCode)
+	if err != nil {
+		return nil, ErrURLNotFound
+	}
+	return s.dbToDomainURL(dbURL), nil
+}
 
+// UpsertDomainReview puts a destination domain on the review list or marks it trusted (admin operation)
+func (s *URLService) UpsertDomainReview(review *DomainReview) (*DomainReview, error) {
+	review.Domain = NormalizeDomain(review.Domain)
+	if review.Domain == "" {
+		return nil, ErrInvalidDomainReview
+	}
+	if err := ValidateDomainStatus(review.Status); err != nil {
+		return nil, err
+	}
+
+	dbReview := &database.DomainReview{
+		Domain:    review.Domain,
+		Status:    review.Status,
+		Reason:    review.Reason,
+		UpdatedBy: review.UpdatedBy,
+	}
+	if err := s.db.UpsertDomainReview(dbReview); err != nil {
+		return nil, fmt.Errorf("failed to save domain review: %w", err)
+	}
+
+	s.invalidateDomainRedirects(review.Domain)
+
+	return dbToDomainReview(dbReview), nil
+}
+
+// DeleteDomainReview removes a destination domain from the review list (admin operation)
+func (s *URLService) DeleteDomainReview(domain string) error {
+	domain = NormalizeDomain(domain)
+	if domain == "" {
+		return ErrInvalidDomainReview
+	}
+
+	if err := s.db.DeleteDomainReview(domain); err != nil {
+		return fmt.Errorf("failed to delete domain review: %w", err)
+	}
+
+	s.invalidateDomainRedirects(domain)
 	return nil
 }
 
-// generateShortCode generates a unique short code using the algorithm from HLD
-func (s *URLService) generateShortCode() (string, error) {
-	const charset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
-	const length = 7 // 62^7 = ~3.5 trillion combinations
+// ListDomainReviews lists reviewed domains, optionally filtered by status (admin operation)
+func (s *URLService) ListDomainReviews(status string) ([]DomainReview, error) {
+	if status != "" {
+		if err := ValidateDomainStatus(status); err != nil {
+			return nil, err
+		}
+	}
+
+	dbReviews, err := s.db.ListDomainReviews(status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list domain reviews: %w", err)
+	}
+
+	reviews := make([]DomainReview, len(dbReviews))
+	for i := range dbReviews {
+		reviews[i] = *dbToDomainReview(&dbReviews[i])
+	}
+	return reviews, nil
+}
+
+// invalidateDomainRedirects drops redirect cache entries that embed a domain's review status
+func (s *URLService) invalidateDomainRedirects(domain string) {
+	if links, err := s.db.GetShortCodesByDestinationDomain(domain); err == nil {
+		for _, link := range links {
+			s.cache.Delete(cache.RedirectCacheKey(link.Domain, link.ShortCode))
+		}
+	}
+}
+
+// GetWorkspace retrieves a workspace owned by the user
+func (s *URLService) GetWorkspace(workspaceID, userID string) (*Workspace, error) {
+	dbWorkspace, err := s.getOwnedWorkspace(workspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return dbToDomainWorkspace(dbWorkspace), nil
+}
+
+// getOwnedWorkspace loads a workspace and checks that userID owns it
+func (s *URLService) getOwnedWorkspace(workspaceID, userID string) (*database.Workspace, error) {
+	dbWorkspace, err := s.db.GetWorkspace(workspaceID)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+	if userID != "" && dbWorkspace.OwnerID != userID {
+		return nil, ErrUnauthorized
+	}
+	return dbWorkspace, nil
+}
+
+// invalidateURLCache removes a URL from both the service cache and the redirect cache
+func (s *URLService) invalidateURLCache(shortDomain, shortCode string) {
+	s.cache.Delete(cache.URLCacheKey(shortDomain, shortCode))
+	s.cache.Delete(cache.RedirectCacheKey(shortDomain, shortCode))
+
+	// Readable domains cache redirects under the lower-case spelling, which
+	// differs for codes created before the domain switched policy
+	if canonical := alias.CodePolicyReadable.Canonical(shortCode); canonical != shortCode {
+		s.cache.Delete(cache.RedirectCacheKey(shortDomain, canonical))
+	}
+}
+
+// generateShortCode generates a unique short code using the algorithm from HLD,
+// spelled with the alphabet of the domain's code policy
+func (s *URLService) generateShortCode(shortDomain string, codePolicy alias.CodePolicy) (string, error) {
+	charset := codePolicy.Alphabet()
+	length := codePolicy.CodeLength()
 
 	for attempts := 0; attempts < 10; attempts++ {
 		// Generate random bytes
@@ -260,7 +683,7 @@ func (s *URLService) generateShortCode() (string, error) {
 			return "", fmt.Errorf("failed to generate random bytes: %w", err)
 		}
 
-		// Convert to base62
+		// Map onto the alphabet
 		shortCode := make([]byte, length)
 		for i, b := range bytes {
 			shortCode[i] = charset[int(b)%len(charset)]
@@ -269,8 +692,8 @@ func (s *URLService) generateShortCode() (string, error) {
 		code := string(shortCode)
 
 		// Check if code already exists
-		existing, _ := s.db.GetURLByShortCode(code)
-		if existing == nil {
+		taken, err := s.db.GetTakenShortCodes(shortDomain, []string{code}, codePolicy.CaseInsensitive())
+		if err == nil && !taken[code] {
 			return code, nil
 		}
 	}
@@ -303,19 +726,12 @@ func (s *URLService) validateURL(longURL string) error {
 	return nil
 }
 
-// validateShortCode validates custom short code format (business rule from HLD)
+// validateShortCode validates custom short code format under the default plan and code policy (business rule from HLD)
 func (s *URLService) validateShortCode(shortCode string) error {
-	if len(shortCode) < 3 || len(shortCode) > 10 {
-		return ErrInvalidShortCode
-	}
-
-	// Only allow alphanumeric characters
-	matched, _ := regexp.MatchString("^[a-zA-Z0-9]+$", shortCode)
-	if !matched {
-		return ErrInvalidShortCode
+	if err := s.validateAlias(shortCode, alias.DefaultPlan); err != nil {
+		return err
 	}
-
-	return nil
+	return DefaultCodePolicy.Check(shortCode)
 }
 
 // Helper functions for data conversion
@@ -336,21 +752,86 @@ func (s *URLService) dbToDomainURL(dbURL *database.URLMapping) *URL {
 		lastAccessed = &dbURL.LastAccessed.Time
 	}
 
+	var activatesAt *time.Time
+	if dbURL.ActivatesAt.Valid {
+		activatesAt = &dbURL.ActivatesAt.Time
+	}
+
+	var disabledAt *time.Time
+	if dbURL.DisabledAt.Valid {
+		disabledAt = &dbURL.DisabledAt.Time
+	}
+
+	var archivedAt *time.Time
+	if dbURL.ArchivedAt.Valid {
+		archivedAt = &dbURL.ArchivedAt.Time
+	}
+
+	var deletedAt, purgeAt *time.Time
+	if dbURL.DeletedAt.Valid {
+		deletedAt = &dbURL.DeletedAt.Time
+		purgeAt = DefaultTrashPolicy.PurgeAt(dbURL.DeletedAt.Time)
+	}
+
 	return &URL{
-		ID:           dbURL.ID,
-		ShortCode:    dbURL.ShortCode,
-		LongURL:      dbURL.LongURL,
-		UserID:       dbURL.UserID,
-		CreatedAt:    dbURL.CreatedAt,
-		ExpiresAt:    expiresAt,
-		ClickCount:   dbURL.ClickCount,
-		LastAccessed: lastAccessed,
-		IsActive:     dbURL.IsActive,
-		Metadata:     metadata,
+		ID:               dbURL.ID,
+		Domain:           dbURL.Domain,
+		ShortCode:        dbURL.ShortCode,
+		LongURL:          dbURL.LongURL,
+		UserID:           dbURL.UserID,
+		CreatedAt:        dbURL.CreatedAt,
+		ExpiresAt:        expiresAt,
+		ClickCount:       dbURL.ClickCount,
+		LastAccessed:     lastAccessed,
+		IsActive:         dbURL.IsActive,
+		Metadata:         metadata,
+		WorkspaceID:      dbURL.WorkspaceID.String,
+		UTMTemplate:      ParseUTMTemplate(dbURL.UTMTemplate),
+		PasswordHash:     dbURL.PasswordHash.String,
+		ActivatesAt:      activatesAt,
+		FallbackURL:      dbURL.FallbackURL.String,
+		MaxClicks:        dbURL.MaxClicks.Int64,
+		InterstitialMode: dbURL.InterstitialMode,
+		DisabledReason:   dbURL.DisabledReason.String,
+		DisabledAt:       disabledAt,
+		Preview:          ParseLinkPreview(dbURL.LinkPreview),
+		PreviewOverride:  ParseLinkPreview(dbURL.PreviewOverride),
+		Tags:             parseTags(dbURL.Tags),
+		FolderID:         dbURL.FolderID.Int64,
+		DeletedAt:        deletedAt,
+		PurgeAt:          purgeAt,
+		InactivityExempt: dbURL.InactivityExempt,
+		ArchivedAt:       archivedAt,
+	}
+}
+
+func dbToDomainWorkspace(dbWorkspace *database.Workspace) *Workspace {
+	return &Workspace{
+		ID:          dbWorkspace.ID,
+		Name:        dbWorkspace.Name,
+		OwnerID:     dbWorkspace.OwnerID,
+		UTMTemplate: ParseUTMTemplate(dbWorkspace.UTMTemplate),
+		Plan:        dbWorkspace.Plan,
+		CreatedAt:   dbWorkspace.CreatedAt,
+		UpdatedAt:   dbWorkspace.UpdatedAt,
+
+		InactivityDays:   dbWorkspace.InactivityDays,
+		InactivityDryRun: dbWorkspace.InactivityDryRun,
+	}
+}
+
+func dbToDomainReview(dbReview *database.DomainReview) *DomainReview {
+	return &DomainReview{
+		Domain:    dbReview.Domain,
+		Status:    dbReview.Status,
+		Reason:    dbReview.Reason,
+		UpdatedBy: dbReview.UpdatedBy,
+		CreatedAt: dbReview.CreatedAt,
+		UpdatedAt: dbReview.UpdatedAt,
 	}
 }
 
-func (s *URLService) cacheToURL(shortCode string, data map[string]interface{}) *URL {
+func (s *URLService) cacheToURL(shortDomain, shortCode string, data map[string]interface{}) *URL {
 	// Enhanced implementation with proper user handling
 	longURL, ok := data["long_url"].(string)
 	if !ok {
@@ -361,6 +842,7 @@ func (s *URLService) cacheToURL(shortCode string, data map[string]interface{}) *
 	isActive, _ := data["is_active"].(bool)
 
 	url := &URL{
+		Domain:    shortDomain,
 		ShortCode: shortCode,
 		LongURL:   longURL,
 		UserID:    userID,
@@ -378,11 +860,52 @@ func (s *URLService) cacheToURL(shortCode string, data map[string]interface{}) *
 		url.ExpiresAt = &expiresAt
 	}
 
+	// Handle activates_at if present
+	if activatesAtUnix, ok := data["activates_at"].(float64); ok {
+		activatesAt := time.Unix(int64(activatesAtUnix), 0)
+		url.ActivatesAt = &activatesAt
+	}
+
+	url.WorkspaceID, _ = data["workspace_id"].(string)
+	url.PasswordHash, _ = data["password_hash"].(string)
+	url.FallbackURL, _ = data["fallback_url"].(string)
+	if maxClicks, ok := data["max_clicks"].(float64); ok {
+		url.MaxClicks = int64(maxClicks)
+	}
+	url.InterstitialMode, _ = data["interstitial_mode"].(string)
+	if raw, ok := data["preview"].(string); ok {
+		url.Preview = ParseLinkPreview(raw)
+	}
+	if raw, ok := data["preview_override"].(string); ok {
+		url.PreviewOverride = ParseLinkPreview(raw)
+	}
+	if tpl, ok := data["utm_template"].(map[string]interface{}); ok {
+		url.UTMTemplate = make(UTMTemplate, len(tpl))
+		for param, value := range tpl {
+			if str, ok := value.(string); ok {
+				url.UTMTemplate[param] = str
+			}
+		}
+	}
+	if tags, ok := data["tags"].([]interface{}); ok {
+		for _, tag := range tags {
+			if name, ok := tag.(string); ok {
+				url.Tags = append(url.Tags, name)
+			}
+		}
+	}
+	if folderID, ok := data["folder_id"].(float64); ok {
+		url.FolderID = int64(folderID)
+	}
+	if exempt, ok := data["inactivity_exempt"].(bool); ok {
+		url.InactivityExempt = exempt
+	}
+
 	return url
 }
 
 func (s *URLService) cacheURL(url *URL) {
-	cacheKey := cache.URLCacheKey(url.ShortCode)
+	cacheKey := cache.URLCacheKey(url.Domain, url.ShortCode)
 	urlData := map[string]interface{}{
 		"long_url":   url.LongURL,
 		"user_id":    url.UserID,
@@ -390,13 +913,54 @@ func (s *URLService) cacheURL(url *URL) {
 		"is_active":  url.IsActive,
 	}
 
-	ttl := time.Hour * 24
+	if url.WorkspaceID != "" {
+		urlData["workspace_id"] = url.WorkspaceID
+	}
+	if len(url.UTMTemplate) > 0 {
+		urlData["utm_template"] = url.UTMTemplate
+	}
+	if url.PasswordHash != "" {
+		urlData["password_hash"] = url.PasswordHash
+	}
 	if url.ExpiresAt != nil {
 		urlData["expires_at"] = url.ExpiresAt.Unix()
-		if url.ExpiresAt.Before(time.Now().Add(ttl)) {
-			ttl = time.Until(*url.ExpiresAt)
-		}
+	}
+	if url.ActivatesAt != nil {
+		urlData["activates_at"] = url.ActivatesAt.Unix()
+	}
+	if url.FallbackURL != "" {
+		urlData["fallback_url"] = url.FallbackURL
+	}
+	if url.MaxClicks > 0 {
+		urlData["max_clicks"] = url.MaxClicks
+	}
+	if url.InterstitialMode != "" {
+		urlData["interstitial_mode"] = url.InterstitialMode
+	}
+	if !url.Preview.IsEmpty() {
+		urlData["preview"] = url.Preview.String()
+	}
+	if !url.PreviewOverride.IsEmpty() {
+		urlData["preview_override"] = url.PreviewOverride.String()
+	}
+	if len(url.Tags) > 0 {
+		urlData["tags"] = url.Tags
+	}
+	if url.FolderID != 0 {
+		urlData["folder_id"] = url.FolderID
+	}
+	if url.InactivityExempt {
+		urlData["inactivity_exempt"] = true
+	}
+
+	// Cache with appropriate TTL (from HLD design): 24 hours, cut short at the activation window boundaries
+	ttl := url.CacheTTL(time.Hour * 24)
+	if ttl <= 0 {
+		return
 	}
 
-	s.cache.SetJSON(cacheKey, urlData, ttl)
+	if err := s.cache.SetJSON(cacheKey, urlData, ttl); err != nil {
+		// Log warning but don't fail the request
+		fmt.Printf("Warning: Failed to cache URL mapping: %v\n", err)
+	}
 }
@@ -38,7 +38,7 @@ func (suite *URLServiceTestSuite) SetupSuite() {
 	assert.NoError(suite.T(), err)
 
 	// Create service
-	suite.service = NewURLService(suite.db, suite.cache)
+	suite.service = NewURLService(suite.db, suite.cache, nil, nil, nil, nil)
 }
 
 func (suite *URLServiceTestSuite) TearDownSuite() {
@@ -93,6 +93,31 @@ func (suite *URLServiceTestSuite) TestShortenURLWithCustomAlias() {
 	assert.Equal(suite.T(), "mycustom", url.ShortCode)
 }
 
+func (suite *URLServiceTestSuite) TestSuggestAliases() {
+	_, err := suite.service.ShortenURL(&CreateURLRequest{
+		LongURL:     "https://example.com/promo",
+		CustomAlias: "promo",
+		UserID:      "test_user_123",
+	})
+	assert.NoError(suite.T(), err)
+	_, err = suite.service.ShortenURL(&CreateURLRequest{
+		LongURL:     "https://example.com/promo2",
+		CustomAlias: "promo2",
+		UserID:      "test_user_123",
+	})
+	assert.NoError(suite.T(), err)
+
+	suggestions, err := suite.service.SuggestAliases(context.Background(), &SuggestAliasesRequest{
+		Alias:  "promo",
+		UserID: "test_user_123",
+		Count:  3,
+	})
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), suggestions.Available)
+	assert.Len(suite.T(), suggestions.Suggestions, 3)
+	assert.NotContains(suite.T(), suggestions.Suggestions, "promo2")
+}
+
 func (suite *URLServiceTestSuite) TestShortenURLWithExpiration() {
 	// Test URL shortening with expiration
 	expirationTime := time.Now().Add(24 * time.Hour)
@@ -108,6 +133,29 @@ func (suite *URLServiceTestSuite) TestShortenURLWithExpiration() {
 	assert.WithinDuration(suite.T(), expirationTime, *url.ExpiresAt, time.Second)
 }
 
+func (suite *URLServiceTestSuite) TestShortenURLWithActivationWindow() {
+	// Test URL shortening with a scheduled activation time
+	activationTime := time.Now().Add(time.Hour)
+	req := &CreateURLRequest{
+		LongURL:        "https://example.com/launch",
+		UserID:         "test_user_123",
+		ActivationTime: &activationTime,
+		FallbackURL:    "https://example.com/coming-soon",
+	}
+
+	url, err := suite.service.ShortenURL(req)
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), url.ActivatesAt)
+	assert.Equal(suite.T(), "https://example.com/coming-soon", url.FallbackURL)
+	assert.Equal(suite.T(), ErrURLNotYetActive, url.IsValidForRedirect())
+
+	// Activation must come before expiration
+	expirationTime := time.Now().Add(30 * time.Minute)
+	req.ExpirationTime = &expirationTime
+	_, err = suite.service.ShortenURL(req)
+	assert.Equal(suite.T(), ErrInvalidActivation, err)
+}
+
 func (suite *URLServiceTestSuite) TestGetURL() {
 	// First create a URL
 	req := &CreateURLRequest{
@@ -119,7 +167,7 @@ func (suite *URLServiceTestSuite) TestGetURL() {
 	assert.NoError(suite.T(), err)
 
 	// Test getting the URL
-	retrievedURL, err := suite.service.GetURL(createdURL.ShortCode, "test_user_123")
+	retrievedURL, err := suite.service.GetURL("", createdURL.ShortCode, "test_user_123")
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), createdURL.ShortCode, retrievedURL.ShortCode)
 	assert.Equal(suite.T(), createdURL.LongURL, retrievedURL.LongURL)
@@ -137,7 +185,7 @@ func (suite *URLServiceTestSuite) TestGetURLUnauthorized() {
 	assert.NoError(suite.T(), err)
 
 	// Test getting the URL with wrong user
-	_, err = suite.service.GetURL(createdURL.ShortCode, "wrong_user")
+	_, err = suite.service.GetURL("", createdURL.ShortCode, "wrong_user")
 	assert.Error(suite.T(), err)
 	assert.Equal(suite.T(), ErrUnauthorized, err)
 }
@@ -153,11 +201,11 @@ func (suite *URLServiceTestSuite) TestDeleteURL() {
 	assert.NoError(suite.T(), err)
 
 	// Delete the URL
-	err = suite.service.DeleteURL(createdURL.ShortCode, "test_user_123")
+	err = suite.service.DeleteURL("", createdURL.ShortCode, "test_user_123")
 	assert.NoError(suite.T(), err)
 
 	// Try to get the deleted URL
-	_, err = suite.service.GetURL(createdURL.ShortCode, "test_user_123")
+	_, err = suite.service.GetURL("", createdURL.ShortCode, "test_user_123")
 	assert.Error(suite.T(), err)
 	assert.Equal(suite.T(), ErrURLNotFound, err)
 }
@@ -0,0 +1,198 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/go-systems-lab/go-url-shortener/utils/database"
+)
+
+// Tag rules
+const (
+	maxTagLength  = 50
+	maxTagsPerURL = 20
+)
+
+// Tag labels a user's links; a link can carry several tags
+type Tag struct {
+	ID        int64     `json:"id"`
+	UserID    string    `json:"user_id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	LinkCount int64     `json:"link_count"` // active links with the tag
+}
+
+// NormalizeTag returns the stored form of a tag name: trimmed, lower case, inner
+// whitespace collapsed to single spaces. Letters (in any script), digits,
+// spaces, "-", "_" and ":" are allowed; "/" is not so that tags can be used
+// as path segments.
+func NormalizeTag(name string) (string, error) {
+	tag := strings.ToLower(strings.Join(strings.Fields(name), " "))
+	if tag == "" || len(tag) > maxTagLength {
+		return "", fmt.Errorf("%w: tags must be 1 to %d characters", ErrInvalidTag, maxTagLength)
+	}
+	for _, c := range tag {
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) && !strings.ContainsRune(" -_:", c) {
+			return "", fmt.Errorf("%w: %q contains %q", ErrInvalidTag, name, c)
+		}
+	}
+	return tag, nil
+}
+
+// NormalizeTags normalizes a link's tags, dropping duplicates, and sorts them
+func NormalizeTags(names []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(names))
+	tags := make([]string, 0, len(names))
+	for _, name := range names {
+		tag, err := NormalizeTag(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		tags = append(tags, tag)
+	}
+	if len(tags) > maxTagsPerURL {
+		return nil, fmt.Errorf("%w: a link can have at most %d tags", ErrInvalidTag, maxTagsPerURL)
+	}
+	sort.Strings(tags)
+	return tags, nil
+}
+
+// CreateTag adds a tag for a user
+func (s *URLService) CreateTag(userID, name string) (*Tag, error) {
+	tagName, err := NormalizeTag(name)
+	if err != nil {
+		return nil, err
+	}
+
+	dbTag := &database.Tag{UserID: userID, Name: tagName}
+	created, err := s.db.CreateTag(dbTag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save tag: %w", err)
+	}
+	if !created {
+		return nil, ErrTagExists
+	}
+	return dbToDomainTag(dbTag), nil
+}
+
+// ListTags lists a user's tags with their link counts
+func (s *URLService) ListTags(userID string) ([]Tag, error) {
+	dbTags, err := s.db.ListTags(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	tags := make([]Tag, len(dbTags))
+	for i := range dbTags {
+		tags[i] = *dbToDomainTag(&dbTags[i])
+	}
+	return tags, nil
+}
+
+// RenameTag renames a user's tag on all of its links
+func (s *URLService) RenameTag(userID, name, newName string) (*Tag, error) {
+	dbTag, err := s.getTag(userID, name)
+	if err != nil {
+		return nil, err
+	}
+	tagName, err := NormalizeTag(newName)
+	if err != nil {
+		return nil, err
+	}
+	if tagName == dbTag.Name {
+		return dbToDomainTag(dbTag), nil
+	}
+
+	renamed, err := s.db.RenameTag(dbTag.ID, userID, tagName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rename tag: %w", err)
+	}
+	if !renamed {
+		return nil, ErrTagExists
+	}
+	s.invalidateTaggedLinks(dbTag.ID)
+
+	dbTag.Name = tagName
+	return dbToDomainTag(dbTag), nil
+}
+
+// DeleteTag deletes a user's tag and removes it from its links
+func (s *URLService) DeleteTag(userID, name string) error {
+	dbTag, err := s.getTag(userID, name)
+	if err != nil {
+		return err
+	}
+
+	// Collect the links first; the tag's url_tags rows go with it
+	links, err := s.db.GetTaggedLinks(dbTag.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list tagged links: %w", err)
+	}
+	if err := s.db.DeleteTag(dbTag.ID, userID); err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+	for _, link := range links {
+		s.invalidateURLCache(link.Domain, link.ShortCode)
+	}
+	return nil
+}
+
+// getTag looks up a user's tag by (not yet normalized) name
+func (s *URLService) getTag(userID, name string) (*database.Tag, error) {
+	tagName, err := NormalizeTag(name)
+	if err != nil {
+		return nil, ErrTagNotFound
+	}
+	dbTag, err := s.db.GetTag(userID, tagName)
+	if err != nil {
+		return nil, ErrTagNotFound
+	}
+	return dbTag, nil
+}
+
+// invalidateTaggedLinks drops the cached entries of a tag's links so they show its new name
+func (s *URLService) invalidateTaggedLinks(tagID int64) {
+	links, err := s.db.GetTaggedLinks(tagID)
+	if err != nil {
+		return
+	}
+	for _, link := range links {
+		s.invalidateURLCache(link.Domain, link.ShortCode)
+	}
+}
+
+// parseTags decodes the JSON tag list of a url_mappings row
+func parseTags(raw string) []string {
+	var tags []string
+	if raw == "" || json.Unmarshal([]byte(raw), &tags) != nil || len(tags) == 0 {
+		return nil
+	}
+	return tags
+}
+
+// tagsJSON encodes a tag list the way url_mappings rows carry it
+func tagsJSON(tags []string) string {
+	if len(tags) == 0 {
+		return "[]"
+	}
+	encoded, _ := json.Marshal(tags)
+	return string(encoded)
+}
+
+func dbToDomainTag(dbTag *database.Tag) *Tag {
+	return &Tag{
+		ID:        dbTag.ID,
+		UserID:    dbTag.UserID,
+		Name:      dbTag.Name,
+		CreatedAt: dbTag.CreatedAt,
+		LinkCount: dbTag.LinkCount,
+	}
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeTag(t *testing.T) {
+	tag, err := NormalizeTag("  Spring   Campaign ")
+	require.NoError(t, err)
+	assert.Equal(t, "spring campaign", tag)
+
+	tag, err = NormalizeTag("Q3:Ärzte_2024")
+	require.NoError(t, err)
+	assert.Equal(t, "q3:ärzte_2024", tag)
+
+	for _, name := range []string{"", "   ", "spring/sale", "promo!", string(make([]byte, maxTagLength+1))} {
+		_, err := NormalizeTag(name)
+		assert.ErrorIs(t, err, ErrInvalidTag, name)
+	}
+}
+
+func TestNormalizeTags(t *testing.T) {
+	tags, err := NormalizeTags([]string{"Social", "email", "social ", "EMAIL"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"email", "social"}, tags)
+
+	tooMany := make([]string, maxTagsPerURL+1)
+	for i := range tooMany {
+		tooMany[i] = string(rune('a' + i))
+	}
+	_, err = NormalizeTags(tooMany)
+	assert.ErrorIs(t, err, ErrInvalidTag)
+}
@@ -0,0 +1,218 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/go-systems-lab/go-url-shortener/utils/database"
+)
+
+// Trash settings (business rule: deleted links can be restored until purged)
+const (
+	trashPurgeBatchSize   = 500
+	defaultTrashRetention = 30 * 24 * time.Hour
+)
+
+// NeverReclaim keeps the codes of purged links taken for good
+const NeverReclaim time.Duration = -1
+
+// TrashPolicy decides how long deleted links can be restored and what purging them removes
+type TrashPolicy struct {
+	Retention      time.Duration // time in the trash before the purge; 0 keeps deleted links until restored
+	PurgeAnalytics bool          // delete the click analytics of purged links
+	CodeReclaim    time.Duration // time after the purge before the code can be reused; NeverReclaim for never
+}
+
+// DefaultTrashPolicy applies to all deleted links; see ConfigureTrashPolicy
+var DefaultTrashPolicy = TrashPolicy{
+	Retention:      defaultTrashRetention,
+	PurgeAnalytics: true,
+	CodeReclaim:    NeverReclaim,
+}
+
+// GetDeletedURLsRequest represents pagination for a user's trash
+type GetDeletedURLsRequest struct {
+	UserID   string `json:"user_id"`
+	Page     int32  `json:"page"` // deprecated: use Cursor
+	PageSize int32  `json:"page_size"`
+	Cursor   string `json:"cursor,omitempty"`
+}
+
+// PurgedLink is a link removed for good by the trash retention
+type PurgedLink struct {
+	Domain          string `json:"domain"`
+	ShortCode       string `json:"short_code"`
+	DeleteAnalytics bool   `json:"delete_analytics"` // the link's click analytics should go too
+}
+
+// TrashPurgeResult summarizes a run of the trash retention worker
+type TrashPurgeResult struct {
+	Purged        []PurgedLink `json:"purged"`
+	AnalyticsKept int          `json:"analytics_kept"` // codes whose analytics another domain's link shares
+	Reclaimed     int64        `json:"reclaimed"`      // retired codes that became free again
+}
+
+// ConfigureTrashPolicy sets the trash retention. codeReclaim says when the
+// code of a purged link can be used again: "never", "purge" (right away) or a
+// Go duration after the purge such as 2160h.
+func ConfigureTrashPolicy(retention time.Duration, purgeAnalytics bool, codeReclaim string) error {
+	if retention < 0 {
+		return fmt.Errorf("trash retention must not be negative, got %s", retention)
+	}
+
+	reclaim, err := ParseCodeReclaim(codeReclaim)
+	if err != nil {
+		return err
+	}
+
+	DefaultTrashPolicy = TrashPolicy{Retention: retention, PurgeAnalytics: purgeAnalytics, CodeReclaim: reclaim}
+	return nil
+}
+
+// ParseCodeReclaim reads when purged codes can be reused: "never", "purge" or a
+// non-negative Go duration after the purge
+func ParseCodeReclaim(value string) (time.Duration, error) {
+	switch value {
+	case "never":
+		return NeverReclaim, nil
+	case "purge":
+		return 0, nil
+	}
+
+	delay, err := time.ParseDuration(value)
+	if err != nil || delay < 0 {
+		return 0, fmt.Errorf("code reclaim must be never, purge or a duration such as 2160h, got %q", value)
+	}
+	return delay, nil
+}
+
+// PurgeAt returns when a link deleted at deletedAt is purged, nil when deleted links are kept
+func (p TrashPolicy) PurgeAt(deletedAt time.Time) *time.Time {
+	if p.Retention <= 0 {
+		return nil
+	}
+	purgeAt := deletedAt.Add(p.Retention)
+	return &purgeAt
+}
+
+// codeRetention says how the codes of links purged at now stay taken
+func (p TrashPolicy) codeRetention(now time.Time) database.CodeRetention {
+	switch {
+	case p.CodeReclaim == NeverReclaim:
+		return database.CodeRetention{Retire: true}
+	case p.CodeReclaim > 0:
+		return database.CodeRetention{Retire: true, ReclaimableAt: sql.NullTime{Time: now.Add(p.CodeReclaim), Valid: true}}
+	default:
+		return database.CodeRetention{}
+	}
+}
+
+// ListDeletedURLs lists a user's links in the trash, most recently deleted first
+func (s *URLService) ListDeletedURLs(req *GetDeletedURLsRequest) (*GetUserURLsResponse, error) {
+	pages, err := newPager("deleted", req.Page, req.PageSize, req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	dbURLs, err := s.db.ListDeletedURLs(req.UserID, pages.cursor, pages.limit(), pages.offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve deleted URLs: %w", err)
+	}
+	total, err := s.db.CountDeletedURLs(req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count deleted URLs: %w", err)
+	}
+
+	dbURLs, cursors := paginate(pages, dbURLs, func(url *database.URLMapping, backward bool) database.Cursor {
+		return database.DeletedURLCursor(url, backward)
+	})
+	urls := make([]URL, len(dbURLs))
+	for i := range dbURLs {
+		urls[i] = *s.dbToDomainURL(&dbURLs[i])
+	}
+
+	return &GetUserURLsResponse{
+		URLs:       urls,
+		TotalCount: int32(total),
+		Page:       pages.page(),
+		PageSize:   int32(pages.size),
+		HasNext:    cursors.hasNext,
+		NextCursor: cursors.next,
+		PrevCursor: cursors.prev,
+	}, nil
+}
+
+// RestoreURL takes a link out of the trash. Its destination is checked again
+// like a new link's, and a branded domain must still belong to its workspace.
+func (s *URLService) RestoreURL(shortDomain, shortCode, userID string) (*URL, error) {
+	dbURL, err := s.db.GetDeletedURL(shortDomain, shortCode)
+	if err != nil {
+		return nil, ErrURLNotFound
+	}
+	if dbURL.UserID != userID {
+		return nil, ErrUnauthorized
+	}
+
+	if _, _, err := s.linkDomain(dbURL.Domain, dbURL.WorkspaceID.String); err != nil {
+		return nil, err
+	}
+	if err := s.checkDestination(dbURL.LongURL); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.RestoreURL(shortDomain, shortCode, userID); err != nil {
+		return nil, fmt.Errorf("failed to restore URL: %w", err)
+	}
+	s.invalidateURLCache(shortDomain, shortCode)
+
+	return s.GetURL(shortDomain, shortCode, userID)
+}
+
+// PurgeTrash hard deletes the links that stayed in the trash longer than the
+// policy's retention, in batches, and clears their cached redirects. The
+// caller forwards the purged links whose analytics should be deleted.
+func (s *URLService) PurgeTrash(ctx context.Context, policy TrashPolicy) (*TrashPurgeResult, error) {
+	result := &TrashPurgeResult{}
+
+	reclaimed, err := s.db.DeleteReclaimedCodes()
+	if err != nil {
+		return result, fmt.Errorf("failed to release reclaimable codes: %w", err)
+	}
+	result.Reclaimed = reclaimed
+
+	if policy.Retention <= 0 {
+		return result, nil
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		now := time.Now()
+		purged, err := s.db.PurgeDeletedURLs(now.Add(-policy.Retention), trashPurgeBatchSize, policy.codeRetention(now))
+		if err != nil {
+			return result, fmt.Errorf("failed to purge deleted URLs: %w", err)
+		}
+
+		for _, link := range purged {
+			s.invalidateURLCache(link.Domain, link.ShortCode)
+
+			deleteAnalytics := policy.PurgeAnalytics && !link.CodeShared
+			if policy.PurgeAnalytics && link.CodeShared {
+				result.AnalyticsKept++
+			}
+			result.Purged = append(result.Purged, PurgedLink{
+				Domain:          link.Domain,
+				ShortCode:       link.ShortCode,
+				DeleteAnalytics: deleteAnalytics,
+			})
+		}
+
+		if len(purged) < trashPurgeBatchSize {
+			return result, nil
+		}
+	}
+}
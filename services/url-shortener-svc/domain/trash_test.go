@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCodeReclaim(t *testing.T) {
+	reclaim, err := ParseCodeReclaim("never")
+	require.NoError(t, err)
+	assert.Equal(t, NeverReclaim, reclaim)
+
+	reclaim, err = ParseCodeReclaim("purge")
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), reclaim)
+
+	reclaim, err = ParseCodeReclaim("2160h")
+	require.NoError(t, err)
+	assert.Equal(t, 90*24*time.Hour, reclaim)
+
+	_, err = ParseCodeReclaim("-1h")
+	assert.Error(t, err)
+	_, err = ParseCodeReclaim("soon")
+	assert.Error(t, err)
+}
+
+func TestTrashPolicy(t *testing.T) {
+	deletedAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	policy := TrashPolicy{Retention: 7 * 24 * time.Hour, CodeReclaim: NeverReclaim}
+	require.NotNil(t, policy.PurgeAt(deletedAt))
+	assert.Equal(t, deletedAt.Add(7*24*time.Hour), *policy.PurgeAt(deletedAt))
+	retention := policy.codeRetention(deletedAt)
+	assert.True(t, retention.Retire)
+	assert.False(t, retention.ReclaimableAt.Valid, "never reclaimed codes have no reclaim date")
+
+	policy = TrashPolicy{CodeReclaim: time.Hour}
+	assert.Nil(t, policy.PurgeAt(deletedAt), "deleted links are kept without a retention")
+	retention = policy.codeRetention(deletedAt)
+	assert.True(t, retention.Retire)
+	assert.Equal(t, deletedAt.Add(time.Hour), retention.ReclaimableAt.Time)
+
+	policy = TrashPolicy{CodeReclaim: 0}
+	assert.False(t, policy.codeRetention(deletedAt).Retire, "codes are free right after the purge")
+}
+
+func TestConfigureTrashPolicy(t *testing.T) {
+	original := DefaultTrashPolicy
+	defer func() { DefaultTrashPolicy = original }()
+
+	require.NoError(t, ConfigureTrashPolicy(24*time.Hour, false, "purge"))
+	assert.Equal(t, TrashPolicy{Retention: 24 * time.Hour, CodeReclaim: 0}, DefaultTrashPolicy)
+
+	assert.Error(t, ConfigureTrashPolicy(-time.Hour, true, "never"))
+	assert.Error(t, ConfigureTrashPolicy(time.Hour, true, "bogus"))
+	assert.Equal(t, 24*time.Hour, DefaultTrashPolicy.Retention, "invalid settings leave the policy alone")
+}
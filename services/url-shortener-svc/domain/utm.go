@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// UTMTemplate maps UTM parameter names (utm_source, utm_medium, ...) to value templates
+type UTMTemplate map[string]string
+
+// UTMContext holds the per-click values substituted into UTM template placeholders
+type UTMContext struct {
+	ShortCode      string
+	Country        string
+	ReferrerDomain string
+}
+
+// Supported UTM parameters and placeholders (business rule)
+var (
+	utmParams = map[string]bool{
+		"utm_source":   true,
+		"utm_medium":   true,
+		"utm_campaign": true,
+		"utm_term":     true,
+		"utm_content":  true,
+		"utm_id":       true,
+	}
+	utmPlaceholders = map[string]bool{
+		"{short_code}":      true,
+		"{country}":         true,
+		"{referrer_domain}": true,
+	}
+	utmPlaceholderPattern = regexp.MustCompile(`\{[^{}]*\}`)
+)
+
+// Validate checks that the template only uses known UTM parameters and placeholders
+func (t UTMTemplate) Validate() error {
+	for param, value := range t {
+		if !utmParams[param] {
+			return ErrInvalidUTMTemplate
+		}
+		for _, placeholder := range utmPlaceholderPattern.FindAllString(value, -1) {
+			if !utmPlaceholders[placeholder] {
+				return ErrInvalidUTMTemplate
+			}
+		}
+		// Any brace left outside a placeholder is malformed
+		if strings.ContainsAny(utmPlaceholderPattern.ReplaceAllString(value, ""), "{}") {
+			return ErrInvalidUTMTemplate
+		}
+	}
+	return nil
+}
+
+// MergeUTMTemplates layers a link-level template over a workspace-level template
+func MergeUTMTemplates(workspace, link UTMTemplate) UTMTemplate {
+	if len(workspace) == 0 && len(link) == 0 {
+		return nil
+	}
+
+	merged := make(UTMTemplate, len(workspace)+len(link))
+	for param, value := range workspace {
+		merged[param] = value
+	}
+	for param, value := range link {
+		if value == "" {
+			// An empty link value opts out of the workspace default
+			delete(merged, param)
+			continue
+		}
+		merged[param] = value
+	}
+	return merged
+}
+
+// Apply renders the template against the click context and appends the result to longURL.
+// UTM parameters already present on the long URL win over the template, and the existing
+// query is kept byte for byte (signed URLs and order-sensitive trackers depend on it).
+func (t UTMTemplate) Apply(longURL string, ctx UTMContext) string {
+	if len(t) == 0 {
+		return longURL
+	}
+
+	parsedURL, err := url.Parse(longURL)
+	if err != nil {
+		return longURL
+	}
+
+	replacer := strings.NewReplacer(
+		"{short_code}", ctx.ShortCode,
+		"{country}", strings.ToLower(ctx.Country),
+		"{referrer_domain}", ctx.ReferrerDomain,
+	)
+
+	existing := parsedURL.Query()
+	added := url.Values{}
+	for param, value := range t {
+		if existing.Has(param) {
+			continue
+		}
+		rendered := strings.TrimSpace(replacer.Replace(value))
+		if rendered == "" {
+			continue
+		}
+		added.Set(param, rendered)
+	}
+	if len(added) == 0 {
+		return longURL
+	}
+
+	if parsedURL.RawQuery == "" {
+		parsedURL.RawQuery = added.Encode()
+	} else {
+		parsedURL.RawQuery = strings.TrimSuffix(parsedURL.RawQuery, "&") + "&" + added.Encode()
+	}
+	return parsedURL.String()
+}
+
+// ReferrerDomain extracts the host of a referrer URL for the {referrer_domain} placeholder
+func ReferrerDomain(referrer string) string {
+	if referrer == "" {
+		return ""
+	}
+	parsedURL, err := url.Parse(referrer)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(strings.ToLower(parsedURL.Hostname()), "www.")
+}
+
+// ExtractUTMValues returns the UTM parameters actually present on a URL
+func ExtractUTMValues(rawURL string) map[string]string {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil
+	}
+
+	values := make(map[string]string)
+	for param, vals := range parsedURL.Query() {
+		if utmParams[param] && len(vals) > 0 {
+			values[param] = vals[0]
+		}
+	}
+	return values
+}
+
+// ParseUTMTemplate decodes a JSONB UTM template column
+func ParseUTMTemplate(raw string) UTMTemplate {
+	if raw == "" || raw == "{}" {
+		return nil
+	}
+	var t UTMTemplate
+	if err := json.Unmarshal([]byte(raw), &t); err != nil {
+		return nil
+	}
+	return t
+}
+
+// String encodes the template for JSONB storage
+func (t UTMTemplate) String() string {
+	if len(t) == 0 {
+		return "{}"
+	}
+	data, err := json.Marshal(t)
+	if err != nil {
+		return "{}"
+	}
+	return string(data)
+}
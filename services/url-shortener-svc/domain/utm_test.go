@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUTMTemplateValidate(t *testing.T) {
+	valid := UTMTemplate{
+		"utm_source":   "{referrer_domain}",
+		"utm_medium":   "social",
+		"utm_campaign": "launch_{short_code}_{country}",
+	}
+	assert.NoError(t, valid.Validate())
+
+	invalidTemplates := []UTMTemplate{
+		{"source": "twitter"},             // not a UTM parameter
+		{"utm_source": "{user_id}"},       // unknown placeholder
+		{"utm_source": "{short_code"},     // unbalanced braces
+		{"utm_campaign": "spring}{promo"}, // unbalanced braces
+	}
+	for _, tpl := range invalidTemplates {
+		assert.Equal(t, ErrInvalidUTMTemplate, tpl.Validate(), "template should be invalid: %v", tpl)
+	}
+}
+
+func TestUTMTemplateApply(t *testing.T) {
+	tpl := UTMTemplate{
+		"utm_source":   "{referrer_domain}",
+		"utm_medium":   "social",
+		"utm_campaign": "{short_code}-{country}",
+	}
+	ctx := UTMContext{ShortCode: "abc123", Country: "US", ReferrerDomain: "twitter.com"}
+
+	result, err := url.Parse(tpl.Apply("https://example.com/landing?ref=1", ctx))
+	assert.NoError(t, err)
+
+	query := result.Query()
+	assert.Equal(t, "1", query.Get("ref"))
+	assert.Equal(t, "twitter.com", query.Get("utm_source"))
+	assert.Equal(t, "social", query.Get("utm_medium"))
+	assert.Equal(t, "abc123-us", query.Get("utm_campaign"))
+
+	// Parameters already on the long URL win over the template
+	result, err = url.Parse(tpl.Apply("https://example.com/?utm_medium=email", ctx))
+	assert.NoError(t, err)
+	assert.Equal(t, "email", result.Query().Get("utm_medium"))
+
+	// Placeholders that render empty are skipped
+	result, err = url.Parse(tpl.Apply("https://example.com/", UTMContext{ShortCode: "abc123"}))
+	assert.NoError(t, err)
+	assert.False(t, result.Query().Has("utm_source"))
+
+	// The existing query is kept as it was, template parameters are appended
+	assert.Equal(t,
+		"https://example.com/s?z=1&a&q=a+b&q=c%20d&sig=AbC%3D&utm_campaign=abc123-us&utm_medium=social&utm_source=twitter.com#top",
+		tpl.Apply("https://example.com/s?z=1&a&q=a+b&q=c%20d&sig=AbC%3D#top", ctx))
+
+	// No template leaves the URL untouched
+	assert.Equal(t, "https://example.com/a?b=c", UTMTemplate(nil).Apply("https://example.com/a?b=c", ctx))
+}
+
+func TestMergeUTMTemplates(t *testing.T) {
+	workspace := UTMTemplate{"utm_source": "newsletter", "utm_medium": "email"}
+	link := UTMTemplate{"utm_campaign": "spring", "utm_medium": ""}
+
+	merged := MergeUTMTemplates(workspace, link)
+	assert.Equal(t, UTMTemplate{"utm_source": "newsletter", "utm_campaign": "spring"}, merged)
+	assert.Nil(t, MergeUTMTemplates(nil, nil))
+}
+
+func TestExtractUTMValues(t *testing.T) {
+	values := ExtractUTMValues("https://example.com/?utm_source=x&utm_campaign=y&page=2")
+	assert.Equal(t, map[string]string{"utm_source": "x", "utm_campaign": "y"}, values)
+	assert.Equal(t, "twitter.com", ReferrerDomain("https://www.twitter.com/some/post"))
+}
@@ -0,0 +1,625 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-systems-lab/go-url-shortener/utils/database"
+	"github.com/go-systems-lab/go-url-shortener/utils/webhook"
+)
+
+// Webhook settings (business rule: failed deliveries are retried with
+// exponential backoff, webhooks that keep failing are turned off)
+const (
+	maxWebhooksPerWorkspace    = 10
+	webhookSecretBytes         = 32
+	webhookDispatchBatchSize   = 100
+	webhookDispatchConcurrency = 8
+	webhookLease               = 5 * time.Minute // longer than a batch takes to send
+	webhookInitialBackoff      = 30 * time.Second
+	webhookMaxBackoff          = 6 * time.Hour
+	DefaultWebhookMaxAttempts  = 8  // per delivery, about 2 hours of retries
+	DefaultWebhookDisableAfter = 20 // consecutive failed attempts across deliveries
+	DefaultWebhookRetention    = 30 * 24 * time.Hour
+)
+
+// Webhook event types
+const (
+	WebhookURLCreated     = "url.created"
+	WebhookURLUpdated     = "url.updated"
+	WebhookURLDeleted     = "url.deleted"
+	WebhookURLExpired     = "url.expired"
+	WebhookURLExpiring    = "url.expiring"
+	WebhookClickThreshold = "url.click_threshold"
+	WebhookTrafficSpike   = "url.traffic_spike"
+)
+
+// webhookEvents lists the event types webhooks can subscribe to
+var webhookEvents = map[string]bool{
+	WebhookURLCreated:     true,
+	WebhookURLUpdated:     true,
+	WebhookURLDeleted:     true,
+	WebhookURLExpired:     true,
+	WebhookURLExpiring:    true,
+	WebhookClickThreshold: true,
+	WebhookTrafficSpike:   true,
+}
+
+// linkAlertEvents lists the event types other services raise about a link
+var linkAlertEvents = map[string]bool{
+	WebhookClickThreshold: true,
+	WebhookTrafficSpike:   true,
+}
+
+// Webhook is an endpoint subscribed to a workspace's link events. Secret is
+// only filled in when it is created or rotated.
+type Webhook struct {
+	ID                  int64      `json:"id"`
+	WorkspaceID         string     `json:"workspace_id"`
+	URL                 string     `json:"url"`
+	Secret              string     `json:"secret,omitempty"`
+	Events              []string   `json:"events"` // empty for all events
+	IsActive            bool       `json:"is_active"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	DisabledAt          *time.Time `json:"disabled_at,omitempty"` // when repeated failures turned it off
+	CreatedBy           string     `json:"created_by"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+}
+
+// CreateWebhookRequest subscribes an endpoint to a workspace's events
+type CreateWebhookRequest struct {
+	WorkspaceID string   `json:"workspace_id"`
+	UserID      string   `json:"user_id"` // must own the workspace
+	URL         string   `json:"url"`
+	Events      []string `json:"events,omitempty"` // empty for all events
+}
+
+// UpdateWebhookRequest changes a webhook; zero fields keep their value
+type UpdateWebhookRequest struct {
+	WorkspaceID  string   `json:"workspace_id"`
+	UserID       string   `json:"user_id"`
+	WebhookID    int64    `json:"webhook_id"`
+	URL          string   `json:"url,omitempty"`
+	Events       []string `json:"events,omitempty"`
+	ClearEvents  bool     `json:"clear_events,omitempty"` // subscribe to all events
+	IsActive     *bool    `json:"is_active,omitempty"`    // re-enabling resets the failure count
+	RotateSecret bool     `json:"rotate_secret,omitempty"`
+}
+
+// WebhookDelivery is a queued, sent or abandoned delivery of an event
+type WebhookDelivery struct {
+	ID             int64      `json:"id"`
+	WebhookID      int64      `json:"webhook_id"`
+	EventID        string     `json:"event_id"`
+	EventType      string     `json:"event_type"`
+	Payload        string     `json:"payload"`
+	Status         string     `json:"status"` // pending, succeeded or failed
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  *time.Time `json:"next_attempt_at,omitempty"` // pending deliveries only
+	LastAttemptAt  *time.Time `json:"last_attempt_at,omitempty"`
+	ResponseStatus int        `json:"response_status,omitempty"`
+	ResponseBody   string     `json:"response_body,omitempty"`
+	Error          string     `json:"error,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+}
+
+// ListWebhookDeliveriesRequest pages the delivery log of a webhook
+type ListWebhookDeliveriesRequest struct {
+	WorkspaceID string `json:"workspace_id"`
+	UserID      string `json:"user_id"`
+	WebhookID   int64  `json:"webhook_id"`
+	Status      string `json:"status,omitempty"` // filter, empty for all
+	PageSize    int32  `json:"page_size"`
+	Cursor      string `json:"cursor,omitempty"`
+}
+
+// ListWebhookDeliveriesResponse is a page of deliveries, newest first
+type ListWebhookDeliveriesResponse struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+	TotalCount int32             `json:"total_count"`
+	PageSize   int32             `json:"page_size"`
+	HasNext    bool              `json:"has_next"`
+	NextCursor string            `json:"next_cursor,omitempty"`
+	PrevCursor string            `json:"prev_cursor,omitempty"`
+}
+
+// WebhookPayload is the JSON body of a delivery. ID identifies the event, so
+// receivers can drop the copies retries and redeliveries send.
+type WebhookPayload struct {
+	ID          string      `json:"id"`
+	Type        string      `json:"type"`
+	CreatedAt   time.Time   `json:"created_at"`
+	WorkspaceID string      `json:"workspace_id"`
+	Data        interface{} `json:"data"`
+}
+
+// WebhookDispatchResult summarizes a run of the webhook dispatcher
+type WebhookDispatchResult struct {
+	Sent      int `json:"sent"`
+	Succeeded int `json:"succeeded"`
+	Retrying  int `json:"retrying"`
+	Failed    int `json:"failed"`   // deliveries out of attempts
+	Disabled  int `json:"disabled"` // webhooks turned off
+}
+
+// CreateWebhook subscribes an endpoint to the events of a workspace the user
+// owns. The returned webhook carries the signing secret, which is not shown again.
+func (s *URLService) CreateWebhook(req *CreateWebhookRequest) (*Webhook, error) {
+	if _, err := s.getOwnedWorkspace(req.WorkspaceID, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := webhook.ValidateURL(req.URL); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidWebhook, err)
+	}
+	events, err := normalizeWebhookEvents(req.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.db.CountWebhooks(req.WorkspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count webhooks: %w", err)
+	}
+	if count >= maxWebhooksPerWorkspace {
+		return nil, fmt.Errorf("%w: a workspace can have at most %d webhooks", ErrInvalidWebhook, maxWebhooksPerWorkspace)
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+	dbWebhook := &database.Webhook{
+		WorkspaceID: req.WorkspaceID,
+		URL:         req.URL,
+		Secret:      secret,
+		Events:      webhookEventsJSON(events),
+		CreatedBy:   req.UserID,
+	}
+	if err := s.db.CreateWebhook(dbWebhook); err != nil {
+		return nil, fmt.Errorf("failed to save webhook: %w", err)
+	}
+
+	created := dbToDomainWebhook(dbWebhook)
+	created.Secret = secret
+	return created, nil
+}
+
+// ListWebhooks lists the webhooks of a workspace the user owns
+func (s *URLService) ListWebhooks(workspaceID, userID string) ([]Webhook, error) {
+	if _, err := s.getOwnedWorkspace(workspaceID, userID); err != nil {
+		return nil, err
+	}
+
+	dbWebhooks, err := s.db.ListWebhooks(workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	webhooks := make([]Webhook, len(dbWebhooks))
+	for i := range dbWebhooks {
+		webhooks[i] = *dbToDomainWebhook(&dbWebhooks[i])
+	}
+	return webhooks, nil
+}
+
+// UpdateWebhook changes the endpoint, event filter or state of a webhook, or
+// rotates its secret. A rotated secret is returned once, like on creation.
+func (s *URLService) UpdateWebhook(req *UpdateWebhookRequest) (*Webhook, error) {
+	dbWebhook, err := s.getOwnedWebhook(req.WorkspaceID, req.UserID, req.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.URL != "" {
+		if err := webhook.ValidateURL(req.URL); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidWebhook, err)
+		}
+		dbWebhook.URL = req.URL
+	}
+	if req.ClearEvents {
+		dbWebhook.Events = "[]"
+	} else if len(req.Events) > 0 {
+		events, err := normalizeWebhookEvents(req.Events)
+		if err != nil {
+			return nil, err
+		}
+		dbWebhook.Events = webhookEventsJSON(events)
+	}
+	if req.IsActive != nil {
+		if *req.IsActive && !dbWebhook.IsActive {
+			dbWebhook.ConsecutiveFailures = 0
+			dbWebhook.DisabledAt = sql.NullTime{}
+		}
+		dbWebhook.IsActive = *req.IsActive
+	}
+	var secret string
+	if req.RotateSecret {
+		if secret, err = newWebhookSecret(); err != nil {
+			return nil, err
+		}
+		dbWebhook.Secret = secret
+	}
+
+	if err := s.db.UpdateWebhook(dbWebhook); err != nil {
+		return nil, fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	updated := dbToDomainWebhook(dbWebhook)
+	updated.Secret = secret
+	return updated, nil
+}
+
+// DeleteWebhook removes a webhook and its delivery log
+func (s *URLService) DeleteWebhook(workspaceID, userID string, webhookID int64) error {
+	if _, err := s.getOwnedWorkspace(workspaceID, userID); err != nil {
+		return err
+	}
+
+	deleted, err := s.db.DeleteWebhook(workspaceID, webhookID)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+	if !deleted {
+		return ErrWebhookNotFound
+	}
+	return nil
+}
+
+// ListWebhookDeliveries lists the delivery log of a webhook, newest first
+func (s *URLService) ListWebhookDeliveries(req *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	if _, err := s.getOwnedWebhook(req.WorkspaceID, req.UserID, req.WebhookID); err != nil {
+		return nil, err
+	}
+	switch req.Status {
+	case "", database.WebhookDeliveryPending, database.WebhookDeliverySucceeded, database.WebhookDeliveryFailed:
+	default:
+		return nil, fmt.Errorf("%w: status must be pending, succeeded or failed", ErrInvalidWebhook)
+	}
+
+	pages, err := newPager("webhook_deliveries", 0, req.PageSize, req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	dbDeliveries, err := s.db.ListWebhookDeliveries(req.WebhookID, req.Status, pages.cursor, pages.limit(), pages.offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve webhook deliveries: %w", err)
+	}
+	total, err := s.db.CountWebhookDeliveries(req.WebhookID, req.Status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count webhook deliveries: %w", err)
+	}
+
+	dbDeliveries, cursors := paginate(pages, dbDeliveries, func(delivery *database.WebhookDelivery, backward bool) database.Cursor {
+		return database.WebhookDeliveryCursor(delivery, backward)
+	})
+	deliveries := make([]WebhookDelivery, len(dbDeliveries))
+	for i := range dbDeliveries {
+		deliveries[i] = dbToDomainWebhookDelivery(&dbDeliveries[i])
+	}
+
+	return &ListWebhookDeliveriesResponse{
+		Deliveries: deliveries,
+		TotalCount: int32(total),
+		PageSize:   int32(pages.size),
+		HasNext:    cursors.hasNext,
+		NextCursor: cursors.next,
+		PrevCursor: cursors.prev,
+	}, nil
+}
+
+// RedeliverWebhook queues a delivery again with the same event ID and
+// payload; the original stays in the log as it was
+func (s *URLService) RedeliverWebhook(workspaceID, userID string, webhookID, deliveryID int64) (*WebhookDelivery, error) {
+	dbWebhook, err := s.getOwnedWebhook(workspaceID, userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	if !dbWebhook.IsActive {
+		return nil, ErrWebhookDisabled
+	}
+
+	dbDelivery, err := s.db.RedeliverWebhookDelivery(webhookID, deliveryID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrWebhookDeliveryNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to queue redelivery: %w", err)
+	}
+
+	delivery := dbToDomainWebhookDelivery(dbDelivery)
+	return &delivery, nil
+}
+
+// EmitWebhookEvent queues an event for the webhooks of a workspace that
+// subscribed to it. data is sent as the payload's data; links outside
+// workspaces have no webhooks.
+func (s *URLService) EmitWebhookEvent(workspaceID, eventType string, data interface{}) error {
+	if workspaceID == "" {
+		return nil
+	}
+
+	eventID, err := newWebhookEventID()
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(&WebhookPayload{
+		ID:          eventID,
+		Type:        eventType,
+		CreatedAt:   time.Now().UTC(),
+		WorkspaceID: workspaceID,
+		Data:        data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+
+	if _, err := s.db.EnqueueWebhookDeliveries(workspaceID, eventID, eventType, string(payload)); err != nil {
+		return fmt.Errorf("failed to queue webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+// EmitLinkAlert queues an event another service raised about a link, such as
+// a click threshold being reached, for the webhooks of the link's workspace
+func (s *URLService) EmitLinkAlert(shortDomain, shortCode, eventType string, data json.RawMessage) error {
+	if !linkAlertEvents[eventType] {
+		return fmt.Errorf("%w: unknown link alert %q", ErrInvalidWebhook, eventType)
+	}
+
+	dbURL, err := s.db.GetURLByShortCode(shortDomain, shortCode)
+	if err != nil {
+		return ErrURLNotFound
+	}
+	if !dbURL.WorkspaceID.Valid {
+		return nil
+	}
+
+	alert := map[string]interface{}{
+		"domain":     dbURL.Domain,
+		"short_code": dbURL.ShortCode,
+		"short_url":  ShortURL(dbURL.Domain, dbURL.ShortCode),
+		"long_url":   dbURL.LongURL,
+	}
+	if len(data) > 0 {
+		alert["alert"] = data
+	}
+	return s.EmitWebhookEvent(dbURL.WorkspaceID.String, eventType, alert)
+}
+
+// DispatchWebhooks sends the due deliveries until none are left. A failed
+// delivery is retried with exponential backoff until it used maxAttempts; a
+// webhook is turned off after disableAfter consecutive failed attempts (0
+// never). Replicas can dispatch at the same time: deliveries are leased.
+func (s *URLService) DispatchWebhooks(ctx context.Context, sender *webhook.Sender, maxAttempts, disableAfter int) (*WebhookDispatchResult, error) {
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultWebhookMaxAttempts
+	}
+
+	result := &WebhookDispatchResult{}
+	var mu sync.Mutex
+	var recordErr error
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		deliveries, err := s.db.ClaimWebhookDeliveries(webhookDispatchBatchSize, webhookLease)
+		if err != nil {
+			return result, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+		}
+
+		sem := make(chan struct{}, webhookDispatchConcurrency)
+		var wg sync.WaitGroup
+		for i := range deliveries {
+			delivery := &deliveries[i]
+			sem <- struct{}{}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				sent := sender.Send(ctx, webhook.Request{
+					URL:        delivery.URL,
+					Secret:     delivery.Secret,
+					Event:      delivery.EventType,
+					DeliveryID: strconv.FormatInt(delivery.ID, 10),
+					Body:       []byte(delivery.Payload),
+				})
+				attempt := webhookAttempt(delivery, &sent, maxAttempts, time.Now())
+				disabled, err := s.db.RecordWebhookAttempt(attempt, disableAfter)
+
+				mu.Lock()
+				defer mu.Unlock()
+				result.Sent++
+				if err != nil {
+					recordErr = fmt.Errorf("failed to record webhook delivery %d: %w", delivery.ID, err)
+					return
+				}
+				switch attempt.Status {
+				case database.WebhookDeliverySucceeded:
+					result.Succeeded++
+				case database.WebhookDeliveryFailed:
+					result.Failed++
+				default:
+					result.Retrying++
+				}
+				if disabled {
+					result.Disabled++
+				}
+			}()
+		}
+		wg.Wait()
+
+		if recordErr != nil {
+			return result, recordErr
+		}
+		if len(deliveries) < webhookDispatchBatchSize {
+			return result, nil
+		}
+	}
+}
+
+// PruneWebhookDeliveries removes finished deliveries older than the retention
+func (s *URLService) PruneWebhookDeliveries(retention time.Duration) (int64, error) {
+	if retention <= 0 {
+		return 0, nil
+	}
+	removed, err := s.db.DeleteWebhookDeliveriesBefore(time.Now().Add(-retention))
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune webhook deliveries: %w", err)
+	}
+	return removed, nil
+}
+
+// webhookAttempt turns the outcome of sending a delivery into its new state
+func webhookAttempt(delivery *database.ClaimedWebhookDelivery, sent *webhook.Result, maxAttempts int, now time.Time) *database.WebhookAttempt {
+	attempt := &database.WebhookAttempt{
+		DeliveryID:     delivery.ID,
+		WebhookID:      delivery.WebhookID,
+		Status:         database.WebhookDeliverySucceeded,
+		NextAttemptAt:  now,
+		ResponseStatus: sent.StatusCode,
+		ResponseBody:   sent.Body,
+		Error:          sent.Error,
+	}
+	if sent.Succeeded() {
+		return attempt
+	}
+
+	if attempt.Error == "" {
+		attempt.Error = fmt.Sprintf("unexpected status %d", sent.StatusCode)
+	}
+	attempts := delivery.Attempts + 1
+	if attempts >= maxAttempts {
+		attempt.Status = database.WebhookDeliveryFailed
+	} else {
+		attempt.Status = database.WebhookDeliveryPending
+		attempt.NextAttemptAt = now.Add(webhookBackoff(attempts))
+	}
+	return attempt
+}
+
+// webhookBackoff is the wait before the next attempt after the attempts-th
+// failed one: 30s, doubling up to 6 hours
+func webhookBackoff(attempts int) time.Duration {
+	backoff := webhookInitialBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= webhookMaxBackoff {
+			return webhookMaxBackoff
+		}
+	}
+	return backoff
+}
+
+// normalizeWebhookEvents validates an event filter, dropping duplicates
+func normalizeWebhookEvents(events []string) ([]string, error) {
+	seen := make(map[string]bool, len(events))
+	normalized := make([]string, 0, len(events))
+	for _, event := range events {
+		if !webhookEvents[event] {
+			return nil, fmt.Errorf("%w: unknown event %q", ErrInvalidWebhook, event)
+		}
+		if !seen[event] {
+			seen[event] = true
+			normalized = append(normalized, event)
+		}
+	}
+	sort.Strings(normalized)
+	return normalized, nil
+}
+
+// webhookEventsJSON encodes an event filter for the database
+func webhookEventsJSON(events []string) string {
+	if len(events) == 0 {
+		return "[]"
+	}
+	data, _ := json.Marshal(events)
+	return string(data)
+}
+
+// newWebhookSecret generates a signing secret
+func newWebhookSecret() (string, error) {
+	b := make([]byte, webhookSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// newWebhookEventID generates the ID of an event
+func newWebhookEventID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate event ID: %w", err)
+	}
+	return "evt_" + hex.EncodeToString(b), nil
+}
+
+// getOwnedWebhook loads a webhook of a workspace the user owns
+func (s *URLService) getOwnedWebhook(workspaceID, userID string, webhookID int64) (*database.Webhook, error) {
+	if _, err := s.getOwnedWorkspace(workspaceID, userID); err != nil {
+		return nil, err
+	}
+	dbWebhook, err := s.db.GetWebhook(workspaceID, webhookID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrWebhookNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load webhook: %w", err)
+	}
+	return dbWebhook, nil
+}
+
+func dbToDomainWebhook(dbWebhook *database.Webhook) *Webhook {
+	events := []string{}
+	json.Unmarshal([]byte(dbWebhook.Events), &events)
+	return &Webhook{
+		ID:                  dbWebhook.ID,
+		WorkspaceID:         dbWebhook.WorkspaceID,
+		URL:                 dbWebhook.URL,
+		Events:              events,
+		IsActive:            dbWebhook.IsActive,
+		ConsecutiveFailures: dbWebhook.ConsecutiveFailures,
+		DisabledAt:          copyTime(dbWebhook.DisabledAt),
+		CreatedBy:           dbWebhook.CreatedBy,
+		CreatedAt:           dbWebhook.CreatedAt,
+		UpdatedAt:           dbWebhook.UpdatedAt,
+	}
+}
+
+func dbToDomainWebhookDelivery(dbDelivery *database.WebhookDelivery) WebhookDelivery {
+	delivery := WebhookDelivery{
+		ID:             dbDelivery.ID,
+		WebhookID:      dbDelivery.WebhookID,
+		EventID:        dbDelivery.EventID,
+		EventType:      dbDelivery.EventType,
+		Payload:        dbDelivery.Payload,
+		Status:         dbDelivery.Status,
+		Attempts:       dbDelivery.Attempts,
+		LastAttemptAt:  copyTime(dbDelivery.LastAttemptAt),
+		ResponseStatus: dbDelivery.ResponseStatus,
+		ResponseBody:   dbDelivery.ResponseBody,
+		Error:          dbDelivery.Error,
+		CreatedAt:      dbDelivery.CreatedAt,
+		DeliveredAt:    copyTime(dbDelivery.DeliveredAt),
+	}
+	if dbDelivery.Status == database.WebhookDeliveryPending {
+		nextAttemptAt := dbDelivery.NextAttemptAt
+		delivery.NextAttemptAt = &nextAttemptAt
+	}
+	return delivery
+}
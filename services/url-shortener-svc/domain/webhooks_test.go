@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-systems-lab/go-url-shortener/utils/database"
+	"github.com/go-systems-lab/go-url-shortener/utils/webhook"
+)
+
+func TestWebhookBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, webhookBackoff(1))
+	assert.Equal(t, time.Minute, webhookBackoff(2))
+	assert.Equal(t, 2*time.Minute, webhookBackoff(3))
+	assert.Equal(t, 32*time.Minute, webhookBackoff(7))
+	assert.Equal(t, webhookMaxBackoff, webhookBackoff(12))
+	assert.Equal(t, webhookMaxBackoff, webhookBackoff(100))
+}
+
+func TestWebhookAttempt(t *testing.T) {
+	now := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	delivery := &database.ClaimedWebhookDelivery{
+		WebhookDelivery: database.WebhookDelivery{ID: 7, WebhookID: 3, Attempts: 0},
+	}
+
+	attempt := webhookAttempt(delivery, &webhook.Result{StatusCode: http.StatusNoContent}, 3, now)
+	assert.Equal(t, database.WebhookDeliverySucceeded, attempt.Status)
+	assert.Empty(t, attempt.Error)
+
+	// A failure is retried after the backoff
+	attempt = webhookAttempt(delivery, &webhook.Result{StatusCode: http.StatusBadGateway}, 3, now)
+	assert.Equal(t, database.WebhookDeliveryPending, attempt.Status)
+	assert.Equal(t, now.Add(30*time.Second), attempt.NextAttemptAt)
+	assert.Equal(t, "unexpected status 502", attempt.Error)
+
+	// The last attempt gives up
+	delivery.Attempts = 2
+	attempt = webhookAttempt(delivery, &webhook.Result{Error: "connection refused"}, 3, now)
+	assert.Equal(t, database.WebhookDeliveryFailed, attempt.Status)
+	assert.Equal(t, "connection refused", attempt.Error)
+}
+
+func TestNormalizeWebhookEvents(t *testing.T) {
+	events, err := normalizeWebhookEvents([]string{WebhookURLDeleted, WebhookURLCreated, WebhookURLDeleted})
+	require.NoError(t, err)
+	assert.Equal(t, []string{WebhookURLCreated, WebhookURLDeleted}, events)
+	assert.Equal(t, `["url.created","url.deleted"]`, webhookEventsJSON(events))
+
+	events, err = normalizeWebhookEvents(nil)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+	assert.Equal(t, "[]", webhookEventsJSON(events))
+
+	_, err = normalizeWebhookEvents([]string{"url.clicked"})
+	assert.ErrorIs(t, err, ErrInvalidWebhook)
+}
@@ -6,11 +6,17 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
 
 	pb "github.com/go-systems-lab/go-url-shortener/proto/url"
+	"github.com/go-systems-lab/go-url-shortener/services/url-shortener-svc/domain"
 	"github.com/go-systems-lab/go-url-shortener/services/url-shortener-svc/store"
+	"github.com/go-systems-lab/go-url-shortener/utils/alias"
 	"github.com/go-systems-lab/go-url-shortener/utils/cache"
 	"github.com/go-systems-lab/go-url-shortener/utils/database"
+	"github.com/go-systems-lab/go-url-shortener/utils/preview"
+	"github.com/go-systems-lab/go-url-shortener/utils/qr"
+	"github.com/go-systems-lab/go-url-shortener/utils/safety"
 )
 
 // URLHandler implements the Go Micro URLShortenerHandler interface
@@ -20,8 +26,8 @@ type URLHandler struct {
 }
 
 // NewURLHandler creates a new URL handler instance
-func NewURLHandler(db *database.PostgreSQL, cache *cache.Redis) pb.URLShortenerHandler {
-	urlStore := store.NewURLStore(db, cache)
+func NewURLHandler(db *database.PostgreSQL, cache *cache.Redis, checker safety.Checker, previews *preview.Fetcher, logos *qr.LogoFetcher, aliases *alias.Policy) pb.URLShortenerHandler {
+	urlStore := store.NewURLStore(db, cache, checker, previews, logos, aliases)
 	return &URLHandler{
 		store: urlStore,
 		log:   logrus.New(),
@@ -41,6 +47,17 @@ func (h *URLHandler) ShortenURL(ctx context.Context, req *pb.ShortenRequest, rsp
 		CustomAlias: req.CustomAlias,
 		UserID:      req.UserId,
 		Metadata:    req.Metadata,
+		WorkspaceID: req.WorkspaceId,
+		UTMTemplate: req.UtmTemplate,
+		Password:    req.Password,
+		FallbackURL: req.FallbackUrl,
+		MaxClicks:   req.MaxClicks,
+		Domain:      req.Domain,
+		Tags:        req.Tags,
+		FolderID:    req.FolderId,
+	}
+	if req.PreviewOverride != nil {
+		storeReq.PreviewOverride = linkPreviewFromProto(req.PreviewOverride)
 	}
 
 	// Handle expiration time
@@ -49,23 +66,38 @@ func (h *URLHandler) ShortenURL(ctx context.Context, req *pb.ShortenRequest, rsp
 		storeReq.ExpirationTime = &expirationTime
 	}
 
+	// Handle scheduled activation time
+	if req.ActivationTime > 0 {
+		activationTime := time.Unix(req.ActivationTime, 0)
+		storeReq.ActivationTime = &activationTime
+	}
+
 	// Call store layer
 	urlResponse, err := h.store.ShortenURL(storeReq)
 	if err != nil {
 		h.log.WithError(err).Error("Failed to shorten URL")
 		return fmt.Errorf("failed to shorten URL: %w", err)
 	}
+	h.audit(ctx, req.UserId, domain.AuditURLCreate, domain.AuditURLTarget(urlResponse.Domain, urlResponse.ShortCode), nil, urlResponse)
+	h.notify(domain.WebhookURLCreated, urlResponse)
 
 	// Convert store response to protobuf response
 	rsp.ShortCode = urlResponse.ShortCode
-	rsp.ShortUrl = fmt.Sprintf("https://short.ly/%s", urlResponse.ShortCode) // TODO: Make configurable
+	rsp.ShortUrl = urlResponse.ShortURL
+	rsp.Domain = urlResponse.Domain
 	rsp.LongUrl = urlResponse.LongURL
 	rsp.CreatedAt = urlResponse.CreatedAt.Unix()
 	rsp.UserId = urlResponse.UserID
+	rsp.WorkspaceId = urlResponse.WorkspaceID
+	rsp.PasswordProtected = urlResponse.PasswordProtected
+	rsp.MaxClicks = urlResponse.MaxClicks
 
 	if urlResponse.ExpiresAt != nil {
 		rsp.ExpiresAt = urlResponse.ExpiresAt.Unix()
 	}
+	if urlResponse.ActivatesAt != nil {
+		rsp.ActivatesAt = urlResponse.ActivatesAt.Unix()
+	}
 
 	h.log.WithFields(logrus.Fields{
 		"short_code": rsp.ShortCode,
@@ -83,7 +115,7 @@ func (h *URLHandler) GetURLInfo(ctx context.Context, req *pb.GetURLRequest, rsp
 	}).Info("Processing GetURLInfo request")
 
 	// Call store layer
-	urlResponse, err := h.store.GetURL(req.ShortCode, req.UserId)
+	urlResponse, err := h.store.GetURL(req.Domain, req.ShortCode, req.UserId)
 	if err != nil {
 		h.log.WithError(err).Error("Failed to get URL info")
 		return fmt.Errorf("failed to get URL info: %w", err)
@@ -91,17 +123,31 @@ func (h *URLHandler) GetURLInfo(ctx context.Context, req *pb.GetURLRequest, rsp
 
 	// Convert store response to protobuf response
 	rsp.ShortCode = urlResponse.ShortCode
-	rsp.ShortUrl = fmt.Sprintf("https://short.ly/%s", urlResponse.ShortCode) // TODO: Make configurable
+	rsp.ShortUrl = urlResponse.ShortURL
+	rsp.Domain = urlResponse.Domain
 	rsp.LongUrl = urlResponse.LongURL
 	rsp.UserId = urlResponse.UserID
 	rsp.CreatedAt = urlResponse.CreatedAt.Unix()
 	rsp.ClickCount = urlResponse.ClickCount
 	rsp.IsActive = urlResponse.IsActive
 	rsp.Metadata = urlResponse.Metadata
+	rsp.WorkspaceId = urlResponse.WorkspaceID
+	rsp.UtmTemplate = urlResponse.UTMTemplate
+	rsp.PasswordProtected = urlResponse.PasswordProtected
+	rsp.FallbackUrl = urlResponse.FallbackURL
+	rsp.MaxClicks = urlResponse.MaxClicks
+	rsp.InterstitialMode = urlResponse.InterstitialMode
+	rsp.Preview = linkPreviewToProto(urlResponse.Preview)
+	rsp.PreviewOverride = linkPreviewToProto(urlResponse.PreviewOverride)
+	rsp.Tags = urlResponse.Tags
+	rsp.FolderId = urlResponse.FolderID
 
 	if urlResponse.ExpiresAt != nil {
 		rsp.ExpiresAt = urlResponse.ExpiresAt.Unix()
 	}
+	if urlResponse.ActivatesAt != nil {
+		rsp.ActivatesAt = urlResponse.ActivatesAt.Unix()
+	}
 
 	h.log.WithFields(logrus.Fields{
 		"short_code": rsp.ShortCode,
@@ -119,13 +165,16 @@ func (h *URLHandler) DeleteURL(ctx context.Context, req *pb.DeleteURLRequest, rs
 	}).Info("Processing DeleteURL request")
 
 	// Call store layer
-	err := h.store.DeleteURL(req.ShortCode, req.UserId)
+	before := h.store.AuditSnapshot(req.Domain, req.ShortCode)
+	err := h.store.DeleteURL(req.Domain, req.ShortCode, req.UserId)
 	if err != nil {
 		h.log.WithError(err).Error("Failed to delete URL")
 		rsp.Success = false
 		rsp.Message = fmt.Sprintf("Failed to delete URL: %v", err)
 		return nil
 	}
+	h.audit(ctx, req.UserId, domain.AuditURLDelete, domain.AuditURLTarget(req.Domain, req.ShortCode), before, nil)
+	h.notify(domain.WebhookURLDeleted, before)
 
 	rsp.Success = true
 	rsp.Message = "URL deleted successfully"
@@ -138,6 +187,161 @@ func (h *URLHandler) DeleteURL(ctx context.Context, req *pb.DeleteURLRequest, rs
 	return nil
 }
 
+// ListDeletedURLs implements the ListDeletedURLs RPC method
+func (h *URLHandler) ListDeletedURLs(ctx context.Context, req *pb.ListDeletedURLsRequest, rsp *pb.GetUserURLsResponse) error {
+	storeResponse, err := h.store.ListDeletedURLs(req.UserId, req.Page, req.PageSize, req.Cursor)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to list deleted URLs")
+		return fmt.Errorf("failed to list deleted URLs: %w", err)
+	}
+
+	rsp.Urls = make([]*pb.URLInfo, len(storeResponse.URLs))
+	for i := range storeResponse.URLs {
+		rsp.Urls[i] = urlInfoToProto(&storeResponse.URLs[i])
+	}
+	rsp.TotalCount = storeResponse.TotalCount
+	rsp.Page = storeResponse.Page
+	rsp.PageSize = storeResponse.PageSize
+	rsp.HasNext = storeResponse.HasNext
+	rsp.NextCursor = storeResponse.NextCursor
+	rsp.PrevCursor = storeResponse.PrevCursor
+
+	return nil
+}
+
+// RestoreURL implements the RestoreURL RPC method
+func (h *URLHandler) RestoreURL(ctx context.Context, req *pb.RestoreURLRequest, rsp *pb.URLInfo) error {
+	h.log.WithFields(logrus.Fields{
+		"short_code": req.ShortCode,
+		"user_id":    req.UserId,
+	}).Info("Processing RestoreURL request")
+
+	url, err := h.store.RestoreURL(req.Domain, req.ShortCode, req.UserId)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to restore URL")
+		return fmt.Errorf("failed to restore URL: %w", err)
+	}
+	h.audit(ctx, req.UserId, domain.AuditURLRestore, domain.AuditURLTarget(req.Domain, req.ShortCode), nil, url)
+
+	proto.Merge(rsp, urlInfoToProto(url))
+	return nil
+}
+
+// SetInactivityPolicy implements the SetInactivityPolicy RPC method
+func (h *URLHandler) SetInactivityPolicy(ctx context.Context, req *pb.SetInactivityPolicyRequest, rsp *pb.WorkspaceInfo) error {
+	h.log.WithFields(logrus.Fields{
+		"workspace_id":    req.WorkspaceId,
+		"user_id":         req.UserId,
+		"inactivity_days": req.InactivityDays,
+		"dry_run":         req.DryRun,
+	}).Info("Processing SetInactivityPolicy request")
+
+	workspace, err := h.store.SetInactivityPolicy(req.WorkspaceId, req.UserId, int(req.InactivityDays), req.DryRun)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to set inactivity policy")
+		return fmt.Errorf("failed to set inactivity policy: %w", err)
+	}
+
+	h.workspaceToProto(workspace, rsp)
+	return nil
+}
+
+// GetInactivityReport implements the GetInactivityReport RPC method
+func (h *URLHandler) GetInactivityReport(ctx context.Context, req *pb.InactivityReportRequest, rsp *pb.InactivityReport) error {
+	h.log.WithFields(logrus.Fields{
+		"workspace_id":    req.WorkspaceId,
+		"user_id":         req.UserId,
+		"inactivity_days": req.InactivityDays,
+	}).Info("Processing GetInactivityReport request")
+
+	report, err := h.store.GetInactivityReport(req.WorkspaceId, req.UserId, int(req.InactivityDays), int(req.Limit))
+	if err != nil {
+		h.log.WithError(err).Error("Failed to get inactivity report")
+		return fmt.Errorf("failed to get inactivity report: %w", err)
+	}
+
+	rsp.WorkspaceId = report.WorkspaceID
+	rsp.InactivityDays = int32(report.InactivityDays)
+	rsp.DryRun = report.DryRun
+	rsp.TotalCount = int32(report.TotalCount)
+	rsp.Urls = make([]*pb.URLInfo, len(report.URLs))
+	for i := range report.URLs {
+		rsp.Urls[i] = urlInfoToProto(&report.URLs[i])
+	}
+	return nil
+}
+
+// ReviveURL implements the ReviveURL RPC method
+func (h *URLHandler) ReviveURL(ctx context.Context, req *pb.RestoreURLRequest, rsp *pb.URLInfo) error {
+	h.log.WithFields(logrus.Fields{
+		"short_code": req.ShortCode,
+		"user_id":    req.UserId,
+	}).Info("Processing ReviveURL request")
+
+	url, err := h.store.ReviveURL(req.Domain, req.ShortCode, req.UserId)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to revive URL")
+		return fmt.Errorf("failed to revive URL: %w", err)
+	}
+	h.audit(ctx, req.UserId, domain.AuditURLRevive, domain.AuditURLTarget(req.Domain, req.ShortCode), nil, url)
+
+	proto.Merge(rsp, urlInfoToProto(url))
+	return nil
+}
+
+// ListURLRevisions implements the ListURLRevisions RPC method
+func (h *URLHandler) ListURLRevisions(ctx context.Context, req *pb.ListURLRevisionsRequest, rsp *pb.ListURLRevisionsResponse) error {
+	storeResponse, err := h.store.ListURLRevisions(req.Domain, req.ShortCode, req.UserId, req.PageSize, req.Cursor)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to list URL revisions")
+		return fmt.Errorf("failed to list URL revisions: %w", err)
+	}
+
+	rsp.Revisions = make([]*pb.URLRevision, len(storeResponse.Revisions))
+	for i, revision := range storeResponse.Revisions {
+		changes := make([]*pb.FieldChange, len(revision.Changes))
+		for j, change := range revision.Changes {
+			changes[j] = &pb.FieldChange{Field: change.Field, OldValue: change.OldValue, NewValue: change.NewValue}
+		}
+		rsp.Revisions[i] = &pb.URLRevision{
+			Id:         revision.ID,
+			Revision:   int32(revision.Revision),
+			ChangedBy:  revision.ChangedBy,
+			CreatedAt:  revision.CreatedAt.Unix(),
+			Changes:    changes,
+			RollbackOf: revision.RollbackOf,
+		}
+	}
+	rsp.TotalCount = storeResponse.TotalCount
+	rsp.PageSize = storeResponse.PageSize
+	rsp.HasNext = storeResponse.HasNext
+	rsp.NextCursor = storeResponse.NextCursor
+	rsp.PrevCursor = storeResponse.PrevCursor
+
+	return nil
+}
+
+// RollbackURL implements the RollbackURL RPC method
+func (h *URLHandler) RollbackURL(ctx context.Context, req *pb.RollbackURLRequest, rsp *pb.URLInfo) error {
+	h.log.WithFields(logrus.Fields{
+		"short_code":  req.ShortCode,
+		"user_id":     req.UserId,
+		"revision_id": req.RevisionId,
+	}).Info("Processing RollbackURL request")
+
+	before := h.store.AuditSnapshot(req.Domain, req.ShortCode)
+	url, err := h.store.RollbackURL(req.Domain, req.ShortCode, req.UserId, req.RevisionId)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to roll back URL")
+		return fmt.Errorf("failed to roll back URL: %w", err)
+	}
+	h.audit(ctx, req.UserId, domain.AuditURLRollback, domain.AuditURLTarget(req.Domain, req.ShortCode), before, url)
+	h.notify(domain.WebhookURLUpdated, url)
+
+	proto.Merge(rsp, urlInfoToProto(url))
+	return nil
+}
+
 // GetUserURLs implements the GetUserURLs RPC method
 func (h *URLHandler) GetUserURLs(ctx context.Context, req *pb.GetUserURLsRequest, rsp *pb.GetUserURLsResponse) error {
 	h.log.WithFields(logrus.Fields{
@@ -148,11 +352,15 @@ func (h *URLHandler) GetUserURLs(ctx context.Context, req *pb.GetUserURLsRequest
 
 	// Convert protobuf request to store request
 	storeReq := &store.GetUserURLsRequest{
-		UserID:    req.UserId,
-		Page:      req.Page,
-		PageSize:  req.PageSize,
-		SortBy:    req.SortBy,
-		SortOrder: req.SortOrder,
+		UserID:            req.UserId,
+		Page:              req.Page,
+		PageSize:          req.PageSize,
+		Cursor:            req.Cursor,
+		SortBy:            req.SortBy,
+		SortOrder:         req.SortOrder,
+		Tag:               req.Tag,
+		FolderID:          req.FolderId,
+		IncludeSubfolders: req.IncludeSubfolders,
 	}
 
 	// Call store layer
@@ -164,23 +372,8 @@ func (h *URLHandler) GetUserURLs(ctx context.Context, req *pb.GetUserURLsRequest
 
 	// Convert store URLs to protobuf URLs
 	urls := make([]*pb.URLInfo, len(storeResponse.URLs))
-	for i, storeURL := range storeResponse.URLs {
-		urlInfo := &pb.URLInfo{
-			ShortCode:  storeURL.ShortCode,
-			ShortUrl:   fmt.Sprintf("https://short.ly/%s", storeURL.ShortCode), // TODO: Make configurable
-			LongUrl:    storeURL.LongURL,
-			UserId:     storeURL.UserID,
-			CreatedAt:  storeURL.CreatedAt.Unix(),
-			ClickCount: storeURL.ClickCount,
-			IsActive:   storeURL.IsActive,
-			Metadata:   storeURL.Metadata,
-		}
-
-		if storeURL.ExpiresAt != nil {
-			urlInfo.ExpiresAt = storeURL.ExpiresAt.Unix()
-		}
-
-		urls[i] = urlInfo
+	for i := range storeResponse.URLs {
+		urls[i] = urlInfoToProto(&storeResponse.URLs[i])
 	}
 
 	rsp.Urls = urls
@@ -188,6 +381,8 @@ func (h *URLHandler) GetUserURLs(ctx context.Context, req *pb.GetUserURLsRequest
 	rsp.Page = storeResponse.Page
 	rsp.PageSize = storeResponse.PageSize
 	rsp.HasNext = storeResponse.HasNext
+	rsp.NextCursor = storeResponse.NextCursor
+	rsp.PrevCursor = storeResponse.PrevCursor
 
 	h.log.WithFields(logrus.Fields{
 		"user_id":   req.UserId,
@@ -198,6 +393,48 @@ func (h *URLHandler) GetUserURLs(ctx context.Context, req *pb.GetUserURLsRequest
 	return nil
 }
 
+// SearchURLs implements the SearchURLs RPC method
+func (h *URLHandler) SearchURLs(ctx context.Context, req *pb.SearchURLsRequest, rsp *pb.SearchURLsResponse) error {
+	h.log.WithFields(logrus.Fields{
+		"user_id": req.UserId,
+		"query":   req.Query,
+		"page":    req.Page,
+	}).Info("Processing SearchURLs request")
+
+	storeResponse, err := h.store.SearchURLs(&store.SearchURLsRequest{
+		UserID:   req.UserId,
+		Query:    req.Query,
+		Tag:      req.Tag,
+		Domain:   req.Domain,
+		Status:   req.Status,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Cursor:   req.Cursor,
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to search URLs")
+		return fmt.Errorf("failed to search URLs: %w", err)
+	}
+
+	rsp.Hits = make([]*pb.SearchHit, len(storeResponse.Hits))
+	for i := range storeResponse.Hits {
+		rsp.Hits[i] = &pb.SearchHit{
+			Url:  urlInfoToProto(&storeResponse.Hits[i].URL),
+			Rank: storeResponse.Hits[i].Rank,
+		}
+	}
+	rsp.TotalCount = storeResponse.TotalCount
+	rsp.Page = storeResponse.Page
+	rsp.PageSize = storeResponse.PageSize
+	rsp.HasNext = storeResponse.HasNext
+	rsp.NextCursor = storeResponse.NextCursor
+	rsp.PrevCursor = storeResponse.PrevCursor
+	rsp.TagFacets = facetsToProto(storeResponse.TagFacets)
+	rsp.DomainFacets = facetsToProto(storeResponse.DomainFacets)
+	rsp.StatusFacets = facetsToProto(storeResponse.StatusFacets)
+	return nil
+}
+
 // UpdateURL implements the UpdateURL RPC method
 func (h *URLHandler) UpdateURL(ctx context.Context, req *pb.UpdateURLRequest, rsp *pb.UpdateURLResponse) error {
 	h.log.WithFields(logrus.Fields{
@@ -207,10 +444,43 @@ func (h *URLHandler) UpdateURL(ctx context.Context, req *pb.UpdateURLRequest, rs
 
 	// Convert protobuf request to store request
 	storeReq := &store.UpdateURLRequest{
-		ShortCode:  req.ShortCode,
-		UserID:     req.UserId,
-		NewLongURL: req.NewLongUrl,
-		Metadata:   req.Metadata,
+		Domain:         req.Domain,
+		ShortCode:      req.ShortCode,
+		UserID:         req.UserId,
+		NewLongURL:     req.NewLongUrl,
+		Metadata:       req.Metadata,
+		NewPassword:    req.NewPassword,
+		ClearPassword:  req.ClearPassword,
+		NewFallbackURL: req.NewFallbackUrl,
+		NewMaxClicks:   req.NewMaxClicks,
+		ClearMaxClicks: req.ClearMaxClicks,
+		ClearTags:      req.ClearTags,
+		NewFolderID:    req.NewFolderId,
+		ClearFolder:    req.ClearFolder,
+	}
+
+	// Handle tag replacement (clear_tags removes them all)
+	if len(req.Tags) > 0 {
+		storeReq.Tags = req.Tags
+	}
+
+	// Handle preview override replacement (an empty message clears them)
+	if req.PreviewOverride != nil {
+		previewOverride := linkPreviewFromProto(req.PreviewOverride)
+		storeReq.PreviewOverride = &previewOverride
+	}
+
+	// Handle the inactivity exemption (clear_inactivity_exempt lifts it)
+	if req.InactivityExempt || req.ClearInactivityExempt {
+		exempt := req.InactivityExempt
+		storeReq.InactivityExempt = &exempt
+	}
+
+	// Handle UTM template replacement or removal
+	if req.ClearUtmTemplate {
+		storeReq.UTMTemplate = map[string]string{}
+	} else if len(req.UtmTemplate) > 0 {
+		storeReq.UTMTemplate = req.UtmTemplate
 	}
 
 	// Handle new expiration time
@@ -219,7 +489,14 @@ func (h *URLHandler) UpdateURL(ctx context.Context, req *pb.UpdateURLRequest, rs
 		storeReq.NewExpirationTime = &newExpirationTime
 	}
 
+	// Handle new activation time
+	if req.NewActivationTime > 0 {
+		newActivationTime := time.Unix(req.NewActivationTime, 0)
+		storeReq.NewActivationTime = &newActivationTime
+	}
+
 	// Call store layer
+	before := h.store.AuditSnapshot(req.Domain, req.ShortCode)
 	urlResponse, err := h.store.UpdateURL(storeReq)
 	if err != nil {
 		h.log.WithError(err).Error("Failed to update URL")
@@ -227,31 +504,607 @@ func (h *URLHandler) UpdateURL(ctx context.Context, req *pb.UpdateURLRequest, rs
 		rsp.Message = fmt.Sprintf("Failed to update URL: %v", err)
 		return nil
 	}
+	h.audit(ctx, req.UserId, domain.AuditURLUpdate, domain.AuditURLTarget(req.Domain, req.ShortCode), before, urlResponse)
+	h.notify(domain.WebhookURLUpdated, urlResponse)
 
-	// Convert store response to protobuf response
-	updatedURL := &pb.URLInfo{
-		ShortCode:  urlResponse.ShortCode,
-		ShortUrl:   fmt.Sprintf("https://short.ly/%s", urlResponse.ShortCode), // TODO: Make configurable
-		LongUrl:    urlResponse.LongURL,
-		UserId:     urlResponse.UserID,
-		CreatedAt:  urlResponse.CreatedAt.Unix(),
-		ClickCount: urlResponse.ClickCount,
-		IsActive:   urlResponse.IsActive,
-		Metadata:   urlResponse.Metadata,
+	rsp.Success = true
+	rsp.Message = "URL updated successfully"
+	rsp.UpdatedUrl = urlInfoToProto(urlResponse)
+
+	h.log.WithFields(logrus.Fields{
+		"short_code": req.ShortCode,
+		"user_id":    req.UserId,
+	}).Info("URL updated successfully")
+
+	return nil
+}
+
+// UpsertWorkspace implements the UpsertWorkspace RPC method
+func (h *URLHandler) UpsertWorkspace(ctx context.Context, req *pb.UpsertWorkspaceRequest, rsp *pb.WorkspaceInfo) error {
+	h.log.WithFields(logrus.Fields{
+		"workspace_id": req.WorkspaceId,
+		"owner_id":     req.OwnerId,
+	}).Info("Processing UpsertWorkspace request")
+
+	// Call store layer
+	workspace, err := h.store.UpsertWorkspace(&store.UpsertWorkspaceRequest{
+		ID:          req.WorkspaceId,
+		Name:        req.Name,
+		OwnerID:     req.OwnerId,
+		UTMTemplate: req.UtmTemplate,
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to save workspace")
+		return fmt.Errorf("failed to save workspace: %w", err)
 	}
 
-	if urlResponse.ExpiresAt != nil {
-		updatedURL.ExpiresAt = urlResponse.ExpiresAt.Unix()
+	h.workspaceToProto(workspace, rsp)
+
+	h.log.WithField("workspace_id", rsp.WorkspaceId).Info("Workspace saved successfully")
+
+	return nil
+}
+
+// GetWorkspace implements the GetWorkspace RPC method
+func (h *URLHandler) GetWorkspace(ctx context.Context, req *pb.GetWorkspaceRequest, rsp *pb.WorkspaceInfo) error {
+	h.log.WithFields(logrus.Fields{
+		"workspace_id": req.WorkspaceId,
+		"user_id":      req.UserId,
+	}).Info("Processing GetWorkspace request")
+
+	// Call store layer
+	workspace, err := h.store.GetWorkspace(req.WorkspaceId, req.UserId)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to get workspace")
+		return fmt.Errorf("failed to get workspace: %w", err)
 	}
 
-	rsp.Success = true
-	rsp.Message = "URL updated successfully"
-	rsp.UpdatedUrl = updatedURL
+	h.workspaceToProto(workspace, rsp)
+
+	return nil
+}
 
+// GetLinkHealth implements the GetLinkHealth RPC method
+func (h *URLHandler) GetLinkHealth(ctx context.Context, req *pb.GetLinkHealthRequest, rsp *pb.LinkHealthInfo) error {
 	h.log.WithFields(logrus.Fields{
 		"short_code": req.ShortCode,
 		"user_id":    req.UserId,
-	}).Info("URL updated successfully")
+	}).Info("Processing GetLinkHealth request")
+
+	health, err := h.store.GetLinkHealth(req.Domain, req.ShortCode, req.UserId)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to get link health")
+		return fmt.Errorf("failed to get link health: %w", err)
+	}
+
+	rsp.Domain = health.Domain
+	rsp.ShortCode = health.ShortCode
+	rsp.Status = health.Status
+	rsp.StatusCode = int32(health.StatusCode)
+	rsp.LatencyMs = health.LatencyMs
+	rsp.RedirectChain = health.RedirectChain
+	rsp.Error = health.Error
+	rsp.ConsecutiveFailures = int32(health.ConsecutiveFailures)
+	if health.CheckedAt != nil {
+		rsp.CheckedAt = health.CheckedAt.Unix()
+	}
+	if health.LastHealthyAt != nil {
+		rsp.LastHealthyAt = health.LastHealthyAt.Unix()
+	}
+
+	return nil
+}
+
+// GetQRCode implements the GetQRCode RPC method
+func (h *URLHandler) GetQRCode(ctx context.Context, req *pb.GetQRCodeRequest, rsp *pb.QRCodeResponse) error {
+	h.log.WithFields(logrus.Fields{
+		"short_code": req.ShortCode,
+		"user_id":    req.UserId,
+		"format":     req.Format,
+	}).Info("Processing GetQRCode request")
+
+	qrRequest := &domain.QRCodeRequest{
+		Domain:       req.Domain,
+		ShortCode:    req.ShortCode,
+		UserID:       req.UserId,
+		Format:       req.Format,
+		Size:         int(req.Size),
+		Level:        req.Level,
+		Foreground:   req.Foreground,
+		Background:   req.Background,
+		LogoURL:      req.LogoUrl,
+		SourceMarker: req.SourceMarker,
+	}
+	if req.Margin != nil {
+		margin := int(*req.Margin)
+		qrRequest.Margin = &margin
+	}
+
+	code, err := h.store.GetQRCode(ctx, qrRequest)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to render QR code")
+		return fmt.Errorf("failed to render QR code: %w", err)
+	}
+
+	rsp.ContentType = code.ContentType
+	rsp.Data = code.Data
+	rsp.Content = code.Content
+	rsp.Etag = code.ETag
+
+	return nil
+}
+
+// AddBrandedDomain implements the AddBrandedDomain RPC method
+func (h *URLHandler) AddBrandedDomain(ctx context.Context, req *pb.BrandedDomainRequest, rsp *pb.BrandedDomain) error {
+	h.log.WithFields(logrus.Fields{
+		"workspace_id": req.WorkspaceId,
+		"user_id":      req.UserId,
+		"domain":       req.Domain,
+	}).Info("Processing AddBrandedDomain request")
+
+	brandedDomain, err := h.store.AddBrandedDomain(req.WorkspaceId, req.UserId, req.Domain, req.CodePolicy)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to add branded domain")
+		return fmt.Errorf("failed to add branded domain: %w", err)
+	}
+
+	brandedDomainToProto(brandedDomain, rsp)
+
+	return nil
+}
+
+// SetBrandedDomainCodePolicy implements the SetBrandedDomainCodePolicy RPC method
+func (h *URLHandler) SetBrandedDomainCodePolicy(ctx context.Context, req *pb.BrandedDomainRequest, rsp *pb.CodePolicyChange) error {
+	h.log.WithFields(logrus.Fields{
+		"workspace_id": req.WorkspaceId,
+		"domain":       req.Domain,
+		"code_policy":  req.CodePolicy,
+	}).Info("Processing SetBrandedDomainCodePolicy request")
+
+	change, err := h.store.SetBrandedDomainCodePolicy(req.WorkspaceId, req.UserId, req.Domain, req.CodePolicy)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to set code policy")
+		return fmt.Errorf("failed to set code policy: %w", err)
+	}
+
+	rsp.Domain = &pb.BrandedDomain{}
+	brandedDomainToProto(change.Domain, rsp.Domain)
+	rsp.CaseConflicts = change.CaseConflicts
+
+	return nil
+}
+
+// RemoveBrandedDomain implements the RemoveBrandedDomain RPC method
+func (h *URLHandler) RemoveBrandedDomain(ctx context.Context, req *pb.BrandedDomainRequest, rsp *pb.DeleteResponse) error {
+	h.log.WithFields(logrus.Fields{
+		"workspace_id": req.WorkspaceId,
+		"user_id":      req.UserId,
+		"domain":       req.Domain,
+	}).Info("Processing RemoveBrandedDomain request")
+
+	if err := h.store.RemoveBrandedDomain(req.WorkspaceId, req.UserId, req.Domain); err != nil {
+		h.log.WithError(err).Error("Failed to remove branded domain")
+		rsp.Success = false
+		rsp.Message = fmt.Sprintf("Failed to remove branded domain: %v", err)
+		return nil
+	}
 
+	rsp.Success = true
+	rsp.Message = "Branded domain removed successfully"
 	return nil
 }
+
+// ListBrandedDomains implements the ListBrandedDomains RPC method
+func (h *URLHandler) ListBrandedDomains(ctx context.Context, req *pb.ListBrandedDomainsRequest, rsp *pb.ListBrandedDomainsResponse) error {
+	brandedDomains, err := h.store.ListBrandedDomains(req.WorkspaceId, req.UserId)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to list branded domains")
+		return fmt.Errorf("failed to list branded domains: %w", err)
+	}
+
+	rsp.Domains = make([]*pb.BrandedDomain, len(brandedDomains))
+	for i := range brandedDomains {
+		rsp.Domains[i] = &pb.BrandedDomain{}
+		brandedDomainToProto(&brandedDomains[i], rsp.Domains[i])
+	}
+
+	return nil
+}
+
+// SuggestAliases implements the SuggestAliases RPC method
+func (h *URLHandler) SuggestAliases(ctx context.Context, req *pb.SuggestAliasesRequest, rsp *pb.SuggestAliasesResponse) error {
+	h.log.WithFields(logrus.Fields{
+		"alias":        req.Alias,
+		"workspace_id": req.WorkspaceId,
+		"user_id":      req.UserId,
+	}).Info("Processing SuggestAliases request")
+
+	suggestions, err := h.store.SuggestAliases(ctx, &store.SuggestAliasesRequest{
+		Alias:       req.Alias,
+		LongURL:     req.LongUrl,
+		Domain:      req.Domain,
+		WorkspaceID: req.WorkspaceId,
+		UserID:      req.UserId,
+		Count:       int(req.Count),
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to suggest aliases")
+		return fmt.Errorf("failed to suggest aliases: %w", err)
+	}
+
+	rsp.Alias = suggestions.Alias
+	rsp.Available = suggestions.Available
+	rsp.Suggestions = suggestions.Suggestions
+
+	return nil
+}
+
+// CreateTag implements the CreateTag RPC method
+func (h *URLHandler) CreateTag(ctx context.Context, req *pb.TagRequest, rsp *pb.Tag) error {
+	tag, err := h.store.CreateTag(req.UserId, req.Name)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to create tag")
+		return fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	tagToProto(tag, rsp)
+	return nil
+}
+
+// ListTags implements the ListTags RPC method
+func (h *URLHandler) ListTags(ctx context.Context, req *pb.ListTagsRequest, rsp *pb.ListTagsResponse) error {
+	tags, err := h.store.ListTags(req.UserId)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to list tags")
+		return fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	rsp.Tags = make([]*pb.Tag, len(tags))
+	for i := range tags {
+		rsp.Tags[i] = &pb.Tag{}
+		tagToProto(&tags[i], rsp.Tags[i])
+	}
+	return nil
+}
+
+// RenameTag implements the RenameTag RPC method
+func (h *URLHandler) RenameTag(ctx context.Context, req *pb.TagRequest, rsp *pb.Tag) error {
+	h.log.WithFields(logrus.Fields{
+		"user_id":  req.UserId,
+		"tag":      req.Name,
+		"new_name": req.NewName,
+	}).Info("Processing RenameTag request")
+
+	tag, err := h.store.RenameTag(req.UserId, req.Name, req.NewName)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to rename tag")
+		return fmt.Errorf("failed to rename tag: %w", err)
+	}
+
+	tagToProto(tag, rsp)
+	return nil
+}
+
+// DeleteTag implements the DeleteTag RPC method
+func (h *URLHandler) DeleteTag(ctx context.Context, req *pb.TagRequest, rsp *pb.DeleteResponse) error {
+	if err := h.store.DeleteTag(req.UserId, req.Name); err != nil {
+		h.log.WithError(err).Error("Failed to delete tag")
+		rsp.Success = false
+		rsp.Message = fmt.Sprintf("Failed to delete tag: %v", err)
+		return nil
+	}
+
+	rsp.Success = true
+	rsp.Message = "Tag deleted successfully"
+	return nil
+}
+
+// CreateFolder implements the CreateFolder RPC method
+func (h *URLHandler) CreateFolder(ctx context.Context, req *pb.FolderRequest, rsp *pb.Folder) error {
+	folder, err := h.store.CreateFolder(req.UserId, req.Name, req.ParentId)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to create folder")
+		return fmt.Errorf("failed to create folder: %w", err)
+	}
+
+	folderToProto(folder, rsp)
+	return nil
+}
+
+// ListFolders implements the ListFolders RPC method
+func (h *URLHandler) ListFolders(ctx context.Context, req *pb.ListFoldersRequest, rsp *pb.ListFoldersResponse) error {
+	folders, err := h.store.ListFolders(req.UserId)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to list folders")
+		return fmt.Errorf("failed to list folders: %w", err)
+	}
+
+	rsp.Folders = make([]*pb.Folder, len(folders))
+	for i := range folders {
+		rsp.Folders[i] = &pb.Folder{}
+		folderToProto(&folders[i], rsp.Folders[i])
+	}
+	return nil
+}
+
+// UpdateFolder implements the UpdateFolder RPC method
+func (h *URLHandler) UpdateFolder(ctx context.Context, req *pb.FolderRequest, rsp *pb.Folder) error {
+	h.log.WithFields(logrus.Fields{
+		"user_id":   req.UserId,
+		"folder_id": req.Id,
+		"parent_id": req.ParentId,
+	}).Info("Processing UpdateFolder request")
+
+	folder, err := h.store.UpdateFolder(req.UserId, req.Id, req.Name, req.ParentId)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to update folder")
+		return fmt.Errorf("failed to update folder: %w", err)
+	}
+
+	folderToProto(folder, rsp)
+	return nil
+}
+
+// DeleteFolder implements the DeleteFolder RPC method
+func (h *URLHandler) DeleteFolder(ctx context.Context, req *pb.FolderRequest, rsp *pb.DeleteResponse) error {
+	if err := h.store.DeleteFolder(req.UserId, req.Id); err != nil {
+		h.log.WithError(err).Error("Failed to delete folder")
+		rsp.Success = false
+		rsp.Message = fmt.Sprintf("Failed to delete folder: %v", err)
+		return nil
+	}
+
+	rsp.Success = true
+	rsp.Message = "Folder deleted successfully"
+	return nil
+}
+
+// SetInterstitialMode implements the SetInterstitialMode RPC method (admin)
+func (h *URLHandler) SetInterstitialMode(ctx context.Context, req *pb.SetInterstitialModeRequest, rsp *pb.UpdateURLResponse) error {
+	h.log.WithFields(logrus.Fields{
+		"short_code": req.ShortCode,
+		"mode":       req.Mode,
+	}).Info("Processing SetInterstitialMode request")
+
+	before := h.store.AuditSnapshot(req.Domain, req.ShortCode)
+	urlResponse, err := h.store.SetInterstitialMode(req.Domain, req.ShortCode, req.Mode)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to set interstitial mode")
+		rsp.Success = false
+		rsp.Message = fmt.Sprintf("Failed to set interstitial mode: %v", err)
+		return nil
+	}
+	h.audit(ctx, "", domain.AuditURLInterstitial, domain.AuditURLTarget(req.Domain, req.ShortCode), before, urlResponse)
+	h.notify(domain.WebhookURLUpdated, urlResponse)
+
+	rsp.Success = true
+	rsp.Message = "Interstitial mode updated successfully"
+	rsp.UpdatedUrl = urlInfoToProto(urlResponse)
+
+	return nil
+}
+
+// SetWorkspacePlan implements the SetWorkspacePlan RPC method (admin)
+func (h *URLHandler) SetWorkspacePlan(ctx context.Context, req *pb.SetWorkspacePlanRequest, rsp *pb.WorkspaceInfo) error {
+	h.log.WithFields(logrus.Fields{
+		"workspace_id": req.WorkspaceId,
+		"plan":         req.Plan,
+	}).Info("Processing SetWorkspacePlan request")
+
+	workspace, err := h.store.SetWorkspacePlan(req.WorkspaceId, req.Plan)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to set workspace plan")
+		return fmt.Errorf("failed to set workspace plan: %w", err)
+	}
+	h.audit(ctx, "", domain.AuditWorkspacePlan, "workspace:"+req.WorkspaceId, nil, workspace)
+
+	h.workspaceToProto(workspace, rsp)
+
+	return nil
+}
+
+// UpsertDomainReview implements the UpsertDomainReview RPC method (admin)
+func (h *URLHandler) UpsertDomainReview(ctx context.Context, req *pb.DomainReview, rsp *pb.DomainReview) error {
+	h.log.WithFields(logrus.Fields{
+		"domain": req.Domain,
+		"status": req.Status,
+	}).Info("Processing UpsertDomainReview request")
+
+	review, err := h.store.UpsertDomainReview(req.Domain, req.Status, req.Reason, req.UpdatedBy)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to save domain review")
+		return fmt.Errorf("failed to save domain review: %w", err)
+	}
+	h.audit(ctx, "", domain.AuditDomainReviewUpsert, "domain_review:"+review.Domain, nil, review)
+
+	domainReviewToProto(review, rsp)
+
+	return nil
+}
+
+// DeleteDomainReview implements the DeleteDomainReview RPC method (admin)
+func (h *URLHandler) DeleteDomainReview(ctx context.Context, req *pb.DeleteDomainReviewRequest, rsp *pb.DeleteResponse) error {
+	h.log.WithField("domain", req.Domain).Info("Processing DeleteDomainReview request")
+
+	if err := h.store.DeleteDomainReview(req.Domain); err != nil {
+		h.log.WithError(err).Error("Failed to delete domain review")
+		rsp.Success = false
+		rsp.Message = fmt.Sprintf("Failed to delete domain review: %v", err)
+		return nil
+	}
+	h.audit(ctx, "", domain.AuditDomainReviewDelete, "domain_review:"+req.Domain, nil, nil)
+
+	rsp.Success = true
+	rsp.Message = "Domain review deleted successfully"
+	return nil
+}
+
+// ListDomainReviews implements the ListDomainReviews RPC method (admin)
+func (h *URLHandler) ListDomainReviews(ctx context.Context, req *pb.ListDomainReviewsRequest, rsp *pb.ListDomainReviewsResponse) error {
+	reviews, err := h.store.ListDomainReviews(req.Status)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to list domain reviews")
+		return fmt.Errorf("failed to list domain reviews: %w", err)
+	}
+
+	rsp.Reviews = make([]*pb.DomainReview, len(reviews))
+	for i := range reviews {
+		rsp.Reviews[i] = &pb.DomainReview{}
+		domainReviewToProto(&reviews[i], rsp.Reviews[i])
+	}
+
+	return nil
+}
+
+// ListFlaggedURLs implements the ListFlaggedURLs RPC method (admin operation)
+func (h *URLHandler) ListFlaggedURLs(ctx context.Context, req *pb.ListFlaggedURLsRequest, rsp *pb.GetUserURLsResponse) error {
+	storeResponse, err := h.store.GetFlaggedURLs(req.Page, req.PageSize, req.Cursor)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to list flagged URLs")
+		return fmt.Errorf("failed to list flagged URLs: %w", err)
+	}
+
+	rsp.Urls = make([]*pb.URLInfo, len(storeResponse.URLs))
+	for i := range storeResponse.URLs {
+		rsp.Urls[i] = urlInfoToProto(&storeResponse.URLs[i])
+	}
+	rsp.TotalCount = storeResponse.TotalCount
+	rsp.Page = storeResponse.Page
+	rsp.PageSize = storeResponse.PageSize
+	rsp.HasNext = storeResponse.HasNext
+	rsp.NextCursor = storeResponse.NextCursor
+	rsp.PrevCursor = storeResponse.PrevCursor
+
+	return nil
+}
+
+// urlInfoToProto converts a store URL into its protobuf representation
+func urlInfoToProto(url *store.URLResponse) *pb.URLInfo {
+	urlInfo := &pb.URLInfo{
+		ShortCode:         url.ShortCode,
+		ShortUrl:          url.ShortURL,
+		Domain:            url.Domain,
+		LongUrl:           url.LongURL,
+		UserId:            url.UserID,
+		CreatedAt:         url.CreatedAt.Unix(),
+		ClickCount:        url.ClickCount,
+		IsActive:          url.IsActive,
+		Metadata:          url.Metadata,
+		WorkspaceId:       url.WorkspaceID,
+		UtmTemplate:       url.UTMTemplate,
+		PasswordProtected: url.PasswordProtected,
+		FallbackUrl:       url.FallbackURL,
+		MaxClicks:         url.MaxClicks,
+		InterstitialMode:  url.InterstitialMode,
+		DisabledReason:    url.DisabledReason,
+		Preview:           linkPreviewToProto(url.Preview),
+		PreviewOverride:   linkPreviewToProto(url.PreviewOverride),
+		Tags:              url.Tags,
+		FolderId:          url.FolderID,
+	}
+
+	if url.ExpiresAt != nil {
+		urlInfo.ExpiresAt = url.ExpiresAt.Unix()
+	}
+	if url.ActivatesAt != nil {
+		urlInfo.ActivatesAt = url.ActivatesAt.Unix()
+	}
+	if url.DisabledAt != nil {
+		urlInfo.DisabledAt = url.DisabledAt.Unix()
+	}
+	if url.DeletedAt != nil {
+		urlInfo.DeletedAt = url.DeletedAt.Unix()
+	}
+	if url.PurgeAt != nil {
+		urlInfo.PurgeAt = url.PurgeAt.Unix()
+	}
+	urlInfo.InactivityExempt = url.InactivityExempt
+	if url.ArchivedAt != nil {
+		urlInfo.ArchivedAt = url.ArchivedAt.Unix()
+	}
+
+	return urlInfo
+}
+
+// linkPreviewToProto converts link preview metadata into its protobuf representation
+func linkPreviewToProto(linkPreview domain.LinkPreview) *pb.LinkPreview {
+	if linkPreview.IsEmpty() {
+		return nil
+	}
+	return &pb.LinkPreview{
+		Title:       linkPreview.Title,
+		Description: linkPreview.Description,
+		Image:       linkPreview.Image,
+	}
+}
+
+// linkPreviewFromProto converts protobuf link preview metadata into the domain type
+func linkPreviewFromProto(linkPreview *pb.LinkPreview) domain.LinkPreview {
+	return domain.LinkPreview{
+		Title:       linkPreview.Title,
+		Description: linkPreview.Description,
+		Image:       linkPreview.Image,
+	}
+}
+
+// domainReviewToProto converts a store domain review into its protobuf representation
+func domainReviewToProto(review *store.DomainReviewResponse, rsp *pb.DomainReview) {
+	rsp.Domain = review.Domain
+	rsp.Status = review.Status
+	rsp.Reason = review.Reason
+	rsp.UpdatedBy = review.UpdatedBy
+	rsp.CreatedAt = review.CreatedAt.Unix()
+	rsp.UpdatedAt = review.UpdatedAt.Unix()
+}
+
+// brandedDomainToProto converts a store branded domain into its protobuf representation
+func brandedDomainToProto(brandedDomain *store.BrandedDomainResponse, rsp *pb.BrandedDomain) {
+	rsp.Domain = brandedDomain.Domain
+	rsp.WorkspaceId = brandedDomain.WorkspaceID
+	rsp.CreatedBy = brandedDomain.CreatedBy
+	rsp.CreatedAt = brandedDomain.CreatedAt.Unix()
+	rsp.ShortUrl = brandedDomain.ShortURL
+	rsp.CodePolicy = brandedDomain.CodePolicy
+}
+
+// facetsToProto converts search facet counts into their protobuf representation
+func facetsToProto(facets []domain.FacetCount) []*pb.FacetCount {
+	counts := make([]*pb.FacetCount, len(facets))
+	for i, facet := range facets {
+		counts[i] = &pb.FacetCount{Value: facet.Value, Count: facet.Count}
+	}
+	return counts
+}
+
+// tagToProto converts a store tag into its protobuf representation
+func tagToProto(tag *store.TagResponse, rsp *pb.Tag) {
+	rsp.Id = tag.ID
+	rsp.UserId = tag.UserID
+	rsp.Name = tag.Name
+	rsp.CreatedAt = tag.CreatedAt.Unix()
+	rsp.LinkCount = tag.LinkCount
+}
+
+// folderToProto converts a store folder into its protobuf representation
+func folderToProto(folder *store.FolderResponse, rsp *pb.Folder) {
+	rsp.Id = folder.ID
+	rsp.UserId = folder.UserID
+	rsp.ParentId = folder.ParentID
+	rsp.Name = folder.Name
+	rsp.Path = folder.Path
+	rsp.CreatedAt = folder.CreatedAt.Unix()
+	rsp.LinkCount = folder.LinkCount
+}
+
+// workspaceToProto converts a store workspace into its protobuf representation
+func (h *URLHandler) workspaceToProto(workspace *store.WorkspaceResponse, rsp *pb.WorkspaceInfo) {
+	rsp.WorkspaceId = workspace.ID
+	rsp.Name = workspace.Name
+	rsp.OwnerId = workspace.OwnerID
+	rsp.UtmTemplate = workspace.UTMTemplate
+	rsp.Plan = workspace.Plan
+	rsp.CreatedAt = workspace.CreatedAt.Unix()
+	rsp.UpdatedAt = workspace.UpdatedAt.Unix()
+	rsp.InactivityDays = int32(workspace.InactivityDays)
+	rsp.InactivityDryRun = workspace.InactivityDryRun
+}
@@ -0,0 +1,201 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/go-systems-lab/go-url-shortener/proto/url"
+	"github.com/go-systems-lab/go-url-shortener/services/url-shortener-svc/domain"
+	"github.com/go-systems-lab/go-url-shortener/services/url-shortener-svc/store"
+)
+
+// notify queues a link event for the webhooks of the link's workspace. Like
+// auditing, the change already happened, so a failure is logged rather than returned.
+func (h *URLHandler) notify(eventType string, url *store.URLResponse) {
+	if url == nil || url.WorkspaceID == "" {
+		return
+	}
+	if err := h.store.EmitWebhookEvent(url.WorkspaceID, eventType, url); err != nil {
+		h.log.WithError(err).WithFields(logrus.Fields{
+			"event":      eventType,
+			"short_code": url.ShortCode,
+		}).Error("Failed to queue webhook event")
+	}
+}
+
+// CreateWebhook implements the CreateWebhook RPC method
+func (h *URLHandler) CreateWebhook(ctx context.Context, req *pb.WebhookRequest, rsp *pb.Webhook) error {
+	h.log.WithFields(logrus.Fields{
+		"workspace_id": req.WorkspaceId,
+		"user_id":      req.UserId,
+	}).Info("Processing CreateWebhook request")
+
+	webhook, err := h.store.CreateWebhook(&domain.CreateWebhookRequest{
+		WorkspaceID: req.WorkspaceId,
+		UserID:      req.UserId,
+		URL:         req.Url,
+		Events:      req.Events,
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to create webhook")
+		return fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	webhookToProto(webhook, rsp)
+	return nil
+}
+
+// ListWebhooks implements the ListWebhooks RPC method
+func (h *URLHandler) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest, rsp *pb.ListWebhooksResponse) error {
+	webhooks, err := h.store.ListWebhooks(req.WorkspaceId, req.UserId)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to list webhooks")
+		return fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	rsp.Webhooks = make([]*pb.Webhook, len(webhooks))
+	for i := range webhooks {
+		rsp.Webhooks[i] = &pb.Webhook{}
+		webhookToProto(&webhooks[i], rsp.Webhooks[i])
+	}
+	return nil
+}
+
+// UpdateWebhook implements the UpdateWebhook RPC method
+func (h *URLHandler) UpdateWebhook(ctx context.Context, req *pb.WebhookRequest, rsp *pb.Webhook) error {
+	h.log.WithFields(logrus.Fields{
+		"workspace_id": req.WorkspaceId,
+		"webhook_id":   req.Id,
+	}).Info("Processing UpdateWebhook request")
+
+	domainReq := &domain.UpdateWebhookRequest{
+		WorkspaceID:  req.WorkspaceId,
+		UserID:       req.UserId,
+		WebhookID:    req.Id,
+		URL:          req.Url,
+		Events:       req.Events,
+		ClearEvents:  req.ClearEvents,
+		RotateSecret: req.RotateSecret,
+	}
+	if req.Enable || req.Disable {
+		active := req.Enable
+		domainReq.IsActive = &active
+	}
+
+	webhook, err := h.store.UpdateWebhook(domainReq)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to update webhook")
+		return fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	webhookToProto(webhook, rsp)
+	return nil
+}
+
+// DeleteWebhook implements the DeleteWebhook RPC method
+func (h *URLHandler) DeleteWebhook(ctx context.Context, req *pb.WebhookRequest, rsp *pb.DeleteResponse) error {
+	h.log.WithFields(logrus.Fields{
+		"workspace_id": req.WorkspaceId,
+		"webhook_id":   req.Id,
+	}).Info("Processing DeleteWebhook request")
+
+	if err := h.store.DeleteWebhook(req.WorkspaceId, req.UserId, req.Id); err != nil {
+		h.log.WithError(err).Error("Failed to delete webhook")
+		rsp.Success = false
+		rsp.Message = fmt.Sprintf("Failed to delete webhook: %v", err)
+		return nil
+	}
+
+	rsp.Success = true
+	rsp.Message = "Webhook deleted successfully"
+	return nil
+}
+
+// ListWebhookDeliveries implements the ListWebhookDeliveries RPC method
+func (h *URLHandler) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest, rsp *pb.ListWebhookDeliveriesResponse) error {
+	response, err := h.store.ListWebhookDeliveries(&domain.ListWebhookDeliveriesRequest{
+		WorkspaceID: req.WorkspaceId,
+		UserID:      req.UserId,
+		WebhookID:   req.WebhookId,
+		Status:      req.Status,
+		PageSize:    req.PageSize,
+		Cursor:      req.Cursor,
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Failed to list webhook deliveries")
+		return fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+
+	rsp.Deliveries = make([]*pb.WebhookDelivery, len(response.Deliveries))
+	for i := range response.Deliveries {
+		rsp.Deliveries[i] = &pb.WebhookDelivery{}
+		webhookDeliveryToProto(&response.Deliveries[i], rsp.Deliveries[i])
+	}
+	rsp.TotalCount = response.TotalCount
+	rsp.PageSize = response.PageSize
+	rsp.HasNext = response.HasNext
+	rsp.NextCursor = response.NextCursor
+	rsp.PrevCursor = response.PrevCursor
+
+	return nil
+}
+
+// RedeliverWebhook implements the RedeliverWebhook RPC method
+func (h *URLHandler) RedeliverWebhook(ctx context.Context, req *pb.RedeliverWebhookRequest, rsp *pb.WebhookDelivery) error {
+	h.log.WithFields(logrus.Fields{
+		"workspace_id": req.WorkspaceId,
+		"webhook_id":   req.WebhookId,
+		"delivery_id":  req.DeliveryId,
+	}).Info("Processing RedeliverWebhook request")
+
+	delivery, err := h.store.RedeliverWebhook(req.WorkspaceId, req.UserId, req.WebhookId, req.DeliveryId)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to redeliver webhook")
+		return fmt.Errorf("failed to redeliver webhook: %w", err)
+	}
+
+	webhookDeliveryToProto(delivery, rsp)
+	return nil
+}
+
+func webhookToProto(webhook *domain.Webhook, rsp *pb.Webhook) {
+	rsp.Id = webhook.ID
+	rsp.WorkspaceId = webhook.WorkspaceID
+	rsp.Url = webhook.URL
+	rsp.Secret = webhook.Secret
+	rsp.Events = webhook.Events
+	rsp.IsActive = webhook.IsActive
+	rsp.ConsecutiveFailures = int32(webhook.ConsecutiveFailures)
+	rsp.DisabledAt = unixOrZero(webhook.DisabledAt)
+	rsp.CreatedBy = webhook.CreatedBy
+	rsp.CreatedAt = webhook.CreatedAt.Unix()
+	rsp.UpdatedAt = webhook.UpdatedAt.Unix()
+}
+
+func webhookDeliveryToProto(delivery *domain.WebhookDelivery, rsp *pb.WebhookDelivery) {
+	rsp.Id = delivery.ID
+	rsp.WebhookId = delivery.WebhookID
+	rsp.EventId = delivery.EventID
+	rsp.EventType = delivery.EventType
+	rsp.Payload = delivery.Payload
+	rsp.Status = delivery.Status
+	rsp.Attempts = int32(delivery.Attempts)
+	rsp.NextAttemptAt = unixOrZero(delivery.NextAttemptAt)
+	rsp.LastAttemptAt = unixOrZero(delivery.LastAttemptAt)
+	rsp.ResponseStatus = int32(delivery.ResponseStatus)
+	rsp.ResponseBody = delivery.ResponseBody
+	rsp.Error = delivery.Error
+	rsp.CreatedAt = delivery.CreatedAt.Unix()
+	rsp.DeliveredAt = unixOrZero(delivery.DeliveredAt)
+}
+
+// unixOrZero converts an optional timestamp to unix seconds, 0 for none
+func unixOrZero(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.Unix()
+}
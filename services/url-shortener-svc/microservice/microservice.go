@@ -2,11 +2,21 @@ package microservice
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net"
 	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 	"go-micro.dev/v5"
+	"go-micro.dev/v5/broker"
+	"go-micro.dev/v5/client"
 
 	// Import NATS plugins
 	natsBroker "github.com/micro/plugins/v5/broker/nats"
@@ -14,11 +24,58 @@ import (
 	natsTransport "github.com/micro/plugins/v5/transport/nats"
 
 	pb "github.com/go-systems-lab/go-url-shortener/proto/url"
+	"github.com/go-systems-lab/go-url-shortener/services/url-shortener-svc/domain"
 	"github.com/go-systems-lab/go-url-shortener/services/url-shortener-svc/handler"
+	"github.com/go-systems-lab/go-url-shortener/utils/alias"
 	"github.com/go-systems-lab/go-url-shortener/utils/cache"
 	"github.com/go-systems-lab/go-url-shortener/utils/database"
+	"github.com/go-systems-lab/go-url-shortener/utils/linkhealth"
 	"github.com/go-systems-lab/go-url-shortener/utils/metrics"
+	"github.com/go-systems-lab/go-url-shortener/utils/preview"
+	"github.com/go-systems-lab/go-url-shortener/utils/qr"
+	"github.com/go-systems-lab/go-url-shortener/utils/safety"
 	"github.com/go-systems-lab/go-url-shortener/utils/tracing"
+	"github.com/go-systems-lab/go-url-shortener/utils/webhook"
+)
+
+// Safety engine settings
+const (
+	safetyListReloadInterval = 30 * time.Second
+	defaultSafetyRescan      = 6 * time.Hour
+)
+
+// Link health monitor settings
+const (
+	defaultLinkHealthInterval = time.Hour
+	linkHealthLockKey         = "link-health-sweep"
+)
+
+// Trash retention worker settings
+const (
+	defaultTrashPurgeInterval = time.Hour
+	trashPurgeLockKey         = "trash-purge"
+	purgeEventTopic           = "url.purged"
+)
+
+// Expiry sweeper settings
+const (
+	defaultExpirySweepInterval = 5 * time.Minute
+	expirySweepLockName        = "expiry-sweep"
+	expiredEventTopic          = "url.expired"
+	expiringEventTopic         = "url.expiring"
+)
+
+// Inactivity sweeper settings
+const (
+	defaultInactivitySweepInterval = time.Hour
+	inactivitySweepLockName        = "inactivity-sweep"
+)
+
+// Webhook dispatcher settings
+const (
+	defaultWebhookDispatchInterval = 10 * time.Second
+	webhookPruneInterval           = time.Hour
+	linkAlertEventTopic            = "url.alert"
 )
 
 // ClientOptions defines options for the microservice
@@ -29,9 +86,10 @@ type ClientOptions struct {
 
 // Microservice represents the URL shortener microservice
 type Microservice struct {
-	service micro.Service
-	log     *logrus.Logger
-	tracer  *tracing.Tracer
+	service    micro.Service
+	urlService *domain.URLService
+	log        *logrus.Logger
+	tracer     *tracing.Tracer
 }
 
 // Init initializes the microservice with NATS plugins and observability
@@ -56,11 +114,23 @@ func Init(opts *ClientOptions) (*Microservice, error) {
 	metricsRegistry := metrics.NewMetrics()
 
 	// Initialize dependencies
+	configureDefaultDomain(opts.Log)
+	configureTrash(opts.Log)
 	db := database.NewPostgreSQL()
 	redisCache := cache.NewRedis()
+	safetyEngine := initializeSafety(opts.Log)
+	previewFetcher := initializePreviews(opts.Log)
+	logoFetcher := initializeQRLogos(opts.Log)
+	aliasPolicy := initializeAliases(opts.Log)
 
 	// Create handler with observability
-	urlHandler := handler.NewURLHandler(db, redisCache)
+	urlHandler := handler.NewURLHandler(db, redisCache, safetyEngine, previewFetcher, logoFetcher, aliasPolicy)
+
+	// Periodically re-check existing links against the (reloaded) lists
+	go runSafetyRescan(domain.NewURLService(db, redisCache, safetyEngine, nil, nil, aliasPolicy), safetyRescanInterval(opts.Log), opts.Log)
+
+	// Periodically probe destinations and flag links that keep failing
+	go runLinkHealthMonitor(domain.NewURLService(db, redisCache, safetyEngine, nil, nil, aliasPolicy), redisCache, opts.Log)
 
 	// Create Go Micro service with NATS plugins and observability middleware
 	service := micro.NewService(
@@ -83,6 +153,18 @@ func Init(opts *ClientOptions) (*Microservice, error) {
 		return nil, err
 	}
 
+	// Periodically purge links that stayed in the trash past the retention
+	go runTrashPurge(domain.NewURLService(db, redisCache, safetyEngine, nil, nil, aliasPolicy), redisCache, service.Client(), opts.Log)
+
+	// Periodically mark expired links and announce links about to expire
+	go runExpirySweeper(domain.NewURLService(db, redisCache, safetyEngine, nil, nil, aliasPolicy), db, service.Client(), opts.Log)
+
+	// Periodically archive links their workspace's inactivity policy applies to
+	go runInactivitySweeper(domain.NewURLService(db, redisCache, safetyEngine, nil, nil, aliasPolicy), db, opts.Log)
+
+	// Send queued webhook deliveries and retry failed ones
+	go runWebhookDispatcher(domain.NewURLService(db, redisCache, safetyEngine, nil, nil, aliasPolicy), opts.Log)
+
 	// Start metrics server in a separate goroutine
 	go func() {
 		http.Handle("/metrics", promhttp.HandlerFor(metricsRegistry.Registry, promhttp.HandlerOpts{}))
@@ -95,14 +177,574 @@ func Init(opts *ClientOptions) (*Microservice, error) {
 	opts.Log.Info("URL Shortener RPC service configured with NATS transport, registry, broker, and full observability stack")
 
 	return &Microservice{
-		service: service,
-		log:     opts.Log,
-		tracer:  tracer,
+		service:    service,
+		urlService: domain.NewURLService(db, redisCache, safetyEngine, nil, nil, aliasPolicy),
+		log:        opts.Log,
+		tracer:     tracer,
 	}, nil
 }
 
+// configureDefaultDomain applies SHORT_URL_BASE, the origin short URLs on the
+// default domain are built from (branded domains use their own host), and
+// SHORT_CODE_POLICY, the code policy of the default domain (mixed or readable)
+func configureDefaultDomain(log *logrus.Logger) {
+	if base := os.Getenv("SHORT_URL_BASE"); base != "" {
+		if err := domain.ConfigureShortURLBase(base); err != nil {
+			log.WithError(err).Fatal("Invalid SHORT_URL_BASE")
+		}
+	}
+	if codePolicy := os.Getenv("SHORT_CODE_POLICY"); codePolicy != "" {
+		if err := domain.ConfigureCodePolicy(codePolicy); err != nil {
+			log.WithError(err).Fatal("Invalid SHORT_CODE_POLICY")
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"short_url_base": domain.DefaultShortURLBase,
+		"code_policy":    domain.DefaultCodePolicy,
+	}).Info("Default short domain configured")
+}
+
+// configureTrash applies the trash policy: TRASH_RETENTION (a Go duration, 0
+// keeps deleted links until restored), TRASH_PURGE_ANALYTICS (whether purged
+// links lose their click analytics) and SHORT_CODE_RECLAIM (never, purge or a
+// Go duration after the purge before a purged code can be reused)
+func configureTrash(log *logrus.Logger) {
+	policy := domain.DefaultTrashPolicy
+	retention := envDuration(log, "TRASH_RETENTION", policy.Retention)
+
+	purgeAnalytics := policy.PurgeAnalytics
+	if value := os.Getenv("TRASH_PURGE_ANALYTICS"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			log.WithError(err).Fatal("Invalid TRASH_PURGE_ANALYTICS")
+		}
+		purgeAnalytics = parsed
+	}
+
+	codeReclaim := os.Getenv("SHORT_CODE_RECLAIM")
+	if codeReclaim == "" {
+		codeReclaim = "never"
+	}
+
+	if err := domain.ConfigureTrashPolicy(retention, purgeAnalytics, codeReclaim); err != nil {
+		log.WithError(err).Fatal("Invalid trash policy")
+	}
+	log.WithFields(logrus.Fields{
+		"retention":       domain.DefaultTrashPolicy.Retention,
+		"purge_analytics": domain.DefaultTrashPolicy.PurgeAnalytics,
+		"code_reclaim":    codeReclaim,
+	}).Info("Trash policy configured")
+}
+
+// initializeSafety builds the destination safety engine: heuristics always run,
+// the blocklist and Safe Browsing hash prefix files are optional and hot reloaded
+func initializeSafety(log *logrus.Logger) *safety.Engine {
+	var shorteners []string
+	if extra := os.Getenv("SAFETY_SHORTENER_DOMAINS"); extra != "" {
+		shorteners = strings.Split(extra, ",")
+	}
+	checkers := []safety.Checker{networkPolicy(log), safety.NewHeuristics(shorteners...)}
+
+	onReloadError := func(err error) {
+		log.WithError(err).Warn("Failed to reload safety list, keeping previous contents")
+	}
+
+	if path := os.Getenv("SAFETY_BLOCKLIST_FILE"); path != "" {
+		blocklist, err := safety.NewBlocklist(path)
+		if err != nil {
+			log.WithError(err).WithField("path", path).Error("Failed to load safety blocklist")
+		} else {
+			go blocklist.Watch(context.Background(), safetyListReloadInterval, onReloadError)
+			checkers = append(checkers, blocklist)
+		}
+	}
+
+	if path := os.Getenv("SAFETY_HASH_PREFIX_FILE"); path != "" {
+		hashPrefixes, err := safety.NewHashPrefixList(path)
+		if err != nil {
+			log.WithError(err).WithField("path", path).Error("Failed to load Safe Browsing hash prefixes")
+		} else {
+			go hashPrefixes.Watch(context.Background(), safetyListReloadInterval, onReloadError)
+			checkers = append(checkers, hashPrefixes)
+		}
+	}
+
+	log.WithField("checkers", len(checkers)).Info("Destination safety engine initialized")
+	return safety.NewEngine(checkers...)
+}
+
+// initializePreviews builds the link preview scraper; LINK_PREVIEW_ENABLED=false turns it off.
+// Fetches go through the network policy so previews cannot be used to reach internal services.
+func initializePreviews(log *logrus.Logger) *preview.Fetcher {
+	if enabled, err := strconv.ParseBool(os.Getenv("LINK_PREVIEW_ENABLED")); err == nil && !enabled {
+		log.Info("Link preview scraping disabled")
+		return nil
+	}
+
+	return preview.NewFetcher(preview.Options{
+		DialContext: networkPolicy(log).DialContext(&net.Dialer{Timeout: preview.DefaultTimeout}),
+	})
+}
+
+// initializeQRLogos builds the downloader for QR code logos; QR_LOGOS_ENABLED=false turns it off.
+// Like previews, logo downloads go through the network policy.
+func initializeQRLogos(log *logrus.Logger) *qr.LogoFetcher {
+	if enabled, err := strconv.ParseBool(os.Getenv("QR_LOGOS_ENABLED")); err == nil && !enabled {
+		log.Info("QR code logos disabled")
+		return nil
+	}
+
+	return qr.NewLogoFetcher(qr.LogoOptions{
+		DialContext: networkPolicy(log).DialContext(&net.Dialer{Timeout: qr.DefaultLogoTimeout}),
+	})
+}
+
+// initializeAliases builds the custom alias policy: ALIAS_PLAN_RULES (JSON, see
+// alias.ParseRules) overrides the per-plan rules, ALIAS_RESERVED adds reserved
+// words and ALIAS_DENY_LIST_FILE adds deny list entries such as brand names
+func initializeAliases(log *logrus.Logger) *alias.Policy {
+	policy := alias.NewPolicy()
+
+	if rules := os.Getenv("ALIAS_PLAN_RULES"); rules != "" {
+		plans, err := alias.ParseRules(rules)
+		if err != nil {
+			log.WithError(err).Fatal("Invalid ALIAS_PLAN_RULES")
+		}
+		for plan, planRules := range plans {
+			if err := policy.SetRules(plan, planRules); err != nil {
+				log.WithError(err).Fatal("Invalid ALIAS_PLAN_RULES")
+			}
+		}
+	}
+
+	if reserved := os.Getenv("ALIAS_RESERVED"); reserved != "" {
+		policy.Reserve(strings.Split(reserved, ",")...)
+	}
+
+	if path := os.Getenv("ALIAS_DENY_LIST_FILE"); path != "" {
+		if err := policy.LoadDenyList(path); err != nil {
+			log.WithError(err).WithField("path", path).Error("Failed to load alias deny list")
+		}
+	}
+
+	return policy
+}
+
+// networkPolicy builds the destination network policy; SAFETY_ALLOWED_NETWORKS
+// (comma-separated CIDRs) opens internal ranges for internal deployments
+func networkPolicy(log *logrus.Logger) *safety.NetworkPolicy {
+	allowed, err := safety.ParseNetworks(os.Getenv("SAFETY_ALLOWED_NETWORKS"))
+	if err != nil {
+		log.WithError(err).Fatal("Invalid SAFETY_ALLOWED_NETWORKS")
+	}
+	if len(allowed) > 0 {
+		log.WithField("allowed_networks", allowed).Warn("Internal destination networks are allowed")
+	}
+	return safety.NewNetworkPolicy(nil, allowed...)
+}
+
+// safetyRescanInterval reads SAFETY_RESCAN_INTERVAL (a Go duration, 0 disables rescans)
+func safetyRescanInterval(log *logrus.Logger) time.Duration {
+	return envDuration(log, "SAFETY_RESCAN_INTERVAL", defaultSafetyRescan)
+}
+
+// runSafetyRescan disables existing links whose destinations became unsafe
+func runSafetyRescan(service *domain.URLService, interval time.Duration, log *logrus.Logger) {
+	if interval <= 0 {
+		log.Info("Periodic safety rescan disabled")
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		result, err := service.RescanURLs(context.Background())
+		if err != nil {
+			log.WithError(err).Error("Safety rescan failed")
+			continue
+		}
+		log.WithFields(logrus.Fields{
+			"scanned":  result.Scanned,
+			"disabled": result.Disabled,
+			"errors":   result.Errors,
+		}).Info("Safety rescan completed")
+	}
+}
+
+// runLinkHealthMonitor sweeps link destinations every LINK_HEALTH_INTERVAL
+// (0 disables it). A Redis lock keeps replicas from sweeping at the same time.
+func runLinkHealthMonitor(service *domain.URLService, redisCache *cache.Redis, log *logrus.Logger) {
+	interval := envDuration(log, "LINK_HEALTH_INTERVAL", defaultLinkHealthInterval)
+	if interval <= 0 {
+		log.Info("Link health monitor disabled")
+		return
+	}
+
+	prober := linkhealth.NewProber(linkhealth.Options{
+		Concurrency: envInt(log, "LINK_HEALTH_CONCURRENCY", linkhealth.DefaultConcurrency),
+		HostDelay:   envDuration(log, "LINK_HEALTH_HOST_DELAY", linkhealth.DefaultHostDelay),
+		DialContext: networkPolicy(log).DialContext(&net.Dialer{Timeout: linkhealth.DefaultTimeout}),
+	})
+	threshold := envInt(log, "LINK_HEALTH_FAILURE_THRESHOLD", domain.DefaultHealthFailureThreshold)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		acquired, err := redisCache.AcquireLock(linkHealthLockKey, interval*9/10)
+		if err != nil {
+			log.WithError(err).Error("Failed to acquire link health lock")
+			continue
+		}
+		if !acquired {
+			continue
+		}
+
+		result, err := service.SweepLinkHealth(context.Background(), prober, threshold)
+		if err != nil {
+			log.WithError(err).Error("Link health sweep failed")
+			continue
+		}
+		log.WithFields(logrus.Fields{
+			"checked": result.Checked,
+			"failing": result.Failing,
+			"broken":  result.Broken,
+			"errors":  result.Errors,
+		}).Info("Link health sweep completed")
+	}
+}
+
+// runTrashPurge purges expired trash every TRASH_PURGE_INTERVAL (0 disables it)
+// and publishes a purge event per link so the analytics service can drop the
+// link's clicks. A Redis lock keeps replicas from purging at the same time.
+func runTrashPurge(service *domain.URLService, redisCache *cache.Redis, publisher client.Client, log *logrus.Logger) {
+	interval := envDuration(log, "TRASH_PURGE_INTERVAL", defaultTrashPurgeInterval)
+	if interval <= 0 {
+		log.Info("Trash purge disabled")
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		acquired, err := redisCache.AcquireLock(trashPurgeLockKey, interval*9/10)
+		if err != nil {
+			log.WithError(err).Error("Failed to acquire trash purge lock")
+			continue
+		}
+		if !acquired {
+			continue
+		}
+
+		result, err := service.PurgeTrash(context.Background(), domain.DefaultTrashPolicy)
+		if err != nil {
+			// Links purged before the failure still get their events
+			log.WithError(err).Error("Trash purge failed")
+		}
+
+		for _, link := range result.Purged {
+			publishPurgeEvent(publisher, link, log)
+		}
+		log.WithFields(logrus.Fields{
+			"purged":         len(result.Purged),
+			"analytics_kept": result.AnalyticsKept,
+			"reclaimed":      result.Reclaimed,
+		}).Info("Trash purge completed")
+	}
+}
+
+// publishPurgeEvent tells other services that a link is gone for good
+func publishPurgeEvent(publisher client.Client, link domain.PurgedLink, log *logrus.Logger) {
+	eventData, err := json.Marshal(&pb.PurgeEvent{
+		Domain:          link.Domain,
+		ShortCode:       link.ShortCode,
+		DeleteAnalytics: link.DeleteAnalytics,
+		Timestamp:       time.Now().Unix(),
+	})
+	if err != nil {
+		log.WithError(err).Error("Failed to marshal purge event")
+		return
+	}
+
+	message := publisher.NewMessage(purgeEventTopic, eventData)
+	if err := publisher.Publish(context.Background(), message); err != nil {
+		log.WithError(err).WithField("short_code", link.ShortCode).Error("Failed to publish purge event")
+	}
+}
+
+// runExpirySweeper marks expired links every EXPIRY_SWEEP_INTERVAL (0
+// disables it) and publishes url.expired events, plus url.expiring events
+// EXPIRY_NOTICE_DAYS ahead (0 disables notices). Replicas elect the sweeping
+// one per run with a Postgres advisory lock.
+func runExpirySweeper(service *domain.URLService, db *database.PostgreSQL, publisher client.Client, log *logrus.Logger) {
+	interval := envDuration(log, "EXPIRY_SWEEP_INTERVAL", defaultExpirySweepInterval)
+	if interval <= 0 {
+		log.Info("Expiry sweeper disabled")
+		return
+	}
+	noticeAhead := time.Duration(envInt(log, "EXPIRY_NOTICE_DAYS", int(domain.DefaultExpiryNotice/(24*time.Hour)))) * 24 * time.Hour
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		release, leader, err := db.TryAdvisoryLock(context.Background(), expirySweepLockName)
+		if err != nil {
+			log.WithError(err).Error("Failed to acquire expiry sweep lock")
+			continue
+		}
+		if !leader {
+			continue
+		}
+
+		result, err := service.SweepExpiry(context.Background(), noticeAhead)
+		release()
+		if err != nil {
+			// Links marked before the failure still get their events
+			log.WithError(err).Error("Expiry sweep failed")
+		}
+
+		for _, link := range result.Expired {
+			publishExpiryEvent(publisher, expiredEventTopic, link, log)
+			if err := service.EmitWebhookEvent(link.WorkspaceID, domain.WebhookURLExpired, link); err != nil {
+				log.WithError(err).WithField("short_code", link.ShortCode).Error("Failed to queue expiry webhook event")
+			}
+		}
+		for _, link := range result.Expiring {
+			publishExpiryEvent(publisher, expiringEventTopic, link, log)
+			if err := service.EmitWebhookEvent(link.WorkspaceID, domain.WebhookURLExpiring, link); err != nil {
+				log.WithError(err).WithField("short_code", link.ShortCode).Error("Failed to queue expiring webhook event")
+			}
+		}
+		log.WithFields(logrus.Fields{
+			"expired":  len(result.Expired),
+			"expiring": len(result.Expiring),
+		}).Info("Expiry sweep completed")
+	}
+}
+
+// runInactivitySweeper archives links unused for longer than their
+// workspace's inactivity policy allows every INACTIVITY_SWEEP_INTERVAL (0
+// disables it) and logs what policies in dry run would archive. Replicas elect
+// the sweeping one per run with a Postgres advisory lock.
+func runInactivitySweeper(service *domain.URLService, db *database.PostgreSQL, log *logrus.Logger) {
+	interval := envDuration(log, "INACTIVITY_SWEEP_INTERVAL", defaultInactivitySweepInterval)
+	if interval <= 0 {
+		log.Info("Inactivity sweeper disabled")
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		release, leader, err := db.TryAdvisoryLock(context.Background(), inactivitySweepLockName)
+		if err != nil {
+			log.WithError(err).Error("Failed to acquire inactivity sweep lock")
+			continue
+		}
+		if !leader {
+			continue
+		}
+
+		result, err := service.SweepInactivity(context.Background())
+		release()
+		if err != nil {
+			log.WithError(err).Error("Inactivity sweep failed")
+		}
+
+		for workspaceID, count := range result.DryRun {
+			log.WithFields(logrus.Fields{
+				"workspace_id":  workspaceID,
+				"would_archive": count,
+			}).Info("Inactivity policy in dry run")
+		}
+		log.WithFields(logrus.Fields{
+			"archived":           len(result.Archived),
+			"dry_run_workspaces": len(result.DryRun),
+		}).Info("Inactivity sweep completed")
+	}
+}
+
+// runWebhookDispatcher sends due webhook deliveries every
+// WEBHOOK_DISPATCH_INTERVAL (0 disables it). A delivery is attempted up to
+// WEBHOOK_MAX_ATTEMPTS times and a webhook is turned off after
+// WEBHOOK_DISABLE_AFTER consecutive failed attempts (0 never). Finished
+// deliveries are kept for WEBHOOK_DELIVERY_RETENTION (0 keeps them). Replicas
+// lease deliveries, so they can all dispatch.
+func runWebhookDispatcher(service *domain.URLService, log *logrus.Logger) {
+	interval := envDuration(log, "WEBHOOK_DISPATCH_INTERVAL", defaultWebhookDispatchInterval)
+	if interval <= 0 {
+		log.Info("Webhook dispatcher disabled")
+		return
+	}
+
+	sender := webhook.NewSender(webhook.Options{
+		DialContext: networkPolicy(log).DialContext(&net.Dialer{Timeout: webhook.DefaultTimeout}),
+	})
+	maxAttempts := envInt(log, "WEBHOOK_MAX_ATTEMPTS", domain.DefaultWebhookMaxAttempts)
+	disableAfter := envInt(log, "WEBHOOK_DISABLE_AFTER", domain.DefaultWebhookDisableAfter)
+	retention := envDuration(log, "WEBHOOK_DELIVERY_RETENTION", domain.DefaultWebhookRetention)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var lastPrune time.Time
+	for range ticker.C {
+		result, err := service.DispatchWebhooks(context.Background(), sender, maxAttempts, disableAfter)
+		if err != nil {
+			log.WithError(err).Error("Webhook dispatch failed")
+		}
+		if result.Sent > 0 {
+			log.WithFields(logrus.Fields{
+				"sent":      result.Sent,
+				"succeeded": result.Succeeded,
+				"retrying":  result.Retrying,
+				"failed":    result.Failed,
+				"disabled":  result.Disabled,
+			}).Info("Webhook dispatch completed")
+		}
+
+		if time.Since(lastPrune) >= webhookPruneInterval {
+			lastPrune = time.Now()
+			if removed, err := service.PruneWebhookDeliveries(retention); err != nil {
+				log.WithError(err).Error("Failed to prune webhook deliveries")
+			} else if removed > 0 {
+				log.WithField("removed", removed).Info("Pruned webhook deliveries")
+			}
+		}
+	}
+}
+
+// subscribeToLinkAlerts delivers the alerts other services raise about links
+// (topic: url.alert) to the webhooks of the links' workspaces
+func subscribeToLinkAlerts(service micro.Service, urlService *domain.URLService, log *logrus.Logger) error {
+	_, err := service.Options().Broker.Subscribe(linkAlertEventTopic, func(event broker.Event) error {
+		jsonData, err := decodeEventBody(event.Message().Body)
+		if err != nil {
+			log.WithError(err).WithField("body", string(event.Message().Body)).Error("Failed to decode link alert event")
+			return nil
+		}
+
+		var alert pb.LinkAlertEvent
+		if err := json.Unmarshal(jsonData, &alert); err != nil {
+			log.WithError(err).WithField("body", string(jsonData)).Error("Failed to parse link alert event")
+			return nil // Don't return error to avoid reprocessing
+		}
+
+		var data json.RawMessage
+		if alert.Data != "" {
+			if !json.Valid([]byte(alert.Data)) {
+				log.WithField("short_code", alert.ShortCode).Error("Link alert data is not JSON")
+				return nil
+			}
+			data = json.RawMessage(alert.Data)
+		}
+
+		if err := urlService.EmitLinkAlert(alert.Domain, alert.ShortCode, alert.Event, data); err != nil {
+			log.WithError(err).WithFields(logrus.Fields{
+				"event":      alert.Event,
+				"short_code": alert.ShortCode,
+			}).Error("Failed to queue link alert webhook event")
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to link alerts: %w", err)
+	}
+
+	log.Info("Successfully subscribed to link alerts")
+	return nil
+}
+
+// decodeEventBody returns the JSON of a broker message. Go Micro may deliver
+// the published bytes as a JSON string holding their base64 encoding.
+func decodeEventBody(messageBody []byte) ([]byte, error) {
+	if len(messageBody) == 0 || messageBody[0] != '"' {
+		// Message is already JSON
+		return messageBody, nil
+	}
+
+	var encodedString string
+	if err := json.Unmarshal(messageBody, &encodedString); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal encoded string: %w", err)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encodedString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64: %w", err)
+	}
+	return decoded, nil
+}
+
+// publishExpiryEvent tells other services that a link expired or will expire soon
+func publishExpiryEvent(publisher client.Client, topic string, link domain.ExpiryNotice, log *logrus.Logger) {
+	eventData, err := json.Marshal(&pb.ExpiryEvent{
+		Domain:      link.Domain,
+		ShortCode:   link.ShortCode,
+		UserId:      link.UserID,
+		WorkspaceId: link.WorkspaceID,
+		LongUrl:     link.LongURL,
+		ExpiresAt:   link.ExpiresAt.Unix(),
+		Timestamp:   time.Now().Unix(),
+	})
+	if err != nil {
+		log.WithError(err).Error("Failed to marshal expiry event")
+		return
+	}
+
+	message := publisher.NewMessage(topic, eventData)
+	if err := publisher.Publish(context.Background(), message); err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"topic":      topic,
+			"short_code": link.ShortCode,
+		}).Error("Failed to publish expiry event")
+	}
+}
+
+// envDuration reads a Go duration from the environment, falling back to def
+func envDuration(log *logrus.Logger, name string, def time.Duration) time.Duration {
+	value := os.Getenv(name)
+	if value == "" {
+		return def
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		log.WithError(err).Warnf("Invalid %s, using default", name)
+		return def
+	}
+	return duration
+}
+
+// envInt reads an integer from the environment, falling back to def
+func envInt(log *logrus.Logger, name string, def int) int {
+	value := os.Getenv(name)
+	if value == "" {
+		return def
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		log.WithError(err).Warnf("Invalid %s, using default", name)
+		return def
+	}
+	return number
+}
+
 // Run starts the microservice
 func (m *Microservice) Run() error {
 	m.log.Info("Starting URL Shortener microservice with NATS and observability...")
+
+	// Subscribe to link alerts once the service (and its broker) is up
+	go func() {
+		time.Sleep(2 * time.Second)
+
+		if err := subscribeToLinkAlerts(m.service, m.urlService, m.log); err != nil {
+			m.log.WithError(err).Error("Failed to subscribe to link alerts")
+		}
+	}()
+
 	return m.service.Run()
 }
@@ -1,11 +1,16 @@
 package store
 
 import (
+	"context"
 	"time"
 
 	"github.com/go-systems-lab/go-url-shortener/services/url-shortener-svc/domain"
+	"github.com/go-systems-lab/go-url-shortener/utils/alias"
 	"github.com/go-systems-lab/go-url-shortener/utils/cache"
 	"github.com/go-systems-lab/go-url-shortener/utils/database"
+	"github.com/go-systems-lab/go-url-shortener/utils/preview"
+	"github.com/go-systems-lab/go-url-shortener/utils/qr"
+	"github.com/go-systems-lab/go-url-shortener/utils/safety"
 )
 
 // URLStore provides a clean interface for URL operations
@@ -14,8 +19,8 @@ type URLStore struct {
 }
 
 // NewURLStore creates a new URL store instance
-func NewURLStore(db *database.PostgreSQL, cache *cache.Redis) *URLStore {
-	service := domain.NewURLService(db, cache)
+func NewURLStore(db *database.PostgreSQL, cache *cache.Redis, checker safety.Checker, previews *preview.Fetcher, logos *qr.LogoFetcher, aliases *alias.Policy) *URLStore {
+	service := domain.NewURLService(db, cache, checker, previews, logos, aliases)
 	return &URLStore{
 		service: service,
 	}
@@ -23,34 +28,67 @@ func NewURLStore(db *database.PostgreSQL, cache *cache.Redis) *URLStore {
 
 // ShortenURLRequest represents the store-level request for URL shortening
 type ShortenURLRequest struct {
-	LongURL        string            `json:"long_url"`
-	CustomAlias    string            `json:"custom_alias,omitempty"`
-	ExpirationTime *time.Time        `json:"expiration_time,omitempty"`
-	UserID         string            `json:"user_id"`
-	Metadata       map[string]string `json:"metadata,omitempty"`
+	LongURL         string             `json:"long_url"`
+	CustomAlias     string             `json:"custom_alias,omitempty"`
+	ExpirationTime  *time.Time         `json:"expiration_time,omitempty"`
+	UserID          string             `json:"user_id"`
+	Metadata        map[string]string  `json:"metadata,omitempty"`
+	WorkspaceID     string             `json:"workspace_id,omitempty"`
+	UTMTemplate     map[string]string  `json:"utm_template,omitempty"`
+	Password        string             `json:"password,omitempty"`
+	ActivationTime  *time.Time         `json:"activation_time,omitempty"`
+	FallbackURL     string             `json:"fallback_url,omitempty"`
+	MaxClicks       int64              `json:"max_clicks,omitempty"`
+	PreviewOverride domain.LinkPreview `json:"preview_override,omitempty"`
+	Domain          string             `json:"domain,omitempty"`
+	Tags            []string           `json:"tags,omitempty"`
+	FolderID        int64              `json:"folder_id,omitempty"`
 }
 
 // URLResponse represents the store-level response for URL operations
 type URLResponse struct {
-	ID           int64             `json:"id"`
-	ShortCode    string            `json:"short_code"`
-	LongURL      string            `json:"long_url"`
-	UserID       string            `json:"user_id"`
-	CreatedAt    time.Time         `json:"created_at"`
-	ExpiresAt    *time.Time        `json:"expires_at"`
-	ClickCount   int64             `json:"click_count"`
-	LastAccessed *time.Time        `json:"last_accessed"`
-	IsActive     bool              `json:"is_active"`
-	Metadata     map[string]string `json:"metadata"`
+	ID                int64              `json:"id"`
+	Domain            string             `json:"domain,omitempty"`
+	ShortCode         string             `json:"short_code"`
+	ShortURL          string             `json:"short_url"`
+	LongURL           string             `json:"long_url"`
+	UserID            string             `json:"user_id"`
+	CreatedAt         time.Time          `json:"created_at"`
+	ExpiresAt         *time.Time         `json:"expires_at"`
+	ClickCount        int64              `json:"click_count"`
+	LastAccessed      *time.Time         `json:"last_accessed"`
+	IsActive          bool               `json:"is_active"`
+	Metadata          map[string]string  `json:"metadata"`
+	WorkspaceID       string             `json:"workspace_id,omitempty"`
+	UTMTemplate       map[string]string  `json:"utm_template,omitempty"`
+	PasswordProtected bool               `json:"password_protected"`
+	ActivatesAt       *time.Time         `json:"activates_at,omitempty"`
+	FallbackURL       string             `json:"fallback_url,omitempty"`
+	MaxClicks         int64              `json:"max_clicks,omitempty"`
+	InterstitialMode  string             `json:"interstitial_mode"`
+	DisabledReason    string             `json:"disabled_reason,omitempty"`
+	DisabledAt        *time.Time         `json:"disabled_at,omitempty"`
+	Preview           domain.LinkPreview `json:"preview,omitempty"`
+	PreviewOverride   domain.LinkPreview `json:"preview_override,omitempty"`
+	Tags              []string           `json:"tags,omitempty"`
+	FolderID          int64              `json:"folder_id,omitempty"`
+	DeletedAt         *time.Time         `json:"deleted_at,omitempty"`
+	PurgeAt           *time.Time         `json:"purge_at,omitempty"`
+	InactivityExempt  bool               `json:"inactivity_exempt,omitempty"`
+	ArchivedAt        *time.Time         `json:"archived_at,omitempty"`
 }
 
 // GetUserURLsRequest represents pagination request for user URLs
 type GetUserURLsRequest struct {
-	UserID    string `json:"user_id"`
-	Page      int32  `json:"page"`
-	PageSize  int32  `json:"page_size"`
-	SortBy    string `json:"sort_by"`
-	SortOrder string `json:"sort_order"`
+	UserID            string `json:"user_id"`
+	Page              int32  `json:"page"`
+	PageSize          int32  `json:"page_size"`
+	Cursor            string `json:"cursor,omitempty"`
+	SortBy            string `json:"sort_by"`
+	SortOrder         string `json:"sort_order"`
+	Tag               string `json:"tag,omitempty"`
+	FolderID          int64  `json:"folder_id,omitempty"`
+	IncludeSubfolders bool   `json:"include_subfolders,omitempty"`
 }
 
 // GetUserURLsResponse represents paginated response for user URLs
@@ -60,25 +98,169 @@ type GetUserURLsResponse struct {
 	Page       int32         `json:"page"`
 	PageSize   int32         `json:"page_size"`
 	HasNext    bool          `json:"has_next"`
+	NextCursor string        `json:"next_cursor,omitempty"`
+	PrevCursor string        `json:"prev_cursor,omitempty"`
 }
 
 // UpdateURLRequest represents the store-level request for URL updates
 type UpdateURLRequest struct {
-	ShortCode         string            `json:"short_code"`
-	UserID            string            `json:"user_id"`
-	NewLongURL        string            `json:"new_long_url,omitempty"`
-	NewExpirationTime *time.Time        `json:"new_expiration_time,omitempty"`
-	Metadata          map[string]string `json:"metadata,omitempty"`
+	Domain            string              `json:"domain,omitempty"`
+	ShortCode         string              `json:"short_code"`
+	UserID            string              `json:"user_id"`
+	NewLongURL        string              `json:"new_long_url,omitempty"`
+	NewExpirationTime *time.Time          `json:"new_expiration_time,omitempty"`
+	Metadata          map[string]string   `json:"metadata,omitempty"`
+	UTMTemplate       map[string]string   `json:"utm_template,omitempty"` // nil leaves the template unchanged
+	NewPassword       string              `json:"new_password,omitempty"`
+	ClearPassword     bool                `json:"clear_password,omitempty"`
+	NewActivationTime *time.Time          `json:"new_activation_time,omitempty"`
+	NewFallbackURL    string              `json:"new_fallback_url,omitempty"`
+	NewMaxClicks      int64               `json:"new_max_clicks,omitempty"`
+	ClearMaxClicks    bool                `json:"clear_max_clicks,omitempty"`
+	PreviewOverride   *domain.LinkPreview `json:"preview_override,omitempty"` // nil leaves the overrides unchanged
+	Tags              []string            `json:"tags,omitempty"`             // nil leaves the tags unchanged
+	ClearTags         bool                `json:"clear_tags,omitempty"`
+	NewFolderID       int64               `json:"new_folder_id,omitempty"`
+	ClearFolder       bool                `json:"clear_folder,omitempty"`
+	InactivityExempt  *bool               `json:"inactivity_exempt,omitempty"` // nil leaves the exemption unchanged
+}
+
+// UpsertWorkspaceRequest represents the store-level request for saving a workspace
+type UpsertWorkspaceRequest struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	OwnerID     string            `json:"owner_id"`
+	UTMTemplate map[string]string `json:"utm_template"`
+}
+
+// WorkspaceResponse represents the store-level response for workspace operations
+type WorkspaceResponse struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	OwnerID     string            `json:"owner_id"`
+	UTMTemplate map[string]string `json:"utm_template"`
+	Plan        string            `json:"plan"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+
+	InactivityDays   int  `json:"inactivity_days"`
+	InactivityDryRun bool `json:"inactivity_dry_run"`
+}
+
+// InactivityReportResponse represents the store-level inactivity report
+type InactivityReportResponse struct {
+	WorkspaceID    string        `json:"workspace_id"`
+	InactivityDays int           `json:"inactivity_days"`
+	DryRun         bool          `json:"dry_run"`
+	TotalCount     int64         `json:"total_count"`
+	URLs           []URLResponse `json:"urls"`
+}
+
+// FieldChange represents a field a store-level revision changed
+type FieldChange struct {
+	Field    string `json:"field"`
+	OldValue string `json:"old_value"`
+	NewValue string `json:"new_value"`
+}
+
+// URLRevisionResponse represents the store-level revision of a link
+type URLRevisionResponse struct {
+	ID         int64         `json:"id"`
+	Revision   int           `json:"revision"`
+	ChangedBy  string        `json:"changed_by"`
+	CreatedAt  time.Time     `json:"created_at"`
+	Changes    []FieldChange `json:"changes"`
+	RollbackOf int64         `json:"rollback_of,omitempty"`
+}
+
+// URLRevisionsResponse represents a store-level page of a link's revisions
+type URLRevisionsResponse struct {
+	Revisions  []URLRevisionResponse `json:"revisions"`
+	TotalCount int32                 `json:"total_count"`
+	PageSize   int32                 `json:"page_size"`
+	HasNext    bool                  `json:"has_next"`
+	NextCursor string                `json:"next_cursor,omitempty"`
+	PrevCursor string                `json:"prev_cursor,omitempty"`
+}
+
+// DomainReviewResponse represents the store-level domain review
+type DomainReviewResponse struct {
+	Domain    string    `json:"domain"`
+	Status    string    `json:"status"`
+	Reason    string    `json:"reason"`
+	UpdatedBy string    `json:"updated_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// BrandedDomainResponse represents the store-level branded short domain
+type BrandedDomainResponse struct {
+	Domain      string    `json:"domain"`
+	WorkspaceID string    `json:"workspace_id"`
+	CreatedBy   string    `json:"created_by"`
+	CodePolicy  string    `json:"code_policy"`
+	CreatedAt   time.Time `json:"created_at"`
+	ShortURL    string    `json:"short_url"`
+}
+
+// CodePolicyChangeResponse represents the store-level result of switching a domain's code policy
+type CodePolicyChangeResponse struct {
+	Domain        *BrandedDomainResponse `json:"domain"`
+	CaseConflicts []string               `json:"case_conflicts"`
+}
+
+// TagResponse represents the store-level tag
+type TagResponse struct {
+	ID        int64     `json:"id"`
+	UserID    string    `json:"user_id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	LinkCount int64     `json:"link_count"`
+}
+
+// FolderResponse represents the store-level folder
+type FolderResponse struct {
+	ID        int64     `json:"id"`
+	UserID    string    `json:"user_id"`
+	ParentID  int64     `json:"parent_id"`
+	Name      string    `json:"name"`
+	Path      string    `json:"path"`
+	CreatedAt time.Time `json:"created_at"`
+	LinkCount int64     `json:"link_count"`
+}
+
+// LinkHealthResponse represents the store-level link health check result
+type LinkHealthResponse struct {
+	Domain              string     `json:"domain,omitempty"`
+	ShortCode           string     `json:"short_code"`
+	Status              string     `json:"status"`
+	StatusCode          int        `json:"status_code"`
+	LatencyMs           int64      `json:"latency_ms"`
+	RedirectChain       []string   `json:"redirect_chain,omitempty"`
+	Error               string     `json:"error,omitempty"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	CheckedAt           *time.Time `json:"checked_at,omitempty"`
+	LastHealthyAt       *time.Time `json:"last_healthy_at,omitempty"`
 }
 
 // ShortenURL creates a new short URL
 func (s *URLStore) ShortenURL(req *ShortenURLRequest) (*URLResponse, error) {
 	domainReq := &domain.CreateURLRequest{
-		LongURL:        req.LongURL,
-		CustomAlias:    req.CustomAlias,
-		ExpirationTime: req.ExpirationTime,
-		UserID:         req.UserID,
-		Metadata:       req.Metadata,
+		LongURL:         req.LongURL,
+		CustomAlias:     req.CustomAlias,
+		ExpirationTime:  req.ExpirationTime,
+		UserID:          req.UserID,
+		Metadata:        req.Metadata,
+		WorkspaceID:     req.WorkspaceID,
+		UTMTemplate:     req.UTMTemplate,
+		Password:        req.Password,
+		ActivationTime:  req.ActivationTime,
+		FallbackURL:     req.FallbackURL,
+		MaxClicks:       req.MaxClicks,
+		PreviewOverride: req.PreviewOverride,
+		Domain:          req.Domain,
+		Tags:            req.Tags,
+		FolderID:        req.FolderID,
 	}
 
 	url, err := s.service.ShortenURL(domainReq)
@@ -89,9 +271,9 @@ func (s *URLStore) ShortenURL(req *ShortenURLRequest) (*URLResponse, error) {
 	return s.domainToStoreURL(url), nil
 }
 
-// GetURL retrieves URL information by short code
-func (s *URLStore) GetURL(shortCode, userID string) (*URLResponse, error) {
-	url, err := s.service.GetURL(shortCode, userID)
+// GetURL retrieves URL information by short domain and code
+func (s *URLStore) GetURL(shortDomain, shortCode, userID string) (*URLResponse, error) {
+	url, err := s.service.GetURL(shortDomain, shortCode, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -102,11 +284,15 @@ func (s *URLStore) GetURL(shortCode, userID string) (*URLResponse, error) {
 // GetUserURLs retrieves URLs for a user with pagination
 func (s *URLStore) GetUserURLs(req *GetUserURLsRequest) (*GetUserURLsResponse, error) {
 	domainReq := &domain.GetUserURLsRequest{
-		UserID:    req.UserID,
-		Page:      req.Page,
-		PageSize:  req.PageSize,
-		SortBy:    req.SortBy,
-		SortOrder: req.SortOrder,
+		UserID:            req.UserID,
+		Page:              req.Page,
+		PageSize:          req.PageSize,
+		Cursor:            req.Cursor,
+		SortBy:            req.SortBy,
+		SortOrder:         req.SortOrder,
+		Tag:               req.Tag,
+		FolderID:          req.FolderID,
+		IncludeSubfolders: req.IncludeSubfolders,
 	}
 
 	response, err := s.service.GetUserURLs(domainReq)
@@ -126,17 +312,33 @@ func (s *URLStore) GetUserURLs(req *GetUserURLsRequest) (*GetUserURLsResponse, e
 		Page:       response.Page,
 		PageSize:   response.PageSize,
 		HasNext:    response.HasNext,
+		NextCursor: response.NextCursor,
+		PrevCursor: response.PrevCursor,
 	}, nil
 }
 
 // UpdateURL updates an existing URL
 func (s *URLStore) UpdateURL(req *UpdateURLRequest) (*URLResponse, error) {
 	domainReq := &domain.UpdateURLRequest{
+		Domain:            req.Domain,
 		ShortCode:         req.ShortCode,
 		UserID:            req.UserID,
 		NewLongURL:        req.NewLongURL,
 		NewExpirationTime: req.NewExpirationTime,
 		Metadata:          req.Metadata,
+		UTMTemplate:       req.UTMTemplate,
+		NewPassword:       req.NewPassword,
+		ClearPassword:     req.ClearPassword,
+		NewActivationTime: req.NewActivationTime,
+		NewFallbackURL:    req.NewFallbackURL,
+		NewMaxClicks:      req.NewMaxClicks,
+		ClearMaxClicks:    req.ClearMaxClicks,
+		PreviewOverride:   req.PreviewOverride,
+		Tags:              req.Tags,
+		ClearTags:         req.ClearTags,
+		NewFolderID:       req.NewFolderID,
+		ClearFolder:       req.ClearFolder,
+		InactivityExempt:  req.InactivityExempt,
 	}
 
 	url, err := s.service.UpdateURL(domainReq)
@@ -148,22 +350,648 @@ func (s *URLStore) UpdateURL(req *UpdateURLRequest) (*URLResponse, error) {
 }
 
 // DeleteURL soft deletes a URL
-func (s *URLStore) DeleteURL(shortCode, userID string) error {
-	return s.service.DeleteURL(shortCode, userID)
+func (s *URLStore) DeleteURL(shortDomain, shortCode, userID string) error {
+	return s.service.DeleteURL(shortDomain, shortCode, userID)
+}
+
+// ListDeletedURLs lists a user's links in the trash
+func (s *URLStore) ListDeletedURLs(userID string, page, pageSize int32, cursor string) (*GetUserURLsResponse, error) {
+	response, err := s.service.ListDeletedURLs(&domain.GetDeletedURLsRequest{
+		UserID:   userID,
+		Page:     page,
+		PageSize: pageSize,
+		Cursor:   cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	urls := make([]URLResponse, len(response.URLs))
+	for i := range response.URLs {
+		urls[i] = *s.domainToStoreURL(&response.URLs[i])
+	}
+
+	return &GetUserURLsResponse{
+		URLs:       urls,
+		TotalCount: response.TotalCount,
+		Page:       response.Page,
+		PageSize:   response.PageSize,
+		HasNext:    response.HasNext,
+		NextCursor: response.NextCursor,
+		PrevCursor: response.PrevCursor,
+	}, nil
+}
+
+// RestoreURL takes a link out of the trash
+func (s *URLStore) RestoreURL(shortDomain, shortCode, userID string) (*URLResponse, error) {
+	url, err := s.service.RestoreURL(shortDomain, shortCode, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.domainToStoreURL(url), nil
+}
+
+// SetInactivityPolicy sets how long workspace links may go unused before they are archived
+func (s *URLStore) SetInactivityPolicy(workspaceID, userID string, days int, dryRun bool) (*WorkspaceResponse, error) {
+	workspace, err := s.service.SetInactivityPolicy(workspaceID, userID, days, dryRun)
+	if err != nil {
+		return nil, err
+	}
+	return s.domainToStoreWorkspace(workspace), nil
+}
+
+// GetInactivityReport lists the workspace links an inactivity policy would archive
+func (s *URLStore) GetInactivityReport(workspaceID, userID string, days, limit int) (*InactivityReportResponse, error) {
+	report, err := s.service.GetInactivityReport(&domain.InactivityReportRequest{
+		WorkspaceID:    workspaceID,
+		UserID:         userID,
+		InactivityDays: days,
+		Limit:          limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	urls := make([]URLResponse, len(report.URLs))
+	for i := range report.URLs {
+		urls[i] = *s.domainToStoreURL(&report.URLs[i])
+	}
+
+	return &InactivityReportResponse{
+		WorkspaceID:    report.WorkspaceID,
+		InactivityDays: report.InactivityDays,
+		DryRun:         report.DryRun,
+		TotalCount:     report.TotalCount,
+		URLs:           urls,
+	}, nil
+}
+
+// ReviveURL reactivates a link archived for inactivity
+func (s *URLStore) ReviveURL(shortDomain, shortCode, userID string) (*URLResponse, error) {
+	url, err := s.service.ReviveURL(shortDomain, shortCode, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.domainToStoreURL(url), nil
+}
+
+// ListURLRevisions lists the revision history of a link, newest first
+func (s *URLStore) ListURLRevisions(shortDomain, shortCode, userID string, pageSize int32, cursor string) (*URLRevisionsResponse, error) {
+	response, err := s.service.ListURLRevisions(&domain.GetURLRevisionsRequest{
+		Domain:    shortDomain,
+		ShortCode: shortCode,
+		UserID:    userID,
+		PageSize:  pageSize,
+		Cursor:    cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]URLRevisionResponse, len(response.Revisions))
+	for i, revision := range response.Revisions {
+		changes := make([]FieldChange, len(revision.Changes))
+		for j, change := range revision.Changes {
+			changes[j] = FieldChange{Field: change.Field, OldValue: change.OldValue, NewValue: change.NewValue}
+		}
+		revisions[i] = URLRevisionResponse{
+			ID:         revision.ID,
+			Revision:   revision.Revision,
+			ChangedBy:  revision.ChangedBy,
+			CreatedAt:  revision.CreatedAt,
+			Changes:    changes,
+			RollbackOf: revision.RollbackOf,
+		}
+	}
+
+	return &URLRevisionsResponse{
+		Revisions:  revisions,
+		TotalCount: response.TotalCount,
+		PageSize:   response.PageSize,
+		HasNext:    response.HasNext,
+		NextCursor: response.NextCursor,
+		PrevCursor: response.PrevCursor,
+	}, nil
+}
+
+// RollbackURL restores a link to its state before a revision
+func (s *URLStore) RollbackURL(shortDomain, shortCode, userID string, revisionID int64) (*URLResponse, error) {
+	url, err := s.service.RollbackURL(shortDomain, shortCode, userID, revisionID)
+	if err != nil {
+		return nil, err
+	}
+	return s.domainToStoreURL(url), nil
+}
+
+// RecordAudit appends an action to the audit log
+func (s *URLStore) RecordAudit(actor domain.AuditActor, action, target string, before, after interface{}) error {
+	return s.service.RecordAudit(actor, action, target, before, after)
+}
+
+// AuditSnapshot loads a link's current state for the audit log; nil when there is no active link
+func (s *URLStore) AuditSnapshot(shortDomain, shortCode string) *URLResponse {
+	url := s.service.AuditSnapshot(shortDomain, shortCode)
+	if url == nil {
+		return nil
+	}
+	return s.domainToStoreURL(url)
+}
+
+// ListAuditEvents lists the audit log, newest first
+func (s *URLStore) ListAuditEvents(req *domain.ListAuditEventsRequest) (*domain.ListAuditEventsResponse, error) {
+	return s.service.ListAuditEvents(req)
+}
+
+// VerifyAuditLog checks the hash chain of the audit log
+func (s *URLStore) VerifyAuditLog(ctx context.Context) (*domain.AuditVerification, error) {
+	return s.service.VerifyAuditLog(ctx)
+}
+
+// CreateWebhook subscribes an endpoint to a workspace's link events
+func (s *URLStore) CreateWebhook(req *domain.CreateWebhookRequest) (*domain.Webhook, error) {
+	return s.service.CreateWebhook(req)
+}
+
+// ListWebhooks lists the webhooks of a workspace
+func (s *URLStore) ListWebhooks(workspaceID, userID string) ([]domain.Webhook, error) {
+	return s.service.ListWebhooks(workspaceID, userID)
+}
+
+// UpdateWebhook changes a webhook or rotates its secret
+func (s *URLStore) UpdateWebhook(req *domain.UpdateWebhookRequest) (*domain.Webhook, error) {
+	return s.service.UpdateWebhook(req)
+}
+
+// DeleteWebhook removes a webhook and its delivery log
+func (s *URLStore) DeleteWebhook(workspaceID, userID string, webhookID int64) error {
+	return s.service.DeleteWebhook(workspaceID, userID, webhookID)
+}
+
+// ListWebhookDeliveries lists the delivery log of a webhook, newest first
+func (s *URLStore) ListWebhookDeliveries(req *domain.ListWebhookDeliveriesRequest) (*domain.ListWebhookDeliveriesResponse, error) {
+	return s.service.ListWebhookDeliveries(req)
+}
+
+// RedeliverWebhook queues a delivery of a webhook again
+func (s *URLStore) RedeliverWebhook(workspaceID, userID string, webhookID, deliveryID int64) (*domain.WebhookDelivery, error) {
+	return s.service.RedeliverWebhook(workspaceID, userID, webhookID, deliveryID)
+}
+
+// EmitWebhookEvent queues an event for the webhooks of a workspace
+func (s *URLStore) EmitWebhookEvent(workspaceID, eventType string, data interface{}) error {
+	return s.service.EmitWebhookEvent(workspaceID, eventType, data)
+}
+
+// UpsertWorkspace creates or updates a workspace
+func (s *URLStore) UpsertWorkspace(req *UpsertWorkspaceRequest) (*WorkspaceResponse, error) {
+	domainReq := &domain.UpsertWorkspaceRequest{
+		ID:          req.ID,
+		Name:        req.Name,
+		OwnerID:     req.OwnerID,
+		UTMTemplate: req.UTMTemplate,
+	}
+
+	workspace, err := s.service.UpsertWorkspace(domainReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.domainToStoreWorkspace(workspace), nil
+}
+
+// GetWorkspace retrieves a workspace by ID
+func (s *URLStore) GetWorkspace(workspaceID, userID string) (*WorkspaceResponse, error) {
+	workspace, err := s.service.GetWorkspace(workspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.domainToStoreWorkspace(workspace), nil
+}
+
+// AddBrandedDomain attaches a short domain to a workspace
+func (s *URLStore) AddBrandedDomain(workspaceID, userID, domainName, codePolicy string) (*BrandedDomainResponse, error) {
+	brandedDomain, err := s.service.AddBrandedDomain(workspaceID, userID, domainName, codePolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	return domainToStoreBrandedDomain(brandedDomain), nil
+}
+
+// ListBrandedDomains lists the short domains of a workspace
+func (s *URLStore) ListBrandedDomains(workspaceID, userID string) ([]BrandedDomainResponse, error) {
+	brandedDomains, err := s.service.ListBrandedDomains(workspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	storeDomains := make([]BrandedDomainResponse, len(brandedDomains))
+	for i := range brandedDomains {
+		storeDomains[i] = *domainToStoreBrandedDomain(&brandedDomains[i])
+	}
+	return storeDomains, nil
+}
+
+// SuggestAliasesRequest represents the store-level request for alias suggestions
+type SuggestAliasesRequest struct {
+	Alias       string `json:"alias"`
+	LongURL     string `json:"long_url,omitempty"`
+	Domain      string `json:"domain,omitempty"`
+	WorkspaceID string `json:"workspace_id,omitempty"`
+	UserID      string `json:"user_id"`
+	Count       int    `json:"count"`
+}
+
+// AliasSuggestionsResponse represents the store-level response for alias suggestions
+type AliasSuggestionsResponse struct {
+	Alias       string   `json:"alias"`
+	Available   bool     `json:"available"`
+	Suggestions []string `json:"suggestions"`
+}
+
+// SuggestAliases returns available alternatives to a custom alias
+func (s *URLStore) SuggestAliases(ctx context.Context, req *SuggestAliasesRequest) (*AliasSuggestionsResponse, error) {
+	suggestions, err := s.service.SuggestAliases(ctx, &domain.SuggestAliasesRequest{
+		Alias:       req.Alias,
+		LongURL:     req.LongURL,
+		Domain:      req.Domain,
+		WorkspaceID: req.WorkspaceID,
+		UserID:      req.UserID,
+		Count:       req.Count,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &AliasSuggestionsResponse{
+		Alias:       suggestions.Alias,
+		Available:   suggestions.Available,
+		Suggestions: suggestions.Suggestions,
+	}, nil
+}
+
+// SetBrandedDomainCodePolicy switches the short code policy of a workspace's domain
+func (s *URLStore) SetBrandedDomainCodePolicy(workspaceID, userID, domainName, codePolicy string) (*CodePolicyChangeResponse, error) {
+	change, err := s.service.SetBrandedDomainCodePolicy(workspaceID, userID, domainName, codePolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CodePolicyChangeResponse{
+		Domain:        domainToStoreBrandedDomain(change.Domain),
+		CaseConflicts: change.CaseConflicts,
+	}, nil
+}
+
+// RemoveBrandedDomain detaches a short domain from a workspace
+func (s *URLStore) RemoveBrandedDomain(workspaceID, userID, domainName string) error {
+	return s.service.RemoveBrandedDomain(workspaceID, userID, domainName)
+}
+
+// GetLinkHealth retrieves the latest destination check for a URL
+func (s *URLStore) GetLinkHealth(shortDomain, shortCode, userID string) (*LinkHealthResponse, error) {
+	health, err := s.service.GetLinkHealth(shortDomain, shortCode, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LinkHealthResponse{
+		Domain:              health.Domain,
+		ShortCode:           health.ShortCode,
+		Status:              health.Status,
+		StatusCode:          health.StatusCode,
+		LatencyMs:           health.LatencyMs,
+		RedirectChain:       health.RedirectChain,
+		Error:               health.Error,
+		ConsecutiveFailures: health.ConsecutiveFailures,
+		CheckedAt:           health.CheckedAt,
+		LastHealthyAt:       health.LastHealthyAt,
+	}, nil
+}
+
+// GetQRCode renders the QR code of a URL
+func (s *URLStore) GetQRCode(ctx context.Context, req *domain.QRCodeRequest) (*domain.QRCode, error) {
+	return s.service.GetQRCode(ctx, req)
+}
+
+// SetInterstitialMode changes the interstitial mode of a URL (admin operation)
+func (s *URLStore) SetInterstitialMode(shortDomain, shortCode, mode string) (*URLResponse, error) {
+	url, err := s.service.SetInterstitialMode(shortDomain, shortCode, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.domainToStoreURL(url), nil
+}
+
+// SetWorkspacePlan changes the plan of a workspace (admin operation)
+func (s *URLStore) SetWorkspacePlan(workspaceID, plan string) (*WorkspaceResponse, error) {
+	workspace, err := s.service.SetWorkspacePlan(workspaceID, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.domainToStoreWorkspace(workspace), nil
+}
+
+// UpsertDomainReview saves a domain review (admin operation)
+func (s *URLStore) UpsertDomainReview(domainName, status, reason, updatedBy string) (*DomainReviewResponse, error) {
+	review, err := s.service.UpsertDomainReview(&domain.DomainReview{
+		Domain:    domainName,
+		Status:    status,
+		Reason:    reason,
+		UpdatedBy: updatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return domainToStoreReview(review), nil
+}
+
+// DeleteDomainReview removes a domain review (admin operation)
+func (s *URLStore) DeleteDomainReview(domainName string) error {
+	return s.service.DeleteDomainReview(domainName)
+}
+
+// ListDomainReviews lists domain reviews (admin operation)
+func (s *URLStore) ListDomainReviews(status string) ([]DomainReviewResponse, error) {
+	reviews, err := s.service.ListDomainReviews(status)
+	if err != nil {
+		return nil, err
+	}
+
+	storeReviews := make([]DomainReviewResponse, len(reviews))
+	for i := range reviews {
+		storeReviews[i] = *domainToStoreReview(&reviews[i])
+	}
+	return storeReviews, nil
+}
+
+// GetFlaggedURLs lists links disabled by the safety engine (admin operation)
+func (s *URLStore) GetFlaggedURLs(page, pageSize int32, cursor string) (*GetUserURLsResponse, error) {
+	response, err := s.service.GetFlaggedURLs(&domain.GetFlaggedURLsRequest{
+		Page:     page,
+		PageSize: pageSize,
+		Cursor:   cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	urls := make([]URLResponse, len(response.URLs))
+	for i := range response.URLs {
+		urls[i] = *s.domainToStoreURL(&response.URLs[i])
+	}
+
+	return &GetUserURLsResponse{
+		URLs:       urls,
+		TotalCount: response.TotalCount,
+		Page:       response.Page,
+		PageSize:   response.PageSize,
+		HasNext:    response.HasNext,
+		NextCursor: response.NextCursor,
+		PrevCursor: response.PrevCursor,
+	}, nil
+}
+
+// CreateTag adds a tag for a user
+func (s *URLStore) CreateTag(userID, name string) (*TagResponse, error) {
+	tag, err := s.service.CreateTag(userID, name)
+	if err != nil {
+		return nil, err
+	}
+	return domainToStoreTag(tag), nil
+}
+
+// ListTags lists a user's tags
+func (s *URLStore) ListTags(userID string) ([]TagResponse, error) {
+	tags, err := s.service.ListTags(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]TagResponse, len(tags))
+	for i := range tags {
+		responses[i] = *domainToStoreTag(&tags[i])
+	}
+	return responses, nil
+}
+
+// RenameTag renames a user's tag
+func (s *URLStore) RenameTag(userID, name, newName string) (*TagResponse, error) {
+	tag, err := s.service.RenameTag(userID, name, newName)
+	if err != nil {
+		return nil, err
+	}
+	return domainToStoreTag(tag), nil
+}
+
+// DeleteTag deletes a user's tag
+func (s *URLStore) DeleteTag(userID, name string) error {
+	return s.service.DeleteTag(userID, name)
+}
+
+// CreateFolder adds a folder for a user
+func (s *URLStore) CreateFolder(userID, name string, parentID int64) (*FolderResponse, error) {
+	folder, err := s.service.CreateFolder(userID, name, parentID)
+	if err != nil {
+		return nil, err
+	}
+	return domainToStoreFolder(folder), nil
+}
+
+// ListFolders lists a user's folders
+func (s *URLStore) ListFolders(userID string) ([]FolderResponse, error) {
+	folders, err := s.service.ListFolders(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]FolderResponse, len(folders))
+	for i := range folders {
+		responses[i] = *domainToStoreFolder(&folders[i])
+	}
+	return responses, nil
+}
+
+// UpdateFolder renames or moves a user's folder
+func (s *URLStore) UpdateFolder(userID string, folderID int64, name string, parentID int64) (*FolderResponse, error) {
+	folder, err := s.service.UpdateFolder(userID, folderID, name, parentID)
+	if err != nil {
+		return nil, err
+	}
+	return domainToStoreFolder(folder), nil
+}
+
+// DeleteFolder deletes a user's folder and its subfolders
+func (s *URLStore) DeleteFolder(userID string, folderID int64) error {
+	return s.service.DeleteFolder(userID, folderID)
+}
+
+// SearchURLsRequest represents the store-level request for searching a user's links
+type SearchURLsRequest struct {
+	UserID   string `json:"user_id"`
+	Query    string `json:"query"`
+	Tag      string `json:"tag,omitempty"`
+	Domain   string `json:"domain,omitempty"`
+	Status   string `json:"status,omitempty"`
+	Page     int32  `json:"page"`
+	PageSize int32  `json:"page_size"`
+	Cursor   string `json:"cursor,omitempty"`
+}
+
+// SearchHitResponse represents a link matching a search
+type SearchHitResponse struct {
+	URL  URLResponse `json:"url"`
+	Rank float64     `json:"rank"`
+}
+
+// SearchURLsResponse represents ranked search results with facet counts
+type SearchURLsResponse struct {
+	Hits         []SearchHitResponse `json:"hits"`
+	TotalCount   int64               `json:"total_count"`
+	Page         int32               `json:"page"`
+	PageSize     int32               `json:"page_size"`
+	HasNext      bool                `json:"has_next"`
+	NextCursor   string              `json:"next_cursor,omitempty"`
+	PrevCursor   string              `json:"prev_cursor,omitempty"`
+	TagFacets    []domain.FacetCount `json:"tag_facets"`
+	DomainFacets []domain.FacetCount `json:"domain_facets"`
+	StatusFacets []domain.FacetCount `json:"status_facets"`
+}
+
+// SearchURLs searches a user's links
+func (s *URLStore) SearchURLs(req *SearchURLsRequest) (*SearchURLsResponse, error) {
+	response, err := s.service.SearchURLs(&domain.SearchURLsRequest{
+		UserID:   req.UserID,
+		Query:    req.Query,
+		Tag:      req.Tag,
+		Domain:   req.Domain,
+		Status:   req.Status,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Cursor:   req.Cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]SearchHitResponse, len(response.Hits))
+	for i, hit := range response.Hits {
+		hits[i] = SearchHitResponse{URL: *s.domainToStoreURL(&hit.URL), Rank: hit.Rank}
+	}
+
+	return &SearchURLsResponse{
+		Hits:         hits,
+		TotalCount:   response.TotalCount,
+		Page:         response.Page,
+		PageSize:     response.PageSize,
+		HasNext:      response.HasNext,
+		NextCursor:   response.NextCursor,
+		PrevCursor:   response.PrevCursor,
+		TagFacets:    response.Facets.Tags,
+		DomainFacets: response.Facets.Domains,
+		StatusFacets: response.Facets.Statuses,
+	}, nil
+}
+
+// Helper function to convert domain tag to store tag
+func domainToStoreTag(tag *domain.Tag) *TagResponse {
+	return &TagResponse{
+		ID:        tag.ID,
+		UserID:    tag.UserID,
+		Name:      tag.Name,
+		CreatedAt: tag.CreatedAt,
+		LinkCount: tag.LinkCount,
+	}
+}
+
+// Helper function to convert domain folder to store folder
+func domainToStoreFolder(folder *domain.Folder) *FolderResponse {
+	return &FolderResponse{
+		ID:        folder.ID,
+		UserID:    folder.UserID,
+		ParentID:  folder.ParentID,
+		Name:      folder.Name,
+		Path:      folder.Path,
+		CreatedAt: folder.CreatedAt,
+		LinkCount: folder.LinkCount,
+	}
+}
+
+// Helper function to convert domain review to store review
+func domainToStoreReview(review *domain.DomainReview) *DomainReviewResponse {
+	return &DomainReviewResponse{
+		Domain:    review.Domain,
+		Status:    review.Status,
+		Reason:    review.Reason,
+		UpdatedBy: review.UpdatedBy,
+		CreatedAt: review.CreatedAt,
+		UpdatedAt: review.UpdatedAt,
+	}
+}
+
+// Helper function to convert domain branded domain to store branded domain
+func domainToStoreBrandedDomain(brandedDomain *domain.BrandedDomain) *BrandedDomainResponse {
+	return &BrandedDomainResponse{
+		Domain:      brandedDomain.Domain,
+		WorkspaceID: brandedDomain.WorkspaceID,
+		CreatedBy:   brandedDomain.CreatedBy,
+		CodePolicy:  string(brandedDomain.CodePolicy),
+		CreatedAt:   brandedDomain.CreatedAt,
+		ShortURL:    brandedDomain.ShortURL,
+	}
+}
+
+// Helper function to convert domain workspace to store workspace
+func (s *URLStore) domainToStoreWorkspace(workspace *domain.Workspace) *WorkspaceResponse {
+	return &WorkspaceResponse{
+		ID:          workspace.ID,
+		Name:        workspace.Name,
+		OwnerID:     workspace.OwnerID,
+		UTMTemplate: workspace.UTMTemplate,
+		Plan:        workspace.Plan,
+		CreatedAt:   workspace.CreatedAt,
+		UpdatedAt:   workspace.UpdatedAt,
+
+		InactivityDays:   workspace.InactivityDays,
+		InactivityDryRun: workspace.InactivityDryRun,
+	}
 }
 
 // Helper function to convert domain URL to store URL
 func (s *URLStore) domainToStoreURL(url *domain.URL) *URLResponse {
 	return &URLResponse{
-		ID:           url.ID,
-		ShortCode:    url.ShortCode,
-		LongURL:      url.LongURL,
-		UserID:       url.UserID,
-		CreatedAt:    url.CreatedAt,
-		ExpiresAt:    url.ExpiresAt,
-		ClickCount:   url.ClickCount,
-		LastAccessed: url.LastAccessed,
-		IsActive:     url.IsActive,
-		Metadata:     url.Metadata,
+		ID:                url.ID,
+		Domain:            url.Domain,
+		ShortCode:         url.ShortCode,
+		ShortURL:          url.ShortURL(),
+		LongURL:           url.LongURL,
+		UserID:            url.UserID,
+		CreatedAt:         url.CreatedAt,
+		ExpiresAt:         url.ExpiresAt,
+		ClickCount:        url.ClickCount,
+		LastAccessed:      url.LastAccessed,
+		IsActive:          url.IsActive,
+		Metadata:          url.Metadata,
+		WorkspaceID:       url.WorkspaceID,
+		UTMTemplate:       url.UTMTemplate,
+		PasswordProtected: url.RequiresPassword(),
+		ActivatesAt:       url.ActivatesAt,
+		FallbackURL:       url.FallbackURL,
+		MaxClicks:         url.MaxClicks,
+		InterstitialMode:  url.InterstitialMode,
+		DisabledReason:    url.DisabledReason,
+		DisabledAt:        url.DisabledAt,
+		Preview:           url.Preview,
+		PreviewOverride:   url.PreviewOverride,
+		Tags:              url.Tags,
+		FolderID:          url.FolderID,
+		DeletedAt:         url.DeletedAt,
+		PurgeAt:           url.PurgeAt,
+		InactivityExempt:  url.InactivityExempt,
+		ArchivedAt:        url.ArchivedAt,
 	}
 }
@@ -0,0 +1,221 @@
+package alias
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// MaxLength is the longest alias any plan may allow (the short_code column width)
+const MaxLength = 64
+
+// DefaultPlan applies to links outside a workspace and to workspaces without a known plan
+const DefaultPlan = "free"
+
+// Alias validation errors
+var (
+	ErrInvalidFormat = errors.New("alias does not match the plan's alias rules")
+	ErrReserved      = errors.New("alias is reserved")
+	ErrOffensive     = errors.New("alias contains blocked words")
+	ErrInvalidRules  = errors.New("invalid alias rules")
+)
+
+// Rules are the format rules of a plan. Letters and digits are always
+// allowed; Separators lists which of "-" and "_" are allowed on top.
+type Rules struct {
+	MinLength  int    `json:"min_length"`
+	MaxLength  int    `json:"max_length"`
+	Separators string `json:"separators"`
+}
+
+// DefaultRules are the built-in rules per plan; ALIAS_PLAN_RULES can override them
+var DefaultRules = map[string]Rules{
+	DefaultPlan:  {MinLength: 3, MaxLength: 10},
+	"pro":        {MinLength: 3, MaxLength: 32, Separators: "-_"},
+	"enterprise": {MinLength: 2, MaxLength: MaxLength, Separators: "-_"},
+}
+
+// Validate checks that the rules are usable
+func (r Rules) Validate() error {
+	if r.MinLength < 1 || r.MaxLength < r.MinLength || r.MaxLength > MaxLength {
+		return fmt.Errorf("%w: lengths must satisfy 1 <= min_length <= max_length <= %d", ErrInvalidRules, MaxLength)
+	}
+	if strings.Trim(r.Separators, "-_") != "" {
+		return fmt.Errorf("%w: separators may only contain - and _", ErrInvalidRules)
+	}
+	return nil
+}
+
+// check reports whether alias matches the rules. Separators may not start or
+// end an alias, so "-promo" and "promo_" are rejected even where allowed.
+func (r Rules) check(alias string) bool {
+	if len(alias) < r.MinLength || len(alias) > r.MaxLength {
+		return false
+	}
+	for i, c := range alias {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case strings.ContainsRune(r.Separators, c) && i > 0 && i < len(alias)-1:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// ParseRules parses per-plan rules from JSON such as
+// {"pro": {"min_length": 3, "max_length": 32, "separators": "-_"}}
+func ParseRules(data string) (map[string]Rules, error) {
+	var plans map[string]Rules
+	if err := json.Unmarshal([]byte(data), &plans); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRules, err)
+	}
+	for plan, rules := range plans {
+		if err := rules.Validate(); err != nil {
+			return nil, fmt.Errorf("plan %q: %w", plan, err)
+		}
+	}
+	return plans, nil
+}
+
+// WellFormed reports whether a code could be valid under any plan; services
+// that only resolve codes use it to reject junk before touching storage
+func WellFormed(code string) bool {
+	return Rules{MinLength: 1, MaxLength: MaxLength, Separators: "-_"}.check(code)
+}
+
+// Policy decides which custom aliases may be used: the plan's format rules,
+// reserved words (exact, case-insensitive), the deny list and the built-in
+// offensive word filter (both matched on a leetspeak-normalized form)
+type Policy struct {
+	mu       sync.RWMutex
+	plans    map[string]Rules
+	reserved map[string]struct{}
+	denied   []pattern
+}
+
+// NewPolicy returns a policy with the built-in plans, reserved words and offensive word filter
+func NewPolicy() *Policy {
+	p := &Policy{
+		plans:    make(map[string]Rules, len(DefaultRules)),
+		reserved: make(map[string]struct{}),
+	}
+	for plan, rules := range DefaultRules {
+		p.plans[plan] = rules
+	}
+	p.Reserve(DefaultReserved...)
+	return p
+}
+
+// SetRules adds or replaces the rules of a plan
+func (p *Policy) SetRules(plan string, rules Rules) error {
+	if err := rules.Validate(); err != nil {
+		return err
+	}
+	p.mu.Lock()
+	p.plans[plan] = rules
+	p.mu.Unlock()
+	return nil
+}
+
+// HasPlan reports whether the policy has rules for a plan
+func (p *Policy) HasPlan(plan string) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	_, ok := p.plans[plan]
+	return ok
+}
+
+// RulesFor returns the rules of a plan, falling back to DefaultPlan
+func (p *Policy) RulesFor(plan string) Rules {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if rules, ok := p.plans[plan]; ok {
+		return rules
+	}
+	return p.plans[DefaultPlan]
+}
+
+// Reserve adds words that can never be used as aliases
+func (p *Policy) Reserve(words ...string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, word := range words {
+		if word = strings.ToLower(strings.TrimSpace(word)); word != "" {
+			p.reserved[word] = struct{}{}
+		}
+	}
+}
+
+// Deny adds deny list entries such as brand names. An entry matches the whole
+// alias; a leading or trailing * makes it match a suffix, prefix or, with
+// both, anywhere ("*paypal*" also blocks "paypal-login").
+func (p *Policy) Deny(entries ...string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, entry := range entries {
+		if pat, ok := parsePattern(entry); ok {
+			p.denied = append(p.denied, pat)
+		}
+	}
+}
+
+// LoadDenyList adds the entries of a deny list file, one per line; blank lines
+// and lines starting with # are skipped
+func (p *Policy) LoadDenyList(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var entries []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			entries = append(entries, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	p.Deny(entries...)
+	return nil
+}
+
+// Reserved reports whether an alias is a reserved word
+func (p *Policy) Reserved(alias string) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	_, ok := p.reserved[strings.ToLower(alias)]
+	return ok
+}
+
+// Check validates an alias for a plan
+func (p *Policy) Check(alias, plan string) error {
+	if !p.RulesFor(plan).check(alias) {
+		return ErrInvalidFormat
+	}
+	if p.Reserved(alias) {
+		return ErrReserved
+	}
+
+	normalized := Normalize(alias)
+	if offensive(normalized) {
+		return ErrOffensive
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for _, pat := range p.denied {
+		if pat.match(normalized) {
+			return ErrReserved
+		}
+	}
+	return nil
+}
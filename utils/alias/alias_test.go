@@ -0,0 +1,96 @@
+package alias
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanRules(t *testing.T) {
+	policy := NewPolicy()
+
+	assert.NoError(t, policy.Check("promo24", DefaultPlan))
+	assert.ErrorIs(t, policy.Check("ab", DefaultPlan), ErrInvalidFormat)
+	assert.ErrorIs(t, policy.Check("spring-sale", DefaultPlan), ErrInvalidFormat)
+	assert.ErrorIs(t, policy.Check("spring-sale", "unknown"), ErrInvalidFormat, "unknown plans use the default rules")
+
+	assert.NoError(t, policy.Check("spring-sale_2024", "pro"))
+	assert.ErrorIs(t, policy.Check("-spring", "pro"), ErrInvalidFormat)
+	assert.ErrorIs(t, policy.Check("spring_", "pro"), ErrInvalidFormat)
+	assert.ErrorIs(t, policy.Check("spring.sale", "pro"), ErrInvalidFormat)
+
+	require.NoError(t, policy.SetRules("team", Rules{MinLength: 5, MaxLength: 8, Separators: "_"}))
+	assert.True(t, policy.HasPlan("team"))
+	assert.NoError(t, policy.Check("q3_deck", "team"))
+	assert.ErrorIs(t, policy.Check("q3-deck", "team"), ErrInvalidFormat)
+	assert.ErrorIs(t, policy.SetRules("bad", Rules{MinLength: 3, MaxLength: MaxLength + 1}), ErrInvalidRules)
+	assert.ErrorIs(t, policy.SetRules("bad", Rules{MinLength: 3, MaxLength: 10, Separators: "."}), ErrInvalidRules)
+}
+
+func TestParseRules(t *testing.T) {
+	plans, err := ParseRules(`{"pro": {"min_length": 4, "max_length": 40, "separators": "-"}}`)
+	require.NoError(t, err)
+	assert.Equal(t, Rules{MinLength: 4, MaxLength: 40, Separators: "-"}, plans["pro"])
+
+	_, err = ParseRules(`{"pro": {"min_length": 0, "max_length": 40}}`)
+	assert.ErrorIs(t, err, ErrInvalidRules)
+	_, err = ParseRules(`not json`)
+	assert.ErrorIs(t, err, ErrInvalidRules)
+}
+
+func TestReservedWords(t *testing.T) {
+	policy := NewPolicy()
+	policy.Reserve(RouteWords([]string{"/", "/:shortCode", "/docs/*any", "/api/v1/shorten", "/api/v1/urls/:shortCode", "/metrics"})...)
+
+	for _, word := range []string{"docs", "Docs", "api", "metrics", "health", "admin"} {
+		assert.ErrorIs(t, policy.Check(word, DefaultPlan), ErrReserved, word)
+	}
+	assert.NoError(t, policy.Check("docs2", DefaultPlan))
+}
+
+func TestRouteWords(t *testing.T) {
+	words := RouteWords([]string{"/", "/:shortCode", "/*any", "/docs/*any", "/api/v1/shorten", "/api/v1/urls", "/health"})
+	assert.Equal(t, []string{"docs", "api", "health"}, words)
+}
+
+func TestDenyList(t *testing.T) {
+	policy := NewPolicy()
+	policy.Deny("acme", "*paypal*", "google*", "*bank", "  ")
+
+	assert.ErrorIs(t, policy.Check("ACME", "pro"), ErrReserved)
+	assert.ErrorIs(t, policy.Check("acm3", "pro"), ErrReserved, "deny list entries match leetspeak")
+	assert.NoError(t, policy.Check("acmesale", "pro"), "plain entries only match the whole alias")
+	assert.ErrorIs(t, policy.Check("secure-paypal-login", "pro"), ErrReserved)
+	assert.ErrorIs(t, policy.Check("g00gle-docs", "pro"), ErrReserved)
+	assert.NoError(t, policy.Check("my-google", "pro"))
+	assert.ErrorIs(t, policy.Check("mybank", "pro"), ErrReserved)
+	assert.NoError(t, policy.Check("bankday", "pro"))
+}
+
+func TestOffensiveWords(t *testing.T) {
+	policy := NewPolicy()
+
+	for _, alias := range []string{"shit", "SH1T", "sh_i-t", "shiiiit", "5hit", "b1tch", "a55", "d1ck"} {
+		assert.ErrorIs(t, policy.Check(alias, "pro"), ErrOffensive, alias)
+	}
+
+	for _, alias := range []string{"peacock", "grapes", "class", "dickens", "assets2", "analyst"} {
+		assert.NoError(t, policy.Check(alias, "pro"), alias)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "shit", Normalize("Sh1iiT"))
+	assert.Equal(t, "spring", Normalize("spr1ng"))
+	assert.Equal(t, "helo", Normalize("he-l_lo"))
+}
+
+func TestWellFormed(t *testing.T) {
+	assert.True(t, WellFormed("abc123"))
+	assert.True(t, WellFormed("spring-sale_2024"))
+	assert.False(t, WellFormed(""))
+	assert.False(t, WellFormed("-abc"))
+	assert.False(t, WellFormed("abc def"))
+	assert.False(t, WellFormed(string(make([]byte, MaxLength+1))))
+}
@@ -0,0 +1,81 @@
+package alias
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// CodePolicy decides how the short codes of a domain are spelled
+type CodePolicy string
+
+// Code policies
+const (
+	// CodePolicyMixed is the original code space: case-sensitive base62
+	CodePolicyMixed CodePolicy = "mixed"
+	// CodePolicyReadable is meant for codes that are read aloud or typed on
+	// phones: lookups ignore case, codes are stored in lower case and
+	// generated codes avoid look-alike characters
+	CodePolicyReadable CodePolicy = "readable"
+)
+
+// ErrConfusable is returned for readable-policy aliases with look-alike digits
+var ErrConfusable = errors.New("alias contains confusable characters")
+
+// Generator alphabets; the readable one drops 0/o, 1/l/i
+const (
+	mixedAlphabet    = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	readableAlphabet = "23456789abcdefghjkmnpqrstuvwxyz"
+)
+
+// ParseCodePolicy parses a code policy name; "" means CodePolicyMixed
+func ParseCodePolicy(name string) (CodePolicy, error) {
+	switch policy := CodePolicy(strings.ToLower(strings.TrimSpace(name))); policy {
+	case "", CodePolicyMixed:
+		return CodePolicyMixed, nil
+	case CodePolicyReadable:
+		return CodePolicyReadable, nil
+	default:
+		return "", fmt.Errorf("code policy must be %s or %s, got %q", CodePolicyMixed, CodePolicyReadable, name)
+	}
+}
+
+// CaseInsensitive reports whether codes are looked up regardless of case
+func (p CodePolicy) CaseInsensitive() bool {
+	return p == CodePolicyReadable
+}
+
+// Alphabet returns the characters generated codes are made of
+func (p CodePolicy) Alphabet() string {
+	if p == CodePolicyReadable {
+		return readableAlphabet
+	}
+	return mixedAlphabet
+}
+
+// CodeLength returns the length of generated codes: 62^7 and 31^8 both give
+// well over a trillion combinations
+func (p CodePolicy) CodeLength() int {
+	if p == CodePolicyReadable {
+		return 8
+	}
+	return 7
+}
+
+// Canonical returns the stored and cached form of a code
+func (p CodePolicy) Canonical(code string) string {
+	if p.CaseInsensitive() {
+		return strings.ToLower(code)
+	}
+	return code
+}
+
+// Check validates a custom alias under the policy. Readable aliases may not
+// use the digits 0 and 1, which are misread as the letters o and l; the
+// letters themselves stay allowed because vanity aliases are mostly words.
+func (p CodePolicy) Check(alias string) error {
+	if p == CodePolicyReadable && strings.ContainsAny(alias, "01") {
+		return ErrConfusable
+	}
+	return nil
+}
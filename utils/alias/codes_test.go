@@ -0,0 +1,41 @@
+package alias
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCodePolicy(t *testing.T) {
+	for name, want := range map[string]CodePolicy{"": CodePolicyMixed, "mixed": CodePolicyMixed, " Readable ": CodePolicyReadable} {
+		policy, err := ParseCodePolicy(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, want, policy, name)
+	}
+
+	_, err := ParseCodePolicy("lower")
+	assert.Error(t, err)
+}
+
+func TestReadableCodePolicy(t *testing.T) {
+	policy := CodePolicyReadable
+
+	assert.True(t, policy.CaseInsensitive())
+	assert.Equal(t, "spring-sale", policy.Canonical("Spring-Sale"))
+	assert.False(t, strings.ContainsAny(policy.Alphabet(), "01ilo"), "readable codes avoid look-alike characters")
+	assert.Equal(t, strings.ToLower(policy.Alphabet()), policy.Alphabet())
+
+	assert.NoError(t, policy.Check("hello"))
+	assert.ErrorIs(t, policy.Check("promo10"), ErrConfusable)
+}
+
+func TestMixedCodePolicy(t *testing.T) {
+	policy := CodePolicyMixed
+
+	assert.False(t, policy.CaseInsensitive())
+	assert.Equal(t, "AbC12", policy.Canonical("AbC12"))
+	assert.Len(t, policy.Alphabet(), 62)
+	assert.NoError(t, policy.Check("l1I0O"))
+}
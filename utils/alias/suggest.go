@@ -0,0 +1,118 @@
+package alias
+
+import (
+	"strconv"
+	"strings"
+)
+
+// suggestionWords are combined with a taken alias ("promo" -> "getpromo", "promo-now")
+var suggestionWords = []string{"go", "get", "now", "my", "hq", "try", "top", "new", "hub", "app", "info", "link"}
+
+// titleStopWords are dropped from page titles before building slugs
+var titleStopWords = map[string]struct{}{
+	"a": {}, "an": {}, "and": {}, "at": {}, "by": {}, "for": {}, "from": {}, "in": {},
+	"of": {}, "on": {}, "or": {}, "the": {}, "to": {}, "with": {}, "your": {},
+}
+
+// Candidates returns alternatives for a desired alias that fit the rules:
+// numbered suffixes, combinations with short dictionary words and slugs of the
+// destination page title (which may be empty). The three kinds are interleaved
+// so the first few candidates are varied. Candidates are not checked against a
+// policy's word lists or for availability.
+func Candidates(desired, title string, rules Rules) []string {
+	titleWords := slugWords(title)
+	base := strings.Join(slugWords(desired), separator(rules))
+	if base == "" && len(titleWords) > 0 {
+		base = titleWords[0]
+	}
+
+	var suffixes, combinations, slugs []string
+	if base != "" {
+		for n := 2; n <= 9; n++ {
+			suffixes = append(suffixes, join(rules, base, strconv.Itoa(n)))
+		}
+		for _, word := range suggestionWords {
+			combinations = append(combinations, join(rules, word, base), join(rules, base, word))
+		}
+	}
+	for n := len(titleWords); n > 0; n-- {
+		slugs = append(slugs, fit(rules, strings.Join(titleWords[:n], separator(rules))))
+	}
+	if len(titleWords) >= 3 {
+		var initials strings.Builder
+		for _, word := range titleWords {
+			initials.WriteByte(word[0])
+		}
+		slugs = append(slugs, fit(rules, initials.String()))
+	}
+
+	seen := map[string]struct{}{strings.ToLower(desired): {}}
+	var candidates []string
+	add := func(candidate string) {
+		if candidate == "" || !rules.check(candidate) {
+			return
+		}
+		if _, ok := seen[candidate]; ok {
+			return
+		}
+		seen[candidate] = struct{}{}
+		candidates = append(candidates, candidate)
+	}
+
+	// The desired alias itself, cleaned up to the rules ("Spring Sale!" -> "spring-sale")
+	add(fit(rules, base))
+	for i := 0; i < len(suffixes) || i < len(combinations) || i < len(slugs); i++ {
+		for _, group := range [][]string{slugs, suffixes, combinations} {
+			if i < len(group) {
+				add(group[i])
+			}
+		}
+	}
+	return candidates
+}
+
+// slugWords splits text into lower-case ASCII words, dropping title stop words
+func slugWords(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(c rune) bool {
+		return !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9')
+	})
+
+	kept := words[:0]
+	for _, word := range words {
+		if _, stop := titleStopWords[word]; !stop || len(words) == 1 {
+			kept = append(kept, word)
+		}
+	}
+	return kept
+}
+
+// separator returns the separator slugs are joined with under the rules
+func separator(rules Rules) string {
+	if strings.Contains(rules.Separators, "-") {
+		return "-"
+	}
+	return ""
+}
+
+// join combines two parts, shortening the longer one to stay within the rules
+func join(rules Rules, first, second string) string {
+	sep := separator(rules)
+	for len(first)+len(sep)+len(second) > rules.MaxLength {
+		if len(first) >= len(second) && len(first) > 1 {
+			first = first[:len(first)-1]
+		} else if len(second) > 1 {
+			second = second[:len(second)-1]
+		} else {
+			return ""
+		}
+	}
+	return fit(rules, strings.Trim(first, "-_")+sep+strings.Trim(second, "-_"))
+}
+
+// fit truncates a slug to the rules' maximum length without a dangling separator
+func fit(rules Rules, slug string) string {
+	if len(slug) > rules.MaxLength {
+		slug = slug[:rules.MaxLength]
+	}
+	return strings.Trim(slug, "-_")
+}
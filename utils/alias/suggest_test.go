@@ -0,0 +1,41 @@
+package alias
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCandidates(t *testing.T) {
+	rules := DefaultRules["pro"]
+	candidates := Candidates("promo", "The Spring Sale of Acme", rules)
+
+	require.NotEmpty(t, candidates)
+	assert.NotContains(t, candidates, "promo", "the desired alias is not a suggestion")
+	assert.Equal(t, []string{"spring-sale-acme", "promo-2", "go-promo"}, candidates[:3], "kinds are interleaved")
+	assert.Contains(t, candidates, "promo-now")
+	assert.Contains(t, candidates, "spring")
+	assert.Contains(t, candidates, "ssa")
+	for _, candidate := range candidates {
+		assert.True(t, rules.check(candidate), candidate)
+	}
+}
+
+func TestCandidatesFitRules(t *testing.T) {
+	rules := DefaultRules[DefaultPlan] // 3-10, no separators
+	candidates := Candidates("Summer Deals!", "", rules)
+
+	assert.Equal(t, "summerdeal", candidates[0], "the desired alias is cleaned up and truncated")
+	assert.Contains(t, candidates, "summerdea2")
+	for _, candidate := range candidates {
+		assert.True(t, rules.check(candidate), candidate)
+	}
+}
+
+func TestCandidatesFromTitleOnly(t *testing.T) {
+	candidates := Candidates("", "Quarterly Report", DefaultRules["pro"])
+	assert.Contains(t, candidates, "quarterly-report")
+	assert.Contains(t, candidates, "quarterly-2")
+	assert.Empty(t, Candidates("", "", DefaultRules["pro"]))
+}
@@ -0,0 +1,111 @@
+package alias
+
+import (
+	"strings"
+)
+
+// DefaultReserved are words kept free for the product itself: the gateway's
+// top-level routes plus paths we are likely to need later
+var DefaultReserved = []string{
+	"api", "docs", "swagger", "metrics", "health", "admin", "static", "assets",
+	"login", "logout", "signup", "register", "account", "settings", "dashboard",
+	"app", "www", "help", "support", "about", "status", "terms", "privacy",
+	"search", // GET /api/v1/urls/search shadows a link with this code
+}
+
+// offensiveSubstrings are blocked anywhere in an alias; offensiveWords only as
+// the whole alias because they hide inside harmless words (peacock, grape)
+var (
+	offensiveSubstrings = []string{
+		"fuck", "shit", "bitch", "cunt", "pussy", "whore", "slut", "bastard",
+		"asshole", "nigger", "nigga", "faggot", "retard", "wank", "twat", "porn", "nazi",
+	}
+	offensiveWords = []string{
+		"ass", "dick", "cock", "tit", "tits", "fag", "cum", "rape", "anal", "piss", "sex",
+	}
+)
+
+// leetspeak maps look-alike digits and symbols to the letters they stand for
+var leetspeak = strings.NewReplacer(
+	"0", "o", "1", "i", "3", "e", "4", "a", "5", "s", "7", "t", "8", "b", "9", "g",
+	"@", "a", "$", "s", "!", "i", "|", "l", "+", "t",
+)
+
+// Normalize folds an alias for word matching: lower case, leetspeak decoded,
+// separators dropped and repeated letters collapsed ("Sh1iiT" -> "shit")
+func Normalize(alias string) string {
+	folded := leetspeak.Replace(strings.ToLower(alias))
+
+	var b strings.Builder
+	var last rune
+	for _, c := range folded {
+		if c == '-' || c == '_' || c == last {
+			continue
+		}
+		b.WriteRune(c)
+		last = c
+	}
+	return b.String()
+}
+
+// offensive reports whether a normalized alias contains an offensive word
+func offensive(normalized string) bool {
+	for _, word := range offensiveSubstrings {
+		if strings.Contains(normalized, Normalize(word)) {
+			return true
+		}
+	}
+	for _, word := range offensiveWords {
+		if normalized == Normalize(word) {
+			return true
+		}
+	}
+	return false
+}
+
+// pattern is a normalized deny list entry
+type pattern struct {
+	word           string
+	prefix, suffix bool // match a prefix / suffix of the alias (both: anywhere)
+}
+
+func parsePattern(entry string) (pattern, bool) {
+	entry = strings.TrimSpace(entry)
+	pat := pattern{
+		prefix: strings.HasSuffix(entry, "*"),
+		suffix: strings.HasPrefix(entry, "*"),
+	}
+	pat.word = Normalize(strings.Trim(entry, "*"))
+	return pat, pat.word != ""
+}
+
+func (pat pattern) match(normalized string) bool {
+	switch {
+	case pat.prefix && pat.suffix:
+		return strings.Contains(normalized, pat.word)
+	case pat.prefix:
+		return strings.HasPrefix(normalized, pat.word)
+	case pat.suffix:
+		return strings.HasSuffix(normalized, pat.word)
+	default:
+		return normalized == pat.word
+	}
+}
+
+// RouteWords returns the literal first path segments of router paths, the
+// aliases a catch-all /:shortCode route would be shadowed by
+func RouteWords(paths []string) []string {
+	seen := make(map[string]struct{})
+	var words []string
+	for _, path := range paths {
+		segment := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 2)[0]
+		if segment == "" || strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+			continue
+		}
+		if _, ok := seen[segment]; !ok {
+			seen[segment] = struct{}{}
+			words = append(words, segment)
+		}
+	}
+	return words
+}
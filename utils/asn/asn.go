@@ -0,0 +1,162 @@
+// Package asn maps client addresses to the autonomous systems announcing
+// them, so clicks can be grouped by network and hosting providers recognized.
+// There is no full BGP table here: a built-in list of well-known hosting
+// networks can be extended with a file of "CIDR ASN organization" lines.
+package asn
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"net/netip"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Network is an address range announced by an autonomous system
+type Network struct {
+	Prefix netip.Prefix
+	ASN    uint32
+	Org    string
+}
+
+// defaultHostingNetworks are large ranges of cloud and hosting providers.
+// Clicks from them come from servers rather than people.
+var defaultHostingNetworks = []string{
+	"3.0.0.0/9 16509 Amazon",
+	"23.20.0.0/14 14618 Amazon",
+	"52.0.0.0/11 16509 Amazon",
+	"34.64.0.0/10 396982 Google Cloud",
+	"35.184.0.0/13 396982 Google Cloud",
+	"13.64.0.0/11 8075 Microsoft",
+	"40.64.0.0/10 8075 Microsoft",
+	"104.131.0.0/16 14061 DigitalOcean",
+	"138.68.0.0/16 14061 DigitalOcean",
+	"159.65.0.0/16 14061 DigitalOcean",
+	"167.99.0.0/16 14061 DigitalOcean",
+	"206.189.0.0/16 14061 DigitalOcean",
+	"65.21.0.0/16 24940 Hetzner",
+	"88.198.0.0/16 24940 Hetzner",
+	"95.216.0.0/16 24940 Hetzner",
+	"135.181.0.0/16 24940 Hetzner",
+	"51.68.0.0/16 16276 OVH",
+	"54.36.0.0/16 16276 OVH",
+	"145.239.0.0/16 16276 OVH",
+	"45.33.0.0/17 63949 Linode",
+	"139.162.0.0/16 63949 Linode",
+	"172.104.0.0/15 63949 Linode",
+	"45.32.0.0/16 20473 Vultr",
+	"45.76.0.0/16 20473 Vultr",
+	"108.61.0.0/16 20473 Vultr",
+}
+
+// Table finds the network an address belongs to, preferring the most
+// specific prefix
+type Table struct {
+	networks []Network
+}
+
+// NewTable creates a table of the given networks
+func NewTable(networks ...Network) *Table {
+	sorted := append([]Network(nil), networks...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Prefix.Bits() > sorted[j].Prefix.Bits()
+	})
+	return &Table{networks: sorted}
+}
+
+// DefaultHostingTable creates a table of the well-known hosting networks and
+// any extra ones
+func DefaultHostingTable(extra ...Network) *Table {
+	networks, err := ParseNetworks(defaultHostingNetworks)
+	if err != nil {
+		panic(err)
+	}
+	return NewTable(append(networks, extra...)...)
+}
+
+// Lookup returns the network of an address
+func (t *Table) Lookup(addr netip.Addr) (Network, bool) {
+	addr = addr.Unmap()
+	for _, network := range t.networks {
+		if network.Prefix.Contains(addr) {
+			return network, true
+		}
+	}
+	return Network{}, false
+}
+
+// Len returns the number of networks in the table
+func (t *Table) Len() int {
+	return len(t.networks)
+}
+
+// GroupKey names the network clicks from an address are grouped by: its
+// autonomous system when known, otherwise its /24 (IPv4) or /48 (IPv6)
+func (t *Table) GroupKey(addr netip.Addr) string {
+	addr = addr.Unmap()
+	if network, ok := t.Lookup(addr); ok {
+		return "AS" + strconv.FormatUint(uint64(network.ASN), 10)
+	}
+
+	bits := 48
+	if addr.Is4() {
+		bits = 24
+	}
+	prefix, err := addr.Prefix(bits)
+	if err != nil {
+		return addr.String()
+	}
+	return prefix.String()
+}
+
+// ParseNetworks parses "CIDR ASN organization" lines; the organization may
+// contain spaces and may be left out
+func ParseNetworks(lines []string) ([]Network, error) {
+	networks := make([]Network, 0, len(lines))
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("invalid network %q: want CIDR, ASN and organization", line)
+		}
+		prefix, err := netip.ParsePrefix(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid network %q: %w", line, err)
+		}
+		number, err := strconv.ParseUint(strings.TrimPrefix(strings.ToUpper(fields[1]), "AS"), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ASN in %q: %w", line, err)
+		}
+		networks = append(networks, Network{
+			Prefix: prefix.Masked(),
+			ASN:    uint32(number),
+			Org:    strings.Join(fields[2:], " "),
+		})
+	}
+	return networks, nil
+}
+
+// LoadFile reads networks from a file of "CIDR ASN organization" lines;
+// blank lines and lines starting with # are skipped
+func LoadFile(path string) ([]Network, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return ParseNetworks(lines)
+}
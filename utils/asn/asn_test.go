@@ -0,0 +1,58 @@
+package asn
+
+import (
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableLookup(t *testing.T) {
+	networks, err := ParseNetworks([]string{
+		"203.0.113.0/24 64500 Example Hosting",
+		"203.0.113.128/25 AS64501 Example Cloud",
+	})
+	require.NoError(t, err)
+	table := NewTable(networks...)
+
+	// The most specific prefix wins
+	network, ok := table.Lookup(netip.MustParseAddr("203.0.113.200"))
+	require.True(t, ok)
+	assert.Equal(t, uint32(64501), network.ASN)
+	assert.Equal(t, "Example Cloud", network.Org)
+
+	network, ok = table.Lookup(netip.MustParseAddr("::ffff:203.0.113.10"))
+	require.True(t, ok)
+	assert.Equal(t, uint32(64500), network.ASN)
+
+	_, ok = table.Lookup(netip.MustParseAddr("198.51.100.1"))
+	assert.False(t, ok)
+}
+
+func TestTableGroupKey(t *testing.T) {
+	table := DefaultHostingTable()
+
+	assert.Equal(t, "AS14061", table.GroupKey(netip.MustParseAddr("167.99.1.2")))
+	assert.Equal(t, "198.51.100.0/24", table.GroupKey(netip.MustParseAddr("198.51.100.77")))
+	assert.Equal(t, "2001:db8:1::/48", table.GroupKey(netip.MustParseAddr("2001:db8:1:2::1")))
+}
+
+func TestParseNetworks(t *testing.T) {
+	_, err := ParseNetworks([]string{"203.0.113.0/24"})
+	assert.Error(t, err)
+	_, err = ParseNetworks([]string{"203.0.113.0/33 64500"})
+	assert.Error(t, err)
+	_, err = ParseNetworks([]string{"203.0.113.0/24 ASX"})
+	assert.Error(t, err)
+
+	path := filepath.Join(t.TempDir(), "hosting.txt")
+	require.NoError(t, os.WriteFile(path, []byte("# extra hosting\n\n192.0.2.0/24 64502\n"), 0o600))
+	networks, err := LoadFile(path)
+	require.NoError(t, err)
+	require.Len(t, networks, 1)
+	assert.Equal(t, uint32(64502), networks[0].ASN)
+	assert.Empty(t, networks[0].Org)
+}
@@ -137,6 +137,12 @@ func (r *Redis) Exists(key string) (bool, error) {
 	return result > 0, nil
 }
 
+// AcquireLock takes a short-lived lock so only one replica runs a background job;
+// it reports false when another holder already has the lock
+func (r *Redis) AcquireLock(key string, ttl time.Duration) (bool, error) {
+	return r.Client.SetNX(r.ctx, CacheKey("lock", key), "1", ttl).Result()
+}
+
 // Increment increments a counter in Redis (for analytics)
 func (r *Redis) Increment(key string) (int64, error) {
 	return r.Client.Incr(r.ctx, key).Result()
@@ -233,9 +239,18 @@ func CacheKey(prefix, identifier string) string {
 	return fmt.Sprintf("urlshortener:%s:%s", prefix, identifier)
 }
 
+// LinkKey identifies a link across short domains: the bare short code on the
+// default domain ("") and domain/code on branded domains
+func LinkKey(domain, shortCode string) string {
+	if domain == "" {
+		return shortCode
+	}
+	return domain + "/" + shortCode
+}
+
 // URLCacheKey generates cache key for URL mappings
-func URLCacheKey(shortCode string) string {
-	return CacheKey("url", shortCode)
+func URLCacheKey(domain, shortCode string) string {
+	return CacheKey("url", LinkKey(domain, shortCode))
 }
 
 // AnalyticsCacheKey generates cache key for analytics data
@@ -247,3 +262,14 @@ func AnalyticsCacheKey(shortCode, metric string) string {
 func UserCacheKey(userID, dataType string) string {
 	return CacheKey("user", fmt.Sprintf("%s:%s", userID, dataType))
 }
+
+// RedirectCacheKey generates the cache key used by the redirect service for resolved URLs
+func RedirectCacheKey(domain, shortCode string) string {
+	return fmt.Sprintf("url:short:%s", LinkKey(domain, shortCode))
+}
+
+// BrandedDomainCacheKey generates the cache key the redirect service uses to
+// remember whether a request host is a registered branded domain
+func BrandedDomainCacheKey(domain string) string {
+	return CacheKey("branded_domain", domain)
+}
@@ -171,9 +171,12 @@ func (suite *RedisTestSuite) TestPrewarmCache() {
 
 func (suite *RedisTestSuite) TestCacheKeys() {
 	// Test cache key generation functions
-	urlKey := URLCacheKey("abc123")
+	urlKey := URLCacheKey("", "abc123")
 	assert.Equal(suite.T(), "urlshortener:url:abc123", urlKey)
 
+	brandedKey := URLCacheKey("go.acme.com", "abc123")
+	assert.Equal(suite.T(), "urlshortener:url:go.acme.com/abc123", brandedKey)
+
 	analyticsKey := AnalyticsCacheKey("abc123", "clicks")
 	assert.Equal(suite.T(), "urlshortener:analytics:abc123:clicks", analyticsKey)
 
@@ -0,0 +1,194 @@
+package database
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// GenesisAuditHash is the previous hash of the first audit event
+var GenesisAuditHash = strings.Repeat("0", 64)
+
+// AuditEvent is an entry of the append-only audit log. Before and After are
+// JSON documents owned by the service layer, empty when there is no state.
+type AuditEvent struct {
+	ID        int64     `db:"id"`
+	CreatedAt time.Time `db:"created_at"`
+	Actor     string    `db:"actor"`
+	IP        string    `db:"ip"`
+	UserAgent string    `db:"user_agent"`
+	RequestID string    `db:"request_id"`
+	Action    string    `db:"action"`
+	Target    string    `db:"target"`
+	Before    string    `db:"before"`
+	After     string    `db:"after"`
+	PrevHash  string    `db:"prev_hash"`
+	Hash      string    `db:"hash"`
+}
+
+// AuditFilter narrows a listing of audit events; empty fields match all
+type AuditFilter struct {
+	Actor     string
+	Action    string
+	Target    string
+	RequestID string
+	Since     time.Time
+	Until     time.Time
+}
+
+// auditEventColumns lists the columns selected into AuditEvent
+const auditEventColumns = `id, created_at, actor, ip, user_agent, request_id, action, target, before, after, prev_hash, hash`
+
+// AuditEventHash chains an event to the hash of the event before it. The
+// hash covers every field but the ID, in a fixed order.
+func AuditEventHash(prevHash string, event *AuditEvent) string {
+	data, _ := json.Marshal([]string{
+		prevHash,
+		event.CreatedAt.UTC().Format(time.RFC3339Nano),
+		event.Actor,
+		event.IP,
+		event.UserAgent,
+		event.RequestID,
+		event.Action,
+		event.Target,
+		event.Before,
+		event.After,
+	})
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// AppendAuditEvent adds an event at the end of the audit log, chained to the
+// last one. Appends are serialized so the chain cannot fork; ID, CreatedAt,
+// PrevHash and Hash are filled in.
+func (p *PostgreSQL) AppendAuditEvent(event *AuditEvent) error {
+	tx, err := p.Pool.Begin(p.ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(p.ctx)
+
+	if _, err := tx.Exec(p.ctx, `SELECT pg_advisory_xact_lock(hashtext('audit_events'))`); err != nil {
+		return err
+	}
+
+	prevHash := GenesisAuditHash
+	err = tx.QueryRow(p.ctx, `SELECT hash FROM audit_events ORDER BY id DESC LIMIT 1`).Scan(&prevHash)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	// Postgres keeps microseconds; truncate so the stored time hashes the same
+	event.CreatedAt = time.Now().UTC().Truncate(time.Microsecond)
+	event.PrevHash = prevHash
+	event.Hash = AuditEventHash(prevHash, event)
+
+	err = tx.QueryRow(p.ctx, `
+		INSERT INTO audit_events (created_at, actor, ip, user_agent, request_id, action, target, before, after, prev_hash, hash)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING id`,
+		event.CreatedAt, event.Actor, event.IP, event.UserAgent, event.RequestID,
+		event.Action, event.Target, event.Before, event.After, event.PrevHash, event.Hash,
+	).Scan(&event.ID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(p.ctx)
+}
+
+// ListAuditEvents lists audit events matching a filter, newest first
+func (p *PostgreSQL) ListAuditEvents(filter AuditFilter, cursor *Cursor, limit, offset int) ([]AuditEvent, error) {
+	where, args := auditConditions(filter)
+	condition, args, ascending, err := keyset("id", sortInt, cursor, false, args)
+	if err != nil {
+		return nil, err
+	}
+	if condition != "" {
+		where += " AND " + condition
+		offset = 0
+	}
+
+	args = append(args, limit, offset)
+	query := fmt.Sprintf(`
+		SELECT `+auditEventColumns+`
+		FROM audit_events
+		WHERE %s
+		ORDER BY id %s
+		LIMIT $%d OFFSET $%d`,
+		where, direction(ascending), len(args)-1, len(args))
+
+	var events []AuditEvent
+	if err := p.DB.Select(&events, query, args...); err != nil {
+		return nil, err
+	}
+	reversePage(events, cursor)
+	return events, nil
+}
+
+// CountAuditEvents counts the audit events matching a filter
+func (p *PostgreSQL) CountAuditEvents(filter AuditFilter) (int64, error) {
+	where, args := auditConditions(filter)
+
+	var count int64
+	err := p.DB.Get(&count, "SELECT COUNT(*) FROM audit_events WHERE "+where, args...)
+	return count, err
+}
+
+// GetAuditEventsAfter returns up to limit audit events with IDs above afterID
+// in chain order, for verifying the chain in batches
+func (p *PostgreSQL) GetAuditEventsAfter(afterID int64, limit int) ([]AuditEvent, error) {
+	query := `
+		SELECT ` + auditEventColumns + `
+		FROM audit_events
+		WHERE id > $1
+		ORDER BY id
+		LIMIT $2`
+
+	var events []AuditEvent
+	if err := p.DB.Select(&events, query, afterID, limit); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// AuditEventCursor returns the cursor of an audit event
+func AuditEventCursor(event *AuditEvent, backward bool) Cursor {
+	return Cursor{Value: formatSortValue(event.ID), ID: event.ID, Backward: backward}
+}
+
+// auditConditions builds the WHERE clause of a filter
+func auditConditions(filter AuditFilter) (string, []interface{}) {
+	conditions := []string{"TRUE"}
+	var args []interface{}
+	add := func(condition string, value interface{}) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.Actor != "" {
+		add("actor = $%d", filter.Actor)
+	}
+	if filter.Action != "" {
+		add("action = $%d", filter.Action)
+	}
+	if filter.Target != "" {
+		add("target = $%d", filter.Target)
+	}
+	if filter.RequestID != "" {
+		add("request_id = $%d", filter.RequestID)
+	}
+	if !filter.Since.IsZero() {
+		add("created_at >= $%d", filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		add("created_at < $%d", filter.Until)
+	}
+	return strings.Join(conditions, " AND "), args
+}
@@ -0,0 +1,107 @@
+package database
+
+import (
+	"time"
+)
+
+// BrandedDomain represents the branded_domains table structure
+type BrandedDomain struct {
+	Domain      string    `db:"domain" json:"domain"`
+	WorkspaceID string    `db:"workspace_id" json:"workspace_id"`
+	CreatedBy   string    `db:"created_by" json:"created_by"`
+	CodePolicy  string    `db:"code_policy" json:"code_policy"`
+	CreatedAt   time.Time `db:"created_at" json:"created_at"`
+}
+
+// CreateBrandedDomain attaches a short domain to a workspace. It reports false
+// when the domain is already registered (to this or another workspace).
+func (p *PostgreSQL) CreateBrandedDomain(domain *BrandedDomain) (bool, error) {
+	query := `
+		INSERT INTO branded_domains (domain, workspace_id, created_by, code_policy)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (domain) DO NOTHING
+		RETURNING created_at`
+
+	rows, err := p.Pool.Query(p.ctx, query, domain.Domain, domain.WorkspaceID, domain.CreatedBy, domain.CodePolicy)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return false, rows.Err()
+	}
+	return true, rows.Scan(&domain.CreatedAt)
+}
+
+// GetBrandedDomain retrieves a registered short domain
+func (p *PostgreSQL) GetBrandedDomain(domain string) (*BrandedDomain, error) {
+	var brandedDomain BrandedDomain
+	query := `
+		SELECT domain, workspace_id, created_by, code_policy, created_at
+		FROM branded_domains
+		WHERE domain = $1`
+
+	err := p.DB.Get(&brandedDomain, query, domain)
+	if err != nil {
+		return nil, err
+	}
+	return &brandedDomain, nil
+}
+
+// ListBrandedDomains lists the short domains attached to a workspace
+func (p *PostgreSQL) ListBrandedDomains(workspaceID string) ([]BrandedDomain, error) {
+	var domains []BrandedDomain
+	query := `
+		SELECT domain, workspace_id, created_by, code_policy, created_at
+		FROM branded_domains
+		WHERE workspace_id = $1
+		ORDER BY domain`
+
+	err := p.DB.Select(&domains, query, workspaceID)
+	return domains, err
+}
+
+// SetBrandedDomainCodePolicy changes the short code policy of a workspace's domain
+func (p *PostgreSQL) SetBrandedDomainCodePolicy(domain, workspaceID, codePolicy string) error {
+	_, err := p.Pool.Exec(p.ctx,
+		`UPDATE branded_domains SET code_policy = $3 WHERE domain = $1 AND workspace_id = $2`,
+		domain, workspaceID, codePolicy,
+	)
+	return err
+}
+
+// GetCaseConflicts lists the codes on a short domain that differ only in case
+// from another code, ordered so that case variants are adjacent
+func (p *PostgreSQL) GetCaseConflicts(domain string) ([]string, error) {
+	var shortCodes []string
+	query := `
+		SELECT short_code
+		FROM url_mappings
+		WHERE domain = $1 AND lower(short_code) IN (
+			SELECT lower(short_code)
+			FROM url_mappings
+			WHERE domain = $1
+			GROUP BY lower(short_code)
+			HAVING COUNT(*) > 1
+		)
+		ORDER BY lower(short_code), short_code`
+
+	err := p.DB.Select(&shortCodes, query, domain)
+	return shortCodes, err
+}
+
+// DeleteBrandedDomain detaches a short domain from a workspace
+func (p *PostgreSQL) DeleteBrandedDomain(domain, workspaceID string) error {
+	_, err := p.Pool.Exec(p.ctx, `DELETE FROM branded_domains WHERE domain = $1 AND workspace_id = $2`, domain, workspaceID)
+	return err
+}
+
+// CountActiveURLsOnDomain counts the active links created on a short domain
+func (p *PostgreSQL) CountActiveURLsOnDomain(domain string) (int64, error) {
+	var count int64
+	err := p.Pool.QueryRow(p.ctx,
+		`SELECT COUNT(*) FROM url_mappings WHERE domain = $1 AND is_active = true`, domain,
+	).Scan(&count)
+	return count, err
+}
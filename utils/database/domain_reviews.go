@@ -0,0 +1,82 @@
+package database
+
+import (
+	"fmt"
+	"time"
+)
+
+// DomainReview represents the domain_reviews table structure
+type DomainReview struct {
+	Domain    string    `db:"domain" json:"domain"`
+	Status    string    `db:"status" json:"status"` // review or trusted
+	Reason    string    `db:"reason" json:"reason"`
+	UpdatedBy string    `db:"updated_by" json:"updated_by"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// UpsertDomainReview adds a domain to the review list or changes its status
+func (p *PostgreSQL) UpsertDomainReview(review *DomainReview) error {
+	query := `
+		INSERT INTO domain_reviews (domain, status, reason, updated_by)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (domain) DO UPDATE
+		SET status = EXCLUDED.status, reason = EXCLUDED.reason,
+		    updated_by = EXCLUDED.updated_by, updated_at = NOW()
+		RETURNING created_at, updated_at`
+
+	return p.Pool.QueryRow(p.ctx, query,
+		review.Domain, review.Status, review.Reason, review.UpdatedBy,
+	).Scan(&review.CreatedAt, &review.UpdatedAt)
+}
+
+// DeleteDomainReview removes a domain from the review list
+func (p *PostgreSQL) DeleteDomainReview(domain string) error {
+	_, err := p.Pool.Exec(p.ctx, `DELETE FROM domain_reviews WHERE domain = $1`, domain)
+	return err
+}
+
+// ListDomainReviews lists reviewed domains, optionally filtered by status
+func (p *PostgreSQL) ListDomainReviews(status string) ([]DomainReview, error) {
+	var reviews []DomainReview
+	query := `
+		SELECT domain, status, COALESCE(reason, '') AS reason, COALESCE(updated_by, '') AS updated_by,
+		       created_at, updated_at
+		FROM domain_reviews
+		WHERE $1 = '' OR status = $1
+		ORDER BY domain`
+
+	err := p.DB.Select(&reviews, query, status)
+	return reviews, err
+}
+
+// GetShortCodesByDestinationDomain lists the active links pointing at a domain or its subdomains
+func (p *PostgreSQL) GetShortCodesByDestinationDomain(domain string) ([]LinkRef, error) {
+	var shortCodes []LinkRef
+	query := `
+		SELECT domain, short_code
+		FROM url_mappings
+		WHERE is_active = true
+		  AND (long_url ILIKE '%://' || $1 || '%' OR long_url ILIKE '%.' || $1 || '%')`
+
+	err := p.DB.Select(&shortCodes, query, domain)
+	return shortCodes, err
+}
+
+// SetInterstitialMode changes the interstitial mode of a URL mapping
+func (p *PostgreSQL) SetInterstitialMode(domain, shortCode, mode string) error {
+	query := `
+		UPDATE url_mappings
+		SET interstitial_mode = $3
+		WHERE domain = $1 AND short_code = $2 AND is_active = true`
+
+	result, err := p.Pool.Exec(p.ctx, query, domain, shortCode, mode)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("URL not found")
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+// ExpiringLink is a link picked by the expiry sweeper
+type ExpiringLink struct {
+	Domain      string    `db:"domain"`
+	ShortCode   string    `db:"short_code"`
+	UserID      string    `db:"user_id"`
+	WorkspaceID string    `db:"workspace_id"`
+	LongURL     string    `db:"long_url"`
+	ExpiresAt   time.Time `db:"expires_at"`
+}
+
+// expiringLinkColumns lists the url_mappings columns scanned into ExpiringLink
+const expiringLinkColumns = `domain, short_code, user_id, COALESCE(workspace_id, '') AS workspace_id, long_url, expires_at`
+
+// TryAdvisoryLock takes the Postgres advisory lock called name without waiting.
+// The lock belongs to a connection held until release is called, so it is
+// freed as well when the process dies. ok is false when another session holds it.
+func (p *PostgreSQL) TryAdvisoryLock(ctx context.Context, name string) (release func(), ok bool, err error) {
+	conn, err := p.Pool.Acquire(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := conn.QueryRow(ctx, "SELECT pg_try_advisory_lock(hashtext($1))", name).Scan(&ok); err != nil || !ok {
+		conn.Release()
+		return nil, false, err
+	}
+
+	release = func() {
+		if _, err := conn.Exec(context.Background(), "SELECT pg_advisory_unlock(hashtext($1))", name); err != nil {
+			// Closing the connection ends the session and with it the lock
+			conn.Conn().Close(context.Background())
+		}
+		conn.Release()
+	}
+	return release, true, nil
+}
+
+// MarkExpiredURLs marks up to limit active links whose expiration passed,
+// oldest expiration first, and returns them
+func (p *PostgreSQL) MarkExpiredURLs(limit int) ([]ExpiringLink, error) {
+	query := `
+		UPDATE url_mappings
+		SET expired_at = NOW()
+		WHERE id IN (
+			SELECT id FROM url_mappings
+			WHERE expires_at <= NOW() AND expired_at IS NULL AND is_active = true
+			ORDER BY expires_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED)
+		RETURNING ` + expiringLinkColumns
+
+	var links []ExpiringLink
+	err := p.DB.Select(&links, query, limit)
+	return links, err
+}
+
+// MarkExpiringURLs records the "expiring soon" notice of up to limit active
+// links expiring before the cutoff that were not notified yet, and returns them
+func (p *PostgreSQL) MarkExpiringURLs(expiresBefore time.Time, limit int) ([]ExpiringLink, error) {
+	query := `
+		UPDATE url_mappings
+		SET expiry_notified_at = NOW()
+		WHERE id IN (
+			SELECT id FROM url_mappings
+			WHERE expires_at > NOW() AND expires_at <= $1 AND expiry_notified_at IS NULL
+			  AND expired_at IS NULL AND is_active = true
+			ORDER BY expires_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED)
+		RETURNING ` + expiringLinkColumns
+
+	var links []ExpiringLink
+	err := p.DB.Select(&links, query, expiresBefore, limit)
+	return links, err
+}
@@ -0,0 +1,86 @@
+package database
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Folder represents the folders table structure; LinkCount is computed
+type Folder struct {
+	ID        int64         `db:"id" json:"id"`
+	UserID    string        `db:"user_id" json:"user_id"`
+	ParentID  sql.NullInt64 `db:"parent_id" json:"parent_id"` // NULL for top-level folders
+	Name      string        `db:"name" json:"name"`
+	CreatedAt time.Time     `db:"created_at" json:"created_at"`
+	LinkCount int64         `db:"link_count" json:"link_count"` // active links directly in the folder
+}
+
+// CreateFolder adds a folder for a user. It reports false when its parent
+// already has a folder with that name (names are compared case-insensitively).
+func (p *PostgreSQL) CreateFolder(folder *Folder) (bool, error) {
+	query := `
+		INSERT INTO folders (user_id, parent_id, name)
+		VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING
+		RETURNING id, created_at`
+
+	rows, err := p.Pool.Query(p.ctx, query, folder.UserID, nullInt64(folder.ParentID), folder.Name)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return false, rows.Err()
+	}
+	return true, rows.Scan(&folder.ID, &folder.CreatedAt)
+}
+
+// ListFolders lists all folders of a user with their link counts, ordered by name
+func (p *PostgreSQL) ListFolders(userID string) ([]Folder, error) {
+	var folders []Folder
+	query := `
+		SELECT f.id, f.user_id, f.parent_id, f.name, f.created_at, COUNT(m.id) AS link_count
+		FROM folders f
+		LEFT JOIN url_mappings m ON m.folder_id = f.id AND m.is_active = true
+		WHERE f.user_id = $1
+		GROUP BY f.id
+		ORDER BY lower(f.name), f.id`
+
+	err := p.DB.Select(&folders, query, userID)
+	return folders, err
+}
+
+// UpdateFolder renames or moves a user's folder. It reports false when the
+// new parent already has another folder with that name.
+func (p *PostgreSQL) UpdateFolder(folder *Folder) (bool, error) {
+	query := `
+		UPDATE folders SET name = $3, parent_id = $4
+		WHERE id = $1 AND user_id = $2
+		  AND NOT EXISTS (
+			SELECT 1 FROM folders
+			WHERE user_id = $2 AND COALESCE(parent_id, 0) = COALESCE($4, 0)
+			  AND lower(name) = lower($3) AND id <> $1
+		  )`
+
+	result, err := p.Pool.Exec(p.ctx, query, folder.ID, folder.UserID, folder.Name, nullInt64(folder.ParentID))
+	if err != nil {
+		return false, err
+	}
+	return result.RowsAffected() > 0, nil
+}
+
+// DeleteFolder deletes a user's folder and its subfolders; their links become unfiled
+func (p *PostgreSQL) DeleteFolder(folderID int64, userID string) error {
+	_, err := p.Pool.Exec(p.ctx, `DELETE FROM folders WHERE id = $1 AND user_id = $2`, folderID, userID)
+	return err
+}
+
+// GetFolderLinks lists the links filed in any of the given folders
+func (p *PostgreSQL) GetFolderLinks(folderIDs []int64) ([]LinkRef, error) {
+	var links []LinkRef
+	query := `SELECT domain, short_code FROM url_mappings WHERE folder_id = ANY($1)`
+
+	err := p.DB.Select(&links, query, folderIDs)
+	return links, err
+}
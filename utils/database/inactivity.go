@@ -0,0 +1,122 @@
+package database
+
+import (
+	"fmt"
+)
+
+// ArchivedLink is a link archived by ArchiveInactiveURLs
+type ArchivedLink struct {
+	Domain      string `db:"domain"`
+	ShortCode   string `db:"short_code"`
+	WorkspaceID string `db:"workspace_id"`
+}
+
+// InactivityCount is the number of links a workspace's inactivity policy applies to
+type InactivityCount struct {
+	WorkspaceID string `db:"workspace_id"`
+	Count       int64  `db:"count"`
+}
+
+// inactiveCondition matches the active, non-exempt links of a workspace that
+// were not clicked, created or revived in the last %s days
+const inactiveCondition = `url_mappings.is_active = true AND url_mappings.inactivity_exempt = false
+		AND ` + lastUsedColumn + ` < NOW() - make_interval(days => %s)`
+
+// lastUsedColumn is when a link was last clicked, created or revived
+const lastUsedColumn = `GREATEST(url_mappings.created_at, url_mappings.last_accessed, url_mappings.revived_at)`
+
+// SetInactivityPolicy changes the inactivity policy of a workspace
+func (p *PostgreSQL) SetInactivityPolicy(id string, days int, dryRun bool) (*Workspace, error) {
+	var ws Workspace
+	query := `
+		UPDATE workspaces
+		SET inactivity_days = $2, inactivity_dry_run = $3, updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + workspaceColumns
+
+	if err := p.DB.Get(&ws, query, id, days, dryRun); err != nil {
+		return nil, err
+	}
+	return &ws, nil
+}
+
+// ListInactiveURLs lists up to limit links of a workspace that an inactivity
+// policy of days would archive, least recently used first
+func (p *PostgreSQL) ListInactiveURLs(workspaceID string, days, limit int) ([]URLMapping, error) {
+	query := fmt.Sprintf(`
+		SELECT `+urlMappingColumns+`
+		FROM url_mappings
+		WHERE workspace_id = $1 AND `+inactiveCondition+`
+		ORDER BY `+lastUsedColumn+`, id
+		LIMIT $3`, "$2")
+
+	var urls []URLMapping
+	if err := p.DB.Select(&urls, query, workspaceID, days, limit); err != nil {
+		return nil, err
+	}
+	return urls, nil
+}
+
+// CountInactiveURLs counts the links of a workspace that an inactivity policy
+// of days would archive
+func (p *PostgreSQL) CountInactiveURLs(workspaceID string, days int) (int64, error) {
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM url_mappings WHERE workspace_id = $1 AND `+inactiveCondition, "$2")
+
+	var count int64
+	err := p.DB.Get(&count, query, workspaceID, days)
+	return count, err
+}
+
+// CountInactiveURLsInDryRun counts, per workspace with a policy in dry run, the
+// links the policy would archive
+func (p *PostgreSQL) CountInactiveURLsInDryRun() ([]InactivityCount, error) {
+	query := fmt.Sprintf(`
+		SELECT w.id AS workspace_id, COUNT(*) AS count
+		FROM url_mappings JOIN workspaces w ON w.id = url_mappings.workspace_id
+		WHERE w.inactivity_days > 0 AND w.inactivity_dry_run = true AND `+inactiveCondition+`
+		GROUP BY w.id
+		ORDER BY w.id`, "w.inactivity_days")
+
+	var counts []InactivityCount
+	err := p.DB.Select(&counts, query)
+	return counts, err
+}
+
+// ArchiveInactiveURLs archives up to limit links that the inactivity policy of
+// their workspace applies to, skipping workspaces in dry run
+func (p *PostgreSQL) ArchiveInactiveURLs(limit int) ([]ArchivedLink, error) {
+	query := fmt.Sprintf(`
+		UPDATE url_mappings
+		SET is_active = false, archived_at = NOW()
+		WHERE id IN (
+			SELECT url_mappings.id
+			FROM url_mappings JOIN workspaces w ON w.id = url_mappings.workspace_id
+			WHERE w.inactivity_days > 0 AND w.inactivity_dry_run = false AND `+inactiveCondition+`
+			ORDER BY url_mappings.id
+			LIMIT $1
+			FOR UPDATE OF url_mappings SKIP LOCKED)
+		RETURNING domain, short_code, workspace_id`, "w.inactivity_days")
+
+	var links []ArchivedLink
+	err := p.DB.Select(&links, query, limit)
+	return links, err
+}
+
+// ReviveURL reactivates an archived link and restarts its inactivity clock
+func (p *PostgreSQL) ReviveURL(domain, shortCode, userID string) error {
+	query := `
+		UPDATE url_mappings
+		SET is_active = true, archived_at = NULL, revived_at = NOW()
+		WHERE domain = $1 AND short_code = $2 AND user_id = $3
+		  AND archived_at IS NOT NULL AND deleted_at IS NULL AND disabled_at IS NULL`
+
+	result, err := p.Pool.Exec(p.ctx, query, domain, shortCode, userID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("URL not found or permission denied")
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package database
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Link health statuses
+const (
+	LinkHealthHealthy = "healthy"
+	LinkHealthFailing = "failing" // failed recently, below the broken threshold
+	LinkHealthBroken  = "broken"
+)
+
+// LinkHealth represents the link_health table structure
+type LinkHealth struct {
+	Domain              string         `db:"domain" json:"domain"`
+	ShortCode           string         `db:"short_code" json:"short_code"`
+	Status              string         `db:"status" json:"status"`
+	StatusCode          int            `db:"status_code" json:"status_code"`
+	LatencyMs           int64          `db:"latency_ms" json:"latency_ms"`
+	RedirectChain       string         `db:"redirect_chain" json:"redirect_chain"` // PostgreSQL JSONB
+	Error               sql.NullString `db:"error" json:"error"`
+	ConsecutiveFailures int            `db:"consecutive_failures" json:"consecutive_failures"`
+	CheckedAt           time.Time      `db:"checked_at" json:"checked_at"`
+	LastHealthyAt       sql.NullTime   `db:"last_healthy_at" json:"last_healthy_at"`
+}
+
+// RecordLinkHealth stores the result of a check. Failures increment the
+// consecutive failure count and mark the link broken once it reaches
+// failureThreshold; a healthy check resets it. Status and
+// ConsecutiveFailures are filled in from the stored row.
+func (p *PostgreSQL) RecordLinkHealth(health *LinkHealth, healthy bool, failureThreshold int) error {
+	query := `
+		INSERT INTO link_health (domain, short_code, status, status_code, latency_ms, redirect_chain, error,
+		                         consecutive_failures, checked_at, last_healthy_at)
+		VALUES ($9, $1,
+		        CASE WHEN $7 THEN 'healthy' WHEN $8 <= 1 THEN 'broken' ELSE 'failing' END,
+		        $2, $3, $4, $5,
+		        CASE WHEN $7 THEN 0 ELSE 1 END,
+		        $6,
+		        CASE WHEN $7 THEN $6::timestamptz END)
+		ON CONFLICT (domain, short_code) DO UPDATE
+		SET status = CASE
+		        WHEN $7 THEN 'healthy'
+		        WHEN link_health.consecutive_failures + 1 >= $8 THEN 'broken'
+		        ELSE 'failing' END,
+		    status_code = EXCLUDED.status_code,
+		    latency_ms = EXCLUDED.latency_ms,
+		    redirect_chain = EXCLUDED.redirect_chain,
+		    error = EXCLUDED.error,
+		    consecutive_failures = CASE WHEN $7 THEN 0 ELSE link_health.consecutive_failures + 1 END,
+		    checked_at = EXCLUDED.checked_at,
+		    last_healthy_at = COALESCE(EXCLUDED.last_healthy_at, link_health.last_healthy_at)
+		RETURNING status, consecutive_failures, last_healthy_at`
+
+	return p.Pool.QueryRow(p.ctx, query,
+		health.ShortCode, health.StatusCode, health.LatencyMs, jsonOrEmptyArray(health.RedirectChain),
+		nullString(health.Error), health.CheckedAt, healthy, failureThreshold, health.Domain,
+	).Scan(&health.Status, &health.ConsecutiveFailures, &health.LastHealthyAt)
+}
+
+// GetLinkHealth retrieves the latest check result for a link
+func (p *PostgreSQL) GetLinkHealth(domain, shortCode string) (*LinkHealth, error) {
+	var health LinkHealth
+	query := `
+		SELECT domain, short_code, status, status_code, latency_ms, redirect_chain, error,
+		       consecutive_failures, checked_at, last_healthy_at
+		FROM link_health
+		WHERE domain = $1 AND short_code = $2`
+
+	err := p.DB.Get(&health, query, domain, shortCode)
+	if err != nil {
+		return nil, err
+	}
+	return &health, nil
+}
+
+// jsonOrEmptyArray defaults an empty JSONB array column to '[]'
+func jsonOrEmptyArray(value string) string {
+	if value == "" {
+		return "[]"
+	}
+	return value
+}
@@ -0,0 +1,99 @@
+package database
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Cursor positions a keyset page next to a row: the row's sort value and id.
+// Rows are ordered by (sort value, id), so pages stay stable while rows are
+// added or removed and cost the same however deep they are.
+type Cursor struct {
+	Value    string // sort value of the row in text form
+	ID       int64
+	Backward bool // the page before the row instead of after it
+}
+
+// sortKind is the type of a sort value
+type sortKind int
+
+const (
+	sortTime sortKind = iota
+	sortInt
+	sortText
+	sortFloat
+)
+
+// Sentinels standing in for missing timestamps, so they sort last either way
+var (
+	lowestTime  = time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	highestTime = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+)
+
+// formatSortValue renders a sort value for a cursor
+func formatSortValue(value interface{}) string {
+	switch v := value.(type) {
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// parseSortValue reads a cursor's sort value back
+func parseSortValue(kind sortKind, value string) (interface{}, error) {
+	switch kind {
+	case sortTime:
+		return time.Parse(time.RFC3339Nano, value)
+	case sortInt:
+		return strconv.ParseInt(value, 10, 64)
+	case sortFloat:
+		return strconv.ParseFloat(value, 64)
+	default:
+		return value, nil
+	}
+}
+
+// keyset adds the condition selecting the rows past a cursor in an ordering on
+// (expr, id) to args and returns it with the direction to scan in: a backward
+// page is read in reverse from the cursor and flipped by reversePage.
+func keyset(expr string, kind sortKind, cursor *Cursor, ascending bool, args []interface{}) (string, []interface{}, bool, error) {
+	if cursor == nil {
+		return "", args, ascending, nil
+	}
+	value, err := parseSortValue(kind, cursor.Value)
+	if err != nil {
+		return "", args, ascending, fmt.Errorf("invalid cursor value: %w", err)
+	}
+
+	scanAscending := ascending != cursor.Backward
+	operator := "<"
+	if scanAscending {
+		operator = ">"
+	}
+	args = append(args, value, cursor.ID)
+	return fmt.Sprintf("(%s, id) %s ($%d, $%d)", expr, operator, len(args)-1, len(args)), args, scanAscending, nil
+}
+
+// direction returns the SQL keyword of a sort direction
+func direction(ascending bool) string {
+	if ascending {
+		return "ASC"
+	}
+	return "DESC"
+}
+
+// reversePage restores the display order of a page read backward
+func reversePage[T any](rows []T, cursor *Cursor) {
+	if cursor == nil || !cursor.Backward {
+		return
+	}
+	for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+		rows[i], rows[j] = rows[j], rows[i]
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -22,18 +23,52 @@ type PostgreSQL struct {
 
 // URLMapping represents the URL table structure from HLD
 type URLMapping struct {
-	ID           int64        `db:"id" json:"id"`
-	ShortCode    string       `db:"short_code" json:"short_code"`
-	LongURL      string       `db:"long_url" json:"long_url"`
-	UserID       string       `db:"user_id" json:"user_id"`
-	CreatedAt    time.Time    `db:"created_at" json:"created_at"`
-	ExpiresAt    sql.NullTime `db:"expires_at" json:"expires_at"`
-	ClickCount   int64        `db:"click_count" json:"click_count"`
-	LastAccessed sql.NullTime `db:"last_accessed" json:"last_accessed"`
-	IsActive     bool         `db:"is_active" json:"is_active"`
-	Metadata     string       `db:"metadata" json:"metadata"` // PostgreSQL JSONB
+	ID               int64          `db:"id" json:"id"`
+	Domain           string         `db:"domain" json:"domain"` // branded short domain, empty for the default domain
+	ShortCode        string         `db:"short_code" json:"short_code"`
+	LongURL          string         `db:"long_url" json:"long_url"`
+	UserID           string         `db:"user_id" json:"user_id"`
+	CreatedAt        time.Time      `db:"created_at" json:"created_at"`
+	ExpiresAt        sql.NullTime   `db:"expires_at" json:"expires_at"`
+	ClickCount       int64          `db:"click_count" json:"click_count"`
+	LastAccessed     sql.NullTime   `db:"last_accessed" json:"last_accessed"`
+	IsActive         bool           `db:"is_active" json:"is_active"`
+	Metadata         string         `db:"metadata" json:"metadata"` // PostgreSQL JSONB
+	WorkspaceID      sql.NullString `db:"workspace_id" json:"workspace_id"`
+	UTMTemplate      string         `db:"utm_template" json:"utm_template"` // PostgreSQL JSONB
+	PasswordHash     sql.NullString `db:"password_hash" json:"-"`
+	ActivatesAt      sql.NullTime   `db:"activates_at" json:"activates_at"`
+	FallbackURL      sql.NullString `db:"fallback_url" json:"fallback_url"`
+	MaxClicks        sql.NullInt64  `db:"max_clicks" json:"max_clicks"`
+	InterstitialMode string         `db:"interstitial_mode" json:"interstitial_mode"` // auto, always or never
+	DisabledReason   sql.NullString `db:"disabled_reason" json:"disabled_reason"`     // safety reason code
+	DisabledAt       sql.NullTime   `db:"disabled_at" json:"disabled_at"`
+	LinkPreview      string         `db:"link_preview" json:"link_preview"`         // PostgreSQL JSONB, scraped from the destination
+	PreviewOverride  string         `db:"preview_override" json:"preview_override"` // PostgreSQL JSONB, set by the owner
+	FolderID         sql.NullInt64  `db:"folder_id" json:"folder_id"`
+	Tags             string         `db:"tags" json:"tags"`                           // JSON array of tag names, read-only (see SetURLTags)
+	DeletedAt        sql.NullTime   `db:"deleted_at" json:"deleted_at"`               // set while the link is in the trash
+	InactivityExempt bool           `db:"inactivity_exempt" json:"inactivity_exempt"` // never archived by the workspace's inactivity policy
+	ArchivedAt       sql.NullTime   `db:"archived_at" json:"archived_at"`             // set while the link is archived for inactivity
 }
 
+// LinkRef identifies a link; short codes are unique per short domain
+type LinkRef struct {
+	Domain    string `db:"domain" json:"domain"`
+	ShortCode string `db:"short_code" json:"short_code"`
+}
+
+// urlMappingColumns lists the url_mappings columns scanned into URLMapping
+const urlMappingColumns = `id, domain, short_code, long_url, user_id, created_at, expires_at,
+		       click_count, last_accessed, is_active, metadata, workspace_id, utm_template,
+		       password_hash, activates_at, fallback_url, max_clicks, interstitial_mode,
+		       disabled_reason, disabled_at, link_preview, preview_override, folder_id,
+		       deleted_at, inactivity_exempt, archived_at, ` + urlTagsColumn
+
+// urlTagsColumn aggregates the tag names of a url_mappings row into a JSON array
+const urlTagsColumn = `COALESCE((SELECT json_agg(t.name ORDER BY t.name) FROM url_tags ut JOIN tags t ON t.id = ut.tag_id
+		                WHERE ut.url_id = url_mappings.id), '[]')::text AS tags`
+
 // ClickEvent represents the analytics table structure
 type ClickEvent struct {
 	ID          int64     `db:"id" json:"id"`
@@ -103,11 +138,59 @@ func NewPostgreSQL() *PostgreSQL {
 func (p *PostgreSQL) CreateTables() error {
 	log.Println("🔧 Creating database tables...")
 
-	// Create URL mappings table
+	// Create workspaces table (referenced by url_mappings)
+	workspacesSQL := `
+	CREATE TABLE IF NOT EXISTS workspaces (
+		id VARCHAR(50) PRIMARY KEY,
+		name VARCHAR(255) NOT NULL,
+		owner_id VARCHAR(50) NOT NULL,
+		utm_template JSONB DEFAULT '{}'::jsonb,
+		plan VARCHAR(32) NOT NULL DEFAULT 'free',
+		inactivity_days INTEGER NOT NULL DEFAULT 0,
+		inactivity_dry_run BOOLEAN NOT NULL DEFAULT false,
+		created_at TIMESTAMPTZ DEFAULT NOW(),
+		updated_at TIMESTAMPTZ DEFAULT NOW()
+	);`
+
+	if _, err := p.Pool.Exec(p.ctx, workspacesSQL); err != nil {
+		return fmt.Errorf("failed to create workspaces table: %v", err)
+	}
+
+	// Create branded short domains (attached to workspaces)
+	brandedDomainsSQL := `
+	CREATE TABLE IF NOT EXISTS branded_domains (
+		domain VARCHAR(253) PRIMARY KEY,
+		workspace_id VARCHAR(50) NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+		created_by VARCHAR(50) NOT NULL,
+		code_policy VARCHAR(16) NOT NULL DEFAULT 'mixed',
+		created_at TIMESTAMPTZ DEFAULT NOW()
+	);`
+
+	if _, err := p.Pool.Exec(p.ctx, brandedDomainsSQL); err != nil {
+		return fmt.Errorf("failed to create branded_domains table: %v", err)
+	}
+
+	// Create folders (nested, referenced by url_mappings); names are unique among siblings
+	foldersSQL := `
+	CREATE TABLE IF NOT EXISTS folders (
+		id BIGSERIAL PRIMARY KEY,
+		user_id VARCHAR(50) NOT NULL,
+		parent_id BIGINT REFERENCES folders(id) ON DELETE CASCADE,
+		name VARCHAR(100) NOT NULL,
+		created_at TIMESTAMPTZ DEFAULT NOW()
+	);
+	CREATE UNIQUE INDEX IF NOT EXISTS idx_folders_user_parent_name ON folders(user_id, COALESCE(parent_id, 0), lower(name));`
+
+	if _, err := p.Pool.Exec(p.ctx, foldersSQL); err != nil {
+		return fmt.Errorf("failed to create folders table: %v", err)
+	}
+
+	// Create URL mappings table; short codes are unique per short domain
 	urlMappingsSQL := `
 	CREATE TABLE IF NOT EXISTS url_mappings (
 		id BIGSERIAL PRIMARY KEY,
-		short_code VARCHAR(10) UNIQUE NOT NULL,
+		domain VARCHAR(253) NOT NULL DEFAULT '',
+		short_code VARCHAR(64) NOT NULL,
 		long_url TEXT NOT NULL,
 		user_id VARCHAR(50),
 		created_at TIMESTAMPTZ DEFAULT NOW(),
@@ -115,13 +198,201 @@ func (p *PostgreSQL) CreateTables() error {
 		click_count BIGINT DEFAULT 0,
 		last_accessed TIMESTAMPTZ,
 		is_active BOOLEAN DEFAULT true,
-		metadata JSONB DEFAULT '{}'::jsonb
+		metadata JSONB DEFAULT '{}'::jsonb,
+		workspace_id VARCHAR(50) REFERENCES workspaces(id) ON DELETE SET NULL,
+		utm_template JSONB DEFAULT '{}'::jsonb,
+		password_hash VARCHAR(255),
+		activates_at TIMESTAMPTZ,
+		fallback_url TEXT,
+		max_clicks BIGINT CHECK (max_clicks > 0),
+		interstitial_mode VARCHAR(10) NOT NULL DEFAULT 'auto',
+		disabled_reason VARCHAR(50),
+		disabled_at TIMESTAMPTZ,
+		link_preview JSONB NOT NULL DEFAULT '{}'::jsonb,
+		preview_override JSONB NOT NULL DEFAULT '{}'::jsonb,
+		folder_id BIGINT REFERENCES folders(id) ON DELETE SET NULL,
+		search_vector tsvector GENERATED ALWAYS AS (
+			setweight(to_tsvector('simple', short_code), 'A') ||
+			setweight(to_tsvector('simple', COALESCE(NULLIF(preview_override->>'title', ''), link_preview->>'title', '')), 'A') ||
+			setweight(to_tsvector('simple', regexp_replace(
+				regexp_replace(long_url, '^[a-zA-Z][a-zA-Z0-9+.-]*://|[?#].*$', '', 'g'),
+				'[^[:alnum:]]+', ' ', 'g')), 'B') ||
+			setweight(jsonb_to_tsvector('simple', COALESCE(metadata, '{}'::jsonb), '["string"]'), 'C')
+		) STORED,
+		deleted_at TIMESTAMPTZ,
+		expired_at TIMESTAMPTZ,
+		expiry_notified_at TIMESTAMPTZ,
+		inactivity_exempt BOOLEAN NOT NULL DEFAULT false,
+		archived_at TIMESTAMPTZ,
+		revived_at TIMESTAMPTZ,
+		UNIQUE (domain, short_code)
 	);`
 
 	if _, err := p.Pool.Exec(p.ctx, urlMappingsSQL); err != nil {
 		return fmt.Errorf("failed to create url_mappings table: %v", err)
 	}
 
+	// Create tags (many-to-many with url_mappings)
+	tagsSQL := `
+	CREATE TABLE IF NOT EXISTS tags (
+		id BIGSERIAL PRIMARY KEY,
+		user_id VARCHAR(50) NOT NULL,
+		name VARCHAR(50) NOT NULL,
+		created_at TIMESTAMPTZ DEFAULT NOW(),
+		UNIQUE (user_id, name)
+	);
+	CREATE TABLE IF NOT EXISTS url_tags (
+		url_id BIGINT NOT NULL REFERENCES url_mappings(id) ON DELETE CASCADE,
+		tag_id BIGINT NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+		PRIMARY KEY (url_id, tag_id)
+	);`
+
+	if _, err := p.Pool.Exec(p.ctx, tagsSQL); err != nil {
+		return fmt.Errorf("failed to create tags tables: %v", err)
+	}
+
+	// Create retired short codes (purged from the trash, not reusable yet)
+	retiredShortCodesSQL := `
+	CREATE TABLE IF NOT EXISTS retired_short_codes (
+		domain VARCHAR(253) NOT NULL DEFAULT '',
+		short_code VARCHAR(64) NOT NULL,
+		retired_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+		reclaimable_at TIMESTAMPTZ, -- NULL: never reused
+		PRIMARY KEY (domain, short_code)
+	);`
+
+	if _, err := p.Pool.Exec(p.ctx, retiredShortCodesSQL); err != nil {
+		return fmt.Errorf("failed to create retired_short_codes table: %v", err)
+	}
+
+	// Create link revision history (immutable, one row per change)
+	urlRevisionsSQL := `
+	CREATE TABLE IF NOT EXISTS url_revisions (
+		id BIGSERIAL PRIMARY KEY,
+		url_id BIGINT NOT NULL REFERENCES url_mappings(id) ON DELETE CASCADE,
+		revision INTEGER NOT NULL,
+		changed_by VARCHAR(255) NOT NULL DEFAULT '',
+		created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+		changes JSONB NOT NULL DEFAULT '[]'::jsonb,
+		state_before JSONB NOT NULL,
+		state_after JSONB NOT NULL,
+		rollback_of BIGINT,
+		UNIQUE (url_id, revision)
+	);
+	CREATE OR REPLACE FUNCTION reject_url_revision_update() RETURNS trigger AS $$
+	BEGIN
+		RAISE EXCEPTION 'url revisions are immutable';
+	END;
+	$$ LANGUAGE plpgsql;
+	DROP TRIGGER IF EXISTS url_revisions_immutable ON url_revisions;
+	CREATE TRIGGER url_revisions_immutable BEFORE UPDATE ON url_revisions
+		FOR EACH ROW EXECUTE FUNCTION reject_url_revision_update();`
+
+	if _, err := p.Pool.Exec(p.ctx, urlRevisionsSQL); err != nil {
+		return fmt.Errorf("failed to create url_revisions table: %v", err)
+	}
+
+	// Create audit log (append-only, hash-chained)
+	auditEventsSQL := `
+	CREATE TABLE IF NOT EXISTS audit_events (
+		id BIGSERIAL PRIMARY KEY,
+		created_at TIMESTAMPTZ NOT NULL,
+		actor VARCHAR(255) NOT NULL,
+		ip VARCHAR(64) NOT NULL DEFAULT '',
+		user_agent TEXT NOT NULL DEFAULT '',
+		request_id VARCHAR(128) NOT NULL DEFAULT '',
+		action VARCHAR(64) NOT NULL,
+		target VARCHAR(512) NOT NULL,
+		before TEXT NOT NULL DEFAULT '',
+		after TEXT NOT NULL DEFAULT '',
+		prev_hash CHAR(64) NOT NULL,
+		hash CHAR(64) NOT NULL
+	);
+	CREATE OR REPLACE FUNCTION reject_audit_event_change() RETURNS trigger AS $$
+	BEGIN
+		RAISE EXCEPTION 'audit events are append-only';
+	END;
+	$$ LANGUAGE plpgsql;
+	DROP TRIGGER IF EXISTS audit_events_append_only ON audit_events;
+	CREATE TRIGGER audit_events_append_only BEFORE UPDATE OR DELETE ON audit_events
+		FOR EACH ROW EXECUTE FUNCTION reject_audit_event_change();`
+
+	if _, err := p.Pool.Exec(p.ctx, auditEventsSQL); err != nil {
+		return fmt.Errorf("failed to create audit_events table: %v", err)
+	}
+
+	// Create webhooks and their delivery queue
+	webhooksSQL := `
+	CREATE TABLE IF NOT EXISTS webhooks (
+		id BIGSERIAL PRIMARY KEY,
+		workspace_id VARCHAR(50) NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+		url TEXT NOT NULL,
+		secret VARCHAR(128) NOT NULL,
+		events JSONB NOT NULL DEFAULT '[]',
+		is_active BOOLEAN NOT NULL DEFAULT true,
+		consecutive_failures INT NOT NULL DEFAULT 0,
+		disabled_at TIMESTAMPTZ,
+		created_by VARCHAR(50) NOT NULL,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+		updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+	);
+	CREATE TABLE IF NOT EXISTS webhook_deliveries (
+		id BIGSERIAL PRIMARY KEY,
+		webhook_id BIGINT NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+		event_id VARCHAR(64) NOT NULL,
+		event_type VARCHAR(64) NOT NULL,
+		payload TEXT NOT NULL,
+		status VARCHAR(16) NOT NULL DEFAULT 'pending',
+		attempts INT NOT NULL DEFAULT 0,
+		next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+		last_attempt_at TIMESTAMPTZ,
+		response_status INT NOT NULL DEFAULT 0,
+		response_body TEXT NOT NULL DEFAULT '',
+		error TEXT NOT NULL DEFAULT '',
+		created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+		delivered_at TIMESTAMPTZ
+	);`
+
+	if _, err := p.Pool.Exec(p.ctx, webhooksSQL); err != nil {
+		return fmt.Errorf("failed to create webhooks tables: %v", err)
+	}
+
+	// Create domain review list (interstitial warnings)
+	domainReviewsSQL := `
+	CREATE TABLE IF NOT EXISTS domain_reviews (
+		domain VARCHAR(255) PRIMARY KEY,
+		status VARCHAR(10) NOT NULL,
+		reason TEXT,
+		updated_by VARCHAR(50),
+		created_at TIMESTAMPTZ DEFAULT NOW(),
+		updated_at TIMESTAMPTZ DEFAULT NOW()
+	);`
+
+	if _, err := p.Pool.Exec(p.ctx, domainReviewsSQL); err != nil {
+		return fmt.Errorf("failed to create domain_reviews table: %v", err)
+	}
+
+	// Create link health table (background destination checks)
+	linkHealthSQL := `
+	CREATE TABLE IF NOT EXISTS link_health (
+		domain VARCHAR(253) NOT NULL DEFAULT '',
+		short_code VARCHAR(64) NOT NULL,
+		status VARCHAR(10) NOT NULL,
+		status_code INTEGER NOT NULL DEFAULT 0,
+		latency_ms BIGINT NOT NULL DEFAULT 0,
+		redirect_chain JSONB NOT NULL DEFAULT '[]'::jsonb,
+		error TEXT,
+		consecutive_failures INTEGER NOT NULL DEFAULT 0,
+		checked_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+		last_healthy_at TIMESTAMPTZ,
+		PRIMARY KEY (domain, short_code),
+		FOREIGN KEY (domain, short_code) REFERENCES url_mappings(domain, short_code) ON DELETE CASCADE
+	);`
+
+	if _, err := p.Pool.Exec(p.ctx, linkHealthSQL); err != nil {
+		return fmt.Errorf("failed to create link_health table: %v", err)
+	}
+
 	// Create click events table
 	clickEventsSQL := `
 	CREATE TABLE IF NOT EXISTS click_events (
@@ -161,6 +432,53 @@ func (p *PostgreSQL) CreateIndexes() error {
 		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_url_mappings_expires_at ON url_mappings(expires_at) WHERE expires_at IS NOT NULL;",
 		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_url_mappings_user_created ON url_mappings(user_id, created_at DESC);",
 		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_url_mappings_active ON url_mappings(is_active) WHERE is_active = true;",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_url_mappings_workspace_id ON url_mappings(workspace_id) WHERE workspace_id IS NOT NULL;",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_workspaces_owner_id ON workspaces(owner_id);",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_link_health_broken ON link_health(checked_at DESC) WHERE status = 'broken';",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_branded_domains_workspace_id ON branded_domains(workspace_id);",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_url_mappings_domain_lower_short_code ON url_mappings(domain, lower(short_code));",
+
+		// Tags, folders and listing sort orders
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_folders_parent_id ON folders(parent_id);",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_url_tags_tag_id ON url_tags(tag_id);",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_url_mappings_folder_id ON url_mappings(folder_id) WHERE folder_id IS NOT NULL;",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_url_mappings_user_created_at ON url_mappings(user_id, created_at, id) WHERE is_active = true;",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_url_mappings_user_click_count ON url_mappings(user_id, click_count, id) WHERE is_active = true;",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_url_mappings_user_last_accessed ON url_mappings(user_id, last_accessed, id) WHERE is_active = true;",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_url_mappings_user_short_code ON url_mappings(user_id, short_code, id) WHERE is_active = true;",
+
+		// Link search: ranked words and trigram substring matches
+		"CREATE EXTENSION IF NOT EXISTS pg_trgm;",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_url_mappings_search_vector ON url_mappings USING GIN (search_vector);",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_url_mappings_short_code_trgm ON url_mappings USING GIN (short_code gin_trgm_ops);",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_url_mappings_long_url_trgm ON url_mappings USING GIN (long_url gin_trgm_ops);",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_tags_name_trgm ON tags USING GIN (name gin_trgm_ops);",
+
+		// Keyset pagination of the flagged link list
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_url_mappings_disabled_at ON url_mappings(disabled_at, id) WHERE disabled_reason IS NOT NULL;",
+
+		// Trash bin and retired codes
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_url_mappings_user_deleted_at ON url_mappings(user_id, deleted_at, id) WHERE deleted_at IS NOT NULL;",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_url_mappings_deleted_at ON url_mappings(deleted_at) WHERE deleted_at IS NOT NULL;",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_retired_short_codes_lower ON retired_short_codes(domain, lower(short_code));",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_retired_short_codes_reclaimable_at ON retired_short_codes(reclaimable_at) WHERE reclaimable_at IS NOT NULL;",
+
+		// Expiry sweeper
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_url_mappings_pending_expiry ON url_mappings(expires_at) WHERE expires_at IS NOT NULL AND expired_at IS NULL AND is_active = true;",
+
+		// Inactivity auto-expiry
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_url_mappings_archived_at ON url_mappings(archived_at) WHERE archived_at IS NOT NULL;",
+
+		// Audit log lookups
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_audit_events_actor ON audit_events(actor, id);",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_audit_events_target ON audit_events(target, id);",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_audit_events_action ON audit_events(action, id);",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_audit_events_request_id ON audit_events(request_id) WHERE request_id <> '';",
+
+		// Webhook lookups and the dispatch queue
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_webhooks_workspace ON webhooks(workspace_id);",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_webhook_deliveries_webhook ON webhook_deliveries(webhook_id, created_at DESC, id DESC);",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_webhook_deliveries_pending ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';",
 
 		// Click events indexes for analytics
 		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_click_events_short_code ON click_events(short_code);",
@@ -194,72 +512,263 @@ func (p *PostgreSQL) AutoMigrate() error {
 // CreateURL inserts a new URL mapping
 func (p *PostgreSQL) CreateURL(url *URLMapping) error {
 	query := `
-		INSERT INTO url_mappings (short_code, long_url, user_id, expires_at, metadata)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO url_mappings (short_code, long_url, user_id, expires_at, metadata, workspace_id, utm_template,
+		                          password_hash, activates_at, fallback_url, max_clicks, preview_override, domain,
+		                          folder_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 		RETURNING id, created_at`
 
-	var expiresAt interface{}
-	if url.ExpiresAt.Valid {
-		expiresAt = url.ExpiresAt.Time
-	} else {
-		expiresAt = nil
-	}
-
 	return p.Pool.QueryRow(p.ctx, query,
-		url.ShortCode, url.LongURL, url.UserID, expiresAt, url.Metadata,
+		url.ShortCode, url.LongURL, url.UserID, nullTime(url.ExpiresAt), url.Metadata,
+		nullString(url.WorkspaceID), jsonOrEmpty(url.UTMTemplate), nullString(url.PasswordHash),
+		nullTime(url.ActivatesAt), nullString(url.FallbackURL), nullInt64(url.MaxClicks),
+		jsonOrEmpty(url.PreviewOverride), url.Domain, nullInt64(url.FolderID),
 	).Scan(&url.ID, &url.CreatedAt)
 }
 
-// GetURLByShortCode retrieves a URL mapping by short code
-func (p *PostgreSQL) GetURLByShortCode(shortCode string) (*URLMapping, error) {
+// UpdateURL persists the mutable fields of an existing URL mapping. A new
+// expiration clears what the expiry sweeper recorded for the old one.
+func (p *PostgreSQL) UpdateURL(url *URLMapping) error {
+	result, err := p.Pool.Exec(p.ctx, updateURLQuery, updateURLArgs(url)...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("URL not found or permission denied")
+	}
+	return nil
+}
+
+// updateURLQuery saves the mutable fields of a link
+const updateURLQuery = `
+		UPDATE url_mappings
+		SET long_url = $3, expires_at = $4, metadata = $5, utm_template = $6, password_hash = $7,
+		    activates_at = $8, fallback_url = $9, max_clicks = $10, preview_override = $11, folder_id = $13,
+		    inactivity_exempt = $14,
+		    expired_at = CASE WHEN expires_at IS DISTINCT FROM $4 THEN NULL ELSE expired_at END,
+		    expiry_notified_at = CASE WHEN expires_at IS DISTINCT FROM $4 THEN NULL ELSE expiry_notified_at END
+		WHERE short_code = $1 AND user_id = $2 AND domain = $12 AND is_active = true`
+
+// updateURLArgs returns the arguments of updateURLQuery
+func updateURLArgs(url *URLMapping) []interface{} {
+	return []interface{}{
+		url.ShortCode, url.UserID, url.LongURL, nullTime(url.ExpiresAt),
+		jsonOrEmpty(url.Metadata), jsonOrEmpty(url.UTMTemplate), nullString(url.PasswordHash),
+		nullTime(url.ActivatesAt), nullString(url.FallbackURL), nullInt64(url.MaxClicks),
+		jsonOrEmpty(url.PreviewOverride), url.Domain, nullInt64(url.FolderID), url.InactivityExempt,
+	}
+}
+
+// GetURLByShortCode retrieves a URL mapping by short domain ("" for the default domain) and short code
+func (p *PostgreSQL) GetURLByShortCode(domain, shortCode string) (*URLMapping, error) {
 	var url URLMapping
 	query := `
-		SELECT id, short_code, long_url, user_id, created_at, expires_at,
-		       click_count, last_accessed, is_active, metadata
+		SELECT ` + urlMappingColumns + `
 		FROM url_mappings 
-		WHERE short_code = $1 AND is_active = true`
+		WHERE domain = $1 AND short_code = $2 AND is_active = true`
 
-	err := p.DB.Get(&url, query, shortCode)
+	err := p.DB.Get(&url, query, domain, shortCode)
 	if err != nil {
 		return nil, err
 	}
 	return &url, nil
 }
 
+// GetTakenShortCodes returns which of the given codes already exist on a short
+// domain, including inactive and deleted links (their codes stay unique) and
+// purged codes that cannot be reclaimed yet. With caseInsensitive the codes
+// must be lower case and match any spelling.
+func (p *PostgreSQL) GetTakenShortCodes(domain string, shortCodes []string, caseInsensitive bool) (map[string]bool, error) {
+	query := `SELECT short_code FROM url_mappings WHERE domain = $1 AND short_code = ANY($2)
+		UNION SELECT short_code FROM retired_short_codes
+		WHERE domain = $1 AND short_code = ANY($2) AND (reclaimable_at IS NULL OR reclaimable_at > NOW())`
+	if caseInsensitive {
+		query = `SELECT lower(short_code) FROM url_mappings WHERE domain = $1 AND lower(short_code) = ANY($2)
+			UNION SELECT lower(short_code) FROM retired_short_codes
+			WHERE domain = $1 AND lower(short_code) = ANY($2) AND (reclaimable_at IS NULL OR reclaimable_at > NOW())`
+	}
+
+	rows, err := p.Pool.Query(p.ctx, query, domain, shortCodes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	taken := make(map[string]bool)
+	for rows.Next() {
+		var shortCode string
+		if err := rows.Scan(&shortCode); err != nil {
+			return nil, err
+		}
+		taken[shortCode] = true
+	}
+	return taken, rows.Err()
+}
+
 // GetURLsByUserID retrieves all URLs for a user with pagination
 func (p *PostgreSQL) GetURLsByUserID(userID string, limit, offset int) ([]URLMapping, error) {
+	return p.ListURLs(URLListFilter{UserID: userID}, nil, limit, offset)
+}
+
+// URLListFilter narrows and orders a user's active links
+type URLListFilter struct {
+	UserID            string
+	Tag               string // tag name; "" for any
+	FolderID          int64  // 0 for any folder
+	IncludeSubfolders bool   // with FolderID, also list the links of nested folders
+	SortBy            string // created_at (default), click_count, last_accessed or alias
+	Ascending         bool
+}
+
+// urlSortKey is a listing sort order: a url_mappings column and its type
+type urlSortKey struct {
+	column string
+	kind   sortKind
+}
+
+// urlSortKeys maps listing sort fields to url_mappings columns
+var urlSortKeys = map[string]urlSortKey{
+	"created_at":    {"created_at", sortTime},
+	"click_count":   {"click_count", sortInt},
+	"last_accessed": {"last_accessed", sortTime},
+	"alias":         {"short_code", sortText},
+}
+
+// sortKey returns the sort order of the filter, created_at by default
+func (f URLListFilter) sortKey() urlSortKey {
+	if key, ok := urlSortKeys[f.SortBy]; ok {
+		return key
+	}
+	return urlSortKeys["created_at"]
+}
+
+// sortExpression returns what the listing sorts on; links that were never
+// accessed sort last in both directions
+func (f URLListFilter) sortExpression() string {
+	key := f.sortKey()
+	if key.column != "last_accessed" {
+		return key.column
+	}
+	missing := lowestTime
+	if f.Ascending {
+		missing = highestTime
+	}
+	return fmt.Sprintf("COALESCE(last_accessed, '%s'::timestamptz)", missing.Format(time.RFC3339))
+}
+
+// conditions builds the WHERE clause of the listing
+func (f URLListFilter) conditions() (string, []interface{}) {
+	conditions := []string{"user_id = $1", "is_active = true"}
+	args := []interface{}{f.UserID}
+	if f.Tag != "" {
+		args = append(args, f.Tag)
+		conditions = append(conditions, fmt.Sprintf(`EXISTS (
+			SELECT 1 FROM url_tags ut JOIN tags t ON t.id = ut.tag_id
+			WHERE ut.url_id = url_mappings.id AND t.user_id = url_mappings.user_id AND t.name = $%d)`, len(args)))
+	}
+	if f.FolderID != 0 {
+		args = append(args, f.FolderID)
+		if f.IncludeSubfolders {
+			conditions = append(conditions, fmt.Sprintf(`folder_id IN (
+				WITH RECURSIVE subtree AS (
+					SELECT id FROM folders WHERE id = $%d
+					UNION ALL
+					SELECT f.id FROM folders f JOIN subtree s ON f.parent_id = s.id
+				)
+				SELECT id FROM subtree)`, len(args)))
+		} else {
+			conditions = append(conditions, fmt.Sprintf("folder_id = $%d", len(args)))
+		}
+	}
+	return strings.Join(conditions, " AND "), args
+}
+
+// URLCursor returns the cursor of a listed link
+func URLCursor(url *URLMapping, filter URLListFilter, backward bool) Cursor {
+	var value interface{}
+	switch filter.sortKey().column {
+	case "click_count":
+		value = url.ClickCount
+	case "short_code":
+		value = url.ShortCode
+	case "last_accessed":
+		switch {
+		case url.LastAccessed.Valid:
+			value = url.LastAccessed.Time
+		case filter.Ascending:
+			value = highestTime
+		default:
+			value = lowestTime
+		}
+	default:
+		value = url.CreatedAt
+	}
+	return Cursor{Value: formatSortValue(value), ID: url.ID, Backward: backward}
+}
+
+// ListURLs retrieves a user's active URLs matching the filter, the page after
+// or before cursor or, without one, at offset. Rows with the same sort value
+// are ordered by id so pages are stable.
+func (p *PostgreSQL) ListURLs(filter URLListFilter, cursor *Cursor, limit, offset int) ([]URLMapping, error) {
+	where, args := filter.conditions()
+	sortExpression := filter.sortExpression()
+
+	condition, args, ascending, err := keyset(sortExpression, filter.sortKey().kind, cursor, filter.Ascending, args)
+	if err != nil {
+		return nil, err
+	}
+	if condition != "" {
+		where += " AND " + condition
+		offset = 0
+	}
+
+	args = append(args, limit, offset)
+	query := fmt.Sprintf(`
+		SELECT `+urlMappingColumns+`
+		FROM url_mappings
+		WHERE %s
+		ORDER BY %s %s, id %s
+		LIMIT $%d OFFSET $%d`,
+		where, sortExpression, direction(ascending), direction(ascending), len(args)-1, len(args))
+
 	var urls []URLMapping
-	query := `
-		SELECT id, short_code, long_url, user_id, created_at, expires_at,
-		       click_count, last_accessed, is_active, metadata
-		FROM url_mappings 
-		WHERE user_id = $1 AND is_active = true
-		ORDER BY created_at DESC
-		LIMIT $2 OFFSET $3`
+	if err := p.DB.Select(&urls, query, args...); err != nil {
+		return nil, err
+	}
+	reversePage(urls, cursor)
+	return urls, nil
+}
+
+// CountURLs counts a user's active URLs matching the filter
+func (p *PostgreSQL) CountURLs(filter URLListFilter) (int64, error) {
+	where, args := filter.conditions()
 
-	err := p.DB.Select(&urls, query, userID, limit, offset)
-	return urls, err
+	var count int64
+	err := p.DB.Get(&count, "SELECT COUNT(*) FROM url_mappings WHERE "+where, args...)
+	return count, err
 }
 
 // UpdateClickCount increments the click count for a URL
-func (p *PostgreSQL) UpdateClickCount(shortCode string) error {
+func (p *PostgreSQL) UpdateClickCount(domain, shortCode string) error {
 	query := `
 		UPDATE url_mappings 
 		SET click_count = click_count + 1, last_accessed = NOW()
-		WHERE short_code = $1`
+		WHERE domain = $1 AND short_code = $2`
 
-	_, err := p.Pool.Exec(p.ctx, query, shortCode)
+	_, err := p.Pool.Exec(p.ctx, query, domain, shortCode)
 	return err
 }
 
-// DeleteURL soft deletes a URL (sets is_active to false)
-func (p *PostgreSQL) DeleteURL(shortCode, userID string) error {
+// DeleteURL soft deletes a URL: it is deactivated and moved to the trash
+// until RestoreURL or PurgeDeletedURLs
+func (p *PostgreSQL) DeleteURL(domain, shortCode, userID string) error {
 	query := `
 		UPDATE url_mappings 
-		SET is_active = false 
-		WHERE short_code = $1 AND user_id = $2`
+		SET is_active = false, deleted_at = NOW()
+		WHERE domain = $1 AND short_code = $2 AND user_id = $3 AND deleted_at IS NULL`
 
-	result, err := p.Pool.Exec(p.ctx, query, shortCode, userID)
+	result, err := p.Pool.Exec(p.ctx, query, domain, shortCode, userID)
 	if err != nil {
 		return err
 	}
@@ -320,6 +829,38 @@ func (p *PostgreSQL) GetStats() map[string]interface{} {
 	}
 }
 
+// nullString converts an optional string column into a query argument
+func nullString(s sql.NullString) interface{} {
+	if s.Valid && s.String != "" {
+		return s.String
+	}
+	return nil
+}
+
+// nullTime converts an optional timestamp column into a query argument
+func nullTime(t sql.NullTime) interface{} {
+	if t.Valid {
+		return t.Time
+	}
+	return nil
+}
+
+// nullInt64 converts an optional integer column into a query argument
+func nullInt64(i sql.NullInt64) interface{} {
+	if i.Valid {
+		return i.Int64
+	}
+	return nil
+}
+
+// jsonOrEmpty defaults empty JSONB payloads to an empty object
+func jsonOrEmpty(s string) string {
+	if s == "" {
+		return "{}"
+	}
+	return s
+}
+
 // Close closes the database connections
 func (p *PostgreSQL) Close() {
 	if p.Pool != nil {
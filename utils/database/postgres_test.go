@@ -66,25 +66,25 @@ func (suite *PostgreSQLTestSuite) TestURLMappingCRUD() {
 	assert.False(suite.T(), urlMapping.CreatedAt.IsZero())
 
 	// Test Read
-	retrieved, err := suite.db.GetURLByShortCode("abc123")
+	retrieved, err := suite.db.GetURLByShortCode("", "abc123")
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), "abc123", retrieved.ShortCode)
 	assert.Equal(suite.T(), "https://example.com/very/long/url", retrieved.LongURL)
 	assert.Equal(suite.T(), "user_123", retrieved.UserID)
 
 	// Test Update (click count)
-	err = suite.db.UpdateClickCount("abc123")
+	err = suite.db.UpdateClickCount("", "abc123")
 	assert.NoError(suite.T(), err)
 
-	updated, err := suite.db.GetURLByShortCode("abc123")
+	updated, err := suite.db.GetURLByShortCode("", "abc123")
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), int64(1), updated.ClickCount)
 
 	// Test Delete (soft delete)
-	err = suite.db.DeleteURL("abc123", "user_123")
+	err = suite.db.DeleteURL("", "abc123", "user_123")
 	assert.NoError(suite.T(), err)
 
-	deleted, err := suite.db.GetURLByShortCode("abc123")
+	deleted, err := suite.db.GetURLByShortCode("", "abc123")
 	assert.Error(suite.T(), err) // Should not find deleted record
 	assert.Nil(suite.T(), deleted)
 }
@@ -0,0 +1,13 @@
+package database
+
+// SetLinkPreview stores the preview metadata scraped from a URL's destination.
+// longURL guards against overwriting the preview after the destination changed.
+func (p *PostgreSQL) SetLinkPreview(domain, shortCode, longURL, preview string) error {
+	query := `
+		UPDATE url_mappings
+		SET link_preview = $4
+		WHERE domain = $1 AND short_code = $2 AND long_url = $3 AND is_active = true`
+
+	_, err := p.Pool.Exec(p.ctx, query, domain, shortCode, longURL, jsonOrEmpty(preview))
+	return err
+}
@@ -0,0 +1,110 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// URLRevision is a recorded change of a link. Changes, StateBefore and
+// StateAfter are JSON documents owned by the service layer.
+type URLRevision struct {
+	ID          int64         `db:"id"`
+	URLID       int64         `db:"url_id"`
+	Revision    int           `db:"revision"`
+	ChangedBy   string        `db:"changed_by"`
+	CreatedAt   time.Time     `db:"created_at"`
+	Changes     string        `db:"changes"`
+	StateBefore string        `db:"state_before"`
+	StateAfter  string        `db:"state_after"`
+	RollbackOf  sql.NullInt64 `db:"rollback_of"`
+}
+
+// urlRevisionColumns lists the columns selected into URLRevision
+const urlRevisionColumns = `id, url_id, revision, changed_by, created_at, changes, state_before, state_after, rollback_of`
+
+// UpdateURLWithRevision updates a link like UpdateURL and records the revision
+// in the same transaction; the revision gets the link's next number and its
+// ID, Revision and CreatedAt are filled in
+func (p *PostgreSQL) UpdateURLWithRevision(url *URLMapping, revision *URLRevision) error {
+	tx, err := p.Pool.Begin(p.ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(p.ctx)
+
+	result, err := tx.Exec(p.ctx, updateURLQuery, updateURLArgs(url)...)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("URL not found or permission denied")
+	}
+
+	// The link's row is locked by the update, so numbering cannot race
+	err = tx.QueryRow(p.ctx, `
+		INSERT INTO url_revisions (url_id, revision, changed_by, changes, state_before, state_after, rollback_of)
+		SELECT $1, COALESCE(MAX(revision), 0) + 1, $2, $3, $4, $5, $6
+		FROM url_revisions WHERE url_id = $1
+		RETURNING id, revision, created_at`,
+		url.ID, revision.ChangedBy, jsonOrEmptyArray(revision.Changes), revision.StateBefore, revision.StateAfter, revision.RollbackOf,
+	).Scan(&revision.ID, &revision.Revision, &revision.CreatedAt)
+	if err != nil {
+		return err
+	}
+	revision.URLID = url.ID
+
+	return tx.Commit(p.ctx)
+}
+
+// ListURLRevisions lists the revisions of a link, newest first
+func (p *PostgreSQL) ListURLRevisions(urlID int64, cursor *Cursor, limit, offset int) ([]URLRevision, error) {
+	where := "url_id = $1"
+	condition, args, ascending, err := keyset("created_at", sortTime, cursor, false, []interface{}{urlID})
+	if err != nil {
+		return nil, err
+	}
+	if condition != "" {
+		where += " AND " + condition
+		offset = 0
+	}
+
+	args = append(args, limit, offset)
+	query := fmt.Sprintf(`
+		SELECT `+urlRevisionColumns+`
+		FROM url_revisions
+		WHERE %s
+		ORDER BY created_at %s, id %s
+		LIMIT $%d OFFSET $%d`,
+		where, direction(ascending), direction(ascending), len(args)-1, len(args))
+
+	var revisions []URLRevision
+	if err := p.DB.Select(&revisions, query, args...); err != nil {
+		return nil, err
+	}
+	reversePage(revisions, cursor)
+	return revisions, nil
+}
+
+// CountURLRevisions counts the revisions of a link
+func (p *PostgreSQL) CountURLRevisions(urlID int64) (int64, error) {
+	var count int64
+	err := p.DB.Get(&count, "SELECT COUNT(*) FROM url_revisions WHERE url_id = $1", urlID)
+	return count, err
+}
+
+// GetURLRevision retrieves a revision of a link
+func (p *PostgreSQL) GetURLRevision(urlID, id int64) (*URLRevision, error) {
+	var revision URLRevision
+	query := `SELECT ` + urlRevisionColumns + ` FROM url_revisions WHERE id = $1 AND url_id = $2`
+
+	if err := p.DB.Get(&revision, query, id, urlID); err != nil {
+		return nil, err
+	}
+	return &revision, nil
+}
+
+// URLRevisionCursor returns the cursor of a revision
+func URLRevisionCursor(revision *URLRevision, backward bool) Cursor {
+	return Cursor{Value: formatSortValue(revision.CreatedAt), ID: revision.ID, Backward: backward}
+}
@@ -0,0 +1,79 @@
+package database
+
+import (
+	"fmt"
+)
+
+// DisableURL deactivates a URL mapping flagged by the safety engine and records the reason code
+func (p *PostgreSQL) DisableURL(domain, shortCode, reason string) error {
+	query := `
+		UPDATE url_mappings
+		SET is_active = false, disabled_reason = $3, disabled_at = NOW()
+		WHERE domain = $1 AND short_code = $2 AND is_active = true`
+
+	result, err := p.Pool.Exec(p.ctx, query, domain, shortCode, reason)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("URL not found")
+	}
+	return nil
+}
+
+// GetActiveURLsAfter pages through active URL mappings by id, for periodic rescans
+func (p *PostgreSQL) GetActiveURLsAfter(afterID int64, limit int) ([]URLMapping, error) {
+	var urls []URLMapping
+	query := `
+		SELECT ` + urlMappingColumns + `
+		FROM url_mappings
+		WHERE id > $1 AND is_active = true
+		ORDER BY id
+		LIMIT $2`
+
+	err := p.DB.Select(&urls, query, afterID, limit)
+	return urls, err
+}
+
+// GetDisabledURLs lists URL mappings disabled by the safety engine, most
+// recent first: the page after or before cursor or, without one, at offset
+func (p *PostgreSQL) GetDisabledURLs(cursor *Cursor, limit, offset int) ([]URLMapping, error) {
+	where := "disabled_reason IS NOT NULL"
+	condition, args, ascending, err := keyset("disabled_at", sortTime, cursor, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	if condition != "" {
+		where += " AND " + condition
+		offset = 0
+	}
+
+	args = append(args, limit, offset)
+	query := fmt.Sprintf(`
+		SELECT `+urlMappingColumns+`
+		FROM url_mappings
+		WHERE %s
+		ORDER BY disabled_at %s, id %s
+		LIMIT $%d OFFSET $%d`,
+		where, direction(ascending), direction(ascending), len(args)-1, len(args))
+
+	var urls []URLMapping
+	if err := p.DB.Select(&urls, query, args...); err != nil {
+		return nil, err
+	}
+	reversePage(urls, cursor)
+	return urls, nil
+}
+
+// CountDisabledURLs counts URL mappings disabled by the safety engine
+func (p *PostgreSQL) CountDisabledURLs() (int64, error) {
+	var count int64
+	err := p.DB.Get(&count, "SELECT COUNT(*) FROM url_mappings WHERE disabled_reason IS NOT NULL")
+	return count, err
+}
+
+// DisabledURLCursor returns the cursor of a disabled link
+func DisabledURLCursor(url *URLMapping, backward bool) Cursor {
+	return Cursor{Value: formatSortValue(url.DisabledAt.Time), ID: url.ID, Backward: backward}
+}
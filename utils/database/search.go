@@ -0,0 +1,165 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// URLSearchFilter selects the links of a user matching every search term.
+// Deleted links are never returned; disabled and archived links are.
+type URLSearchFilter struct {
+	UserID string
+	Query  string         // the whole query, ranked first when it is a link's short code
+	Terms  []string       // lower-case words of letters and digits from the query
+	Tag    string         // tag name; "" for any
+	Domain sql.NullString // short domain, "" for the default domain; unset for any
+	Status string         // active, expired, disabled or archived; "" for any
+}
+
+// URLSearchHit is a link matching a search with its relevance
+type URLSearchHit struct {
+	URLMapping
+	Rank float64 `db:"rank"`
+}
+
+// FacetCount is the number of matching links with a facet value
+type FacetCount struct {
+	Value string `db:"value"`
+	Count int64  `db:"count"`
+}
+
+// URLSearchFacets counts the links matching a search by tag, domain and status
+type URLSearchFacets struct {
+	Tags     []FacetCount
+	Domains  []FacetCount
+	Statuses []FacetCount
+}
+
+// urlStatusColumn classifies a url_mappings row as active, expired, disabled or
+// archived; links that reached their click limit count as expired
+const urlStatusColumn = `CASE
+		WHEN disabled_at IS NOT NULL THEN 'disabled'
+		WHEN archived_at IS NOT NULL THEN 'archived'
+		WHEN expires_at <= NOW() OR click_count >= max_clicks THEN 'expired'
+		ELSE 'active' END`
+
+// searchConditions builds the WHERE clause of a search. Each term matches as a
+// word prefix of the search document or as a substring of the short code, the
+// destination or a tag name.
+func searchConditions(filter URLSearchFilter) (string, []interface{}) {
+	conditions := []string{"user_id = $1", "deleted_at IS NULL", "(is_active = true OR disabled_at IS NOT NULL OR archived_at IS NOT NULL)"}
+	args := []interface{}{filter.UserID}
+
+	for _, term := range filter.Terms {
+		args = append(args, term+":*", "%"+term+"%")
+		prefix, substring := len(args)-1, len(args)
+		conditions = append(conditions, fmt.Sprintf(`(search_vector @@ to_tsquery('simple', $%d)
+			OR short_code ILIKE $%d OR long_url ILIKE $%d
+			OR EXISTS (SELECT 1 FROM url_tags ut JOIN tags t ON t.id = ut.tag_id
+			           WHERE ut.url_id = url_mappings.id AND t.name ILIKE $%d))`, prefix, substring, substring, substring))
+	}
+	if filter.Tag != "" {
+		args = append(args, filter.Tag)
+		conditions = append(conditions, fmt.Sprintf(`EXISTS (
+			SELECT 1 FROM url_tags ut JOIN tags t ON t.id = ut.tag_id
+			WHERE ut.url_id = url_mappings.id AND t.user_id = url_mappings.user_id AND t.name = $%d)`, len(args)))
+	}
+	if filter.Domain.Valid {
+		args = append(args, filter.Domain.String)
+		conditions = append(conditions, fmt.Sprintf("domain = $%d", len(args)))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		conditions = append(conditions, fmt.Sprintf("(%s) = $%d", urlStatusColumn, len(args)))
+	}
+	return strings.Join(conditions, " AND "), args
+}
+
+// SearchURLs retrieves the links matching a search, most relevant first: the
+// page after or before cursor or, without one, at offset. Rank is the
+// full-text rank of the terms, plus one for a link whose short code is the
+// whole query regardless of case; links of equal rank are newest first.
+func (p *PostgreSQL) SearchURLs(filter URLSearchFilter, cursor *Cursor, limit, offset int) ([]URLSearchHit, error) {
+	where, args := searchConditions(filter)
+
+	rank := "0::real"
+	if len(filter.Terms) > 0 {
+		prefixes := make([]string, len(filter.Terms))
+		for i, term := range filter.Terms {
+			prefixes[i] = term + ":*"
+		}
+		args = append(args, strings.Join(prefixes, " | "), filter.Query)
+		rank = fmt.Sprintf(`(ts_rank(search_vector, to_tsquery('simple', $%d))
+			+ CASE WHEN lower(short_code) = lower($%d) THEN 1 ELSE 0 END)`, len(args)-1, len(args))
+	}
+
+	condition, args, ascending, err := keyset(rank, sortFloat, cursor, false, args)
+	if err != nil {
+		return nil, err
+	}
+	if condition != "" {
+		where += " AND " + condition
+		offset = 0
+	}
+
+	args = append(args, limit, offset)
+	query := fmt.Sprintf(`
+		SELECT `+urlMappingColumns+`, %s AS rank
+		FROM url_mappings
+		WHERE %s
+		ORDER BY rank %s, id %s
+		LIMIT $%d OFFSET $%d`,
+		rank, where, direction(ascending), direction(ascending), len(args)-1, len(args))
+
+	var hits []URLSearchHit
+	if err := p.DB.Select(&hits, query, args...); err != nil {
+		return nil, err
+	}
+	reversePage(hits, cursor)
+	return hits, nil
+}
+
+// SearchCursor returns the cursor of a search hit
+func SearchCursor(hit *URLSearchHit, backward bool) Cursor {
+	return Cursor{Value: formatSortValue(hit.Rank), ID: hit.ID, Backward: backward}
+}
+
+// GetSearchFacets counts the links matching a search by status, short domain
+// and tag; only the tagLimit most used tags are counted
+func (p *PostgreSQL) GetSearchFacets(filter URLSearchFilter, tagLimit int) (*URLSearchFacets, error) {
+	where, args := searchConditions(filter)
+	facets := &URLSearchFacets{}
+
+	statusQuery := fmt.Sprintf(`
+		SELECT %s AS value, COUNT(*) AS count
+		FROM url_mappings
+		WHERE %s
+		GROUP BY 1
+		ORDER BY 2 DESC, 1`, urlStatusColumn, where)
+	if err := p.DB.Select(&facets.Statuses, statusQuery, args...); err != nil {
+		return nil, err
+	}
+
+	domainQuery := fmt.Sprintf(`
+		SELECT domain AS value, COUNT(*) AS count
+		FROM url_mappings
+		WHERE %s
+		GROUP BY 1
+		ORDER BY 2 DESC, 1`, where)
+	if err := p.DB.Select(&facets.Domains, domainQuery, args...); err != nil {
+		return nil, err
+	}
+
+	tagQuery := fmt.Sprintf(`
+		SELECT t.name AS value, COUNT(*) AS count
+		FROM url_tags ut JOIN tags t ON t.id = ut.tag_id
+		WHERE ut.url_id IN (SELECT id FROM url_mappings WHERE %s)
+		GROUP BY 1
+		ORDER BY 2 DESC, 1
+		LIMIT $%d`, where, len(args)+1)
+	if err := p.DB.Select(&facets.Tags, tagQuery, append(args, tagLimit)...); err != nil {
+		return nil, err
+	}
+	return facets, nil
+}
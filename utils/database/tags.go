@@ -0,0 +1,148 @@
+package database
+
+import (
+	"time"
+)
+
+// Tag represents the tags table structure; LinkCount is computed
+type Tag struct {
+	ID        int64     `db:"id" json:"id"`
+	UserID    string    `db:"user_id" json:"user_id"`
+	Name      string    `db:"name" json:"name"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	LinkCount int64     `db:"link_count" json:"link_count"` // active links with the tag
+}
+
+// CreateTag adds a tag for a user. It reports false when the user already has
+// a tag with that name, in which case tag is filled from the existing row.
+func (p *PostgreSQL) CreateTag(tag *Tag) (bool, error) {
+	query := `
+		INSERT INTO tags (user_id, name)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id, name) DO NOTHING
+		RETURNING id, created_at`
+
+	rows, err := p.Pool.Query(p.ctx, query, tag.UserID, tag.Name)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return false, err
+		}
+		existing, err := p.GetTag(tag.UserID, tag.Name)
+		if err != nil {
+			return false, err
+		}
+		*tag = *existing
+		return false, nil
+	}
+	return true, rows.Scan(&tag.ID, &tag.CreatedAt)
+}
+
+// GetTag retrieves a user's tag by name
+func (p *PostgreSQL) GetTag(userID, name string) (*Tag, error) {
+	var tag Tag
+	query := `
+		SELECT t.id, t.user_id, t.name, t.created_at,
+		       (SELECT COUNT(*) FROM url_tags ut JOIN url_mappings m ON m.id = ut.url_id
+		        WHERE ut.tag_id = t.id AND m.is_active = true) AS link_count
+		FROM tags t
+		WHERE t.user_id = $1 AND t.name = $2`
+
+	err := p.DB.Get(&tag, query, userID, name)
+	if err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// ListTags lists a user's tags by name with their link counts
+func (p *PostgreSQL) ListTags(userID string) ([]Tag, error) {
+	var tags []Tag
+	query := `
+		SELECT t.id, t.user_id, t.name, t.created_at, COUNT(m.id) AS link_count
+		FROM tags t
+		LEFT JOIN url_tags ut ON ut.tag_id = t.id
+		LEFT JOIN url_mappings m ON m.id = ut.url_id AND m.is_active = true
+		WHERE t.user_id = $1
+		GROUP BY t.id
+		ORDER BY t.name`
+
+	err := p.DB.Select(&tags, query, userID)
+	return tags, err
+}
+
+// RenameTag renames a user's tag; it reports false when the user already has a tag with the new name
+func (p *PostgreSQL) RenameTag(tagID int64, userID, name string) (bool, error) {
+	query := `
+		UPDATE tags SET name = $3
+		WHERE id = $1 AND user_id = $2
+		  AND NOT EXISTS (SELECT 1 FROM tags WHERE user_id = $2 AND name = $3)`
+
+	result, err := p.Pool.Exec(p.ctx, query, tagID, userID, name)
+	if err != nil {
+		return false, err
+	}
+	return result.RowsAffected() > 0, nil
+}
+
+// DeleteTag deletes a user's tag and removes it from its links
+func (p *PostgreSQL) DeleteTag(tagID int64, userID string) error {
+	_, err := p.Pool.Exec(p.ctx, `DELETE FROM tags WHERE id = $1 AND user_id = $2`, tagID, userID)
+	return err
+}
+
+// GetTaggedLinks lists the links that carry a tag
+func (p *PostgreSQL) GetTaggedLinks(tagID int64) ([]LinkRef, error) {
+	var links []LinkRef
+	query := `
+		SELECT m.domain, m.short_code
+		FROM url_tags ut
+		JOIN url_mappings m ON m.id = ut.url_id
+		WHERE ut.tag_id = $1`
+
+	err := p.DB.Select(&links, query, tagID)
+	return links, err
+}
+
+// SetURLTags replaces the tags of a link with the named tags of its owner,
+// creating tags that do not exist yet
+func (p *PostgreSQL) SetURLTags(urlID int64, userID string, names []string) error {
+	tx, err := p.Pool.Begin(p.ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(p.ctx)
+
+	if len(names) > 0 {
+		_, err = tx.Exec(p.ctx, `
+			INSERT INTO tags (user_id, name)
+			SELECT $1, unnest($2::text[])
+			ON CONFLICT (user_id, name) DO NOTHING`,
+			userID, names,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.Exec(p.ctx, `DELETE FROM url_tags WHERE url_id = $1`, urlID); err != nil {
+		return err
+	}
+
+	if len(names) > 0 {
+		_, err = tx.Exec(p.ctx, `
+			INSERT INTO url_tags (url_id, tag_id)
+			SELECT $1, id FROM tags WHERE user_id = $2 AND name = ANY($3)`,
+			urlID, userID, names,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(p.ctx)
+}
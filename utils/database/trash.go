@@ -0,0 +1,136 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// PurgedLink is a deleted link removed for good by PurgeDeletedURLs
+type PurgedLink struct {
+	Domain    string `db:"domain"`
+	ShortCode string `db:"short_code"`
+	// CodeShared is set when a link on another short domain has the same code.
+	// Click analytics are keyed by code alone, so they belong to that link too.
+	CodeShared bool `db:"code_shared"`
+}
+
+// CodeRetention decides whether the code of a purged link can be reused
+type CodeRetention struct {
+	Retire        bool         // keep the code taken after the purge
+	ReclaimableAt sql.NullTime // when a retired code becomes free again; unset for never
+}
+
+// GetDeletedURL retrieves a link in the trash by short domain and short code
+func (p *PostgreSQL) GetDeletedURL(domain, shortCode string) (*URLMapping, error) {
+	var url URLMapping
+	query := `
+		SELECT ` + urlMappingColumns + `
+		FROM url_mappings
+		WHERE domain = $1 AND short_code = $2 AND deleted_at IS NOT NULL`
+
+	if err := p.DB.Get(&url, query, domain, shortCode); err != nil {
+		return nil, err
+	}
+	return &url, nil
+}
+
+// ListDeletedURLs lists a user's links in the trash, most recently deleted
+// first: the page after or before cursor or, without one, at offset
+func (p *PostgreSQL) ListDeletedURLs(userID string, cursor *Cursor, limit, offset int) ([]URLMapping, error) {
+	where := "user_id = $1 AND deleted_at IS NOT NULL"
+	condition, args, ascending, err := keyset("deleted_at", sortTime, cursor, false, []interface{}{userID})
+	if err != nil {
+		return nil, err
+	}
+	if condition != "" {
+		where += " AND " + condition
+		offset = 0
+	}
+
+	args = append(args, limit, offset)
+	query := fmt.Sprintf(`
+		SELECT `+urlMappingColumns+`
+		FROM url_mappings
+		WHERE %s
+		ORDER BY deleted_at %s, id %s
+		LIMIT $%d OFFSET $%d`,
+		where, direction(ascending), direction(ascending), len(args)-1, len(args))
+
+	var urls []URLMapping
+	if err := p.DB.Select(&urls, query, args...); err != nil {
+		return nil, err
+	}
+	reversePage(urls, cursor)
+	return urls, nil
+}
+
+// CountDeletedURLs counts a user's links in the trash
+func (p *PostgreSQL) CountDeletedURLs(userID string) (int64, error) {
+	var count int64
+	err := p.DB.Get(&count, "SELECT COUNT(*) FROM url_mappings WHERE user_id = $1 AND deleted_at IS NOT NULL", userID)
+	return count, err
+}
+
+// DeletedURLCursor returns the cursor of a link in the trash
+func DeletedURLCursor(url *URLMapping, backward bool) Cursor {
+	return Cursor{Value: formatSortValue(url.DeletedAt.Time), ID: url.ID, Backward: backward}
+}
+
+// RestoreURL takes a link out of the trash. A link that was disabled as unsafe
+// or archived before it was deleted stays so.
+func (p *PostgreSQL) RestoreURL(domain, shortCode, userID string) error {
+	query := `
+		UPDATE url_mappings
+		SET is_active = disabled_at IS NULL AND archived_at IS NULL, deleted_at = NULL
+		WHERE domain = $1 AND short_code = $2 AND user_id = $3 AND deleted_at IS NOT NULL`
+
+	result, err := p.Pool.Exec(p.ctx, query, domain, shortCode, userID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("URL not found or permission denied")
+	}
+	return nil
+}
+
+// PurgeDeletedURLs hard deletes up to limit links deleted before the cutoff,
+// oldest first, along with their health checks and tag assignments. Codes are
+// recorded in retired_short_codes when the retention keeps them taken.
+func (p *PostgreSQL) PurgeDeletedURLs(deletedBefore time.Time, limit int, retention CodeRetention) ([]PurgedLink, error) {
+	query := `
+		WITH purged AS (
+			DELETE FROM url_mappings
+			WHERE id IN (
+				SELECT id FROM url_mappings
+				WHERE deleted_at IS NOT NULL AND deleted_at < $1
+				ORDER BY deleted_at
+				LIMIT $2
+				FOR UPDATE SKIP LOCKED)
+			RETURNING domain, short_code
+		), retired AS (
+			INSERT INTO retired_short_codes (domain, short_code, reclaimable_at)
+			SELECT domain, short_code, $4 FROM purged WHERE $3
+			ON CONFLICT (domain, short_code) DO UPDATE
+			SET retired_at = NOW(), reclaimable_at = EXCLUDED.reclaimable_at
+		)
+		SELECT purged.domain, purged.short_code,
+		       EXISTS (SELECT 1 FROM url_mappings m
+		               WHERE m.short_code = purged.short_code AND m.domain <> purged.domain) AS code_shared
+		FROM purged`
+
+	var links []PurgedLink
+	err := p.DB.Select(&links, query, deletedBefore, limit, retention.Retire, nullTime(retention.ReclaimableAt))
+	return links, err
+}
+
+// DeleteReclaimedCodes forgets retired codes that can be reused again
+func (p *PostgreSQL) DeleteReclaimedCodes() (int64, error) {
+	result, err := p.Pool.Exec(p.ctx, `DELETE FROM retired_short_codes WHERE reclaimable_at <= NOW()`)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,314 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// Webhook delivery statuses
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// Webhook represents the webhooks table structure
+type Webhook struct {
+	ID                  int64        `db:"id" json:"id"`
+	WorkspaceID         string       `db:"workspace_id" json:"workspace_id"`
+	URL                 string       `db:"url" json:"url"`
+	Secret              string       `db:"secret" json:"-"`
+	Events              string       `db:"events" json:"events"` // PostgreSQL JSONB array, empty for all events
+	IsActive            bool         `db:"is_active" json:"is_active"`
+	ConsecutiveFailures int          `db:"consecutive_failures" json:"consecutive_failures"`
+	DisabledAt          sql.NullTime `db:"disabled_at" json:"disabled_at"`
+	CreatedBy           string       `db:"created_by" json:"created_by"`
+	CreatedAt           time.Time    `db:"created_at" json:"created_at"`
+	UpdatedAt           time.Time    `db:"updated_at" json:"updated_at"`
+}
+
+// webhookColumns lists the webhooks columns scanned into Webhook
+const webhookColumns = `id, workspace_id, url, secret, events::text AS events, is_active, consecutive_failures,
+		       disabled_at, created_by, created_at, updated_at`
+
+// WebhookDelivery represents the webhook_deliveries table structure
+type WebhookDelivery struct {
+	ID             int64        `db:"id" json:"id"`
+	WebhookID      int64        `db:"webhook_id" json:"webhook_id"`
+	EventID        string       `db:"event_id" json:"event_id"`
+	EventType      string       `db:"event_type" json:"event_type"`
+	Payload        string       `db:"payload" json:"payload"` // JSON body, signed as stored
+	Status         string       `db:"status" json:"status"`
+	Attempts       int          `db:"attempts" json:"attempts"`
+	NextAttemptAt  time.Time    `db:"next_attempt_at" json:"next_attempt_at"`
+	LastAttemptAt  sql.NullTime `db:"last_attempt_at" json:"last_attempt_at"`
+	ResponseStatus int          `db:"response_status" json:"response_status"`
+	ResponseBody   string       `db:"response_body" json:"response_body"`
+	Error          string       `db:"error" json:"error"`
+	CreatedAt      time.Time    `db:"created_at" json:"created_at"`
+	DeliveredAt    sql.NullTime `db:"delivered_at" json:"delivered_at"`
+}
+
+// webhookDeliveryColumns lists the webhook_deliveries columns scanned into WebhookDelivery
+const webhookDeliveryColumns = `id, webhook_id, event_id, event_type, payload, status, attempts, next_attempt_at,
+		       last_attempt_at, response_status, response_body, error, created_at, delivered_at`
+
+// ClaimedWebhookDelivery is a delivery leased to the dispatcher with the
+// endpoint and signing secret of its webhook
+type ClaimedWebhookDelivery struct {
+	WebhookDelivery
+	URL    string `db:"url"`
+	Secret string `db:"secret"`
+}
+
+// WebhookAttempt is the outcome of sending a claimed delivery. Status is the
+// delivery's status after the attempt; a pending delivery is retried at NextAttemptAt.
+type WebhookAttempt struct {
+	DeliveryID     int64
+	WebhookID      int64
+	Status         string
+	NextAttemptAt  time.Time
+	ResponseStatus int
+	ResponseBody   string
+	Error          string
+}
+
+// CreateWebhook subscribes an endpoint to a workspace's events
+func (p *PostgreSQL) CreateWebhook(webhook *Webhook) error {
+	query := `
+		INSERT INTO webhooks (workspace_id, url, secret, events, created_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, is_active, consecutive_failures, created_at, updated_at`
+
+	return p.Pool.QueryRow(p.ctx, query,
+		webhook.WorkspaceID, webhook.URL, webhook.Secret, jsonOrEmptyArray(webhook.Events), webhook.CreatedBy,
+	).Scan(&webhook.ID, &webhook.IsActive, &webhook.ConsecutiveFailures, &webhook.CreatedAt, &webhook.UpdatedAt)
+}
+
+// CountWebhooks counts the webhooks of a workspace
+func (p *PostgreSQL) CountWebhooks(workspaceID string) (int, error) {
+	var count int
+	err := p.DB.Get(&count, "SELECT COUNT(*) FROM webhooks WHERE workspace_id = $1", workspaceID)
+	return count, err
+}
+
+// ListWebhooks lists the webhooks of a workspace, oldest first
+func (p *PostgreSQL) ListWebhooks(workspaceID string) ([]Webhook, error) {
+	var webhooks []Webhook
+	query := `
+		SELECT ` + webhookColumns + `
+		FROM webhooks
+		WHERE workspace_id = $1
+		ORDER BY id`
+
+	err := p.DB.Select(&webhooks, query, workspaceID)
+	return webhooks, err
+}
+
+// GetWebhook retrieves a webhook of a workspace
+func (p *PostgreSQL) GetWebhook(workspaceID string, id int64) (*Webhook, error) {
+	var webhook Webhook
+	query := `SELECT ` + webhookColumns + ` FROM webhooks WHERE id = $1 AND workspace_id = $2`
+
+	if err := p.DB.Get(&webhook, query, id, workspaceID); err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// UpdateWebhook saves the endpoint, secret, event filter and state of a webhook
+func (p *PostgreSQL) UpdateWebhook(webhook *Webhook) error {
+	query := `
+		UPDATE webhooks
+		SET url = $3, secret = $4, events = $5, is_active = $6, consecutive_failures = $7,
+		    disabled_at = $8, updated_at = NOW()
+		WHERE id = $1 AND workspace_id = $2
+		RETURNING updated_at`
+
+	return p.Pool.QueryRow(p.ctx, query,
+		webhook.ID, webhook.WorkspaceID, webhook.URL, webhook.Secret, jsonOrEmptyArray(webhook.Events),
+		webhook.IsActive, webhook.ConsecutiveFailures, webhook.DisabledAt,
+	).Scan(&webhook.UpdatedAt)
+}
+
+// DeleteWebhook removes a webhook of a workspace and its delivery log. It
+// reports false when the workspace has no such webhook.
+func (p *PostgreSQL) DeleteWebhook(workspaceID string, id int64) (bool, error) {
+	result, err := p.Pool.Exec(p.ctx, "DELETE FROM webhooks WHERE id = $1 AND workspace_id = $2", id, workspaceID)
+	if err != nil {
+		return false, err
+	}
+	return result.RowsAffected() > 0, nil
+}
+
+// EnqueueWebhookDeliveries queues an event for every active webhook of the
+// workspace subscribed to its type and returns how many were queued
+func (p *PostgreSQL) EnqueueWebhookDeliveries(workspaceID, eventID, eventType, payload string) (int64, error) {
+	query := `
+		INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload)
+		SELECT id, $2, $3, $4
+		FROM webhooks
+		WHERE workspace_id = $1 AND is_active = true
+		  AND (events = '[]'::jsonb OR events @> jsonb_build_array($3::text))`
+
+	result, err := p.Pool.Exec(p.ctx, query, workspaceID, eventID, eventType, payload)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+// ClaimWebhookDeliveries leases up to limit due deliveries of active webhooks,
+// oldest due first. A lease pushes the next attempt past the lease duration, so
+// other dispatchers skip the delivery until it is recorded or the lease lapses.
+func (p *PostgreSQL) ClaimWebhookDeliveries(limit int, lease time.Duration) ([]ClaimedWebhookDelivery, error) {
+	query := `
+		WITH claimed AS (
+			UPDATE webhook_deliveries
+			SET next_attempt_at = NOW() + make_interval(secs => $2)
+			WHERE id IN (
+				SELECT d.id FROM webhook_deliveries d
+				JOIN webhooks w ON w.id = d.webhook_id
+				WHERE d.status = 'pending' AND d.next_attempt_at <= NOW() AND w.is_active = true
+				ORDER BY d.next_attempt_at
+				LIMIT $1
+				FOR UPDATE OF d SKIP LOCKED)
+			RETURNING ` + webhookDeliveryColumns + `)
+		SELECT claimed.*, w.url, w.secret
+		FROM claimed
+		JOIN webhooks w ON w.id = claimed.webhook_id
+		ORDER BY claimed.next_attempt_at, claimed.id`
+
+	var deliveries []ClaimedWebhookDelivery
+	err := p.DB.Select(&deliveries, query, limit, lease.Seconds())
+	return deliveries, err
+}
+
+// RecordWebhookAttempt saves the outcome of sending a delivery. A success
+// resets the webhook's consecutive failures; a failure counts towards them and
+// turns the webhook off once they reach disableAfter (0 never does). It
+// reports whether this attempt turned the webhook off.
+func (p *PostgreSQL) RecordWebhookAttempt(attempt *WebhookAttempt, disableAfter int) (bool, error) {
+	tx, err := p.Pool.Begin(p.ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(p.ctx)
+
+	_, err = tx.Exec(p.ctx, `
+		UPDATE webhook_deliveries
+		SET status = $2, attempts = attempts + 1, last_attempt_at = NOW(), next_attempt_at = $3,
+		    response_status = $4, response_body = $5, error = $6,
+		    delivered_at = CASE WHEN $2 = 'succeeded' THEN NOW() END
+		WHERE id = $1`,
+		attempt.DeliveryID, attempt.Status, attempt.NextAttemptAt,
+		attempt.ResponseStatus, attempt.ResponseBody, attempt.Error,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	disabled := false
+	if attempt.Status == WebhookDeliverySucceeded {
+		_, err = tx.Exec(p.ctx, "UPDATE webhooks SET consecutive_failures = 0 WHERE id = $1", attempt.WebhookID)
+	} else {
+		err = tx.QueryRow(p.ctx, `
+			WITH previous AS (SELECT is_active FROM webhooks WHERE id = $1 FOR UPDATE)
+			UPDATE webhooks
+			SET consecutive_failures = consecutive_failures + 1,
+			    is_active = is_active AND NOT ($2 > 0 AND consecutive_failures + 1 >= $2),
+			    disabled_at = CASE WHEN is_active AND $2 > 0 AND consecutive_failures + 1 >= $2 THEN NOW() ELSE disabled_at END
+			WHERE id = $1
+			RETURNING (SELECT is_active FROM previous) AND NOT is_active`,
+			attempt.WebhookID, disableAfter,
+		).Scan(&disabled)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return disabled, tx.Commit(p.ctx)
+}
+
+// ListWebhookDeliveries lists the deliveries of a webhook, newest first;
+// status filters them when not empty
+func (p *PostgreSQL) ListWebhookDeliveries(webhookID int64, status string, cursor *Cursor, limit, offset int) ([]WebhookDelivery, error) {
+	where, args := webhookDeliveryConditions(webhookID, status)
+	condition, args, ascending, err := keyset("created_at", sortTime, cursor, false, args)
+	if err != nil {
+		return nil, err
+	}
+	if condition != "" {
+		where += " AND " + condition
+		offset = 0
+	}
+
+	args = append(args, limit, offset)
+	query := fmt.Sprintf(`
+		SELECT `+webhookDeliveryColumns+`
+		FROM webhook_deliveries
+		WHERE %s
+		ORDER BY created_at %s, id %s
+		LIMIT $%d OFFSET $%d`,
+		where, direction(ascending), direction(ascending), len(args)-1, len(args))
+
+	var deliveries []WebhookDelivery
+	if err := p.DB.Select(&deliveries, query, args...); err != nil {
+		return nil, err
+	}
+	reversePage(deliveries, cursor)
+	return deliveries, nil
+}
+
+// CountWebhookDeliveries counts the deliveries of a webhook; status filters them when not empty
+func (p *PostgreSQL) CountWebhookDeliveries(webhookID int64, status string) (int64, error) {
+	where, args := webhookDeliveryConditions(webhookID, status)
+	var count int64
+	err := p.DB.Get(&count, "SELECT COUNT(*) FROM webhook_deliveries WHERE "+where, args...)
+	return count, err
+}
+
+// webhookDeliveryConditions builds the WHERE clause selecting a webhook's deliveries
+func webhookDeliveryConditions(webhookID int64, status string) (string, []interface{}) {
+	where := "webhook_id = $1"
+	args := []interface{}{webhookID}
+	if status != "" {
+		args = append(args, status)
+		where += fmt.Sprintf(" AND status = $%d", len(args))
+	}
+	return where, args
+}
+
+// RedeliverWebhookDelivery queues a copy of a webhook's delivery, with the
+// same event ID and payload, and returns it
+func (p *PostgreSQL) RedeliverWebhookDelivery(webhookID, id int64) (*WebhookDelivery, error) {
+	var delivery WebhookDelivery
+	query := `
+		INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload)
+		SELECT webhook_id, event_id, event_type, payload
+		FROM webhook_deliveries
+		WHERE id = $1 AND webhook_id = $2
+		RETURNING ` + webhookDeliveryColumns
+
+	if err := p.DB.Get(&delivery, query, id, webhookID); err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// DeleteWebhookDeliveriesBefore removes the finished deliveries created before
+// the cutoff and returns how many were removed
+func (p *PostgreSQL) DeleteWebhookDeliveriesBefore(cutoff time.Time) (int64, error) {
+	result, err := p.Pool.Exec(p.ctx,
+		"DELETE FROM webhook_deliveries WHERE created_at < $1 AND status <> 'pending'", cutoff)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+// WebhookDeliveryCursor returns the cursor of a delivery
+func WebhookDeliveryCursor(delivery *WebhookDelivery, backward bool) Cursor {
+	return Cursor{Value: formatSortValue(delivery.CreatedAt), ID: delivery.ID, Backward: backward}
+}
@@ -0,0 +1,80 @@
+package database
+
+import (
+	"time"
+)
+
+// Workspace represents the workspaces table structure
+type Workspace struct {
+	ID          string    `db:"id" json:"id"`
+	Name        string    `db:"name" json:"name"`
+	OwnerID     string    `db:"owner_id" json:"owner_id"`
+	UTMTemplate string    `db:"utm_template" json:"utm_template"` // PostgreSQL JSONB
+	Plan        string    `db:"plan" json:"plan"`
+	CreatedAt   time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at" json:"updated_at"`
+
+	InactivityDays   int  `db:"inactivity_days" json:"inactivity_days"` // archive links not clicked for this many days, 0 for never
+	InactivityDryRun bool `db:"inactivity_dry_run" json:"inactivity_dry_run"`
+}
+
+// workspaceColumns lists the workspaces columns scanned into Workspace
+const workspaceColumns = `id, name, owner_id, utm_template, plan, created_at, updated_at, inactivity_days, inactivity_dry_run`
+
+// UpsertWorkspace creates a workspace or updates its name and UTM template
+func (p *PostgreSQL) UpsertWorkspace(ws *Workspace) error {
+	query := `
+		INSERT INTO workspaces (id, name, owner_id, utm_template)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (id) DO UPDATE
+		SET name = EXCLUDED.name, utm_template = EXCLUDED.utm_template, updated_at = NOW()
+		WHERE workspaces.owner_id = EXCLUDED.owner_id
+		RETURNING plan, created_at, updated_at, inactivity_days, inactivity_dry_run`
+
+	return p.Pool.QueryRow(p.ctx, query,
+		ws.ID, ws.Name, ws.OwnerID, jsonOrEmpty(ws.UTMTemplate),
+	).Scan(&ws.Plan, &ws.CreatedAt, &ws.UpdatedAt, &ws.InactivityDays, &ws.InactivityDryRun)
+}
+
+// SetWorkspacePlan changes the plan of a workspace
+func (p *PostgreSQL) SetWorkspacePlan(id, plan string) (*Workspace, error) {
+	var ws Workspace
+	query := `
+		UPDATE workspaces
+		SET plan = $2, updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + workspaceColumns
+
+	err := p.DB.Get(&ws, query, id, plan)
+	if err != nil {
+		return nil, err
+	}
+	return &ws, nil
+}
+
+// GetWorkspace retrieves a workspace by ID
+func (p *PostgreSQL) GetWorkspace(id string) (*Workspace, error) {
+	var ws Workspace
+	query := `
+		SELECT ` + workspaceColumns + `
+		FROM workspaces
+		WHERE id = $1`
+
+	err := p.DB.Get(&ws, query, id)
+	if err != nil {
+		return nil, err
+	}
+	return &ws, nil
+}
+
+// GetShortCodesByWorkspace lists the active links that belong to a workspace
+func (p *PostgreSQL) GetShortCodesByWorkspace(workspaceID string) ([]LinkRef, error) {
+	var shortCodes []LinkRef
+	query := `
+		SELECT domain, short_code
+		FROM url_mappings
+		WHERE workspace_id = $1 AND is_active = true`
+
+	err := p.DB.Select(&shortCodes, query, workspaceID)
+	return shortCodes, err
+}
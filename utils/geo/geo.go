@@ -0,0 +1,130 @@
+// Package geo maps client addresses to the countries they are registered in.
+// There is no built-in database: the table is loaded from a file of
+// "CIDR country" lines, e.g. converted from the RIR delegation files or a
+// GeoIP country export.
+package geo
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"net/netip"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Network is an address range registered in a country
+type Network struct {
+	Prefix  netip.Prefix
+	Country string // ISO 3166-1 alpha-2 code, upper case
+}
+
+// Table finds the country of an address, preferring the most specific prefix.
+// Prefixes are grouped by length so a lookup costs one map probe per length.
+type Table struct {
+	lengths4  []int // IPv4 prefix lengths present, longest first
+	lengths6  []int // IPv6 prefix lengths present, longest first
+	countries map[netip.Prefix]string
+}
+
+// NewTable creates a table of the given networks
+func NewTable(networks ...Network) *Table {
+	t := &Table{countries: make(map[netip.Prefix]string, len(networks))}
+	for _, network := range networks {
+		prefix := network.Prefix.Masked()
+		t.countries[prefix] = network.Country
+		if prefix.Addr().Is4() {
+			t.lengths4 = appendLength(t.lengths4, prefix.Bits())
+		} else {
+			t.lengths6 = appendLength(t.lengths6, prefix.Bits())
+		}
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(t.lengths4)))
+	sort.Sort(sort.Reverse(sort.IntSlice(t.lengths6)))
+	return t
+}
+
+// Country returns the country code of an address, or "" when it is unknown.
+// A nil table knows no countries.
+func (t *Table) Country(addr netip.Addr) string {
+	if t == nil || !addr.IsValid() {
+		return ""
+	}
+	addr = addr.Unmap().WithZone("")
+	lengths := t.lengths6
+	if addr.Is4() {
+		lengths = t.lengths4
+	}
+	for _, bits := range lengths {
+		prefix, err := addr.Prefix(bits)
+		if err != nil {
+			continue
+		}
+		if country, ok := t.countries[prefix]; ok {
+			return country
+		}
+	}
+	return ""
+}
+
+// Len returns the number of networks in the table
+func (t *Table) Len() int {
+	if t == nil {
+		return 0
+	}
+	return len(t.countries)
+}
+
+func appendLength(lengths []int, bits int) []int {
+	for _, length := range lengths {
+		if length == bits {
+			return lengths
+		}
+	}
+	return append(lengths, bits)
+}
+
+// ParseNetworks parses "CIDR country" lines
+func ParseNetworks(lines []string) ([]Network, error) {
+	networks := make([]Network, 0, len(lines))
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid network %q: want CIDR and country code", line)
+		}
+		prefix, err := netip.ParsePrefix(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid network %q: %w", line, err)
+		}
+		country := strings.ToUpper(fields[1])
+		if len(country) != 2 || strings.Trim(country, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+			return nil, fmt.Errorf("invalid country code in %q", line)
+		}
+		networks = append(networks, Network{Prefix: prefix.Masked(), Country: country})
+	}
+	return networks, nil
+}
+
+// LoadFile reads networks from a file of "CIDR country" lines; blank lines
+// and lines starting with # are skipped
+func LoadFile(path string) ([]Network, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return ParseNetworks(lines)
+}
@@ -0,0 +1,48 @@
+package geo
+
+import (
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableCountry(t *testing.T) {
+	networks, err := ParseNetworks([]string{
+		"81.0.0.0/8 DE",
+		"81.2.69.0/24 gb",
+		"2a02:2000::/20 NL",
+	})
+	require.NoError(t, err)
+	table := NewTable(networks...)
+	assert.Equal(t, 3, table.Len())
+
+	// The most specific prefix wins
+	assert.Equal(t, "GB", table.Country(netip.MustParseAddr("81.2.69.160")))
+	assert.Equal(t, "DE", table.Country(netip.MustParseAddr("81.3.1.1")))
+	assert.Equal(t, "DE", table.Country(netip.MustParseAddr("::ffff:81.3.1.1")))
+	assert.Equal(t, "NL", table.Country(netip.MustParseAddr("2a02:2001::1")))
+
+	assert.Empty(t, table.Country(netip.MustParseAddr("198.51.100.1")))
+	assert.Empty(t, table.Country(netip.Addr{}))
+	assert.Empty(t, (*Table)(nil).Country(netip.MustParseAddr("81.3.1.1")))
+}
+
+func TestParseNetworks(t *testing.T) {
+	_, err := ParseNetworks([]string{"81.0.0.0/8"})
+	assert.Error(t, err)
+	_, err = ParseNetworks([]string{"81.0.0.0/33 DE"})
+	assert.Error(t, err)
+	_, err = ParseNetworks([]string{"81.0.0.0/8 DEU"})
+	assert.Error(t, err)
+
+	path := filepath.Join(t.TempDir(), "countries.txt")
+	require.NoError(t, os.WriteFile(path, []byte("# countries\n\n192.0.2.0/24 us\n"), 0o600))
+	networks, err := LoadFile(path)
+	require.NoError(t, err)
+	require.Len(t, networks, 1)
+	assert.Equal(t, "US", networks[0].Country)
+}
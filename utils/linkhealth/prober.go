@@ -0,0 +1,255 @@
+// Package linkhealth probes link destinations to find broken ones.
+package linkhealth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// Prober defaults
+const (
+	DefaultConcurrency  = 10
+	DefaultHostDelay    = time.Second
+	DefaultTimeout      = 10 * time.Second
+	DefaultMaxRedirects = 10
+	userAgent           = "go-url-shortener-linkcheck/1.0"
+	maxBodyRead         = 64 << 10
+	maxTrackedHosts     = 1024
+)
+
+// Options configures a Prober
+type Options struct {
+	Concurrency  int           // parallel requests across all hosts
+	HostDelay    time.Duration // minimum spacing between requests to the same host
+	Timeout      time.Duration // per request
+	MaxRedirects int
+	// DialContext replaces the transport dialer, e.g. with a network policy
+	// that refuses internal addresses
+	DialContext func(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// Result is the outcome of probing one destination
+type Result struct {
+	StatusCode    int           `json:"status_code"`
+	Latency       time.Duration `json:"latency"`
+	RedirectChain []string      `json:"redirect_chain,omitempty"` // every URL visited after the first
+	Error         string        `json:"error,omitempty"`
+	CheckedAt     time.Time     `json:"checked_at"`
+}
+
+// Healthy reports whether the destination answered with a non-error status
+func (r *Result) Healthy() bool {
+	return r.Error == "" && r.StatusCode > 0 && r.StatusCode < 400
+}
+
+// Target is a destination to probe
+type Target struct {
+	ID  string
+	URL string
+}
+
+// Prober sends HEAD (falling back to GET) requests to destinations with a
+// global concurrency limit and per-host politeness
+type Prober struct {
+	client       *http.Client
+	concurrency  int
+	hostDelay    time.Duration
+	maxRedirects int
+
+	mu       sync.Mutex
+	nextSlot map[string]time.Time // host -> earliest time of the next request
+}
+
+// NewProber creates a prober; zero options take the package defaults
+func NewProber(opts Options) *Prober {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = DefaultConcurrency
+	}
+	if opts.HostDelay < 0 {
+		opts.HostDelay = 0
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultTimeout
+	}
+	if opts.MaxRedirects <= 0 {
+		opts.MaxRedirects = DefaultMaxRedirects
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// Connect directly: behind an environment proxy the dialer would only see
+	// the proxy's address, never the user-supplied destination
+	transport.Proxy = nil
+	if opts.DialContext != nil {
+		transport.DialContext = opts.DialContext
+	}
+	transport.MaxIdleConnsPerHost = 2
+
+	return &Prober{
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   opts.Timeout,
+			// Redirects are followed by hand so the chain can be recorded
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		concurrency:  opts.Concurrency,
+		hostDelay:    opts.HostDelay,
+		maxRedirects: opts.MaxRedirects,
+		nextSlot:     make(map[string]time.Time),
+	}
+}
+
+// CheckAll probes targets concurrently and calls report for each result.
+// report may be called from several goroutines at once.
+func (p *Prober) CheckAll(ctx context.Context, targets []Target, report func(Target, Result)) {
+	sem := make(chan struct{}, p.concurrency)
+	var wg sync.WaitGroup
+
+	for _, target := range targets {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(target Target) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			report(target, p.Check(ctx, target.URL))
+		}(target)
+	}
+	wg.Wait()
+}
+
+// Check probes one destination, following up to MaxRedirects redirects
+func (p *Prober) Check(ctx context.Context, rawURL string) Result {
+	start := time.Now()
+	result := Result{CheckedAt: start}
+
+	current := rawURL
+	for hops := 0; ; hops++ {
+		statusCode, location, err := p.request(ctx, current)
+		if err != nil {
+			result.Error = err.Error()
+			break
+		}
+		result.StatusCode = statusCode
+
+		if location == "" {
+			break
+		}
+		if hops >= p.maxRedirects {
+			result.Error = fmt.Sprintf("stopped after %d redirects", p.maxRedirects)
+			break
+		}
+
+		next, err := resolveLocation(current, location)
+		if err != nil {
+			result.Error = err.Error()
+			break
+		}
+		result.RedirectChain = append(result.RedirectChain, next)
+		current = next
+	}
+
+	result.Latency = time.Since(start)
+	return result
+}
+
+// request sends HEAD, retrying with GET when the server does not support HEAD,
+// and returns the status code and redirect location (if any)
+func (p *Prober) request(ctx context.Context, rawURL string) (int, string, error) {
+	statusCode, location, err := p.do(ctx, http.MethodHead, rawURL)
+	if err == nil && statusCode != http.StatusMethodNotAllowed && statusCode != http.StatusNotImplemented && statusCode != http.StatusForbidden {
+		return statusCode, location, nil
+	}
+	return p.do(ctx, http.MethodGet, rawURL)
+}
+
+func (p *Prober) do(ctx context.Context, method, rawURL string) (int, string, error) {
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, nil)
+	if err != nil {
+		return 0, "", err
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	if err := p.waitForHost(ctx, req.URL.Host); err != nil {
+		return 0, "", err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxBodyRead))
+
+	if resp.StatusCode >= 300 && resp.StatusCode < 400 {
+		return resp.StatusCode, resp.Header.Get("Location"), nil
+	}
+	return resp.StatusCode, "", nil
+}
+
+// waitForHost reserves the next request slot for a host and sleeps until it
+func (p *Prober) waitForHost(ctx context.Context, host string) error {
+	if p.hostDelay == 0 {
+		return nil
+	}
+
+	p.mu.Lock()
+	now := time.Now()
+	if len(p.nextSlot) > maxTrackedHosts {
+		for trackedHost, next := range p.nextSlot {
+			if next.Before(now) {
+				delete(p.nextSlot, trackedHost)
+			}
+		}
+	}
+	slot := p.nextSlot[host]
+	if slot.Before(now) {
+		slot = now
+	}
+	p.nextSlot[host] = slot.Add(p.hostDelay)
+	p.mu.Unlock()
+
+	wait := time.Until(slot)
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// resolveLocation resolves a Location header against the URL that returned it
+func resolveLocation(base, location string) (string, error) {
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+	locationURL, err := url.Parse(location)
+	if err != nil {
+		return "", fmt.Errorf("invalid redirect location %q", location)
+	}
+	return baseURL.ResolveReference(locationURL).String(), nil
+}
@@ -0,0 +1,134 @@
+package linkhealth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProberCheck(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("/moved", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/moved-again", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/moved-again", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/ok", http.StatusFound)
+	})
+	mux.HandleFunc("/loop", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/loop", http.StatusFound)
+	})
+	mux.HandleFunc("/get-only", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.Write([]byte("hello"))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	prober := NewProber(Options{HostDelay: -1, MaxRedirects: 3})
+	ctx := context.Background()
+
+	result := prober.Check(ctx, server.URL+"/ok")
+	assert.True(t, result.Healthy())
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+	assert.Empty(t, result.RedirectChain)
+	assert.Positive(t, result.Latency)
+
+	result = prober.Check(ctx, server.URL+"/missing")
+	assert.False(t, result.Healthy())
+	assert.Equal(t, http.StatusNotFound, result.StatusCode)
+
+	result = prober.Check(ctx, server.URL+"/moved")
+	assert.True(t, result.Healthy())
+	assert.Equal(t, []string{server.URL + "/moved-again", server.URL + "/ok"}, result.RedirectChain)
+
+	result = prober.Check(ctx, server.URL+"/loop")
+	assert.False(t, result.Healthy())
+	assert.Contains(t, result.Error, "stopped after 3 redirects")
+	assert.Len(t, result.RedirectChain, 3)
+
+	result = prober.Check(ctx, server.URL+"/get-only")
+	assert.True(t, result.Healthy())
+
+	server.Close()
+	result = prober.Check(ctx, server.URL+"/ok")
+	assert.False(t, result.Healthy())
+	assert.NotEmpty(t, result.Error)
+}
+
+func TestProberTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	prober := NewProber(Options{HostDelay: -1, Timeout: 50 * time.Millisecond})
+	result := prober.Check(context.Background(), server.URL)
+	assert.False(t, result.Healthy())
+	assert.NotEmpty(t, result.Error)
+}
+
+func TestProberCheckAllLimitsConcurrencyAndSpacesHosts(t *testing.T) {
+	var inFlight, maxInFlight int32
+	var mu sync.Mutex
+	var requestTimes []time.Time
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			seen := atomic.LoadInt32(&maxInFlight)
+			if current <= seen || atomic.CompareAndSwapInt32(&maxInFlight, seen, current) {
+				break
+			}
+		}
+		mu.Lock()
+		requestTimes = append(requestTimes, time.Now())
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	targets := []Target{
+		{ID: "a", URL: server.URL + "/a"},
+		{ID: "b", URL: server.URL + "/b"},
+		{ID: "c", URL: server.URL + "/c"},
+		{ID: "d", URL: server.URL + "/d"},
+	}
+
+	prober := NewProber(Options{Concurrency: 2, HostDelay: 30 * time.Millisecond})
+	results := make(map[string]Result)
+	var resultsMu sync.Mutex
+	prober.CheckAll(context.Background(), targets, func(target Target, result Result) {
+		resultsMu.Lock()
+		results[target.ID] = result
+		resultsMu.Unlock()
+	})
+
+	require.Len(t, results, 4)
+	for id, result := range results {
+		assert.True(t, result.Healthy(), id)
+	}
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(2))
+
+	// All targets share one host, so requests are spaced by the host delay
+	require.Len(t, requestTimes, 4)
+	for i := 1; i < len(requestTimes); i++ {
+		assert.GreaterOrEqual(t, requestTimes[i].Sub(requestTimes[i-1]), 25*time.Millisecond)
+	}
+}
@@ -0,0 +1,44 @@
+package preview
+
+import "strings"
+
+// unfurlBots are user agent fragments of chat apps and social networks that
+// fetch links to build previews
+var unfurlBots = []string{
+	"slackbot",
+	"slack-imgproxy",
+	"twitterbot",
+	"facebookexternalhit",
+	"facebookcatalog",
+	"meta-externalagent",
+	"linkedinbot",
+	"discordbot",
+	"telegrambot",
+	"whatsapp",
+	"skypeuripreview",
+	"microsoftpreview",
+	"redditbot",
+	"pinterestbot",
+	"embedly",
+	"mastodon",
+	"bluesky",
+	"vkshare",
+	"viber",
+	"line-poker",
+	"iframely",
+	"google-pagerenderer",
+}
+
+// IsUnfurlBot reports whether a user agent belongs to a known link preview bot
+func IsUnfurlBot(userAgent string) bool {
+	ua := strings.ToLower(userAgent)
+	if ua == "" {
+		return false
+	}
+	for _, bot := range unfurlBots {
+		if strings.Contains(ua, bot) {
+			return true
+		}
+	}
+	return false
+}
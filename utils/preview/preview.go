@@ -0,0 +1,237 @@
+// Package preview fetches Open Graph and Twitter card metadata for link unfurls.
+package preview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+// Fetcher defaults and field limits
+const (
+	DefaultTimeout    = 10 * time.Second
+	maxRedirects      = 5
+	maxBodyRead       = 512 << 10 // metadata lives in <head>; stop long before the body of large pages
+	MaxTitleLength    = 300
+	MaxDescriptionLen = 1000
+	MaxImageURLLength = 2048
+	userAgent         = "go-url-shortener-preview/1.0 (+link unfurl)"
+)
+
+// ErrNotHTML is returned when the destination does not serve an HTML page
+var ErrNotHTML = errors.New("destination is not an HTML page")
+
+// Metadata is the unfurl information of a page
+type Metadata struct {
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Image       string `json:"image,omitempty"`
+}
+
+// IsEmpty reports whether no metadata was found
+func (m *Metadata) IsEmpty() bool {
+	return m.Title == "" && m.Description == "" && m.Image == ""
+}
+
+// Options configures a Fetcher
+type Options struct {
+	Timeout time.Duration
+	// DialContext replaces the transport dialer, e.g. with a network policy
+	// that refuses internal addresses
+	DialContext func(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// Fetcher downloads pages and extracts their preview metadata
+type Fetcher struct {
+	client *http.Client
+}
+
+// NewFetcher creates a fetcher; zero options take the package defaults
+func NewFetcher(opts Options) *Fetcher {
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultTimeout
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// Connect directly: behind an environment proxy the dialer would only see
+	// the proxy's address, never the user-supplied destination
+	transport.Proxy = nil
+	if opts.DialContext != nil {
+		transport.DialContext = opts.DialContext
+	}
+
+	return &Fetcher{
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   opts.Timeout,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if len(via) >= maxRedirects {
+					return fmt.Errorf("stopped after %d redirects", maxRedirects)
+				}
+				return nil
+			},
+		},
+	}
+}
+
+// Fetch downloads a page and extracts its Open Graph / Twitter card metadata,
+// falling back to <title> and the description meta tag
+func (f *Fetcher) Fetch(ctx context.Context, rawURL string) (*Metadata, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml;q=0.9,*/*;q=0.1")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("destination returned status %d", resp.StatusCode)
+	}
+	if mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type")); mediaType != "text/html" && mediaType != "application/xhtml+xml" {
+		return nil, ErrNotHTML
+	}
+
+	return Parse(io.LimitReader(resp.Body, maxBodyRead), resp.Request.URL), nil
+}
+
+// Parse extracts preview metadata from an HTML document; relative image URLs
+// are resolved against base
+func Parse(r io.Reader, base *url.URL) *Metadata {
+	var og, twitter, fallback Metadata
+	var inTitle bool
+
+	tokenizer := html.NewTokenizer(r)
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return finish(base, og, twitter, fallback)
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			switch token.Data {
+			case "body":
+				return finish(base, og, twitter, fallback)
+			case "title":
+				inTitle = true
+			case "meta":
+				key, content := metaTag(token)
+				switch key {
+				case "og:title":
+					setOnce(&og.Title, content)
+				case "og:description":
+					setOnce(&og.Description, content)
+				case "og:image", "og:image:url", "og:image:secure_url":
+					setOnce(&og.Image, content)
+				case "twitter:title":
+					setOnce(&twitter.Title, content)
+				case "twitter:description":
+					setOnce(&twitter.Description, content)
+				case "twitter:image", "twitter:image:src":
+					setOnce(&twitter.Image, content)
+				case "description":
+					setOnce(&fallback.Description, content)
+				}
+			}
+
+		case html.TextToken:
+			if inTitle {
+				setOnce(&fallback.Title, string(tokenizer.Text()))
+			}
+
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			switch string(name) {
+			case "title":
+				inTitle = false
+			case "head":
+				return finish(base, og, twitter, fallback)
+			}
+		}
+	}
+}
+
+// metaTag returns the property/name and content of a <meta> tag, lower-casing the key
+func metaTag(token html.Token) (string, string) {
+	var key, content string
+	for _, attr := range token.Attr {
+		switch strings.ToLower(attr.Key) {
+		case "property", "name":
+			if key == "" {
+				key = strings.ToLower(strings.TrimSpace(attr.Val))
+			}
+		case "content":
+			content = attr.Val
+		}
+	}
+	return key, content
+}
+
+// finish picks Open Graph over Twitter card over plain HTML values and cleans them up
+func finish(base *url.URL, og, twitter, fallback Metadata) *Metadata {
+	metadata := &Metadata{
+		Title:       Clean(firstNonEmpty(og.Title, twitter.Title, fallback.Title), MaxTitleLength),
+		Description: Clean(firstNonEmpty(og.Description, twitter.Description, fallback.Description), MaxDescriptionLen),
+	}
+	if image := firstNonEmpty(og.Image, twitter.Image); image != "" {
+		metadata.Image = resolveImage(base, image)
+	}
+	return metadata
+}
+
+// resolveImage turns an image reference into an absolute http(s) URL, or "" if it cannot be used
+func resolveImage(base *url.URL, image string) string {
+	ref, err := url.Parse(strings.TrimSpace(image))
+	if err != nil {
+		return ""
+	}
+	if base != nil {
+		ref = base.ResolveReference(ref)
+	}
+	if (ref.Scheme != "http" && ref.Scheme != "https") || ref.Host == "" {
+		return ""
+	}
+	resolved := ref.String()
+	if len(resolved) > MaxImageURLLength {
+		return ""
+	}
+	return resolved
+}
+
+// Clean collapses whitespace and truncates a value to max runes
+func Clean(value string, max int) string {
+	value = strings.Join(strings.Fields(value), " ")
+	if runes := []rune(value); len(runes) > max {
+		value = strings.TrimSpace(string(runes[:max-1])) + "…"
+	}
+	return value
+}
+
+func setOnce(field *string, value string) {
+	if *field == "" {
+		*field = strings.TrimSpace(value)
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,107 @@
+package preview
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	base, _ := url.Parse("https://example.com/blog/post")
+
+	page := `<!DOCTYPE html><html><head>
+		<title>  Fallback
+		   title </title>
+		<meta name="description" content="Plain description">
+		<meta name="twitter:title" content="Twitter title">
+		<meta property="og:title" content="Open Graph title">
+		<meta name="twitter:image" content="https://cdn.example.com/twitter.png">
+		<meta property="og:image" content="/images/cover.png">
+		</head><body><meta property="og:description" content="ignored, outside head"></body></html>`
+
+	metadata := Parse(strings.NewReader(page), base)
+	assert.Equal(t, "Open Graph title", metadata.Title)
+	assert.Equal(t, "Plain description", metadata.Description)
+	assert.Equal(t, "https://example.com/images/cover.png", metadata.Image)
+
+	metadata = Parse(strings.NewReader(`<html><head><title>Only a title</title>
+		<meta property="og:image" content="javascript:alert(1)"></head></html>`), base)
+	assert.Equal(t, "Only a title", metadata.Title)
+	assert.Empty(t, metadata.Image)
+
+	metadata = Parse(strings.NewReader(`<html><body>no head</body></html>`), base)
+	assert.True(t, metadata.IsEmpty())
+}
+
+func TestClean(t *testing.T) {
+	assert.Equal(t, "a b c", Clean(" a \n b\tc ", 10))
+	assert.Equal(t, "abcd…", Clean("abcdefgh", 5))
+	assert.Equal(t, "héllo", Clean("héllo", 5))
+}
+
+func TestFetcherFetch(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/article", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte(`<html><head><meta property="og:title" content="Article">
+			<meta property="og:description" content="About things">
+			<meta property="og:image" content="/cover.jpg"></head></html>`))
+	})
+	mux.HandleFunc("/moved", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/article", http.StatusFound)
+	})
+	mux.HandleFunc("/file.pdf", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Write([]byte("%PDF-1.7"))
+	})
+	mux.HandleFunc("/gone", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	fetcher := NewFetcher(Options{})
+	ctx := context.Background()
+
+	metadata, err := fetcher.Fetch(ctx, server.URL+"/moved")
+	require.NoError(t, err)
+	assert.Equal(t, "Article", metadata.Title)
+	assert.Equal(t, "About things", metadata.Description)
+	assert.Equal(t, server.URL+"/cover.jpg", metadata.Image)
+
+	_, err = fetcher.Fetch(ctx, server.URL+"/file.pdf")
+	assert.ErrorIs(t, err, ErrNotHTML)
+
+	_, err = fetcher.Fetch(ctx, server.URL+"/gone")
+	assert.Error(t, err)
+}
+
+func TestIsUnfurlBot(t *testing.T) {
+	bots := []string{
+		"Slackbot-LinkExpanding 1.0 (+https://api.slack.com/robots)",
+		"Twitterbot/1.0",
+		"facebookexternalhit/1.1 (+http://www.facebook.com/externalhit_uatext.php)",
+		"Mozilla/5.0 (compatible; Discordbot/2.0; +https://discordapp.com)",
+		"WhatsApp/2.23.20.0",
+		"TelegramBot (like TwitterBot)",
+		"LinkedInBot/1.0 (compatible; Mozilla/5.0; Apache-HttpClient +http://www.linkedin.com)",
+	}
+	for _, ua := range bots {
+		assert.True(t, IsUnfurlBot(ua), ua)
+	}
+
+	humans := []string{
+		"",
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0 Safari/537.36",
+		"Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Mobile/15E148 Safari/604.1",
+	}
+	for _, ua := range humans {
+		assert.False(t, IsUnfurlBot(ua), ua)
+	}
+}
@@ -0,0 +1,356 @@
+// Package qr renders QR codes as PNG or SVG with custom colours and an optional centred logo.
+package qr
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"math"
+	"strconv"
+	"strings"
+
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// Output formats
+const (
+	FormatPNG = "png"
+	FormatSVG = "svg"
+)
+
+// Rendering defaults and limits
+const (
+	DefaultSize   = 256
+	MinSize       = 64
+	MaxSize       = 2048
+	DefaultMargin = 4 // quiet zone in modules, as recommended by the QR specification
+	MaxMargin     = 16
+	logoRatio     = 0.22 // share of the symbol width a logo may cover at level H
+	minContrast   = 3.0  // WCAG contrast ratio below which scanners struggle
+)
+
+// Level is an error correction level: L (7%), M (15%), Q (25%) or H (30%)
+type Level string
+
+// Error correction levels
+const (
+	LevelLow     Level = "L"
+	LevelMedium  Level = "M"
+	LevelHigh    Level = "Q"
+	LevelHighest Level = "H"
+)
+
+// Errors returned for invalid options
+var (
+	ErrInvalidFormat = errors.New("format must be png or svg")
+	ErrInvalidSize   = fmt.Errorf("size must be between %d and %d pixels", MinSize, MaxSize)
+	ErrInvalidMargin = fmt.Errorf("margin must be between 0 and %d modules", MaxMargin)
+	ErrInvalidLevel  = errors.New("error correction level must be L, M, Q or H")
+	ErrInvalidColor  = errors.New("colours must be hex values such as #000000 or #ffffff00")
+	ErrLowContrast   = errors.New("foreground and background colours do not contrast enough to scan")
+)
+
+// Options configures how a QR code is rendered; zero values take the package defaults
+type Options struct {
+	Format     string
+	Size       int // width and height of the output in pixels
+	Margin     *int
+	Level      Level
+	Foreground color.NRGBA
+	Background color.NRGBA
+	Logo       image.Image // drawn in the centre; forces level H
+}
+
+// ParseLevel parses an error correction level, case-insensitively; "" means M
+func ParseLevel(value string) (Level, error) {
+	switch level := Level(strings.ToUpper(strings.TrimSpace(value))); level {
+	case "":
+		return LevelMedium, nil
+	case LevelLow, LevelMedium, LevelHigh, LevelHighest:
+		return level, nil
+	}
+	return "", ErrInvalidLevel
+}
+
+// ParseColor parses #rgb, #rrggbb or #rrggbbaa (the # is optional)
+func ParseColor(value string) (color.NRGBA, error) {
+	hex := strings.TrimPrefix(strings.TrimSpace(value), "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) == 6 {
+		hex += "ff"
+	}
+	if len(hex) != 8 {
+		return color.NRGBA{}, ErrInvalidColor
+	}
+	rgba, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return color.NRGBA{}, ErrInvalidColor
+	}
+	return color.NRGBA{R: uint8(rgba >> 24), G: uint8(rgba >> 16), B: uint8(rgba >> 8), A: uint8(rgba)}, nil
+}
+
+// HexColor formats a colour as #rrggbb, or #rrggbbaa when it is not opaque
+func HexColor(c color.NRGBA) string {
+	if c.A == 0xff {
+		return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+	}
+	return fmt.Sprintf("#%02x%02x%02x%02x", c.R, c.G, c.B, c.A)
+}
+
+// Normalize validates the options and fills in defaults
+func (o *Options) Normalize() error {
+	switch strings.ToLower(o.Format) {
+	case "", FormatPNG:
+		o.Format = FormatPNG
+	case FormatSVG:
+		o.Format = FormatSVG
+	default:
+		return ErrInvalidFormat
+	}
+
+	if o.Size == 0 {
+		o.Size = DefaultSize
+	}
+	if o.Size < MinSize || o.Size > MaxSize {
+		return ErrInvalidSize
+	}
+
+	if o.Margin == nil {
+		margin := DefaultMargin
+		o.Margin = &margin
+	}
+	if *o.Margin < 0 || *o.Margin > MaxMargin {
+		return ErrInvalidMargin
+	}
+
+	level, err := ParseLevel(string(o.Level))
+	if err != nil {
+		return err
+	}
+	o.Level = level
+	if o.Logo != nil {
+		o.Level = LevelHighest // the logo hides modules; only H leaves enough redundancy
+	}
+
+	if o.Foreground == (color.NRGBA{}) {
+		o.Foreground = color.NRGBA{A: 0xff}
+	}
+	if o.Background == (color.NRGBA{}) {
+		o.Background = color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	}
+	if contrastRatio(o.Foreground, o.Background) < minContrast {
+		return ErrLowContrast
+	}
+	return nil
+}
+
+// ContentType returns the MIME type of the rendered output
+func (o *Options) ContentType() string {
+	if o.Format == FormatSVG {
+		return "image/svg+xml"
+	}
+	return "image/png"
+}
+
+// Render encodes content as a QR code in the requested format
+func Render(content string, opts Options) ([]byte, error) {
+	if err := opts.Normalize(); err != nil {
+		return nil, err
+	}
+
+	code, err := qrcode.New(content, recoveryLevel(opts.Level))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode QR code: %w", err)
+	}
+	code.DisableBorder = true
+
+	symbol := newLayout(code.Bitmap(), opts)
+	if symbol.scale < 1 {
+		return nil, fmt.Errorf("%w: %d pixels cannot fit %d modules", ErrInvalidSize, opts.Size, symbol.modules)
+	}
+
+	if opts.Format == FormatSVG {
+		return renderSVG(symbol, opts)
+	}
+	return renderPNG(symbol, opts)
+}
+
+// layout positions the module grid inside the output image
+type layout struct {
+	bitmap  [][]bool
+	margin  int
+	modules int // symbol width including the quiet zone
+	scale   int // pixels per module in raster output
+	offset  int // extra pixels around the grid so the image is exactly opts.Size wide
+}
+
+func newLayout(bitmap [][]bool, opts Options) layout {
+	modules := len(bitmap) + 2**opts.Margin
+	scale := opts.Size / modules
+	return layout{
+		bitmap:  bitmap,
+		margin:  *opts.Margin,
+		modules: modules,
+		scale:   scale,
+		offset:  (opts.Size - modules*scale) / 2,
+	}
+}
+
+// logoBox returns the side and origin of the logo area in module units
+func (l layout) logoBox() (side, origin float64) {
+	side = float64(len(l.bitmap)) * logoRatio
+	origin = float64(l.margin) + (float64(len(l.bitmap))-side)/2
+	return side, origin
+}
+
+func renderPNG(symbol layout, opts Options) ([]byte, error) {
+	img := image.NewNRGBA(image.Rect(0, 0, opts.Size, opts.Size))
+	draw.Draw(img, img.Bounds(), image.NewUniform(opts.Background), image.Point{}, draw.Src)
+
+	dark := image.NewUniform(opts.Foreground)
+	for y, row := range symbol.bitmap {
+		for x, on := range row {
+			if !on {
+				continue
+			}
+			px := symbol.offset + (symbol.margin+x)*symbol.scale
+			py := symbol.offset + (symbol.margin+y)*symbol.scale
+			draw.Draw(img, image.Rect(px, py, px+symbol.scale, py+symbol.scale), dark, image.Point{}, draw.Src)
+		}
+	}
+
+	if opts.Logo != nil {
+		side, origin := symbol.logoBox()
+		pad := int(math.Round(side * float64(symbol.scale) * 0.1))
+		box := image.Rect(0, 0, int(side*float64(symbol.scale)), int(side*float64(symbol.scale))).
+			Add(image.Pt(symbol.offset+int(origin*float64(symbol.scale)), symbol.offset+int(origin*float64(symbol.scale))))
+		draw.Draw(img, box, image.NewUniform(opts.Background), image.Point{}, draw.Src)
+		logo := fit(opts.Logo, box.Dx()-2*pad, box.Dy()-2*pad)
+		at := image.Pt(box.Min.X+(box.Dx()-logo.Bounds().Dx())/2, box.Min.Y+(box.Dy()-logo.Bounds().Dy())/2)
+		draw.Draw(img, logo.Bounds().Add(at), logo, image.Point{}, draw.Over)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode PNG: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func renderSVG(symbol layout, opts Options) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`,
+		opts.Size, opts.Size, symbol.modules, symbol.modules)
+	fmt.Fprintf(&buf, `<rect width="100%%" height="100%%" %s/>`, svgFill(opts.Background))
+
+	// One path of horizontal runs keeps the document small
+	fmt.Fprintf(&buf, `<path %s d="`, svgFill(opts.Foreground))
+	for y, row := range symbol.bitmap {
+		for x := 0; x < len(row); x++ {
+			if !row[x] {
+				continue
+			}
+			start := x
+			for x < len(row) && row[x] {
+				x++
+			}
+			fmt.Fprintf(&buf, "M%d %dh%dv1h-%dz", symbol.margin+start, symbol.margin+y, x-start, x-start)
+		}
+	}
+	buf.WriteString(`"/>`)
+
+	if opts.Logo != nil {
+		side, origin := symbol.logoBox()
+		pad := side * 0.1
+		var logo bytes.Buffer
+		if err := png.Encode(&logo, opts.Logo); err != nil {
+			return nil, fmt.Errorf("failed to encode logo: %w", err)
+		}
+		fmt.Fprintf(&buf, `<rect x="%.2f" y="%.2f" width="%.2f" height="%.2f" %s/>`, origin, origin, side, side, svgFill(opts.Background))
+		fmt.Fprintf(&buf, `<image x="%.2f" y="%.2f" width="%.2f" height="%.2f" preserveAspectRatio="xMidYMid meet" href="data:image/png;base64,%s"/>`,
+			origin+pad, origin+pad, side-2*pad, side-2*pad, base64.StdEncoding.EncodeToString(logo.Bytes()))
+	}
+
+	buf.WriteString(`</svg>`)
+	return buf.Bytes(), nil
+}
+
+func svgFill(c color.NRGBA) string {
+	fill := fmt.Sprintf(`fill="#%02x%02x%02x"`, c.R, c.G, c.B)
+	if c.A != 0xff {
+		fill += fmt.Sprintf(` fill-opacity="%.3f"`, float64(c.A)/0xff)
+	}
+	return fill
+}
+
+// fit scales an image to fit within width x height, keeping its aspect ratio.
+// Each output pixel averages the source pixels it covers, which is good enough for logos.
+func fit(src image.Image, width, height int) image.Image {
+	bounds := src.Bounds()
+	if width <= 0 || height <= 0 || bounds.Empty() {
+		return image.NewNRGBA(image.Rectangle{})
+	}
+	ratio := math.Min(float64(width)/float64(bounds.Dx()), float64(height)/float64(bounds.Dy()))
+	w := max(1, int(float64(bounds.Dx())*ratio))
+	h := max(1, int(float64(bounds.Dy())*ratio))
+
+	dst := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		y0 := bounds.Min.Y + y*bounds.Dy()/h
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*bounds.Dy()/h)
+		for x := 0; x < w; x++ {
+			x0 := bounds.Min.X + x*bounds.Dx()/w
+			x1 := max(x0+1, bounds.Min.X+(x+1)*bounds.Dx()/w)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := src.At(sx, sy).RGBA()
+					r, g, b, a, n = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa), n+1
+				}
+			}
+			dst.Set(x, y, color.RGBA64{R: uint16(r / n), G: uint16(g / n), B: uint16(b / n), A: uint16(a / n)})
+		}
+	}
+	return dst
+}
+
+func recoveryLevel(level Level) qrcode.RecoveryLevel {
+	switch level {
+	case LevelLow:
+		return qrcode.Low
+	case LevelHigh:
+		return qrcode.High
+	case LevelHighest:
+		return qrcode.Highest
+	}
+	return qrcode.Medium
+}
+
+// contrastRatio is the WCAG contrast ratio of two colours, with alpha blended onto white
+func contrastRatio(a, b color.NRGBA) float64 {
+	la, lb := luminance(a), luminance(b)
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+func luminance(c color.NRGBA) float64 {
+	channel := func(v uint8) float64 {
+		alpha := float64(c.A) / 0xff
+		s := (float64(v)*alpha + 0xff*(1-alpha)) / 0xff
+		if s <= 0.03928 {
+			return s / 12.92
+		}
+		return math.Pow((s+0.055)/1.055, 2.4)
+	}
+	return 0.2126*channel(c.R) + 0.7152*channel(c.G) + 0.0722*channel(c.B)
+}
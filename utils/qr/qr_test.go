@@ -0,0 +1,124 @@
+package qr
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseColor(t *testing.T) {
+	c, err := ParseColor("#1a2B3c")
+	require.NoError(t, err)
+	assert.Equal(t, color.NRGBA{R: 0x1a, G: 0x2b, B: 0x3c, A: 0xff}, c)
+
+	c, err = ParseColor("f00")
+	require.NoError(t, err)
+	assert.Equal(t, color.NRGBA{R: 0xff, A: 0xff}, c)
+
+	c, err = ParseColor("#ffffff00")
+	require.NoError(t, err)
+	assert.Equal(t, uint8(0), c.A)
+	assert.Equal(t, "#ffffff00", HexColor(c))
+
+	for _, value := range []string{"", "#12", "#gggggg", "red"} {
+		_, err := ParseColor(value)
+		assert.ErrorIs(t, err, ErrInvalidColor, value)
+	}
+}
+
+func TestOptionsNormalize(t *testing.T) {
+	opts := Options{}
+	require.NoError(t, opts.Normalize())
+	assert.Equal(t, FormatPNG, opts.Format)
+	assert.Equal(t, DefaultSize, opts.Size)
+	assert.Equal(t, DefaultMargin, *opts.Margin)
+	assert.Equal(t, LevelMedium, opts.Level)
+
+	opts = Options{Level: "l", Logo: image.NewNRGBA(image.Rect(0, 0, 4, 4))}
+	require.NoError(t, opts.Normalize())
+	assert.Equal(t, LevelHighest, opts.Level, "a logo forces the highest error correction")
+
+	margin := MaxMargin + 1
+	assert.ErrorIs(t, (&Options{Margin: &margin}).Normalize(), ErrInvalidMargin)
+	assert.ErrorIs(t, (&Options{Size: MaxSize + 1}).Normalize(), ErrInvalidSize)
+	assert.ErrorIs(t, (&Options{Format: "gif"}).Normalize(), ErrInvalidFormat)
+	assert.ErrorIs(t, (&Options{Level: "X"}).Normalize(), ErrInvalidLevel)
+
+	grey := color.NRGBA{R: 0xee, G: 0xee, B: 0xee, A: 0xff}
+	assert.ErrorIs(t, (&Options{Foreground: grey}).Normalize(), ErrLowContrast)
+}
+
+func TestRenderPNG(t *testing.T) {
+	margin := 2
+	data, err := Render("https://short.ly/abc123?src=qr", Options{
+		Size:       300,
+		Margin:     &margin,
+		Foreground: color.NRGBA{R: 0x10, G: 0x20, B: 0x80, A: 0xff},
+	})
+	require.NoError(t, err)
+
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 300, 300), img.Bounds())
+
+	// The corner is quiet zone; the finder pattern starts right after the margin
+	assert.Equal(t, color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, color.NRGBAModel.Convert(img.At(0, 0)))
+	symbol := newLayout(make([][]bool, 25), Options{Size: 300, Margin: &margin})
+	at := symbol.offset + margin*symbol.scale + 1
+	assert.Equal(t, color.NRGBA{R: 0x10, G: 0x20, B: 0x80, A: 0xff}, color.NRGBAModel.Convert(img.At(at, at)))
+}
+
+func TestRenderSVGWithLogo(t *testing.T) {
+	logo := image.NewNRGBA(image.Rect(0, 0, 40, 20))
+	data, err := Render("https://short.ly/abc123", Options{Format: FormatSVG, Logo: logo})
+	require.NoError(t, err)
+
+	svg := string(data)
+	assert.True(t, strings.HasPrefix(svg, "<svg "))
+	assert.Contains(t, svg, `width="256" height="256"`)
+	assert.Contains(t, svg, `<path fill="#000000" d="M4 4h7v1h-7z`)
+	assert.Contains(t, svg, `href="data:image/png;base64,`)
+}
+
+func TestRenderTooSmall(t *testing.T) {
+	content := strings.Repeat("https://example.com/a-very-long-path/", 40)
+	_, err := Render(content, Options{Size: MinSize})
+	assert.ErrorIs(t, err, ErrInvalidSize)
+}
+
+func TestLogoFetcherFetch(t *testing.T) {
+	var logo bytes.Buffer
+	require.NoError(t, png.Encode(&logo, image.NewNRGBA(image.Rect(0, 0, 32, 32))))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/logo.png", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(logo.Bytes())
+	})
+	mux.HandleFunc("/page.html", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("<html></html>"))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	fetcher := NewLogoFetcher(LogoOptions{})
+
+	img, err := fetcher.Fetch(context.Background(), server.URL+"/logo.png")
+	require.NoError(t, err)
+	assert.Equal(t, 32, img.Bounds().Dx())
+
+	_, err = fetcher.Fetch(context.Background(), server.URL+"/page.html")
+	assert.ErrorIs(t, err, ErrInvalidLogo)
+
+	_, err = fetcher.Fetch(context.Background(), server.URL+"/missing.png")
+	assert.ErrorIs(t, err, ErrInvalidLogo)
+}
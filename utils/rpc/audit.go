@@ -0,0 +1,45 @@
+package rpc
+
+import (
+	"context"
+
+	"go-micro.dev/v5/metadata"
+)
+
+// Request metadata forwarded from the REST API to the services for the audit log
+const (
+	ActorMetadataKey     = "Audit-Actor" // set for admin requests; user requests are attributed to their user_id
+	ClientIPMetadataKey  = "Client-Ip"
+	UserAgentMetadataKey = "Client-User-Agent"
+	RequestIDMetadataKey = "X-Request-Id"
+)
+
+// Caller describes where an RPC request came from
+type Caller struct {
+	Actor     string
+	IP        string
+	UserAgent string
+	RequestID string
+}
+
+// WithCaller attaches the caller to the metadata of outgoing RPC requests
+func WithCaller(ctx context.Context, caller Caller) context.Context {
+	return metadata.MergeContext(ctx, metadata.Metadata{
+		ActorMetadataKey:     caller.Actor,
+		ClientIPMetadataKey:  caller.IP,
+		UserAgentMetadataKey: caller.UserAgent,
+		RequestIDMetadataKey: caller.RequestID,
+	}, true)
+}
+
+// CallerFromContext reads the caller of an incoming RPC request; fields the
+// client did not send are empty
+func CallerFromContext(ctx context.Context) Caller {
+	md, _ := metadata.FromContext(ctx)
+	var caller Caller
+	caller.Actor, _ = md.Get(ActorMetadataKey)
+	caller.IP, _ = md.Get(ClientIPMetadataKey)
+	caller.UserAgent, _ = md.Get(UserAgentMetadataKey)
+	caller.RequestID, _ = md.Get(RequestIDMetadataKey)
+	return caller
+}
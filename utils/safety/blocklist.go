@@ -0,0 +1,103 @@
+package safety
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Blocklist flags destinations listed in a local file. Each line holds either a
+// domain (which also blocks its subdomains) or a URL prefix such as
+// "https://example.com/phish". Lines starting with # are comments.
+type Blocklist struct {
+	file *watchedFile
+
+	mu      sync.RWMutex
+	domains map[string]struct{}
+	urls    []string
+}
+
+// NewBlocklist loads a blocklist file; call Watch to pick up later edits
+func NewBlocklist(path string) (*Blocklist, error) {
+	b := &Blocklist{}
+	b.file = &watchedFile{path: path, parse: b.load}
+	if err := b.file.reload(true); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Name implements Checker
+func (b *Blocklist) Name() string {
+	return "blocklist"
+}
+
+// Watch reloads the file when it changes until the context is cancelled
+func (b *Blocklist) Watch(ctx context.Context, interval time.Duration, onError func(error)) {
+	b.file.watch(ctx, interval, onError)
+}
+
+// Check implements Checker
+func (b *Blocklist) Check(_ context.Context, rawURL string) (*Verdict, error) {
+	host, err := Host(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, domain := range parentDomains(strings.TrimPrefix(host, "www.")) {
+		if _, ok := b.domains[domain]; ok {
+			return &Verdict{Reason: ReasonBlocklistedDomain, Detail: domain}, nil
+		}
+	}
+
+	normalized := normalizeURLEntry(rawURL)
+	for _, prefix := range b.urls {
+		if strings.HasPrefix(normalized, prefix) {
+			return &Verdict{Reason: ReasonBlocklistedURL, Detail: prefix}, nil
+		}
+	}
+	return nil, nil
+}
+
+// load replaces the blocklist contents
+func (b *Blocklist) load(lines []string) error {
+	domains := make(map[string]struct{})
+	var urls []string
+	for _, line := range lines {
+		if strings.Contains(line, "/") {
+			urls = append(urls, normalizeURLEntry(line))
+			continue
+		}
+		domains[strings.TrimPrefix(strings.TrimSuffix(strings.ToLower(line), "."), "www.")] = struct{}{}
+	}
+
+	b.mu.Lock()
+	b.domains = domains
+	b.urls = urls
+	b.mu.Unlock()
+	return nil
+}
+
+// normalizeURLEntry drops the scheme, "www." and fragment and lowercases the host,
+// so "HTTPS://www.Example.com/a" and "http://example.com/a" compare equal
+func normalizeURLEntry(rawURL string) string {
+	rawURL = strings.TrimSpace(rawURL)
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "http://" + rawURL
+	}
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return strings.ToLower(rawURL)
+	}
+	host := strings.TrimPrefix(strings.TrimSuffix(strings.ToLower(parsedURL.Host), "."), "www.")
+	normalized := host + parsedURL.EscapedPath()
+	if parsedURL.RawQuery != "" {
+		normalized += "?" + parsedURL.RawQuery
+	}
+	return normalized
+}
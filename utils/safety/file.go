@@ -0,0 +1,75 @@
+package safety
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// watchedFile reloads a list file whenever its modification time changes
+type watchedFile struct {
+	path  string
+	parse func(lines []string) error
+
+	mu      sync.Mutex
+	modTime time.Time
+}
+
+// reload re-reads the file if it changed since the last load
+func (w *watchedFile) reload(force bool) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	info, err := os.Stat(w.path)
+	if err != nil {
+		return err
+	}
+	if !force && info.ModTime().Equal(w.modTime) {
+		return nil
+	}
+
+	content, err := os.ReadFile(w.path)
+	if err != nil {
+		return err
+	}
+	if err := w.parse(listLines(content)); err != nil {
+		return err
+	}
+	w.modTime = info.ModTime()
+	return nil
+}
+
+// watch polls the file until the context is cancelled; reload errors keep the previous contents
+func (w *watchedFile) watch(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.reload(false); err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+// listLines returns the non-empty, non-comment lines of a list file
+func listLines(content []byte) []string {
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
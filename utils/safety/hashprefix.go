@@ -0,0 +1,158 @@
+package safety
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Hash prefix sizes allowed by the Safe Browsing format
+const (
+	minHashPrefixSize = 4
+	maxHashPrefixSize = sha256.Size
+)
+
+// HashPrefixList flags destinations whose Safe Browsing URL expressions hash to a
+// listed SHA-256 prefix. The file holds one hex-encoded prefix (4 to 32 bytes)
+// per line, as exported from a Safe Browsing threat list update.
+type HashPrefixList struct {
+	file *watchedFile
+
+	mu       sync.RWMutex
+	prefixes map[int]map[string]struct{} // prefix length -> raw prefixes
+}
+
+// NewHashPrefixList loads a hash prefix file; call Watch to pick up later updates
+func NewHashPrefixList(path string) (*HashPrefixList, error) {
+	h := &HashPrefixList{}
+	h.file = &watchedFile{path: path, parse: h.load}
+	if err := h.file.reload(true); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// Name implements Checker
+func (h *HashPrefixList) Name() string {
+	return "safe_browsing"
+}
+
+// Watch reloads the file when it changes until the context is cancelled
+func (h *HashPrefixList) Watch(ctx context.Context, interval time.Duration, onError func(error)) {
+	h.file.watch(ctx, interval, onError)
+}
+
+// Check implements Checker
+func (h *HashPrefixList) Check(_ context.Context, rawURL string) (*Verdict, error) {
+	expressions, err := URLExpressions(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for _, expression := range expressions {
+		sum := sha256.Sum256([]byte(expression))
+		for size, prefixes := range h.prefixes {
+			if _, ok := prefixes[string(sum[:size])]; ok {
+				return &Verdict{Reason: ReasonSafeBrowsing, Detail: expression}, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// load replaces the prefix set
+func (h *HashPrefixList) load(lines []string) error {
+	prefixes := make(map[int]map[string]struct{})
+	for i, line := range lines {
+		prefix, err := hex.DecodeString(line)
+		if err != nil || len(prefix) < minHashPrefixSize || len(prefix) > maxHashPrefixSize {
+			return fmt.Errorf("invalid hash prefix on entry %d", i+1)
+		}
+		if prefixes[len(prefix)] == nil {
+			prefixes[len(prefix)] = make(map[string]struct{})
+		}
+		prefixes[len(prefix)][string(prefix)] = struct{}{}
+	}
+
+	h.mu.Lock()
+	h.prefixes = prefixes
+	h.mu.Unlock()
+	return nil
+}
+
+// URLExpressions returns the host suffix / path prefix combinations that
+// Safe Browsing hashes for a URL, e.g. "a.b.example.com/1/2.html?x=1",
+// "example.com/1/" and "example.com/"
+func URLExpressions(rawURL string) ([]string, error) {
+	parsedURL, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return nil, err
+	}
+	host := strings.Trim(strings.ToLower(parsedURL.Hostname()), ".")
+	for strings.Contains(host, "..") {
+		host = strings.ReplaceAll(host, "..", ".")
+	}
+	if host == "" {
+		return nil, fmt.Errorf("URL has no host")
+	}
+
+	// Hosts: the exact host plus up to four suffixes built from the last five components
+	hosts := []string{host}
+	if net.ParseIP(host) == nil {
+		components := strings.Split(host, ".")
+		if len(components) > 5 {
+			components = components[len(components)-5:]
+		}
+		for i := 0; i < len(components)-1 && len(hosts) < 5; i++ {
+			if suffix := strings.Join(components[i:], "."); suffix != host {
+				hosts = append(hosts, suffix)
+			}
+		}
+	}
+
+	// Paths: the exact path with and without query, plus up to four prefixes from the root
+	canonicalPath := parsedURL.EscapedPath()
+	if canonicalPath == "" {
+		canonicalPath = "/"
+	}
+	trailingSlash := strings.HasSuffix(canonicalPath, "/")
+	canonicalPath = path.Clean(canonicalPath)
+	if trailingSlash && canonicalPath != "/" {
+		canonicalPath += "/"
+	}
+
+	var paths []string
+	if parsedURL.RawQuery != "" {
+		paths = append(paths, canonicalPath+"?"+parsedURL.RawQuery)
+	}
+	paths = append(paths, canonicalPath)
+	prefix := "/"
+	components := strings.Split(strings.Trim(canonicalPath, "/"), "/")
+	for i := 0; i < len(components) && i < 4; i++ {
+		if prefix != canonicalPath {
+			paths = append(paths, prefix)
+		}
+		if components[i] == "" {
+			break
+		}
+		prefix += components[i] + "/"
+	}
+
+	expressions := make([]string, 0, len(hosts)*len(paths))
+	for _, h := range hosts {
+		for _, p := range paths {
+			expressions = append(expressions, h+p)
+		}
+	}
+	return expressions, nil
+}
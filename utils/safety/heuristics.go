@@ -0,0 +1,110 @@
+package safety
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/idna"
+)
+
+// defaultShorteners are public link shorteners; pointing a short link at another
+// short link hides the real destination from every check
+var defaultShorteners = []string{
+	"bit.ly", "bitly.com", "buff.ly", "cutt.ly", "goo.gl", "is.gd", "lnkd.in", "ow.ly",
+	"rb.gy", "rebrand.ly", "shorturl.at", "t.co", "t.ly", "tiny.cc", "tinyurl.com", "v.gd",
+}
+
+// homographLetters are Cyrillic and Greek letters that render like Latin ones
+var homographLetters = map[rune]struct{}{
+	'а': {}, 'в': {}, 'е': {}, 'к': {}, 'м': {}, 'н': {}, 'о': {}, 'р': {}, 'с': {}, 'т': {},
+	'у': {}, 'х': {}, 'ѕ': {}, 'і': {}, 'ј': {}, 'һ': {}, 'ԁ': {}, 'ԛ': {}, 'ԝ': {}, 'ӏ': {},
+	'α': {}, 'ε': {}, 'ι': {}, 'κ': {}, 'ν': {}, 'ο': {}, 'ρ': {}, 'τ': {}, 'υ': {}, 'χ': {},
+}
+
+// Heuristics flags destinations that look like phishing without needing a list:
+// IDN homographs, raw IP hosts and chains through other link shorteners
+type Heuristics struct {
+	shorteners map[string]struct{}
+}
+
+// NewHeuristics creates the heuristic checker; extraShorteners adds domains to the
+// known shortener list (typically this service's own short domain)
+func NewHeuristics(extraShorteners ...string) *Heuristics {
+	h := &Heuristics{shorteners: make(map[string]struct{})}
+	for _, domain := range append(defaultShorteners, extraShorteners...) {
+		if domain = strings.ToLower(strings.TrimSpace(domain)); domain != "" {
+			h.shorteners[strings.TrimPrefix(domain, "www.")] = struct{}{}
+		}
+	}
+	return h
+}
+
+// Name implements Checker
+func (h *Heuristics) Name() string {
+	return "heuristics"
+}
+
+// Check implements Checker
+func (h *Heuristics) Check(_ context.Context, rawURL string) (*Verdict, error) {
+	host, err := Host(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := ParseIPHost(host); ok {
+		return &Verdict{Reason: ReasonIPHost, Detail: host}, nil
+	}
+
+	if label, ok := homographLabel(host); ok {
+		return &Verdict{Reason: ReasonIDNHomograph, Detail: label}, nil
+	}
+
+	for _, domain := range parentDomains(strings.TrimPrefix(host, "www.")) {
+		if _, ok := h.shorteners[domain]; ok {
+			return &Verdict{Reason: ReasonShortenerChain, Detail: domain}, nil
+		}
+	}
+	return nil, nil
+}
+
+// homographLabel returns the first host label (in Unicode form) that mixes
+// scripts or is spelled entirely with Latin look-alike letters
+func homographLabel(host string) (string, bool) {
+	unicodeHost, err := idna.ToUnicode(host)
+	if err != nil {
+		unicodeHost = host
+	}
+
+	for _, label := range strings.Split(unicodeHost, ".") {
+		scripts := make(map[string]struct{})
+		lookalikes := true
+		letters := 0
+		for _, r := range label {
+			if !unicode.IsLetter(r) {
+				continue
+			}
+			letters++
+			switch {
+			case unicode.Is(unicode.Latin, r):
+				scripts["latin"] = struct{}{}
+				lookalikes = false
+			case unicode.Is(unicode.Cyrillic, r):
+				scripts["cyrillic"] = struct{}{}
+			case unicode.Is(unicode.Greek, r):
+				scripts["greek"] = struct{}{}
+			default:
+				scripts["other"] = struct{}{}
+				lookalikes = false
+			}
+			if _, ok := homographLetters[r]; !ok {
+				lookalikes = false
+			}
+		}
+
+		if len(scripts) > 1 || (letters > 0 && lookalikes) {
+			return label, true
+		}
+	}
+	return "", false
+}
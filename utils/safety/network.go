@@ -0,0 +1,350 @@
+package safety
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Destination validation errors
+var (
+	ErrBlockedAddress   = errors.New("destination resolves to a blocked network")
+	ErrLocalHostname    = errors.New("destination is a local hostname")
+	ErrUnresolvableHost = errors.New("destination host does not resolve")
+)
+
+// ReasonPrivateNetwork is recorded when a destination points into a blocked network
+const ReasonPrivateNetwork Reason = "private_network"
+
+// defaultBlockedNetworks are never valid destinations for a public short link
+var defaultBlockedNetworks = []string{
+	"0.0.0.0/8",       // "this" network
+	"10.0.0.0/8",      // private
+	"100.64.0.0/10",   // carrier-grade NAT
+	"127.0.0.0/8",     // loopback
+	"169.254.0.0/16",  // link-local, including cloud metadata (169.254.169.254)
+	"172.16.0.0/12",   // private
+	"192.0.0.0/24",    // IETF protocol assignments
+	"192.0.2.0/24",    // documentation
+	"192.168.0.0/16",  // private
+	"198.18.0.0/15",   // benchmarking
+	"198.51.100.0/24", // documentation
+	"203.0.113.0/24",  // documentation
+	"224.0.0.0/4",     // multicast
+	"240.0.0.0/4",     // reserved and broadcast
+	"::/128",          // unspecified
+	"::1/128",         // loopback
+	"100::/64",        // discard
+	"2001:db8::/32",   // documentation
+	"fc00::/7",        // unique local (ULA), including fd00:ec2::254 metadata
+	"fe80::/10",       // link-local
+	"ff00::/8",        // multicast
+	"64:ff9b:1::/48",  // local-use NAT64
+	"2001::/32",       // Teredo tunnels (embed arbitrary IPv4)
+	"2002::/16",       // 6to4 tunnels (embed arbitrary IPv4)
+}
+
+// nat64Prefix is the well-known NAT64 prefix; its last 32 bits carry an IPv4 address
+var nat64Prefix = netip.MustParsePrefix("64:ff9b::/96")
+
+// Resolver looks up the addresses of a hostname (*net.Resolver satisfies it)
+type Resolver interface {
+	LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error)
+}
+
+// NetworkPolicy rejects destinations that point into loopback, private,
+// link-local, CGNAT, metadata or other non-public networks, resolving
+// hostnames so that "internal.corp" pointing at 10.0.0.5 is caught too
+type NetworkPolicy struct {
+	resolver Resolver
+	blocked  []netip.Prefix
+	allowed  []netip.Prefix
+}
+
+// NewNetworkPolicy creates a policy with the default blocked networks.
+// allowed networks are carved out of the blocked ones (e.g. corp ranges for
+// internal deployments). A nil resolver uses net.DefaultResolver.
+func NewNetworkPolicy(resolver Resolver, allowed ...netip.Prefix) *NetworkPolicy {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+
+	policy := &NetworkPolicy{resolver: resolver, allowed: allowed}
+	for _, network := range defaultBlockedNetworks {
+		policy.blocked = append(policy.blocked, netip.MustParsePrefix(network))
+	}
+	return policy
+}
+
+// ParseNetworks parses a comma-separated list of CIDR prefixes or single addresses
+func ParseNetworks(list string) ([]netip.Prefix, error) {
+	var networks []netip.Prefix
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if !strings.Contains(entry, "/") {
+			addr, err := netip.ParseAddr(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid network %q: %w", entry, err)
+			}
+			networks = append(networks, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		network, err := netip.ParsePrefix(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid network %q: %w", entry, err)
+		}
+		networks = append(networks, network.Masked())
+	}
+	return networks, nil
+}
+
+// Name implements Checker
+func (p *NetworkPolicy) Name() string {
+	return "network"
+}
+
+// Check implements Checker; hosts that do not resolve are reported as errors, not verdicts
+func (p *NetworkPolicy) Check(ctx context.Context, rawURL string) (*Verdict, error) {
+	err := p.ValidateURL(ctx, rawURL)
+	switch {
+	case errors.Is(err, ErrBlockedAddress), errors.Is(err, ErrLocalHostname):
+		return &Verdict{Reason: ReasonPrivateNetwork, Detail: err.Error()}, nil
+	case err != nil:
+		return nil, err
+	}
+	return nil, nil
+}
+
+// ValidateURL checks the host of a destination URL
+func (p *NetworkPolicy) ValidateURL(ctx context.Context, rawURL string) error {
+	parsedURL, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return err
+	}
+	if parsedURL.Hostname() == "" {
+		return errors.New("URL has no host")
+	}
+	return p.ValidateHost(ctx, parsedURL.Hostname())
+}
+
+// ValidateHost checks a hostname or IP literal; hostnames are resolved and
+// every returned address must be allowed
+func (p *NetworkPolicy) ValidateHost(ctx context.Context, host string) error {
+	host = strings.TrimSuffix(strings.ToLower(strings.Trim(host, "[]")), ".")
+
+	if addr, ok := ParseIPHost(host); ok {
+		if p.IsBlocked(addr) {
+			return fmt.Errorf("%w: %s", ErrBlockedAddress, addr)
+		}
+		return nil
+	}
+
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("%w: %s", ErrLocalHostname, host)
+	}
+
+	addrs, err := p.resolver.LookupNetIP(ctx, "ip", host)
+	if err != nil || len(addrs) == 0 {
+		return fmt.Errorf("%w: %s", ErrUnresolvableHost, host)
+	}
+	for _, addr := range addrs {
+		if p.IsBlocked(addr) {
+			return fmt.Errorf("%w: %s resolves to %s", ErrBlockedAddress, host, addr)
+		}
+	}
+	return nil
+}
+
+// maxCachedHosts bounds the hostname verdicts a HostCache keeps
+const maxCachedHosts = 10000
+
+// HostCache remembers the hostname verdicts of a NetworkPolicy for a short
+// time, so hot paths do not resolve the same host on every request. IP
+// literals and local hostnames are still checked on every call.
+type HostCache struct {
+	policy *NetworkPolicy
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu       sync.Mutex
+	verdicts map[string]hostVerdict
+}
+
+type hostVerdict struct {
+	err     error
+	expires time.Time
+}
+
+// NewHostCache caches the hostname verdicts of policy for ttl
+func NewHostCache(policy *NetworkPolicy, ttl time.Duration) *HostCache {
+	return &HostCache{
+		policy:   policy,
+		ttl:      ttl,
+		now:      time.Now,
+		verdicts: make(map[string]hostVerdict),
+	}
+}
+
+// ValidateHost works like NetworkPolicy.ValidateHost, answering hostnames from the cache
+func (c *HostCache) ValidateHost(ctx context.Context, host string) error {
+	name := strings.TrimSuffix(strings.ToLower(strings.Trim(host, "[]")), ".")
+	if _, ok := ParseIPHost(name); ok || name == "localhost" || strings.HasSuffix(name, ".localhost") {
+		return c.policy.ValidateHost(ctx, name)
+	}
+
+	now := c.now()
+	c.mu.Lock()
+	verdict, ok := c.verdicts[name]
+	c.mu.Unlock()
+	if ok && now.Before(verdict.expires) {
+		return verdict.err
+	}
+
+	err := c.policy.ValidateHost(ctx, name)
+	if ctx.Err() != nil {
+		// A lookup cut short by the caller says nothing about the host
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.verdicts) >= maxCachedHosts {
+		for cached, v := range c.verdicts {
+			if !now.Before(v.expires) {
+				delete(c.verdicts, cached)
+			}
+		}
+		if len(c.verdicts) >= maxCachedHosts {
+			c.verdicts = make(map[string]hostVerdict)
+		}
+	}
+	c.verdicts[name] = hostVerdict{err: err, expires: now.Add(c.ttl)}
+	return err
+}
+
+// IsBlocked reports whether an address falls in a blocked network and not in an allowed one.
+// IPv4-mapped and NAT64 addresses are checked as the IPv4 address they carry.
+func (p *NetworkPolicy) IsBlocked(addr netip.Addr) bool {
+	addr = addr.WithZone("")
+	if addr.Is4In6() {
+		addr = addr.Unmap()
+	} else if nat64Prefix.Contains(addr) {
+		raw := addr.As16()
+		addr = netip.AddrFrom4([4]byte{raw[12], raw[13], raw[14], raw[15]})
+	}
+
+	for _, network := range p.allowed {
+		if network.Contains(addr) {
+			return false
+		}
+	}
+	for _, network := range p.blocked {
+		if network.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// DialContext wraps a dialer so outgoing connections re-check the address they
+// actually connect to, which also defeats DNS rebinding between validation and fetch
+func (p *NetworkPolicy) DialContext(dialer *net.Dialer) func(ctx context.Context, network, address string) (net.Conn, error) {
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, err
+		}
+
+		addr, ok := ParseIPHost(host)
+		if !ok {
+			if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+				return nil, fmt.Errorf("%w: %s", ErrLocalHostname, host)
+			}
+			addrs, err := p.resolver.LookupNetIP(ctx, "ip", host)
+			if err != nil || len(addrs) == 0 {
+				return nil, fmt.Errorf("%w: %s", ErrUnresolvableHost, host)
+			}
+			addr = addrs[0]
+		}
+		if p.IsBlocked(addr) {
+			return nil, fmt.Errorf("%w: %s", ErrBlockedAddress, addr)
+		}
+		return dialer.DialContext(ctx, network, net.JoinHostPort(addr.String(), port))
+	}
+}
+
+// ParseIPHost parses an IP literal, including the legacy IPv4 spellings that
+// browsers and inet_aton accept: "3232235777", "0x7f.1", "0177.0.0.1", "127.1"
+func ParseIPHost(host string) (netip.Addr, bool) {
+	host = strings.Trim(host, "[]")
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return addr, true
+	}
+
+	// Browsers ignore a single trailing dot: "127.0.0.1." is 127.0.0.1
+	parts := strings.Split(strings.TrimSuffix(host, "."), ".")
+	if len(parts) > 4 {
+		return netip.Addr{}, false
+	}
+
+	values := make([]uint64, len(parts))
+	for i, part := range parts {
+		value, ok := parseIPv4Part(part)
+		if !ok {
+			return netip.Addr{}, false
+		}
+		values[i] = value
+	}
+
+	// The last part fills all remaining bytes, earlier parts are one byte each
+	var ip uint64
+	for i, value := range values[:len(values)-1] {
+		if value > 0xff {
+			return netip.Addr{}, false
+		}
+		ip |= value << (8 * (3 - i))
+	}
+	last := values[len(values)-1]
+	if last >= 1<<(8*(5-len(values))) {
+		return netip.Addr{}, false
+	}
+	ip |= last
+
+	return netip.AddrFrom4([4]byte{byte(ip >> 24), byte(ip >> 16), byte(ip >> 8), byte(ip)}), true
+}
+
+// parseIPv4Part parses one part of an IPv4 host the way the WHATWG URL parser
+// does: "0x"/"0X" hex (no digits means 0), a leading "0" for octal, decimal otherwise
+func parseIPv4Part(part string) (uint64, bool) {
+	if part == "" {
+		return 0, false
+	}
+
+	base := 10
+	switch {
+	case len(part) >= 2 && (part[:2] == "0x" || part[:2] == "0X"):
+		part, base = part[2:], 16
+		if part == "" {
+			return 0, true
+		}
+	case len(part) >= 2 && part[0] == '0':
+		part, base = part[1:], 8
+	}
+
+	// An explicit base keeps out Go-only spellings such as "0b1", "0o7" and "1_0"
+	value, err := strconv.ParseUint(part, base, 32)
+	if err != nil {
+		return 0, false
+	}
+	return value, true
+}
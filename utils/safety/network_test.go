@@ -0,0 +1,189 @@
+package safety
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeResolver map[string][]string
+
+func (f fakeResolver) LookupNetIP(_ context.Context, _, host string) ([]netip.Addr, error) {
+	ips, ok := f[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	addrs := make([]netip.Addr, len(ips))
+	for i, ip := range ips {
+		addrs[i] = netip.MustParseAddr(ip)
+	}
+	return addrs, nil
+}
+
+var testResolver = fakeResolver{
+	"example.com":     {"93.184.215.14", "2606:2800:21f:cb07:6820:80da:af6b:8b2c"},
+	"internal.corp":   {"10.20.1.5"},
+	"rebind.example":  {"93.184.215.14", "127.0.0.1"},
+	"metadata.cloud":  {"169.254.169.254"},
+	"ula.example":     {"fd00:ec2::254"},
+	"mapped.example":  {"::ffff:192.168.1.1"},
+	"nat64.example":   {"64:ff9b::a00:1"},
+	"cgnat.example":   {"100.64.3.3"},
+	"partner.example": {"10.30.0.7"},
+}
+
+func TestNetworkPolicyValidateURL(t *testing.T) {
+	ctx := context.Background()
+	policy := NewNetworkPolicy(testResolver)
+
+	allowed := []string{
+		"https://example.com/page",
+		"http://93.184.215.14/",
+		"http://[2606:2800:21f:cb07:6820:80da:af6b:8b2c]/",
+	}
+	for _, rawURL := range allowed {
+		assert.NoError(t, policy.ValidateURL(ctx, rawURL), rawURL)
+	}
+
+	blocked := []string{
+		"http://internal.corp/admin",
+		"http://rebind.example/",
+		"http://metadata.cloud/latest/meta-data/",
+		"http://169.254.169.254/latest/meta-data/",
+		"http://ula.example/",
+		"http://mapped.example/",
+		"http://nat64.example/",
+		"http://cgnat.example/",
+		"http://127.0.0.1:8080/",
+		"http://[::1]/",
+		"http://[::ffff:127.0.0.1]/",
+		"http://[fe80::1]/",
+		"http://2130706433/",   // 127.0.0.1 as a decimal
+		"http://0x7f.1/",       // 127.0.0.1 in hex, short form
+		"http://0177.0.0.1/",   // 127.0.0.1 in octal
+		"http://017700000001/", // 127.0.0.1 as a single octal number
+		"http://10.1/",         // 10.0.0.1
+	}
+	for _, rawURL := range blocked {
+		assert.ErrorIs(t, policy.ValidateURL(ctx, rawURL), ErrBlockedAddress, rawURL)
+	}
+
+	for _, rawURL := range []string{"http://localhost:3000/", "http://LOCALHOST./", "http://api.localhost/"} {
+		assert.ErrorIs(t, policy.ValidateURL(ctx, rawURL), ErrLocalHostname, rawURL)
+	}
+
+	assert.ErrorIs(t, policy.ValidateURL(ctx, "http://does-not-exist.example/"), ErrUnresolvableHost)
+}
+
+func TestNetworkPolicyAllowedNetworks(t *testing.T) {
+	ctx := context.Background()
+	allowed, err := ParseNetworks("10.30.0.0/16, 192.168.1.1")
+	require.NoError(t, err)
+
+	policy := NewNetworkPolicy(testResolver, allowed...)
+	assert.NoError(t, policy.ValidateURL(ctx, "http://partner.example/"))
+	assert.NoError(t, policy.ValidateURL(ctx, "http://mapped.example/"))
+	assert.ErrorIs(t, policy.ValidateURL(ctx, "http://internal.corp/"), ErrBlockedAddress)
+
+	_, err = ParseNetworks("10.0.0.0/33")
+	assert.Error(t, err)
+	_, err = ParseNetworks("corp")
+	assert.Error(t, err)
+}
+
+func TestNetworkPolicyCheck(t *testing.T) {
+	ctx := context.Background()
+	policy := NewNetworkPolicy(testResolver)
+
+	verdict, err := policy.Check(ctx, "http://internal.corp/")
+	require.NoError(t, err)
+	require.NotNil(t, verdict)
+	assert.Equal(t, ReasonPrivateNetwork, verdict.Reason)
+
+	verdict, err = policy.Check(ctx, "http://does-not-exist.example/")
+	assert.Nil(t, verdict)
+	assert.True(t, errors.Is(err, ErrUnresolvableHost))
+}
+
+func TestNetworkPolicyDialContext(t *testing.T) {
+	dial := NewNetworkPolicy(testResolver).DialContext(&net.Dialer{})
+
+	_, err := dial(context.Background(), "tcp", "internal.corp:80")
+	assert.ErrorIs(t, err, ErrBlockedAddress)
+	_, err = dial(context.Background(), "tcp", "[::1]:443")
+	assert.ErrorIs(t, err, ErrBlockedAddress)
+	_, err = dial(context.Background(), "tcp", "localhost:80")
+	assert.ErrorIs(t, err, ErrLocalHostname)
+}
+
+func TestParseIPHost(t *testing.T) {
+	cases := map[string]string{
+		"127.0.0.1":     "127.0.0.1",
+		"3232235777":    "192.168.1.1",
+		"0xc0a80101":    "192.168.1.1",
+		"192.168.257":   "192.168.1.1",
+		"0300.0250.1.1": "192.168.1.1",
+		"0X7F.1":        "127.0.0.1",
+		"0x":            "0.0.0.0",
+		"0x.0x.0x.0x":   "0.0.0.0",
+		"127.0.0.1.":    "127.0.0.1",
+		"::1":           "::1",
+		"[::1]":         "::1",
+	}
+	for host, want := range cases {
+		addr, ok := ParseIPHost(host)
+		require.True(t, ok, host)
+		assert.Equal(t, want, addr.String(), host)
+	}
+
+	for _, host := range []string{"example.com", "1.2.3.4.5", "256.1.1.1", "4294967296", "1.2.3.256", "",
+		"1_0", "0b1.1.1.1", "0o177.1", "08.1.1.1", "0x1g", "+1.2.3.4"} {
+		_, ok := ParseIPHost(host)
+		assert.False(t, ok, host)
+	}
+}
+
+type countingResolver struct {
+	fakeResolver
+	lookups int
+}
+
+func (c *countingResolver) LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error) {
+	c.lookups++
+	return c.fakeResolver.LookupNetIP(ctx, network, host)
+}
+
+func TestHostCache(t *testing.T) {
+	ctx := context.Background()
+	resolver := &countingResolver{fakeResolver: fakeResolver{
+		"example.com":   {"93.184.215.14"},
+		"internal.corp": {"10.20.1.5"},
+	}}
+	hosts := NewHostCache(NewNetworkPolicy(resolver), time.Minute)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	hosts.now = func() time.Time { return now }
+
+	assert.NoError(t, hosts.ValidateHost(ctx, "example.com"))
+	assert.NoError(t, hosts.ValidateHost(ctx, "EXAMPLE.com."))
+	assert.ErrorIs(t, hosts.ValidateHost(ctx, "internal.corp"), ErrBlockedAddress)
+	assert.ErrorIs(t, hosts.ValidateHost(ctx, "internal.corp"), ErrBlockedAddress)
+	assert.Equal(t, 2, resolver.lookups)
+
+	// IP literals and local names never touch the resolver or the cache
+	assert.ErrorIs(t, hosts.ValidateHost(ctx, "127.1"), ErrBlockedAddress)
+	assert.ErrorIs(t, hosts.ValidateHost(ctx, "0x"), ErrBlockedAddress)
+	assert.ErrorIs(t, hosts.ValidateHost(ctx, "localhost"), ErrLocalHostname)
+	assert.Equal(t, 2, resolver.lookups)
+
+	// Verdicts are looked up again once they expire
+	resolver.fakeResolver["example.com"] = []string{"127.0.0.1"}
+	now = now.Add(time.Minute)
+	assert.ErrorIs(t, hosts.ValidateHost(ctx, "example.com"), ErrBlockedAddress)
+	assert.Equal(t, 3, resolver.lookups)
+}
@@ -0,0 +1,98 @@
+// Package safety checks link destinations against blocklists and phishing heuristics.
+package safety
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// Reason is the code recorded when a destination is flagged
+type Reason string
+
+// Reason codes (visible to admins on disabled links)
+const (
+	ReasonBlocklistedDomain Reason = "blocklisted_domain"
+	ReasonBlocklistedURL    Reason = "blocklisted_url"
+	ReasonSafeBrowsing      Reason = "safe_browsing_match"
+	ReasonIDNHomograph      Reason = "idn_homograph"
+	ReasonIPHost            Reason = "ip_host"
+	ReasonShortenerChain    Reason = "shortener_chain"
+)
+
+// Verdict describes why a destination was flagged
+type Verdict struct {
+	Reason  Reason `json:"reason"`
+	Checker string `json:"checker"`
+	Detail  string `json:"detail,omitempty"`
+}
+
+// Checker inspects a destination URL; a nil verdict means nothing was flagged
+type Checker interface {
+	Name() string
+	Check(ctx context.Context, rawURL string) (*Verdict, error)
+}
+
+// Engine runs several checkers and reports the first one that flags a destination
+type Engine struct {
+	checkers []Checker
+}
+
+// NewEngine creates an engine from the given checkers (nil checkers are skipped)
+func NewEngine(checkers ...Checker) *Engine {
+	engine := &Engine{}
+	for _, checker := range checkers {
+		if checker != nil {
+			engine.checkers = append(engine.checkers, checker)
+		}
+	}
+	return engine
+}
+
+// Name implements Checker
+func (e *Engine) Name() string {
+	return "engine"
+}
+
+// Check implements Checker. A failing checker does not stop the others;
+// its error is returned alongside the verdict of the remaining ones.
+func (e *Engine) Check(ctx context.Context, rawURL string) (*Verdict, error) {
+	var errs []error
+	for _, checker := range e.checkers {
+		verdict, err := checker.Check(ctx, rawURL)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if verdict != nil {
+			if verdict.Checker == "" {
+				verdict.Checker = checker.Name()
+			}
+			return verdict, nil
+		}
+	}
+	return nil, errors.Join(errs...)
+}
+
+// Host returns the lowercased hostname of a URL without a trailing dot
+func Host(rawURL string) (string, error) {
+	parsedURL, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return "", err
+	}
+	if parsedURL.Hostname() == "" {
+		return "", errors.New("URL has no host")
+	}
+	return strings.TrimSuffix(strings.ToLower(parsedURL.Hostname()), "."), nil
+}
+
+// parentDomains lists a host and its parent domains, most specific first
+func parentDomains(host string) []string {
+	var domains []string
+	for strings.Contains(host, ".") {
+		domains = append(domains, host)
+		_, host, _ = strings.Cut(host, ".")
+	}
+	return domains
+}
@@ -0,0 +1,145 @@
+package safety
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeList(t *testing.T, path, content string, modTime time.Time) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestBlocklist(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "blocklist.txt")
+	writeList(t, path, "# phishing\nevil.example\nhttps://www.shared-host.example/phish\n", time.Now().Add(-time.Hour))
+
+	blocklist, err := NewBlocklist(path)
+	require.NoError(t, err)
+
+	verdict, err := blocklist.Check(ctx, "https://login.evil.example/account")
+	require.NoError(t, err)
+	require.NotNil(t, verdict)
+	assert.Equal(t, ReasonBlocklistedDomain, verdict.Reason)
+
+	verdict, _ = blocklist.Check(ctx, "http://shared-host.example/phish/page?x=1")
+	require.NotNil(t, verdict)
+	assert.Equal(t, ReasonBlocklistedURL, verdict.Reason)
+
+	verdict, _ = blocklist.Check(ctx, "https://shared-host.example/blog")
+	assert.Nil(t, verdict)
+	verdict, _ = blocklist.Check(ctx, "https://notevil.example/")
+	assert.Nil(t, verdict)
+
+	// Hot reload picks up edits by modification time
+	writeList(t, path, "notevil.example\n", time.Now())
+	require.NoError(t, blocklist.file.reload(false))
+	verdict, _ = blocklist.Check(ctx, "https://notevil.example/")
+	assert.NotNil(t, verdict)
+	verdict, _ = blocklist.Check(ctx, "https://evil.example/")
+	assert.Nil(t, verdict)
+}
+
+func TestHashPrefixList(t *testing.T) {
+	ctx := context.Background()
+	sum := sha256.Sum256([]byte("malware.example/"))
+	path := filepath.Join(t.TempDir(), "prefixes.txt")
+	writeList(t, path, hex.EncodeToString(sum[:4])+"\n", time.Now())
+
+	list, err := NewHashPrefixList(path)
+	require.NoError(t, err)
+
+	verdict, err := list.Check(ctx, "https://cdn.malware.example/download/file.exe?id=1")
+	require.NoError(t, err)
+	require.NotNil(t, verdict)
+	assert.Equal(t, ReasonSafeBrowsing, verdict.Reason)
+	assert.Equal(t, "malware.example/", verdict.Detail)
+
+	verdict, _ = list.Check(ctx, "https://example.com/")
+	assert.Nil(t, verdict)
+
+	writeList(t, path, "abc\n", time.Now().Add(time.Hour))
+	_, err = NewHashPrefixList(path)
+	assert.Error(t, err)
+}
+
+func TestURLExpressions(t *testing.T) {
+	expressions, err := URLExpressions("http://a.b.c/1/2.html?param=1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		"a.b.c/1/2.html?param=1", "a.b.c/1/2.html", "a.b.c/", "a.b.c/1/",
+		"b.c/1/2.html?param=1", "b.c/1/2.html", "b.c/", "b.c/1/",
+	}, expressions)
+
+	expressions, err = URLExpressions("http://1.2.3.4/1/")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"1.2.3.4/1/", "1.2.3.4/"}, expressions)
+}
+
+func TestHeuristics(t *testing.T) {
+	ctx := context.Background()
+	heuristics := NewHeuristics("sho.rt")
+
+	cases := map[string]Reason{
+		"http://192.168.1.10/login":          ReasonIPHost,
+		"http://[::1]:8080/":                 ReasonIPHost,
+		"http://3232235777/":                 ReasonIPHost,
+		"https://xn--80ak6aa92e.com/":        ReasonIDNHomograph, // "аррӏе" in Cyrillic
+		"https://pаypal.com/":                ReasonIDNHomograph, // mixed Latin and Cyrillic
+		"https://bit.ly/abc":                 ReasonShortenerChain,
+		"https://www.tinyurl.com/abc":        ReasonShortenerChain,
+		"https://sho.rt/abc":                 ReasonShortenerChain,
+		"https://example.com/":               "",
+		"https://xn--mnchen-3ya.de/":         "", // "münchen" is plain Latin
+		"https://xn--e1afmkfd.xn--p1ai/news": "", // "пример.рф" uses letters without Latin look-alikes
+	}
+	for rawURL, want := range cases {
+		verdict, err := heuristics.Check(ctx, rawURL)
+		require.NoError(t, err, rawURL)
+		if want == "" {
+			assert.Nil(t, verdict, rawURL)
+			continue
+		}
+		require.NotNil(t, verdict, rawURL)
+		assert.Equal(t, want, verdict.Reason, rawURL)
+	}
+}
+
+type stubChecker struct {
+	verdict *Verdict
+	err     error
+}
+
+func (s stubChecker) Name() string { return "stub" }
+
+func (s stubChecker) Check(context.Context, string) (*Verdict, error) { return s.verdict, s.err }
+
+func TestEngine(t *testing.T) {
+	ctx := context.Background()
+	failing := stubChecker{err: errors.New("list unavailable")}
+	flagging := stubChecker{verdict: &Verdict{Reason: ReasonIPHost}}
+
+	verdict, err := NewEngine(failing, nil, flagging).Check(ctx, "http://10.0.0.1/")
+	assert.NoError(t, err)
+	require.NotNil(t, verdict)
+	assert.Equal(t, "stub", verdict.Checker)
+
+	verdict, err = NewEngine(failing, stubChecker{}).Check(ctx, "https://example.com/")
+	assert.Nil(t, verdict)
+	assert.Error(t, err)
+
+	verdict, err = NewEngine().Check(ctx, "https://example.com/")
+	assert.Nil(t, verdict)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,196 @@
+// Package webhook signs and sends outbound webhook deliveries.
+//
+// A delivery is a JSON POST. Receivers check it with the secret of their
+// subscription: the X-Webhook-Signature header holds "sha256=" and the hex
+// HMAC-SHA256 of the X-Webhook-Timestamp header, a dot and the raw body.
+// Rejecting timestamps older than a few minutes stops replays.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Delivery headers
+const (
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+	TimestampHeader = "X-Webhook-Timestamp"
+	SignatureHeader = "X-Webhook-Signature"
+	signaturePrefix = "sha256="
+)
+
+// Sender defaults
+const (
+	DefaultTimeout   = 10 * time.Second
+	DefaultTolerance = 5 * time.Minute
+	MaxResponseBody  = 1 << 10 // response bytes kept for the delivery log
+	userAgent        = "go-url-shortener-webhook/1.0"
+)
+
+// Errors returned by Verify
+var (
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrStaleTimestamp   = errors.New("webhook timestamp outside the tolerance")
+)
+
+// Sign computes the signature of a body sent at timestamp (unix seconds)
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature and timestamp headers of a received delivery
+// against now; tolerance bounds the age of the timestamp (0 for DefaultTolerance)
+func Verify(secret, signature, timestamp string, body []byte, now time.Time, tolerance time.Duration) error {
+	if tolerance <= 0 {
+		tolerance = DefaultTolerance
+	}
+	sentAt, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if age := now.Sub(time.Unix(sentAt, 0)); age > tolerance || age < -tolerance {
+		return ErrStaleTimestamp
+	}
+	if !hmac.Equal([]byte(signature), []byte(Sign(secret, sentAt, body))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// ValidateURL checks that a webhook endpoint is an absolute http(s) URL.
+// Where it may point is enforced when connecting, by the sender's DialContext.
+func ValidateURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid webhook URL: %w", err)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("webhook URL must use http or https")
+	}
+	if parsed.Host == "" || parsed.User != nil {
+		return fmt.Errorf("webhook URL must have a host and no credentials")
+	}
+	return nil
+}
+
+// Options configures a Sender
+type Options struct {
+	Timeout time.Duration // per delivery
+	// DialContext replaces the transport dialer, e.g. with a network policy
+	// that refuses internal addresses
+	DialContext func(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// Request is a delivery to send
+type Request struct {
+	URL        string
+	Secret     string
+	Event      string // event type
+	DeliveryID string
+	Body       []byte
+}
+
+// Result is the outcome of sending a delivery
+type Result struct {
+	StatusCode int
+	Body       string // first MaxResponseBody bytes of the response
+	Error      string
+	Duration   time.Duration
+}
+
+// Succeeded reports whether the receiver accepted the delivery with a 2xx status
+func (r *Result) Succeeded() bool {
+	return r.Error == "" && r.StatusCode >= 200 && r.StatusCode < 300
+}
+
+// Sender posts signed deliveries. Redirects are not followed: a receiver
+// answering with one has to be reconfigured.
+type Sender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewSender creates a sender; zero options take the package defaults
+func NewSender(opts Options) *Sender {
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultTimeout
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// Connect directly: behind an environment proxy the dialer would only see
+	// the proxy's address, never the user-supplied destination
+	transport.Proxy = nil
+	if opts.DialContext != nil {
+		transport.DialContext = opts.DialContext
+	}
+
+	return &Sender{
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   opts.Timeout,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		now: time.Now,
+	}
+}
+
+// Send signs and posts a delivery
+func (s *Sender) Send(ctx context.Context, delivery Request) Result {
+	start := time.Now()
+	result := s.send(ctx, delivery)
+	result.Duration = time.Since(start)
+	return result
+}
+
+func (s *Sender) send(ctx context.Context, delivery Request) Result {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Body))
+	if err != nil {
+		return Result{Error: err.Error()}
+	}
+
+	timestamp := s.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(EventHeader, delivery.Event)
+	req.Header.Set(DeliveryHeader, delivery.DeliveryID)
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(delivery.Secret, timestamp, delivery.Body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return Result{Error: err.Error()}
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, MaxResponseBody))
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	result := Result{StatusCode: resp.StatusCode, Body: strings.ToValidUTF8(string(body), "")}
+	if resp.StatusCode >= 300 && resp.StatusCode < 400 {
+		result.Error = fmt.Sprintf("redirected to %s", resp.Header.Get("Location"))
+	}
+	return result
+}